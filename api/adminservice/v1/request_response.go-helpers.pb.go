@@ -3321,3 +3321,928 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ExportArchivedWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *ExportArchivedWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportArchivedWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *ExportArchivedWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportArchivedWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportArchivedWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportArchivedWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportArchivedWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *ExportArchivedWorkflowExecutionsRequest:
+		that1 = t
+	case ExportArchivedWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExportArchivedWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *ExportArchivedWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportArchivedWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *ExportArchivedWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportArchivedWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportArchivedWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportArchivedWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportArchivedWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *ExportArchivedWorkflowExecutionsResponse:
+		that1 = t
+	case ExportArchivedWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ArchivedWorkflowExecution to the protobuf v3 wire format
+func (val *ArchivedWorkflowExecution) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ArchivedWorkflowExecution from the protobuf v3 wire format
+func (val *ArchivedWorkflowExecution) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ArchivedWorkflowExecution) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ArchivedWorkflowExecution values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ArchivedWorkflowExecution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ArchivedWorkflowExecution
+	switch t := that.(type) {
+	case *ArchivedWorkflowExecution:
+		that1 = t
+	case ArchivedWorkflowExecution:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartArchivalVerificationRequest to the protobuf v3 wire format
+func (val *StartArchivalVerificationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartArchivalVerificationRequest from the protobuf v3 wire format
+func (val *StartArchivalVerificationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartArchivalVerificationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartArchivalVerificationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartArchivalVerificationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartArchivalVerificationRequest
+	switch t := that.(type) {
+	case *StartArchivalVerificationRequest:
+		that1 = t
+	case StartArchivalVerificationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartArchivalVerificationResponse to the protobuf v3 wire format
+func (val *StartArchivalVerificationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartArchivalVerificationResponse from the protobuf v3 wire format
+func (val *StartArchivalVerificationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartArchivalVerificationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartArchivalVerificationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartArchivalVerificationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartArchivalVerificationResponse
+	switch t := that.(type) {
+	case *StartArchivalVerificationResponse:
+		that1 = t
+	case StartArchivalVerificationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartPersistenceMigrationRequest to the protobuf v3 wire format
+func (val *StartPersistenceMigrationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartPersistenceMigrationRequest from the protobuf v3 wire format
+func (val *StartPersistenceMigrationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartPersistenceMigrationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartPersistenceMigrationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartPersistenceMigrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartPersistenceMigrationRequest
+	switch t := that.(type) {
+	case *StartPersistenceMigrationRequest:
+		that1 = t
+	case StartPersistenceMigrationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartPersistenceMigrationResponse to the protobuf v3 wire format
+func (val *StartPersistenceMigrationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartPersistenceMigrationResponse from the protobuf v3 wire format
+func (val *StartPersistenceMigrationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartPersistenceMigrationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartPersistenceMigrationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartPersistenceMigrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartPersistenceMigrationResponse
+	switch t := that.(type) {
+	case *StartPersistenceMigrationResponse:
+		that1 = t
+	case StartPersistenceMigrationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribePersistenceMigrationRequest to the protobuf v3 wire format
+func (val *DescribePersistenceMigrationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribePersistenceMigrationRequest from the protobuf v3 wire format
+func (val *DescribePersistenceMigrationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribePersistenceMigrationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribePersistenceMigrationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribePersistenceMigrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribePersistenceMigrationRequest
+	switch t := that.(type) {
+	case *DescribePersistenceMigrationRequest:
+		that1 = t
+	case DescribePersistenceMigrationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribePersistenceMigrationResponse to the protobuf v3 wire format
+func (val *DescribePersistenceMigrationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribePersistenceMigrationResponse from the protobuf v3 wire format
+func (val *DescribePersistenceMigrationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribePersistenceMigrationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribePersistenceMigrationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribePersistenceMigrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribePersistenceMigrationResponse
+	switch t := that.(type) {
+	case *DescribePersistenceMigrationResponse:
+		that1 = t
+	case DescribePersistenceMigrationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PersistenceMigrationShardProgress to the protobuf v3 wire format
+func (val *PersistenceMigrationShardProgress) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PersistenceMigrationShardProgress from the protobuf v3 wire format
+func (val *PersistenceMigrationShardProgress) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PersistenceMigrationShardProgress) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PersistenceMigrationShardProgress values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PersistenceMigrationShardProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PersistenceMigrationShardProgress
+	switch t := that.(type) {
+	case *PersistenceMigrationShardProgress:
+		that1 = t
+	case PersistenceMigrationShardProgress:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryPersistenceUsageRequest to the protobuf v3 wire format
+func (val *DescribeHistoryPersistenceUsageRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryPersistenceUsageRequest from the protobuf v3 wire format
+func (val *DescribeHistoryPersistenceUsageRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryPersistenceUsageRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryPersistenceUsageRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryPersistenceUsageRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryPersistenceUsageRequest
+	switch t := that.(type) {
+	case *DescribeHistoryPersistenceUsageRequest:
+		that1 = t
+	case DescribeHistoryPersistenceUsageRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryPersistenceUsageResponse to the protobuf v3 wire format
+func (val *DescribeHistoryPersistenceUsageResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryPersistenceUsageResponse from the protobuf v3 wire format
+func (val *DescribeHistoryPersistenceUsageResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryPersistenceUsageResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryPersistenceUsageResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryPersistenceUsageResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryPersistenceUsageResponse
+	switch t := that.(type) {
+	case *DescribeHistoryPersistenceUsageResponse:
+		that1 = t
+	case DescribeHistoryPersistenceUsageResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryHostPersistenceUsage to the protobuf v3 wire format
+func (val *HistoryHostPersistenceUsage) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryHostPersistenceUsage from the protobuf v3 wire format
+func (val *HistoryHostPersistenceUsage) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryHostPersistenceUsage) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryHostPersistenceUsage values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryHostPersistenceUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryHostPersistenceUsage
+	switch t := that.(type) {
+	case *HistoryHostPersistenceUsage:
+		that1 = t
+	case HistoryHostPersistenceUsage:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigOverridesRequest to the protobuf v3 wire format
+func (val *GetDynamicConfigOverridesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigOverridesRequest from the protobuf v3 wire format
+func (val *GetDynamicConfigOverridesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigOverridesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigOverridesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigOverridesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigOverridesRequest
+	switch t := that.(type) {
+	case *GetDynamicConfigOverridesRequest:
+		that1 = t
+	case GetDynamicConfigOverridesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigOverridesResponse to the protobuf v3 wire format
+func (val *GetDynamicConfigOverridesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigOverridesResponse from the protobuf v3 wire format
+func (val *GetDynamicConfigOverridesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigOverridesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigOverridesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigOverridesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigOverridesResponse
+	switch t := that.(type) {
+	case *GetDynamicConfigOverridesResponse:
+		that1 = t
+	case GetDynamicConfigOverridesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigOverrideRequest to the protobuf v3 wire format
+func (val *SetDynamicConfigOverrideRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigOverrideRequest from the protobuf v3 wire format
+func (val *SetDynamicConfigOverrideRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigOverrideRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigOverrideRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigOverrideRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigOverrideRequest
+	switch t := that.(type) {
+	case *SetDynamicConfigOverrideRequest:
+		that1 = t
+	case SetDynamicConfigOverrideRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigOverrideResponse to the protobuf v3 wire format
+func (val *SetDynamicConfigOverrideResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigOverrideResponse from the protobuf v3 wire format
+func (val *SetDynamicConfigOverrideResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigOverrideResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigOverrideResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigOverrideResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigOverrideResponse
+	switch t := that.(type) {
+	case *SetDynamicConfigOverrideResponse:
+		that1 = t
+	case SetDynamicConfigOverrideResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteDynamicConfigOverrideRequest to the protobuf v3 wire format
+func (val *DeleteDynamicConfigOverrideRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteDynamicConfigOverrideRequest from the protobuf v3 wire format
+func (val *DeleteDynamicConfigOverrideRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteDynamicConfigOverrideRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteDynamicConfigOverrideRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteDynamicConfigOverrideRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteDynamicConfigOverrideRequest
+	switch t := that.(type) {
+	case *DeleteDynamicConfigOverrideRequest:
+		that1 = t
+	case DeleteDynamicConfigOverrideRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteDynamicConfigOverrideResponse to the protobuf v3 wire format
+func (val *DeleteDynamicConfigOverrideResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteDynamicConfigOverrideResponse from the protobuf v3 wire format
+func (val *DeleteDynamicConfigOverrideResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteDynamicConfigOverrideResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteDynamicConfigOverrideResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteDynamicConfigOverrideResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteDynamicConfigOverrideResponse
+	switch t := that.(type) {
+	case *DeleteDynamicConfigOverrideResponse:
+		that1 = t
+	case DeleteDynamicConfigOverrideResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type EvaluateDynamicConfigRequest to the protobuf v3 wire format
+func (val *EvaluateDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type EvaluateDynamicConfigRequest from the protobuf v3 wire format
+func (val *EvaluateDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *EvaluateDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two EvaluateDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *EvaluateDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *EvaluateDynamicConfigRequest
+	switch t := that.(type) {
+	case *EvaluateDynamicConfigRequest:
+		that1 = t
+	case EvaluateDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type EvaluateDynamicConfigResponse to the protobuf v3 wire format
+func (val *EvaluateDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type EvaluateDynamicConfigResponse from the protobuf v3 wire format
+func (val *EvaluateDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *EvaluateDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two EvaluateDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *EvaluateDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *EvaluateDynamicConfigResponse
+	switch t := that.(type) {
+	case *EvaluateDynamicConfigResponse:
+		that1 = t
+	case EvaluateDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigSettingsRequest to the protobuf v3 wire format
+func (val *ListDynamicConfigSettingsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigSettingsRequest from the protobuf v3 wire format
+func (val *ListDynamicConfigSettingsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigSettingsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigSettingsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigSettingsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigSettingsRequest
+	switch t := that.(type) {
+	case *ListDynamicConfigSettingsRequest:
+		that1 = t
+	case ListDynamicConfigSettingsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigSettingsResponse to the protobuf v3 wire format
+func (val *ListDynamicConfigSettingsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigSettingsResponse from the protobuf v3 wire format
+func (val *ListDynamicConfigSettingsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigSettingsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigSettingsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigSettingsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigSettingsResponse
+	switch t := that.(type) {
+	case *ListDynamicConfigSettingsResponse:
+		that1 = t
+	case ListDynamicConfigSettingsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityMigrationRequest to the protobuf v3 wire format
+func (val *StartVisibilityMigrationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityMigrationRequest from the protobuf v3 wire format
+func (val *StartVisibilityMigrationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityMigrationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityMigrationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityMigrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityMigrationRequest
+	switch t := that.(type) {
+	case *StartVisibilityMigrationRequest:
+		that1 = t
+	case StartVisibilityMigrationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityMigrationResponse to the protobuf v3 wire format
+func (val *StartVisibilityMigrationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityMigrationResponse from the protobuf v3 wire format
+func (val *StartVisibilityMigrationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityMigrationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityMigrationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityMigrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityMigrationResponse
+	switch t := that.(type) {
+	case *StartVisibilityMigrationResponse:
+		that1 = t
+	case StartVisibilityMigrationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -85,6 +85,17 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_ExportArchivedWorkflowExecutions_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/ExportArchivedWorkflowExecutions"
+	AdminService_StartArchivalVerification_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/StartArchivalVerification"
+	AdminService_StartVisibilityMigration_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/StartVisibilityMigration"
+	AdminService_StartPersistenceMigration_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/StartPersistenceMigration"
+	AdminService_DescribePersistenceMigration_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/DescribePersistenceMigration"
+	AdminService_DescribeHistoryPersistenceUsage_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryPersistenceUsage"
+	AdminService_GetDynamicConfigOverrides_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfigOverrides"
+	AdminService_SetDynamicConfigOverride_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfigOverride"
+	AdminService_DeleteDynamicConfigOverride_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfigOverride"
+	AdminService_EvaluateDynamicConfig_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/EvaluateDynamicConfig"
+	AdminService_ListDynamicConfigSettings_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigSettings"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -175,6 +186,38 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// ExportArchivedWorkflowExecutions returns a page of archived visibility records of a namespace together with
+	// the archived history of each execution, read through the namespace's configured archivers.
+	ExportArchivedWorkflowExecutions(ctx context.Context, in *ExportArchivedWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ExportArchivedWorkflowExecutionsResponse, error)
+	// StartArchivalVerification starts the archival verifier workflow for a namespace. The workflow reads back
+	// the archived histories selected by the query and reports the ones that are corrupted or missing.
+	StartArchivalVerification(ctx context.Context, in *StartArchivalVerificationRequest, opts ...grpc.CallOption) (*StartArchivalVerificationResponse, error)
+	// StartVisibilityMigration starts the visibility migration workflow for a namespace. The workflow copies
+	// the records of the primary visibility store which are missing or stale in the secondary one, then
+	// reports the records which still differ between both stores.
+	StartVisibilityMigration(ctx context.Context, in *StartVisibilityMigrationRequest, opts ...grpc.CallOption) (*StartVisibilityMigrationResponse, error)
+	// StartPersistenceMigration starts the persistence migration workflow, which copies history shards and
+	// metadata from the default data store to the migration target store, verifies them and cuts the shards
+	// over one by one. The cluster must be configured with a data store migration.
+	StartPersistenceMigration(ctx context.Context, in *StartPersistenceMigrationRequest, opts ...grpc.CallOption) (*StartPersistenceMigrationResponse, error)
+	// DescribePersistenceMigration returns the progress of the persistence migration workflow.
+	DescribePersistenceMigration(ctx context.Context, in *DescribePersistenceMigrationRequest, opts ...grpc.CallOption) (*DescribePersistenceMigrationResponse, error)
+	// DescribeHistoryPersistenceUsage returns the persistence usage of each namespace by operation class, summed
+	// over the available history hosts. The usage of frontend, matching and worker hosts is not included.
+	DescribeHistoryPersistenceUsage(ctx context.Context, in *DescribeHistoryPersistenceUsageRequest, opts ...grpc.CallOption) (*DescribeHistoryPersistenceUsageResponse, error)
+	// GetDynamicConfigOverrides returns the dynamic config overrides stored in the cluster metadata, and the audit
+	// log of their recent changes.
+	GetDynamicConfigOverrides(ctx context.Context, in *GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*GetDynamicConfigOverridesResponse, error)
+	// SetDynamicConfigOverride creates or replaces the dynamic config override of a key and constraints. Overrides
+	// take precedence over the values of the dynamic config file, and are propagated to all hosts of the cluster.
+	SetDynamicConfigOverride(ctx context.Context, in *SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*SetDynamicConfigOverrideResponse, error)
+	// DeleteDynamicConfigOverride deletes the dynamic config override of a key and constraints.
+	DeleteDynamicConfigOverride(ctx context.Context, in *DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigOverrideResponse, error)
+	// EvaluateDynamicConfig returns the effective value of a dynamic config setting on a frontend or history host for
+	// the given constraints, and the configured value it was taken from.
+	EvaluateDynamicConfig(ctx context.Context, in *EvaluateDynamicConfigRequest, opts ...grpc.CallOption) (*EvaluateDynamicConfigResponse, error)
+	// ListDynamicConfigSettings returns the registered dynamic config settings with their defaults.
+	ListDynamicConfigSettings(ctx context.Context, in *ListDynamicConfigSettingsRequest, opts ...grpc.CallOption) (*ListDynamicConfigSettingsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -594,6 +637,105 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) ExportArchivedWorkflowExecutions(ctx context.Context, in *ExportArchivedWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ExportArchivedWorkflowExecutionsResponse, error) {
+	out := new(ExportArchivedWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExportArchivedWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartArchivalVerification(ctx context.Context, in *StartArchivalVerificationRequest, opts ...grpc.CallOption) (*StartArchivalVerificationResponse, error) {
+	out := new(StartArchivalVerificationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartArchivalVerification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartVisibilityMigration(ctx context.Context, in *StartVisibilityMigrationRequest, opts ...grpc.CallOption) (*StartVisibilityMigrationResponse, error) {
+	out := new(StartVisibilityMigrationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartVisibilityMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartPersistenceMigration(ctx context.Context, in *StartPersistenceMigrationRequest, opts ...grpc.CallOption) (*StartPersistenceMigrationResponse, error) {
+	out := new(StartPersistenceMigrationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartPersistenceMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribePersistenceMigration(ctx context.Context, in *DescribePersistenceMigrationRequest, opts ...grpc.CallOption) (*DescribePersistenceMigrationResponse, error) {
+	out := new(DescribePersistenceMigrationResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribePersistenceMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeHistoryPersistenceUsage(ctx context.Context, in *DescribeHistoryPersistenceUsageRequest, opts ...grpc.CallOption) (*DescribeHistoryPersistenceUsageResponse, error) {
+	out := new(DescribeHistoryPersistenceUsageResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeHistoryPersistenceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfigOverrides(ctx context.Context, in *GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*GetDynamicConfigOverridesResponse, error) {
+	out := new(GetDynamicConfigOverridesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDynamicConfigOverrides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfigOverride(ctx context.Context, in *SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*SetDynamicConfigOverrideResponse, error) {
+	out := new(SetDynamicConfigOverrideResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetDynamicConfigOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDynamicConfigOverride(ctx context.Context, in *DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigOverrideResponse, error) {
+	out := new(DeleteDynamicConfigOverrideResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteDynamicConfigOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EvaluateDynamicConfig(ctx context.Context, in *EvaluateDynamicConfigRequest, opts ...grpc.CallOption) (*EvaluateDynamicConfigResponse, error) {
+	out := new(EvaluateDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_EvaluateDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfigSettings(ctx context.Context, in *ListDynamicConfigSettingsRequest, opts ...grpc.CallOption) (*ListDynamicConfigSettingsResponse, error) {
+	out := new(ListDynamicConfigSettingsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDynamicConfigSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -682,6 +824,38 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// ExportArchivedWorkflowExecutions returns a page of archived visibility records of a namespace together with
+	// the archived history of each execution, read through the namespace's configured archivers.
+	ExportArchivedWorkflowExecutions(context.Context, *ExportArchivedWorkflowExecutionsRequest) (*ExportArchivedWorkflowExecutionsResponse, error)
+	// StartArchivalVerification starts the archival verifier workflow for a namespace. The workflow reads back
+	// the archived histories selected by the query and reports the ones that are corrupted or missing.
+	StartArchivalVerification(context.Context, *StartArchivalVerificationRequest) (*StartArchivalVerificationResponse, error)
+	// StartVisibilityMigration starts the visibility migration workflow for a namespace. The workflow copies
+	// the records of the primary visibility store which are missing or stale in the secondary one, then
+	// reports the records which still differ between both stores.
+	StartVisibilityMigration(context.Context, *StartVisibilityMigrationRequest) (*StartVisibilityMigrationResponse, error)
+	// StartPersistenceMigration starts the persistence migration workflow, which copies history shards and
+	// metadata from the default data store to the migration target store, verifies them and cuts the shards
+	// over one by one. The cluster must be configured with a data store migration.
+	StartPersistenceMigration(context.Context, *StartPersistenceMigrationRequest) (*StartPersistenceMigrationResponse, error)
+	// DescribePersistenceMigration returns the progress of the persistence migration workflow.
+	DescribePersistenceMigration(context.Context, *DescribePersistenceMigrationRequest) (*DescribePersistenceMigrationResponse, error)
+	// DescribeHistoryPersistenceUsage returns the persistence usage of each namespace by operation class, summed
+	// over the available history hosts. The usage of frontend, matching and worker hosts is not included.
+	DescribeHistoryPersistenceUsage(context.Context, *DescribeHistoryPersistenceUsageRequest) (*DescribeHistoryPersistenceUsageResponse, error)
+	// GetDynamicConfigOverrides returns the dynamic config overrides stored in the cluster metadata, and the audit
+	// log of their recent changes.
+	GetDynamicConfigOverrides(context.Context, *GetDynamicConfigOverridesRequest) (*GetDynamicConfigOverridesResponse, error)
+	// SetDynamicConfigOverride creates or replaces the dynamic config override of a key and constraints. Overrides
+	// take precedence over the values of the dynamic config file, and are propagated to all hosts of the cluster.
+	SetDynamicConfigOverride(context.Context, *SetDynamicConfigOverrideRequest) (*SetDynamicConfigOverrideResponse, error)
+	// DeleteDynamicConfigOverride deletes the dynamic config override of a key and constraints.
+	DeleteDynamicConfigOverride(context.Context, *DeleteDynamicConfigOverrideRequest) (*DeleteDynamicConfigOverrideResponse, error)
+	// EvaluateDynamicConfig returns the effective value of a dynamic config setting on a frontend or history host for
+	// the given constraints, and the configured value it was taken from.
+	EvaluateDynamicConfig(context.Context, *EvaluateDynamicConfigRequest) (*EvaluateDynamicConfigResponse, error)
+	// ListDynamicConfigSettings returns the registered dynamic config settings with their defaults.
+	ListDynamicConfigSettings(context.Context, *ListDynamicConfigSettingsRequest) (*ListDynamicConfigSettingsResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -818,6 +992,39 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) ExportArchivedWorkflowExecutions(context.Context, *ExportArchivedWorkflowExecutionsRequest) (*ExportArchivedWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportArchivedWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) StartArchivalVerification(context.Context, *StartArchivalVerificationRequest) (*StartArchivalVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartArchivalVerification not implemented")
+}
+func (UnimplementedAdminServiceServer) StartVisibilityMigration(context.Context, *StartVisibilityMigrationRequest) (*StartVisibilityMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVisibilityMigration not implemented")
+}
+func (UnimplementedAdminServiceServer) StartPersistenceMigration(context.Context, *StartPersistenceMigrationRequest) (*StartPersistenceMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPersistenceMigration not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribePersistenceMigration(context.Context, *DescribePersistenceMigrationRequest) (*DescribePersistenceMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribePersistenceMigration not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeHistoryPersistenceUsage(context.Context, *DescribeHistoryPersistenceUsageRequest) (*DescribeHistoryPersistenceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryPersistenceUsage not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDynamicConfigOverrides(context.Context, *GetDynamicConfigOverridesRequest) (*GetDynamicConfigOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfigOverrides not implemented")
+}
+func (UnimplementedAdminServiceServer) SetDynamicConfigOverride(context.Context, *SetDynamicConfigOverrideRequest) (*SetDynamicConfigOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfigOverride not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteDynamicConfigOverride(context.Context, *DeleteDynamicConfigOverrideRequest) (*DeleteDynamicConfigOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDynamicConfigOverride not implemented")
+}
+func (UnimplementedAdminServiceServer) EvaluateDynamicConfig(context.Context, *EvaluateDynamicConfigRequest) (*EvaluateDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDynamicConfigSettings(context.Context, *ListDynamicConfigSettingsRequest) (*ListDynamicConfigSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfigSettings not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1613,6 +1820,204 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ExportArchivedWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportArchivedWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportArchivedWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExportArchivedWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportArchivedWorkflowExecutions(ctx, req.(*ExportArchivedWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartArchivalVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartArchivalVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartArchivalVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartArchivalVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartArchivalVerification(ctx, req.(*StartArchivalVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartVisibilityMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartVisibilityMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartVisibilityMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartVisibilityMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartVisibilityMigration(ctx, req.(*StartVisibilityMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartPersistenceMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPersistenceMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartPersistenceMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartPersistenceMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartPersistenceMigration(ctx, req.(*StartPersistenceMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribePersistenceMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribePersistenceMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribePersistenceMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribePersistenceMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribePersistenceMigration(ctx, req.(*DescribePersistenceMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeHistoryPersistenceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryPersistenceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeHistoryPersistenceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeHistoryPersistenceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeHistoryPersistenceUsage(ctx, req.(*DescribeHistoryPersistenceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDynamicConfigOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfigOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDynamicConfigOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfigOverrides(ctx, req.(*GetDynamicConfigOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfigOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfigOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetDynamicConfigOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfigOverride(ctx, req.(*SetDynamicConfigOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteDynamicConfigOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDynamicConfigOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteDynamicConfigOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteDynamicConfigOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteDynamicConfigOverride(ctx, req.(*DeleteDynamicConfigOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EvaluateDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EvaluateDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EvaluateDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EvaluateDynamicConfig(ctx, req.(*EvaluateDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfigSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfigSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDynamicConfigSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfigSettings(ctx, req.(*ListDynamicConfigSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1788,6 +2193,50 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "ExportArchivedWorkflowExecutions",
+			Handler:    _AdminService_ExportArchivedWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "StartArchivalVerification",
+			Handler:    _AdminService_StartArchivalVerification_Handler,
+		},
+		{
+			MethodName: "StartVisibilityMigration",
+			Handler:    _AdminService_StartVisibilityMigration_Handler,
+		},
+		{
+			MethodName: "StartPersistenceMigration",
+			Handler:    _AdminService_StartPersistenceMigration_Handler,
+		},
+		{
+			MethodName: "DescribePersistenceMigration",
+			Handler:    _AdminService_DescribePersistenceMigration_Handler,
+		},
+		{
+			MethodName: "DescribeHistoryPersistenceUsage",
+			Handler:    _AdminService_DescribeHistoryPersistenceUsage_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfigOverrides",
+			Handler:    _AdminService_GetDynamicConfigOverrides_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfigOverride",
+			Handler:    _AdminService_SetDynamicConfigOverride_Handler,
+		},
+		{
+			MethodName: "DeleteDynamicConfigOverride",
+			Handler:    _AdminService_DeleteDynamicConfigOverride_Handler,
+		},
+		{
+			MethodName: "EvaluateDynamicConfig",
+			Handler:    _AdminService_EvaluateDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfigSettings",
+			Handler:    _AdminService_ListDynamicConfigSettings_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
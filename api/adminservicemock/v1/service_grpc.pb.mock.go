@@ -186,6 +186,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceClient) DeleteDynamicConfigOverride(ctx context.Context, in *adminservice.DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDynamicConfigOverride", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfigOverride indicates an expected call of DeleteDynamicConfigOverride.
+func (mr *MockAdminServiceClientMockRecorder) DeleteDynamicConfigOverride(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteDynamicConfigOverride), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -266,6 +286,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryHost(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryHost), varargs...)
 }
 
+// DescribeHistoryPersistenceUsage mocks base method.
+func (m *MockAdminServiceClient) DescribeHistoryPersistenceUsage(ctx context.Context, in *adminservice.DescribeHistoryPersistenceUsageRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryPersistenceUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHistoryPersistenceUsage", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryPersistenceUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryPersistenceUsage indicates an expected call of DescribeHistoryPersistenceUsage.
+func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryPersistenceUsage(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryPersistenceUsage", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryPersistenceUsage), varargs...)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceClient) DescribeMutableState(ctx context.Context, in *adminservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +326,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribePersistenceMigration mocks base method.
+func (m *MockAdminServiceClient) DescribePersistenceMigration(ctx context.Context, in *adminservice.DescribePersistenceMigrationRequest, opts ...grpc.CallOption) (*adminservice.DescribePersistenceMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePersistenceMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribePersistenceMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceMigration indicates an expected call of DescribePersistenceMigration.
+func (mr *MockAdminServiceClientMockRecorder) DescribePersistenceMigration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribePersistenceMigration), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +366,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// EvaluateDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) EvaluateDynamicConfig(ctx context.Context, in *adminservice.EvaluateDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.EvaluateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvaluateDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.EvaluateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateDynamicConfig indicates an expected call of EvaluateDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) EvaluateDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).EvaluateDynamicConfig), varargs...)
+}
+
+// ExportArchivedWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) ExportArchivedWorkflowExecutions(ctx context.Context, in *adminservice.ExportArchivedWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.ExportArchivedWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportArchivedWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExportArchivedWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportArchivedWorkflowExecutions indicates an expected call of ExportArchivedWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) ExportArchivedWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportArchivedWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ExportArchivedWorkflowExecutions), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -406,6 +506,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQTasks(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQTasks), varargs...)
 }
 
+// GetDynamicConfigOverrides mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfigOverrides(ctx context.Context, in *adminservice.GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfigOverrides", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfigOverrides indicates an expected call of GetDynamicConfigOverrides.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfigOverrides(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfigOverrides", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfigOverrides), varargs...)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceClient) GetNamespace(ctx context.Context, in *adminservice.GetNamespaceRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -626,6 +746,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
 }
 
+// ListDynamicConfigSettings mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfigSettings(ctx context.Context, in *adminservice.ListDynamicConfigSettingsRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigSettings", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigSettings indicates an expected call of ListDynamicConfigSettings.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfigSettings(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigSettings", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfigSettings), varargs...)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -886,6 +1026,86 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// SetDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfigOverride(ctx context.Context, in *adminservice.SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfigOverride", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfigOverride indicates an expected call of SetDynamicConfigOverride.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfigOverride(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfigOverride), varargs...)
+}
+
+// StartArchivalVerification mocks base method.
+func (m *MockAdminServiceClient) StartArchivalVerification(ctx context.Context, in *adminservice.StartArchivalVerificationRequest, opts ...grpc.CallOption) (*adminservice.StartArchivalVerificationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartArchivalVerification", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartArchivalVerificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartArchivalVerification indicates an expected call of StartArchivalVerification.
+func (mr *MockAdminServiceClientMockRecorder) StartArchivalVerification(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartArchivalVerification", reflect.TypeOf((*MockAdminServiceClient)(nil).StartArchivalVerification), varargs...)
+}
+
+// StartPersistenceMigration mocks base method.
+func (m *MockAdminServiceClient) StartPersistenceMigration(ctx context.Context, in *adminservice.StartPersistenceMigrationRequest, opts ...grpc.CallOption) (*adminservice.StartPersistenceMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartPersistenceMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartPersistenceMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPersistenceMigration indicates an expected call of StartPersistenceMigration.
+func (mr *MockAdminServiceClientMockRecorder) StartPersistenceMigration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPersistenceMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).StartPersistenceMigration), varargs...)
+}
+
+// StartVisibilityMigration mocks base method.
+func (m *MockAdminServiceClient) StartVisibilityMigration(ctx context.Context, in *adminservice.StartVisibilityMigrationRequest, opts ...grpc.CallOption) (*adminservice.StartVisibilityMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartVisibilityMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityMigration indicates an expected call of StartVisibilityMigration.
+func (mr *MockAdminServiceClientMockRecorder) StartVisibilityMigration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).StartVisibilityMigration), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -1176,6 +1396,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceServer) DeleteDynamicConfigOverride(arg0 context.Context, arg1 *adminservice.DeleteDynamicConfigOverrideRequest) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDynamicConfigOverride", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfigOverride indicates an expected call of DeleteDynamicConfigOverride.
+func (mr *MockAdminServiceServerMockRecorder) DeleteDynamicConfigOverride(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteDynamicConfigOverride), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1236,6 +1471,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
 }
 
+// DescribeHistoryPersistenceUsage mocks base method.
+func (m *MockAdminServiceServer) DescribeHistoryPersistenceUsage(arg0 context.Context, arg1 *adminservice.DescribeHistoryPersistenceUsageRequest) (*adminservice.DescribeHistoryPersistenceUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryPersistenceUsage", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryPersistenceUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryPersistenceUsage indicates an expected call of DescribeHistoryPersistenceUsage.
+func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryPersistenceUsage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryPersistenceUsage", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryPersistenceUsage), arg0, arg1)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1251,6 +1501,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribePersistenceMigration mocks base method.
+func (m *MockAdminServiceServer) DescribePersistenceMigration(arg0 context.Context, arg1 *adminservice.DescribePersistenceMigrationRequest) (*adminservice.DescribePersistenceMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePersistenceMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribePersistenceMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceMigration indicates an expected call of DescribePersistenceMigration.
+func (mr *MockAdminServiceServerMockRecorder) DescribePersistenceMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribePersistenceMigration), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1266,6 +1531,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// EvaluateDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) EvaluateDynamicConfig(arg0 context.Context, arg1 *adminservice.EvaluateDynamicConfigRequest) (*adminservice.EvaluateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.EvaluateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateDynamicConfig indicates an expected call of EvaluateDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) EvaluateDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).EvaluateDynamicConfig), arg0, arg1)
+}
+
+// ExportArchivedWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) ExportArchivedWorkflowExecutions(arg0 context.Context, arg1 *adminservice.ExportArchivedWorkflowExecutionsRequest) (*adminservice.ExportArchivedWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportArchivedWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExportArchivedWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportArchivedWorkflowExecutions indicates an expected call of ExportArchivedWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) ExportArchivedWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportArchivedWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ExportArchivedWorkflowExecutions), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1341,6 +1636,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQTasks(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQTasks), arg0, arg1)
 }
 
+// GetDynamicConfigOverrides mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfigOverrides(arg0 context.Context, arg1 *adminservice.GetDynamicConfigOverridesRequest) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfigOverrides", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfigOverrides indicates an expected call of GetDynamicConfigOverrides.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfigOverrides(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfigOverrides", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfigOverrides), arg0, arg1)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceServer) GetNamespace(arg0 context.Context, arg1 *adminservice.GetNamespaceRequest) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -1506,6 +1816,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
 }
 
+// ListDynamicConfigSettings mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfigSettings(arg0 context.Context, arg1 *adminservice.ListDynamicConfigSettingsRequest) (*adminservice.ListDynamicConfigSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigSettings", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigSettings indicates an expected call of ListDynamicConfigSettings.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfigSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigSettings", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfigSettings), arg0, arg1)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1701,6 +2026,66 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// SetDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfigOverride(arg0 context.Context, arg1 *adminservice.SetDynamicConfigOverrideRequest) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDynamicConfigOverride", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfigOverride indicates an expected call of SetDynamicConfigOverride.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfigOverride(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfigOverride), arg0, arg1)
+}
+
+// StartArchivalVerification mocks base method.
+func (m *MockAdminServiceServer) StartArchivalVerification(arg0 context.Context, arg1 *adminservice.StartArchivalVerificationRequest) (*adminservice.StartArchivalVerificationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartArchivalVerification", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartArchivalVerificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartArchivalVerification indicates an expected call of StartArchivalVerification.
+func (mr *MockAdminServiceServerMockRecorder) StartArchivalVerification(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartArchivalVerification", reflect.TypeOf((*MockAdminServiceServer)(nil).StartArchivalVerification), arg0, arg1)
+}
+
+// StartPersistenceMigration mocks base method.
+func (m *MockAdminServiceServer) StartPersistenceMigration(arg0 context.Context, arg1 *adminservice.StartPersistenceMigrationRequest) (*adminservice.StartPersistenceMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPersistenceMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartPersistenceMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPersistenceMigration indicates an expected call of StartPersistenceMigration.
+func (mr *MockAdminServiceServerMockRecorder) StartPersistenceMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPersistenceMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).StartPersistenceMigration), arg0, arg1)
+}
+
+// StartVisibilityMigration mocks base method.
+func (m *MockAdminServiceServer) StartVisibilityMigration(arg0 context.Context, arg1 *adminservice.StartVisibilityMigrationRequest) (*adminservice.StartVisibilityMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVisibilityMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityMigration indicates an expected call of StartVisibilityMigration.
+func (mr *MockAdminServiceServerMockRecorder) StartVisibilityMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).StartVisibilityMigration), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
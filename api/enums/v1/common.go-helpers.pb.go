@@ -66,6 +66,27 @@ func ChecksumFlavorFromString(s string) (ChecksumFlavor, error) {
 	return ChecksumFlavor(0), fmt.Errorf("%s is not a valid ChecksumFlavor", s)
 }
 
+var (
+	DataStoreMigrationState_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Backfilled":  1,
+		"Verified":    2,
+		"CuttingOver": 3,
+		"CutOver":     4,
+	}
+)
+
+// DataStoreMigrationStateFromString parses a DataStoreMigrationState value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to DataStoreMigrationState
+func DataStoreMigrationStateFromString(s string) (DataStoreMigrationState, error) {
+	if v, ok := DataStoreMigrationState_value[s]; ok {
+		return DataStoreMigrationState(v), nil
+	} else if v, ok := DataStoreMigrationState_shorthandValue[s]; ok {
+		return DataStoreMigrationState(v), nil
+	}
+	return DataStoreMigrationState(0), fmt.Errorf("%s is not a valid DataStoreMigrationState", s)
+}
+
 var (
 	SchedulerState_shorthandValue = map[string]int32{
 		"Unspecified": 0,
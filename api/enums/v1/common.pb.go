@@ -157,6 +157,81 @@ func (ChecksumFlavor) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
+// State of a history shard in a data store migration.
+type DataStoreMigrationState int32
+
+const (
+	// Default value, the shard has not been migrated yet.
+	DATA_STORE_MIGRATION_STATE_UNSPECIFIED DataStoreMigrationState = 0
+	// The shard was copied to the target store.
+	DATA_STORE_MIGRATION_STATE_BACKFILLED DataStoreMigrationState = 1
+	// The shard was verified to be identical in both stores.
+	DATA_STORE_MIGRATION_STATE_VERIFIED DataStoreMigrationState = 2
+	// The shard is being cut over to the target store and cannot be acquired.
+	DATA_STORE_MIGRATION_STATE_CUTTING_OVER DataStoreMigrationState = 3
+	// The shard is served by the target store.
+	DATA_STORE_MIGRATION_STATE_CUT_OVER DataStoreMigrationState = 4
+)
+
+// Enum value maps for DataStoreMigrationState.
+var (
+	DataStoreMigrationState_name = map[int32]string{
+		0: "DATA_STORE_MIGRATION_STATE_UNSPECIFIED",
+		1: "DATA_STORE_MIGRATION_STATE_BACKFILLED",
+		2: "DATA_STORE_MIGRATION_STATE_VERIFIED",
+		3: "DATA_STORE_MIGRATION_STATE_CUTTING_OVER",
+		4: "DATA_STORE_MIGRATION_STATE_CUT_OVER",
+	}
+	DataStoreMigrationState_value = map[string]int32{
+		"DATA_STORE_MIGRATION_STATE_UNSPECIFIED":  0,
+		"DATA_STORE_MIGRATION_STATE_BACKFILLED":   1,
+		"DATA_STORE_MIGRATION_STATE_VERIFIED":     2,
+		"DATA_STORE_MIGRATION_STATE_CUTTING_OVER": 3,
+		"DATA_STORE_MIGRATION_STATE_CUT_OVER":     4,
+	}
+)
+
+func (x DataStoreMigrationState) Enum() *DataStoreMigrationState {
+	p := new(DataStoreMigrationState)
+	*p = x
+	return p
+}
+
+func (x DataStoreMigrationState) String() string {
+	switch x {
+	case DATA_STORE_MIGRATION_STATE_UNSPECIFIED:
+		return "Unspecified"
+	case DATA_STORE_MIGRATION_STATE_BACKFILLED:
+		return "Backfilled"
+	case DATA_STORE_MIGRATION_STATE_VERIFIED:
+		return "Verified"
+	case DATA_STORE_MIGRATION_STATE_CUTTING_OVER:
+		return "CuttingOver"
+	case DATA_STORE_MIGRATION_STATE_CUT_OVER:
+		return "CutOver"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (DataStoreMigrationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (DataStoreMigrationState) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[2]
+}
+
+func (x DataStoreMigrationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataStoreMigrationState.Descriptor instead.
+func (DataStoreMigrationState) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
 // State of a scheduler.
 type SchedulerState int32
 
@@ -204,11 +279,11 @@ func (x SchedulerState) String() string {
 }
 
 func (SchedulerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_temporal_server_api_enums_v1_common_proto_enumTypes[2].Descriptor()
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[3].Descriptor()
 }
 
 func (SchedulerState) Type() protoreflect.EnumType {
-	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[2]
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[3]
 }
 
 func (x SchedulerState) Number() protoreflect.EnumNumber {
@@ -217,7 +292,7 @@ func (x SchedulerState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SchedulerState.Descriptor instead.
 func (SchedulerState) EnumDescriptor() ([]byte, []int) {
-	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{2}
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{3}
 }
 
 // State of a callback.
@@ -285,11 +360,11 @@ func (x CallbackState) String() string {
 }
 
 func (CallbackState) Descriptor() protoreflect.EnumDescriptor {
-	return file_temporal_server_api_enums_v1_common_proto_enumTypes[3].Descriptor()
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[4].Descriptor()
 }
 
 func (CallbackState) Type() protoreflect.EnumType {
-	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[3]
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[4]
 }
 
 func (x CallbackState) Number() protoreflect.EnumNumber {
@@ -298,7 +373,7 @@ func (x CallbackState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CallbackState.Descriptor instead.
 func (CallbackState) EnumDescriptor() ([]byte, []int) {
-	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{4}
 }
 
 var File_temporal_server_api_enums_v1_common_proto protoreflect.FileDescriptor
@@ -323,30 +398,45 @@ var file_temporal_server_api_enums_v1_common_proto_rawDesc = []byte{
 	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x31, 0x0a, 0x2d, 0x43, 0x48,
 	0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f, 0x46, 0x4c, 0x41, 0x56, 0x4f, 0x52, 0x5f, 0x49, 0x45,
 	0x45, 0x45, 0x5f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x33, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x2a, 0x6d, 0x0a,
-	0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x1f, 0x0a, 0x1b, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x4f, 0x54, 0x4f, 0x33, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x2a, 0xef, 0x01,
+	0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x26, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x54,
+	0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x27, 0x0a, 0x23, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4d,
+	0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x56,
+	0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x2b, 0x0a, 0x27, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x55, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x4f, 0x56, 0x45, 0x52, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x53,
+	0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x55, 0x54, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x04, 0x2a,
+	0x6d, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0xc2,
+	0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41,
 	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1b, 0x0a, 0x17, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a,
-	0x19, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0xc2, 0x01, 0x0a,
-	0x0d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e,
-	0x0a, 0x1a, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a,
-	0x0a, 0x16, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x42, 0x59, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41,
-	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48,
-	0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x41, 0x4c, 0x4c,
-	0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x49,
-	0x4e, 0x47, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x41, 0x4c, 0x4c,
-	0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
-	0x05, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
-	0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
-	0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x1a, 0x0a, 0x16, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x42, 0x59, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x41,
+	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x43,
+	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x41,
+	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x10, 0x05, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -361,12 +451,13 @@ func file_temporal_server_api_enums_v1_common_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_temporal_server_api_enums_v1_common_proto_goTypes = []interface{}{
-	(DeadLetterQueueType)(0), // 0: temporal.server.api.enums.v1.DeadLetterQueueType
-	(ChecksumFlavor)(0),      // 1: temporal.server.api.enums.v1.ChecksumFlavor
-	(SchedulerState)(0),      // 2: temporal.server.api.enums.v1.SchedulerState
-	(CallbackState)(0),       // 3: temporal.server.api.enums.v1.CallbackState
+	(DeadLetterQueueType)(0),     // 0: temporal.server.api.enums.v1.DeadLetterQueueType
+	(ChecksumFlavor)(0),          // 1: temporal.server.api.enums.v1.ChecksumFlavor
+	(DataStoreMigrationState)(0), // 2: temporal.server.api.enums.v1.DataStoreMigrationState
+	(SchedulerState)(0),          // 3: temporal.server.api.enums.v1.SchedulerState
+	(CallbackState)(0),           // 4: temporal.server.api.enums.v1.CallbackState
 }
 var file_temporal_server_api_enums_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -386,7 +477,7 @@ func file_temporal_server_api_enums_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_common_proto_rawDesc,
-			NumEnums:      4,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -5061,6 +5061,154 @@ func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type GetPersistenceUsageRequest to the protobuf v3 wire format
+func (val *GetPersistenceUsageRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetPersistenceUsageRequest from the protobuf v3 wire format
+func (val *GetPersistenceUsageRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetPersistenceUsageRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetPersistenceUsageRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetPersistenceUsageRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetPersistenceUsageRequest
+	switch t := that.(type) {
+	case *GetPersistenceUsageRequest:
+		that1 = t
+	case GetPersistenceUsageRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetPersistenceUsageResponse to the protobuf v3 wire format
+func (val *GetPersistenceUsageResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetPersistenceUsageResponse from the protobuf v3 wire format
+func (val *GetPersistenceUsageResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetPersistenceUsageResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetPersistenceUsageResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetPersistenceUsageResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetPersistenceUsageResponse
+	switch t := that.(type) {
+	case *GetPersistenceUsageResponse:
+		that1 = t
+	case GetPersistenceUsageResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type EvaluateDynamicConfigRequest to the protobuf v3 wire format
+func (val *EvaluateDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type EvaluateDynamicConfigRequest from the protobuf v3 wire format
+func (val *EvaluateDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *EvaluateDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two EvaluateDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *EvaluateDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *EvaluateDynamicConfigRequest
+	switch t := that.(type) {
+	case *EvaluateDynamicConfigRequest:
+		that1 = t
+	case EvaluateDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type EvaluateDynamicConfigResponse to the protobuf v3 wire format
+func (val *EvaluateDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type EvaluateDynamicConfigResponse from the protobuf v3 wire format
+func (val *EvaluateDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *EvaluateDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two EvaluateDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *EvaluateDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *EvaluateDynamicConfigResponse
+	switch t := that.(type) {
+	case *EvaluateDynamicConfigResponse:
+		that1 = t
+	case EvaluateDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type SyncWorkflowStateRequest to the protobuf v3 wire format
 func (val *SyncWorkflowStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -108,6 +108,8 @@ const (
 	HistoryService_CompleteNexusOperation_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation"
 	HistoryService_InvokeStateMachineMethod_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod"
 	HistoryService_DeepHealthCheck_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/DeepHealthCheck"
+	HistoryService_GetPersistenceUsage_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/GetPersistenceUsage"
+	HistoryService_EvaluateDynamicConfig_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/EvaluateDynamicConfig"
 	HistoryService_SyncWorkflowState_FullMethodName                      = "/temporal.server.api.historyservice.v1.HistoryService/SyncWorkflowState"
 	HistoryService_UpdateActivityOptions_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityOptions"
 	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
@@ -331,6 +333,10 @@ type HistoryServiceClient interface {
 	InvokeStateMachineMethod(ctx context.Context, in *InvokeStateMachineMethodRequest, opts ...grpc.CallOption) (*InvokeStateMachineMethodResponse, error)
 	// Deep health check history service dependencies health status
 	DeepHealthCheck(ctx context.Context, in *DeepHealthCheckRequest, opts ...grpc.CallOption) (*DeepHealthCheckResponse, error)
+	// GetPersistenceUsage returns the persistence usage of each namespace on a history host.
+	GetPersistenceUsage(ctx context.Context, in *GetPersistenceUsageRequest, opts ...grpc.CallOption) (*GetPersistenceUsageResponse, error)
+	// EvaluateDynamicConfig returns the effective value of a dynamic config setting on a history host.
+	EvaluateDynamicConfig(ctx context.Context, in *EvaluateDynamicConfigRequest, opts ...grpc.CallOption) (*EvaluateDynamicConfigResponse, error)
 	SyncWorkflowState(ctx context.Context, in *SyncWorkflowStateRequest, opts ...grpc.CallOption) (*SyncWorkflowStateResponse, error)
 	// UpdateActivityOptions is called by the client to update the options of an activity
 	// (-- api-linter: core::0134::method-signature=disabled
@@ -340,14 +346,13 @@ type HistoryServiceClient interface {
 	// Returns a `NotFound` error if there is no pending activity with the provided ID.
 	//
 	// Pausing an activity means:
-	//   - If the activity is currently waiting for a retry or is running and subsequently fails,
-	//     it will not be rescheduled until it is unpaused.
-	//   - If the activity is already paused, calling this method will have no effect.
-	//   - If the activity is running and will finish successfully, activity will be completed.
-	//   - If the activity is running and will finish with failure:
-	//   - if there is no retry left - activity will be completed.
-	//   - if there are more retries left - activity will be paused.
-	//
+	// - If the activity is currently waiting for a retry or is running and subsequently fails,
+	//   it will not be rescheduled until it is unpaused.
+	// - If the activity is already paused, calling this method will have no effect.
+	// - If the activity is running and will finish successfully, activity will be completed.
+	// - If the activity is running and will finish with failure:
+	//   * if there is no retry left - activity will be completed.
+	//   * if there are more retries left - activity will be paused.
 	// For long-running activities:
 	// - activity in paused state will send a cancellation with "activity_paused" set to 'true' in response to 'RecordActivityTaskHeartbeat'.
 	// - The activity should respond to the cancellation accordingly.
@@ -358,14 +363,12 @@ type HistoryServiceClient interface {
 	// Returns a `NotFound` error if there is no pending activity with the provided ID.
 	// There are two 'modes' of unpausing an activity:
 	// 'resume' - If the activity is paused, it will be resumed and scheduled for execution.
-	//   - If activity is currently running Unpause with 'resume' has no effect.
-	//   - if 'no_wait' flag is set and activity is waiting, the activity will be scheduled immediately.
-	//
+	//    * If activity is currently running Unpause with 'resume' has no effect.
+	//    * if 'no_wait' flag is set and activity is waiting, the activity will be scheduled immediately.
 	// 'reset' - If the activity is paused, it will be reset to its initial state and (depending on parameters) scheduled for execution.
-	//   - If activity is currently running Unpause with 'reset' will reset the number of attempts.
-	//   - if 'no_wait' flag is set, the activity will be scheduled immediately.
-	//   - if 'reset_heartbeats' flag is set, the activity heartbeat timer and heartbeats will be reset.
-	//
+	//    * If activity is currently running Unpause with 'reset' will reset the number of attempts.
+	//    * if 'no_wait' flag is set, the activity will be scheduled immediately.
+	//    * if 'reset_heartbeats' flag is set, the activity heartbeat timer and heartbeats will be reset.
 	// If activity is in waiting for retry and past it retry timeout, it will be scheduled immediately.
 	// Once activity is unpaused, all timeout timers will be regenerated.
 	// (-- api-linter: core::0134::method-signature=disabled
@@ -1009,6 +1012,24 @@ func (c *historyServiceClient) DeepHealthCheck(ctx context.Context, in *DeepHeal
 	return out, nil
 }
 
+func (c *historyServiceClient) GetPersistenceUsage(ctx context.Context, in *GetPersistenceUsageRequest, opts ...grpc.CallOption) (*GetPersistenceUsageResponse, error) {
+	out := new(GetPersistenceUsageResponse)
+	err := c.cc.Invoke(ctx, HistoryService_GetPersistenceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) EvaluateDynamicConfig(ctx context.Context, in *EvaluateDynamicConfigRequest, opts ...grpc.CallOption) (*EvaluateDynamicConfigResponse, error) {
+	out := new(EvaluateDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, HistoryService_EvaluateDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) SyncWorkflowState(ctx context.Context, in *SyncWorkflowStateRequest, opts ...grpc.CallOption) (*SyncWorkflowStateResponse, error) {
 	out := new(SyncWorkflowStateResponse)
 	err := c.cc.Invoke(ctx, HistoryService_SyncWorkflowState_FullMethodName, in, out, opts...)
@@ -1270,6 +1291,10 @@ type HistoryServiceServer interface {
 	InvokeStateMachineMethod(context.Context, *InvokeStateMachineMethodRequest) (*InvokeStateMachineMethodResponse, error)
 	// Deep health check history service dependencies health status
 	DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error)
+	// GetPersistenceUsage returns the persistence usage of each namespace on a history host.
+	GetPersistenceUsage(context.Context, *GetPersistenceUsageRequest) (*GetPersistenceUsageResponse, error)
+	// EvaluateDynamicConfig returns the effective value of a dynamic config setting on a history host.
+	EvaluateDynamicConfig(context.Context, *EvaluateDynamicConfigRequest) (*EvaluateDynamicConfigResponse, error)
 	SyncWorkflowState(context.Context, *SyncWorkflowStateRequest) (*SyncWorkflowStateResponse, error)
 	// UpdateActivityOptions is called by the client to update the options of an activity
 	// (-- api-linter: core::0134::method-signature=disabled
@@ -1279,14 +1304,13 @@ type HistoryServiceServer interface {
 	// Returns a `NotFound` error if there is no pending activity with the provided ID.
 	//
 	// Pausing an activity means:
-	//   - If the activity is currently waiting for a retry or is running and subsequently fails,
-	//     it will not be rescheduled until it is unpaused.
-	//   - If the activity is already paused, calling this method will have no effect.
-	//   - If the activity is running and will finish successfully, activity will be completed.
-	//   - If the activity is running and will finish with failure:
-	//   - if there is no retry left - activity will be completed.
-	//   - if there are more retries left - activity will be paused.
-	//
+	// - If the activity is currently waiting for a retry or is running and subsequently fails,
+	//   it will not be rescheduled until it is unpaused.
+	// - If the activity is already paused, calling this method will have no effect.
+	// - If the activity is running and will finish successfully, activity will be completed.
+	// - If the activity is running and will finish with failure:
+	//   * if there is no retry left - activity will be completed.
+	//   * if there are more retries left - activity will be paused.
 	// For long-running activities:
 	// - activity in paused state will send a cancellation with "activity_paused" set to 'true' in response to 'RecordActivityTaskHeartbeat'.
 	// - The activity should respond to the cancellation accordingly.
@@ -1297,14 +1321,12 @@ type HistoryServiceServer interface {
 	// Returns a `NotFound` error if there is no pending activity with the provided ID.
 	// There are two 'modes' of unpausing an activity:
 	// 'resume' - If the activity is paused, it will be resumed and scheduled for execution.
-	//   - If activity is currently running Unpause with 'resume' has no effect.
-	//   - if 'no_wait' flag is set and activity is waiting, the activity will be scheduled immediately.
-	//
+	//    * If activity is currently running Unpause with 'resume' has no effect.
+	//    * if 'no_wait' flag is set and activity is waiting, the activity will be scheduled immediately.
 	// 'reset' - If the activity is paused, it will be reset to its initial state and (depending on parameters) scheduled for execution.
-	//   - If activity is currently running Unpause with 'reset' will reset the number of attempts.
-	//   - if 'no_wait' flag is set, the activity will be scheduled immediately.
-	//   - if 'reset_heartbeats' flag is set, the activity heartbeat timer and heartbeats will be reset.
-	//
+	//    * If activity is currently running Unpause with 'reset' will reset the number of attempts.
+	//    * if 'no_wait' flag is set, the activity will be scheduled immediately.
+	//    * if 'reset_heartbeats' flag is set, the activity heartbeat timer and heartbeats will be reset.
 	// If activity is in waiting for retry and past it retry timeout, it will be scheduled immediately.
 	// Once activity is unpaused, all timeout timers will be regenerated.
 	// (-- api-linter: core::0134::method-signature=disabled
@@ -1527,6 +1549,12 @@ func (UnimplementedHistoryServiceServer) InvokeStateMachineMethod(context.Contex
 func (UnimplementedHistoryServiceServer) DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeepHealthCheck not implemented")
 }
+func (UnimplementedHistoryServiceServer) GetPersistenceUsage(context.Context, *GetPersistenceUsageRequest) (*GetPersistenceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersistenceUsage not implemented")
+}
+func (UnimplementedHistoryServiceServer) EvaluateDynamicConfig(context.Context, *EvaluateDynamicConfigRequest) (*EvaluateDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateDynamicConfig not implemented")
+}
 func (UnimplementedHistoryServiceServer) SyncWorkflowState(context.Context, *SyncWorkflowStateRequest) (*SyncWorkflowStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncWorkflowState not implemented")
 }
@@ -2751,6 +2779,42 @@ func _HistoryService_DeepHealthCheck_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_GetPersistenceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersistenceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetPersistenceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetPersistenceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetPersistenceUsage(ctx, req.(*GetPersistenceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_EvaluateDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).EvaluateDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_EvaluateDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).EvaluateDynamicConfig(ctx, req.(*EvaluateDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_SyncWorkflowState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncWorkflowStateRequest)
 	if err := dec(in); err != nil {
@@ -3108,6 +3172,14 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeepHealthCheck",
 			Handler:    _HistoryService_DeepHealthCheck_Handler,
 		},
+		{
+			MethodName: "GetPersistenceUsage",
+			Handler:    _HistoryService_GetPersistenceUsage_Handler,
+		},
+		{
+			MethodName: "EvaluateDynamicConfig",
+			Handler:    _HistoryService_EvaluateDynamicConfig_Handler,
+		},
 		{
 			MethodName: "SyncWorkflowState",
 			Handler:    _HistoryService_SyncWorkflowState_Handler,
@@ -266,6 +266,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeWorkflowExecution(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// EvaluateDynamicConfig mocks base method.
+func (m *MockHistoryServiceClient) EvaluateDynamicConfig(ctx context.Context, in *historyservice.EvaluateDynamicConfigRequest, opts ...grpc.CallOption) (*historyservice.EvaluateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvaluateDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*historyservice.EvaluateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateDynamicConfig indicates an expected call of EvaluateDynamicConfig.
+func (mr *MockHistoryServiceClientMockRecorder) EvaluateDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateDynamicConfig", reflect.TypeOf((*MockHistoryServiceClient)(nil).EvaluateDynamicConfig), varargs...)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockHistoryServiceClient) ExecuteMultiOperation(ctx context.Context, in *historyservice.ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*historyservice.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -406,6 +426,26 @@ func (mr *MockHistoryServiceClientMockRecorder) GetMutableState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetMutableState), varargs...)
 }
 
+// GetPersistenceUsage mocks base method.
+func (m *MockHistoryServiceClient) GetPersistenceUsage(ctx context.Context, in *historyservice.GetPersistenceUsageRequest, opts ...grpc.CallOption) (*historyservice.GetPersistenceUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPersistenceUsage", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetPersistenceUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersistenceUsage indicates an expected call of GetPersistenceUsage.
+func (mr *MockHistoryServiceClientMockRecorder) GetPersistenceUsage(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersistenceUsage", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetPersistenceUsage), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockHistoryServiceClient) GetReplicationMessages(ctx context.Context, in *historyservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*historyservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1796,6 +1836,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeWorkflowExecution(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// EvaluateDynamicConfig mocks base method.
+func (m *MockHistoryServiceServer) EvaluateDynamicConfig(arg0 context.Context, arg1 *historyservice.EvaluateDynamicConfigRequest) (*historyservice.EvaluateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.EvaluateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateDynamicConfig indicates an expected call of EvaluateDynamicConfig.
+func (mr *MockHistoryServiceServerMockRecorder) EvaluateDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateDynamicConfig", reflect.TypeOf((*MockHistoryServiceServer)(nil).EvaluateDynamicConfig), arg0, arg1)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockHistoryServiceServer) ExecuteMultiOperation(arg0 context.Context, arg1 *historyservice.ExecuteMultiOperationRequest) (*historyservice.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1901,6 +1956,21 @@ func (mr *MockHistoryServiceServerMockRecorder) GetMutableState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetMutableState), arg0, arg1)
 }
 
+// GetPersistenceUsage mocks base method.
+func (m *MockHistoryServiceServer) GetPersistenceUsage(arg0 context.Context, arg1 *historyservice.GetPersistenceUsageRequest) (*historyservice.GetPersistenceUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersistenceUsage", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetPersistenceUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersistenceUsage indicates an expected call of GetPersistenceUsage.
+func (mr *MockHistoryServiceServerMockRecorder) GetPersistenceUsage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersistenceUsage", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetPersistenceUsage), arg0, arg1)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockHistoryServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *historyservice.GetReplicationMessagesRequest) (*historyservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,289 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package persistence
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type DynamicConfigOverrides to the protobuf v3 wire format
+func (val *DynamicConfigOverrides) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigOverrides from the protobuf v3 wire format
+func (val *DynamicConfigOverrides) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigOverrides) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigOverrides values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigOverrides) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigOverrides
+	switch t := that.(type) {
+	case *DynamicConfigOverrides:
+		that1 = t
+	case DynamicConfigOverrides:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigOverride to the protobuf v3 wire format
+func (val *DynamicConfigOverride) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigOverride from the protobuf v3 wire format
+func (val *DynamicConfigOverride) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigOverride) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigOverride values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigOverride
+	switch t := that.(type) {
+	case *DynamicConfigOverride:
+		that1 = t
+	case DynamicConfigOverride:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigConstraints to the protobuf v3 wire format
+func (val *DynamicConfigConstraints) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigConstraints from the protobuf v3 wire format
+func (val *DynamicConfigConstraints) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigConstraints) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigConstraints values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigConstraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigConstraints
+	switch t := that.(type) {
+	case *DynamicConfigConstraints:
+		that1 = t
+	case DynamicConfigConstraints:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigAuditEntry to the protobuf v3 wire format
+func (val *DynamicConfigAuditEntry) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigAuditEntry from the protobuf v3 wire format
+func (val *DynamicConfigAuditEntry) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigAuditEntry) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigAuditEntry values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigAuditEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigAuditEntry
+	switch t := that.(type) {
+	case *DynamicConfigAuditEntry:
+		that1 = t
+	case DynamicConfigAuditEntry:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigValue to the protobuf v3 wire format
+func (val *DynamicConfigValue) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigValue from the protobuf v3 wire format
+func (val *DynamicConfigValue) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigValue) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigValue values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigValue
+	switch t := that.(type) {
+	case *DynamicConfigValue:
+		that1 = t
+	case DynamicConfigValue:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigEvaluation to the protobuf v3 wire format
+func (val *DynamicConfigEvaluation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigEvaluation from the protobuf v3 wire format
+func (val *DynamicConfigEvaluation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigEvaluation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigEvaluation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigEvaluation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigEvaluation
+	switch t := that.(type) {
+	case *DynamicConfigEvaluation:
+		that1 = t
+	case DynamicConfigEvaluation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigSetting to the protobuf v3 wire format
+func (val *DynamicConfigSetting) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigSetting from the protobuf v3 wire format
+func (val *DynamicConfigSetting) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigSetting) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigSetting values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigSetting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigSetting
+	switch t := that.(type) {
+	case *DynamicConfigSetting:
+		that1 = t
+	case DynamicConfigSetting:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
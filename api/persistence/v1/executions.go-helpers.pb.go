@@ -66,6 +66,43 @@ func (this *ShardInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DataStoreMigrationInfo to the protobuf v3 wire format
+func (val *DataStoreMigrationInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DataStoreMigrationInfo from the protobuf v3 wire format
+func (val *DataStoreMigrationInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DataStoreMigrationInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DataStoreMigrationInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DataStoreMigrationInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DataStoreMigrationInfo
+	switch t := that.(type) {
+	case *DataStoreMigrationInfo:
+		that1 = t
+	case DataStoreMigrationInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type WorkflowExecutionInfo to the protobuf v3 wire format
 func (val *WorkflowExecutionInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -0,0 +1,219 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/persistence/v1/usage.proto
+
+package persistence
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NamespacePersistenceUsage is the persistence usage of a namespace for one class of operations, averaged over
+// the usage window of the host.
+type NamespacePersistenceUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Operation class of the requests, either "read" or "write".
+	OperationClass string `protobuf:"bytes,2,opt,name=operation_class,json=operationClass,proto3" json:"operation_class,omitempty"`
+	// Requests per second which reached the data store.
+	Rps float64 `protobuf:"fixed64,3,opt,name=rps,proto3" json:"rps,omitempty"`
+	// Requests per second which were rejected by persistence rate limits.
+	ThrottledRps float64 `protobuf:"fixed64,4,opt,name=throttled_rps,json=throttledRps,proto3" json:"throttled_rps,omitempty"`
+	// Namespace persistence QPS limit of the host, or zero if persistence requests are not rate limited. The
+	// limit applies to all operation classes together.
+	QuotaRps float64 `protobuf:"fixed64,5,opt,name=quota_rps,json=quotaRps,proto3" json:"quota_rps,omitempty"`
+}
+
+func (x *NamespacePersistenceUsage) Reset() {
+	*x = NamespacePersistenceUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_temporal_server_api_persistence_v1_usage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespacePersistenceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespacePersistenceUsage) ProtoMessage() {}
+
+func (x *NamespacePersistenceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_usage_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespacePersistenceUsage.ProtoReflect.Descriptor instead.
+func (*NamespacePersistenceUsage) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_usage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NamespacePersistenceUsage) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespacePersistenceUsage) GetOperationClass() string {
+	if x != nil {
+		return x.OperationClass
+	}
+	return ""
+}
+
+func (x *NamespacePersistenceUsage) GetRps() float64 {
+	if x != nil {
+		return x.Rps
+	}
+	return 0
+}
+
+func (x *NamespacePersistenceUsage) GetThrottledRps() float64 {
+	if x != nil {
+		return x.ThrottledRps
+	}
+	return 0
+}
+
+func (x *NamespacePersistenceUsage) GetQuotaRps() float64 {
+	if x != nil {
+		return x.QuotaRps
+	}
+	return 0
+}
+
+var File_temporal_server_api_persistence_v1_usage_proto protoreflect.FileDescriptor
+
+var file_temporal_server_api_persistence_v1_usage_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x22, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x22,
+	0xca, 0x01, 0x0a, 0x19, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x02, 0x68, 0x00, 0x12, 0x2b, 0x0a, 0x0f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x42, 0x02, 0x68, 0x00, 0x12, 0x14, 0x0a, 0x03, 0x72, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x72, 0x70, 0x73, 0x42, 0x02, 0x68, 0x00, 0x12, 0x27, 0x0a, 0x0d, 0x74, 0x68,
+	0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x52, 0x70, 0x73, 0x42, 0x02, 0x68,
+	0x00, 0x12, 0x1f, 0x0a, 0x09, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x72, 0x70, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x70, 0x73, 0x42, 0x02, 0x68, 0x00,
+	0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69,
+	0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_temporal_server_api_persistence_v1_usage_proto_rawDescOnce sync.Once
+	file_temporal_server_api_persistence_v1_usage_proto_rawDescData = file_temporal_server_api_persistence_v1_usage_proto_rawDesc
+)
+
+func file_temporal_server_api_persistence_v1_usage_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_persistence_v1_usage_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_persistence_v1_usage_proto_rawDescData = protoimpl.X.CompressGZIP(file_temporal_server_api_persistence_v1_usage_proto_rawDescData)
+	})
+	return file_temporal_server_api_persistence_v1_usage_proto_rawDescData
+}
+
+var file_temporal_server_api_persistence_v1_usage_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_temporal_server_api_persistence_v1_usage_proto_goTypes = []interface{}{
+	(*NamespacePersistenceUsage)(nil), // 0: temporal.server.api.persistence.v1.NamespacePersistenceUsage
+}
+var file_temporal_server_api_persistence_v1_usage_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_persistence_v1_usage_proto_init() }
+func file_temporal_server_api_persistence_v1_usage_proto_init() {
+	if File_temporal_server_api_persistence_v1_usage_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_temporal_server_api_persistence_v1_usage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespacePersistenceUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_temporal_server_api_persistence_v1_usage_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_persistence_v1_usage_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_persistence_v1_usage_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_persistence_v1_usage_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_persistence_v1_usage_proto = out.File
+	file_temporal_server_api_persistence_v1_usage_proto_rawDesc = nil
+	file_temporal_server_api_persistence_v1_usage_proto_goTypes = nil
+	file_temporal_server_api_persistence_v1_usage_proto_depIdxs = nil
+}
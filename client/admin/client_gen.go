@@ -93,6 +93,16 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -133,6 +143,16 @@ func (c *clientImpl) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeHistoryPersistenceUsage(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryPersistenceUsageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryPersistenceUsageResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeHistoryPersistenceUsage(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -143,6 +163,16 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribePersistenceMigration(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribePersistenceMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribePersistenceMigration(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -153,6 +183,26 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) EvaluateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.EvaluateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.EvaluateDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.EvaluateDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExportArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportArchivedWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportArchivedWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExportArchivedWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -203,6 +253,16 @@ func (c *clientImpl) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -313,6 +373,16 @@ func (c *clientImpl) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListDynamicConfigSettings(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigSettingsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigSettingsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfigSettings(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -443,6 +513,46 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartArchivalVerification(
+	ctx context.Context,
+	request *adminservice.StartArchivalVerificationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartArchivalVerificationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartArchivalVerification(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartPersistenceMigration(
+	ctx context.Context,
+	request *adminservice.StartPersistenceMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartPersistenceMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartPersistenceMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartVisibilityMigration(
+	ctx context.Context,
+	request *adminservice.StartVisibilityMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartVisibilityMigration(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -117,6 +117,20 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteDynamicConfigOverrideResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteDynamicConfigOverride")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -173,6 +187,20 @@ func (c *metricClient) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeHistoryPersistenceUsage(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryPersistenceUsageRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeHistoryPersistenceUsageResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeHistoryPersistenceUsage")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeHistoryPersistenceUsage(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -187,6 +215,20 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribePersistenceMigration(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribePersistenceMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribePersistenceMigration")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribePersistenceMigration(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -201,6 +243,34 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) EvaluateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.EvaluateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.EvaluateDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientEvaluateDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.EvaluateDynamicConfig(ctx, request, opts...)
+}
+
+func (c *metricClient) ExportArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportArchivedWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExportArchivedWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientExportArchivedWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExportArchivedWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -271,6 +341,20 @@ func (c *metricClient) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDynamicConfigOverridesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetDynamicConfigOverrides")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -425,6 +509,20 @@ func (c *metricClient) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *metricClient) ListDynamicConfigSettings(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigSettingsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListDynamicConfigSettingsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListDynamicConfigSettings")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDynamicConfigSettings(ctx, request, opts...)
+}
+
 func (c *metricClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -607,6 +705,62 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetDynamicConfigOverrideResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetDynamicConfigOverride")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *metricClient) StartArchivalVerification(
+	ctx context.Context,
+	request *adminservice.StartArchivalVerificationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartArchivalVerificationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartArchivalVerification")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartArchivalVerification(ctx, request, opts...)
+}
+
+func (c *metricClient) StartPersistenceMigration(
+	ctx context.Context,
+	request *adminservice.StartPersistenceMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartPersistenceMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartPersistenceMigration")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartPersistenceMigration(ctx, request, opts...)
+}
+
+func (c *metricClient) StartVisibilityMigration(
+	ctx context.Context,
+	request *adminservice.StartVisibilityMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartVisibilityMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartVisibilityMigration")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartVisibilityMigration(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
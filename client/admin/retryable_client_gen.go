@@ -125,6 +125,21 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	var resp *adminservice.DeleteDynamicConfigOverrideResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -185,6 +200,21 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeHistoryPersistenceUsage(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryPersistenceUsageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryPersistenceUsageResponse, error) {
+	var resp *adminservice.DescribeHistoryPersistenceUsageResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHistoryPersistenceUsage(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -200,6 +230,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribePersistenceMigration(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribePersistenceMigrationResponse, error) {
+	var resp *adminservice.DescribePersistenceMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribePersistenceMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -215,6 +260,36 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) EvaluateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.EvaluateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.EvaluateDynamicConfigResponse, error) {
+	var resp *adminservice.EvaluateDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.EvaluateDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExportArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportArchivedWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportArchivedWorkflowExecutionsResponse, error) {
+	var resp *adminservice.ExportArchivedWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExportArchivedWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -290,6 +365,21 @@ func (c *retryableClient) GetDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	var resp *adminservice.GetDynamicConfigOverridesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -455,6 +545,21 @@ func (c *retryableClient) ListClusters(
 	return resp, err
 }
 
+func (c *retryableClient) ListDynamicConfigSettings(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigSettingsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigSettingsResponse, error) {
+	var resp *adminservice.ListDynamicConfigSettingsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfigSettings(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -650,6 +755,66 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	var resp *adminservice.SetDynamicConfigOverrideResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetDynamicConfigOverride(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartArchivalVerification(
+	ctx context.Context,
+	request *adminservice.StartArchivalVerificationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartArchivalVerificationResponse, error) {
+	var resp *adminservice.StartArchivalVerificationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartArchivalVerification(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartPersistenceMigration(
+	ctx context.Context,
+	request *adminservice.StartPersistenceMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartPersistenceMigrationResponse, error) {
+	var resp *adminservice.StartPersistenceMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartPersistenceMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartVisibilityMigration(
+	ctx context.Context,
+	request *adminservice.StartVisibilityMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityMigrationResponse, error) {
+	var resp *adminservice.StartVisibilityMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartVisibilityMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
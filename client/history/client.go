@@ -100,6 +100,14 @@ func (c *clientImpl) DeepHealthCheck(ctx context.Context, request *historyservic
 	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetPersistenceUsage(ctx context.Context, request *historyservice.GetPersistenceUsageRequest, opts ...grpc.CallOption) (*historyservice.GetPersistenceUsageResponse, error) {
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.GetPersistenceUsage(ctx, request, opts...)
+}
+
+func (c *clientImpl) EvaluateDynamicConfig(ctx context.Context, request *historyservice.EvaluateDynamicConfigRequest, opts ...grpc.CallOption) (*historyservice.EvaluateDynamicConfigResponse, error) {
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.EvaluateDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
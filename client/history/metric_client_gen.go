@@ -173,6 +173,20 @@ func (c *metricClient) DescribeWorkflowExecution(
 	return c.client.DescribeWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) EvaluateDynamicConfig(
+	ctx context.Context,
+	request *historyservice.EvaluateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.EvaluateDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientEvaluateDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.EvaluateDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
@@ -271,6 +285,20 @@ func (c *metricClient) GetMutableState(
 	return c.client.GetMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) GetPersistenceUsage(
+	ctx context.Context,
+	request *historyservice.GetPersistenceUsageRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.GetPersistenceUsageResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientGetPersistenceUsage")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetPersistenceUsage(ctx, request, opts...)
+}
+
 func (c *metricClient) GetReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetReplicationMessagesRequest,
@@ -185,6 +185,21 @@ func (c *retryableClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) EvaluateDynamicConfig(
+	ctx context.Context,
+	request *historyservice.EvaluateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.EvaluateDynamicConfigResponse, error) {
+	var resp *historyservice.EvaluateDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.EvaluateDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
@@ -290,6 +305,21 @@ func (c *retryableClient) GetMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) GetPersistenceUsage(
+	ctx context.Context,
+	request *historyservice.GetPersistenceUsageRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetPersistenceUsageResponse, error) {
+	var resp *historyservice.GetPersistenceUsageResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetPersistenceUsage(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetReplicationMessagesRequest,
@@ -25,10 +25,10 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
-	"go/parser"
-	"go/token"
+	"go/format"
 	"io"
 	"log"
 	"os"
@@ -45,8 +45,10 @@ type (
 	settingPrecedence struct {
 		Name   string
 		GoArgs string
-		Expr   string
-		Index  int
+		// ArgsFromConstraints assigns the arguments of GoArgs from the fields of Constraints cs.
+		ArgsFromConstraints string
+		Expr                string
+		Index               int
 	}
 )
 
@@ -89,18 +91,21 @@ var (
 			Expr:   "[]Constraints{{}}",
 		},
 		{
-			Name:   "Namespace",
-			GoArgs: "namespace string",
-			Expr:   "[]Constraints{{Namespace: namespace}, {}}",
+			Name:                "Namespace",
+			GoArgs:              "namespace string",
+			ArgsFromConstraints: "namespace := cs.Namespace",
+			Expr:                "[]Constraints{{Namespace: namespace}, {}}",
 		},
 		{
-			Name:   "NamespaceID",
-			GoArgs: "namespaceID string",
-			Expr:   "[]Constraints{{NamespaceID: namespaceID}, {}}",
+			Name:                "NamespaceID",
+			GoArgs:              "namespaceID string",
+			ArgsFromConstraints: "namespaceID := cs.NamespaceID",
+			Expr:                "[]Constraints{{NamespaceID: namespaceID}, {}}",
 		},
 		{
-			Name:   "TaskQueue",
-			GoArgs: "namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType",
+			Name:                "TaskQueue",
+			GoArgs:              "namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType",
+			ArgsFromConstraints: "namespace, taskQueue, taskQueueType := cs.Namespace, cs.TaskQueueName, cs.TaskQueueType",
 			// A task-queue-name-only filter applies to a single task queue name across all
 			// namespaces, with higher precedence than a namespace-only filter. This is intended to
 			// be used by the default partition count and is probably not useful otherwise.
@@ -113,18 +118,21 @@ var (
 		}`,
 		},
 		{
-			Name:   "ShardID",
-			GoArgs: "shardID int32",
-			Expr:   "[]Constraints{{ShardID: shardID}, {}}",
+			Name:                "ShardID",
+			GoArgs:              "shardID int32",
+			ArgsFromConstraints: "shardID := cs.ShardID",
+			Expr:                "[]Constraints{{ShardID: shardID}, {}}",
 		},
 		{
-			Name:   "TaskType",
-			GoArgs: "taskType enumsspb.TaskType",
-			Expr:   "[]Constraints{{TaskType: taskType}, {}}",
+			Name:                "TaskType",
+			GoArgs:              "taskType enumsspb.TaskType",
+			ArgsFromConstraints: "taskType := cs.TaskType",
+			Expr:                "[]Constraints{{TaskType: taskType}, {}}",
 		},
 		{
-			Name:   "Destination",
-			GoArgs: "namespace string, destination string",
+			Name:                "Destination",
+			GoArgs:              "namespace string, destination string",
+			ArgsFromConstraints: "namespace, destination := cs.Namespace, cs.Destination",
 			Expr: `[]Constraints{
 			{Namespace: namespace, Destination: destination},
 			{Destination: destination},
@@ -151,6 +159,21 @@ const Precedence{{.Name}} Precedence = {{.Index}}
 `, prec)
 }
 
+func generatePrecString(w io.Writer) {
+	writeTemplatedCode(w, `
+func (p Precedence) String() string {
+	switch p {
+	{{- range .}}
+	case Precedence{{.Name}}:
+		return "{{.Name}}"
+	{{- end}}
+	default:
+		return "Unknown"
+	}
+}
+`, precedences)
+}
+
 func generateType(w io.Writer, tp *settingType, prec *settingPrecedence) {
 	writeTemplatedCode(w, `
 {{ if .T.IsGeneric -}}
@@ -260,6 +283,18 @@ func (s {{.P.Name}}TypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs [
 	)
 }
 
+func (s {{.P.Name}}TypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	{{- if .P.ArgsFromConstraints}}
+	{{.P.ArgsFromConstraints}}
+	{{- end}}
+	prec := {{.P.Expr}}
+	return explain(c, s.key, Precedence{{.P.Name}}, s.def, s.cdef, s.convert, prec)
+}
+
+func (s {{.P.Name}}TypedSetting[T]) describe() SettingDescription {
+	return describe(s.key, Precedence{{.P.Name}}, s.def, s.cdef, s.description)
+}
+
 {{if eq .P.Name "Global" -}}
 func GetTypedPropertyFn[T any](value T) TypedPropertyFn[T] {
 {{- else -}}
@@ -316,6 +351,7 @@ const PrecedenceUnknown Precedence = 0
 		prec.Index = idx + 1
 		generatePrecEnum(w, prec)
 	}
+	generatePrecString(w)
 	for _, tp := range types {
 		for _, prec := range precedences {
 			generateType(w, tp, prec)
@@ -323,24 +359,17 @@ const PrecedenceUnknown Precedence = 0
 	}
 }
 
-func checkParses(filename string) {
-	_, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.SkipObjectResolution)
-	fatalIfErr(err)
-}
-
+// callWithFile writes the code generated by f to filename, formatted, which also checks that it
+// parses.
 func callWithFile(f func(io.Writer), filename string, licenseText string) {
-	w, err := os.Create(filename + "_gen.go")
-	if err != nil {
-		panic(err)
-	}
-	defer func() {
-		fatalIfErr(w.Close())
-		checkParses(w.Name())
-	}()
-	if _, err := fmt.Fprintf(w, "%s\n// Code generated by cmd/tools/gendynamicconfig. DO NOT EDIT.\n", licenseText); err != nil {
+	var buf bytes.Buffer
+	if _, err := fmt.Fprintf(&buf, "%s\n// Code generated by cmd/tools/gendynamicconfig. DO NOT EDIT.\n", licenseText); err != nil {
 		panic(err)
 	}
-	f(w)
+	f(&buf)
+	src, err := format.Source(buf.Bytes())
+	fatalIfErr(err)
+	fatalIfErr(os.WriteFile(filename+"_gen.go", src, 0644))
 }
 
 func readLicenseFile(path string) string {
@@ -115,7 +115,7 @@ func newFaultInjection{{.StoreName}}(
 
 	writeStoreMethods(w, store, `
 func (c *faultInjection{{.StoreName}}) {{.MethodName}}({{.InParams}}){{.OutParams}} {
-	return inject{{.NumOutParams}}(c.generator.generate("{{.MethodName}}"), func(){{.OutParams}} {
+	return inject{{.NumOutParams}}(ctx, c.generator.generate("{{.MethodName}}", {{.RequestVar}}), func(){{.OutParams}} {
 		return c.baseStore.{{.MethodName}}({{.InVars}})
 	})
 }
@@ -144,6 +144,8 @@ func writeStoreMethod(w io.Writer, store reflect.Type, m reflect.Method, tmpl st
 	mT := m.Type
 	inParams := ""
 	inVars := ""
+	// Requests are passed to the fault generator, which can limit faults to some shards or namespaces.
+	requestVar := "nil"
 	for i := 0; i < mT.NumIn(); i++ {
 		pName := fmt.Sprintf("p%d", i)
 		// Special names for ctx and request for better intellisense support.
@@ -151,6 +153,7 @@ func writeStoreMethod(w io.Writer, store reflect.Type, m reflect.Method, tmpl st
 			pName = "ctx"
 		} else if strings.HasSuffix(mT.In(i).String(), "Request") {
 			pName = "request"
+			requestVar = pName
 		}
 		inParams += fmt.Sprintf("\n\t%s %s,", pName, mT.In(i).String())
 		inVars += fmt.Sprintf("%s, ", pName)
@@ -180,6 +183,7 @@ func writeStoreMethod(w io.Writer, store reflect.Type, m reflect.Method, tmpl st
 		"MethodName":   m.Name,
 		"InParams":     inParams,
 		"InVars":       inVars,
+		"RequestVar":   requestVar,
 		"OutParams":    outParams,
 		"NumOutParams": mT.NumOut() - 1,
 	}
@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package archiveformat implements the compressed, content-addressed history archive format
+// shared by the filestore and s3store archivers.
+//
+// A history archived in this format consists of a set of batch blobs and one manifest. Each
+// batch blob holds a JSON encoded slice of history batches compressed with the configured
+// algorithm and is stored under the SHA-256 checksum of its stored bytes, so identical batches
+// archived more than once are only stored once. The manifest lists the batches in order together
+// with their checksums and is written last, which makes its existence the commit point of an
+// archival.
+package archiveformat
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/codec"
+)
+
+const (
+	// CompressionNone disables the archive format and keeps the legacy uncompressed layout
+	CompressionNone Compression = ""
+	// CompressionGzip compresses batches with gzip
+	CompressionGzip Compression = "gzip"
+	// CompressionZstd compresses batches with zstd
+	CompressionZstd Compression = "zstd"
+
+	// FormatVersion is the version of the manifest written by this package
+	FormatVersion = 1
+)
+
+var (
+	// ErrChecksumMismatch is returned when a batch blob does not match the checksum recorded in the manifest
+	ErrChecksumMismatch = errors.New("archived history batch checksum mismatch")
+	// ErrUnsupportedCompression is returned for an unknown compression algorithm
+	ErrUnsupportedCompression = errors.New("unsupported archival compression")
+	// ErrUnsupportedFormatVersion is returned when a manifest was written by a newer version of the format
+	ErrUnsupportedFormatVersion = errors.New("unsupported archival manifest format version")
+
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+type (
+	// Compression is the algorithm used to compress archived history batches
+	Compression string
+
+	// Manifest describes one archived workflow history
+	Manifest struct {
+		FormatVersion        int         `json:"formatVersion"`
+		Compression          Compression `json:"compression"`
+		NamespaceID          string      `json:"namespaceId"`
+		WorkflowID           string      `json:"workflowId"`
+		RunID                string      `json:"runId"`
+		CloseFailoverVersion int64       `json:"closeFailoverVersion"`
+		Batches              []BatchRef  `json:"batches"`
+	}
+
+	// BatchRef references one batch blob from a manifest
+	BatchRef struct {
+		// Checksum is the hex encoded SHA-256 of the stored (compressed) bytes and also the blob name
+		Checksum string `json:"checksum"`
+		// Size is the stored size in bytes
+		Size int `json:"size"`
+		// HistoryCount is the number of history batches in the blob
+		HistoryCount int `json:"historyCount"`
+		// EventCount is the number of history events in the blob
+		EventCount int `json:"eventCount"`
+	}
+)
+
+// ParseCompression converts the compression config value into a Compression
+func ParseCompression(s string) (Compression, error) {
+	switch c := Compression(s); c {
+	case CompressionNone, CompressionGzip, CompressionZstd:
+		return c, nil
+	default:
+		return CompressionNone, fmt.Errorf("%w: %q", ErrUnsupportedCompression, s)
+	}
+}
+
+// EncodeBatch encodes and compresses history batches and returns the bytes to store together with
+// the reference that should be recorded in the manifest.
+func EncodeBatch(compression Compression, histories []*historypb.History) ([]byte, BatchRef, error) {
+	encoder := codec.NewJSONPBEncoder()
+	encoded, err := encoder.EncodeHistories(histories)
+	if err != nil {
+		return nil, BatchRef{}, err
+	}
+	data, err := compress(compression, encoded)
+	if err != nil {
+		return nil, BatchRef{}, err
+	}
+
+	eventCount := 0
+	for _, history := range histories {
+		eventCount += len(history.Events)
+	}
+	return data, BatchRef{
+		Checksum:     Checksum(data),
+		Size:         len(data),
+		HistoryCount: len(histories),
+		EventCount:   eventCount,
+	}, nil
+}
+
+// DecodeBatch verifies a batch blob against its reference, then decompresses and decodes it
+func DecodeBatch(compression Compression, ref BatchRef, data []byte) ([]*historypb.History, error) {
+	if err := VerifyBatch(ref, data); err != nil {
+		return nil, err
+	}
+	encoded, err := decompress(compression, data)
+	if err != nil {
+		return nil, err
+	}
+	encoder := codec.NewJSONPBEncoder()
+	return encoder.DecodeHistories(encoded)
+}
+
+// VerifyBatch checks that data matches the size and checksum recorded in ref
+func VerifyBatch(ref BatchRef, data []byte) error {
+	if len(data) != ref.Size || Checksum(data) != ref.Checksum {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
+
+// Checksum returns the hex encoded SHA-256 of data
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// EncodeManifest serializes a manifest
+func EncodeManifest(manifest *Manifest) ([]byte, error) {
+	return json.Marshal(manifest)
+}
+
+// DecodeManifest deserializes and validates a manifest
+func DecodeManifest(data []byte) (*Manifest, error) {
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	if manifest.FormatVersion < 1 || manifest.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedFormatVersion, manifest.FormatVersion)
+	}
+	if manifest.Compression != CompressionGzip && manifest.Compression != CompressionZstd {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedCompression, manifest.Compression)
+	}
+	return manifest, nil
+}
+
+func compress(compression Compression, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		return zstdEncoder.EncodeAll(data, nil), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedCompression, compression)
+	}
+}
+
+func decompress(compression Compression, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = r.Close() }()
+		return io.ReadAll(r)
+	case CompressionZstd:
+		return zstdDecoder.DecodeAll(data, nil)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedCompression, compression)
+	}
+}
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiveformat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+)
+
+type formatSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestFormatSuite(t *testing.T) {
+	suite.Run(t, new(formatSuite))
+}
+
+func (s *formatSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *formatSuite) TestParseCompression() {
+	for _, value := range []string{"", "gzip", "zstd"} {
+		compression, err := ParseCompression(value)
+		s.NoError(err)
+		s.Equal(Compression(value), compression)
+	}
+	_, err := ParseCompression("lz4")
+	s.ErrorIs(err, ErrUnsupportedCompression)
+}
+
+func (s *formatSuite) TestEncodeDecodeBatch() {
+	histories := testHistories()
+	for _, compression := range []Compression{CompressionGzip, CompressionZstd} {
+		data, ref, err := EncodeBatch(compression, histories)
+		s.NoError(err)
+		s.Equal(len(data), ref.Size)
+		s.Equal(Checksum(data), ref.Checksum)
+		s.Equal(2, ref.HistoryCount)
+		s.Equal(3, ref.EventCount)
+
+		decoded, err := DecodeBatch(compression, ref, data)
+		s.NoError(err)
+		s.Len(decoded, 2)
+		s.Equal(histories[1].Events[1].GetEventId(), decoded[1].Events[1].GetEventId())
+		s.Equal(histories[0].Events[0].GetEventType(), decoded[0].Events[0].GetEventType())
+
+		// identical content must be addressed by the same checksum
+		_, sameRef, err := EncodeBatch(compression, histories)
+		s.NoError(err)
+		s.Equal(ref, sameRef)
+	}
+}
+
+func (s *formatSuite) TestDecodeBatch_ChecksumMismatch() {
+	data, ref, err := EncodeBatch(CompressionZstd, testHistories())
+	s.NoError(err)
+
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	_, err = DecodeBatch(CompressionZstd, ref, corrupted)
+	s.ErrorIs(err, ErrChecksumMismatch)
+
+	_, err = DecodeBatch(CompressionZstd, ref, data[:len(data)-1])
+	s.ErrorIs(err, ErrChecksumMismatch)
+}
+
+func (s *formatSuite) TestEncodeBatch_UnsupportedCompression() {
+	_, _, err := EncodeBatch(CompressionNone, testHistories())
+	s.ErrorIs(err, ErrUnsupportedCompression)
+}
+
+func (s *formatSuite) TestEncodeDecodeManifest() {
+	_, ref, err := EncodeBatch(CompressionGzip, testHistories())
+	s.NoError(err)
+	manifest := &Manifest{
+		FormatVersion:        FormatVersion,
+		Compression:          CompressionGzip,
+		NamespaceID:          "namespace-id",
+		WorkflowID:           "workflow-id",
+		RunID:                "run-id",
+		CloseFailoverVersion: 100,
+		Batches:              []BatchRef{ref},
+	}
+	data, err := EncodeManifest(manifest)
+	s.NoError(err)
+
+	decoded, err := DecodeManifest(data)
+	s.NoError(err)
+	s.Equal(manifest, decoded)
+}
+
+func (s *formatSuite) TestDecodeManifest_Invalid() {
+	_, err := DecodeManifest([]byte("not json"))
+	s.Error(err)
+
+	_, err = DecodeManifest([]byte(`{"formatVersion":2,"compression":"zstd"}`))
+	s.ErrorIs(err, ErrUnsupportedFormatVersion)
+
+	_, err = DecodeManifest([]byte(`{"formatVersion":1,"compression":"lz4"}`))
+	s.ErrorIs(err, ErrUnsupportedCompression)
+}
+
+func testHistories() []*historypb.History {
+	return []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{EventId: 1, Version: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+			},
+		},
+		{
+			Events: []*historypb.HistoryEvent{
+				{EventId: 2, Version: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+				{EventId: 3, Version: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+			},
+		},
+	}
+}
@@ -0,0 +1,289 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package azuritetest provides an in-memory stand-in for the Azurite Blob service emulator.
+// It implements the subset of the Blob service REST API used by the azblob archiver, using
+// Azurite's path style URLs (http://<host>/<account>/<container>/<blob>).
+package azuritetest
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// AccountName is the well-known Azurite development account name
+	AccountName = "devstoreaccount1"
+	// AccountKey is the well-known Azurite development account key
+	AccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+	defaultMaxResults = 5000
+)
+
+type (
+	// Server is an in-memory Blob service
+	Server struct {
+		*httptest.Server
+
+		sync.Mutex
+		containers map[string]map[string][]byte
+		failNext   int
+		failStatus int
+	}
+
+	listEntry struct {
+		name     string
+		isPrefix bool
+	}
+
+	enumerationResults struct {
+		XMLName       xml.Name   `xml:"EnumerationResults"`
+		ContainerName string     `xml:"ContainerName,attr"`
+		Prefix        string     `xml:"Prefix,omitempty"`
+		Marker        string     `xml:"Marker,omitempty"`
+		MaxResults    int        `xml:"MaxResults,omitempty"`
+		Delimiter     string     `xml:"Delimiter,omitempty"`
+		Blobs         blobsEntry `xml:"Blobs"`
+		NextMarker    string     `xml:"NextMarker"`
+	}
+
+	blobsEntry struct {
+		Blob       []nameEntry `xml:"Blob"`
+		BlobPrefix []nameEntry `xml:"BlobPrefix"`
+	}
+
+	nameEntry struct {
+		Name string `xml:"Name"`
+	}
+
+	errorEntry struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}
+)
+
+// NewServer starts a new Server with the given containers already created
+func NewServer(containers ...string) *Server {
+	s := &Server{
+		containers: make(map[string]map[string][]byte),
+	}
+	for _, container := range containers {
+		s.containers[container] = make(map[string][]byte)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AccountURL returns the endpoint to be used in config.AzblobArchiver
+func (s *Server) AccountURL() string {
+	return s.URL + "/" + AccountName
+}
+
+// BlobNames returns the sorted names of all blobs in the container
+func (s *Server) BlobNames(container string) []string {
+	s.Lock()
+	defer s.Unlock()
+	var names []string
+	for name := range s.containers[container] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PutBlob stores a blob directly, bypassing the REST API
+func (s *Server) PutBlob(container string, name string, data []byte) {
+	s.Lock()
+	defer s.Unlock()
+	s.containers[container][name] = data
+}
+
+// DeleteBlob removes a blob directly, bypassing the REST API
+func (s *Server) DeleteBlob(container string, name string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.containers[container], name)
+}
+
+// FailNext makes the next count requests fail with the given HTTP status code
+func (s *Server) FailNext(count int, statusCode int) {
+	s.Lock()
+	defer s.Unlock()
+	s.failNext = count
+	s.failStatus = statusCode
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.failNext > 0 {
+		s.failNext--
+		writeError(w, r, s.failStatus, "InternalError", "injected failure")
+		return
+	}
+
+	if r.Header.Get("x-ms-version") == "" || r.Header.Get("x-ms-date") == "" {
+		writeError(w, r, http.StatusBadRequest, "MissingRequiredHeader", "x-ms-version and x-ms-date are required")
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey "+AccountName+":") {
+		writeError(w, r, http.StatusForbidden, "AuthorizationFailure", "shared key authorization is required")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/"+AccountName+"/")
+	if path == r.URL.Path {
+		writeError(w, r, http.StatusBadRequest, "InvalidUri", "unknown account")
+		return
+	}
+	containerName, blobName, _ := strings.Cut(path, "/")
+	container, ok := s.containers[containerName]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "ContainerNotFound", "the specified container does not exist")
+		return
+	}
+
+	if blobName == "" {
+		if r.URL.Query().Get("restype") != "container" {
+			writeError(w, r, http.StatusBadRequest, "InvalidQueryParameterValue", "restype=container is required")
+			return
+		}
+		switch {
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet && r.URL.Query().Get("comp") == "list":
+			s.listBlobs(w, r, containerName, container)
+		default:
+			writeError(w, r, http.StatusBadRequest, "UnsupportedHttpVerb", r.Method)
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("x-ms-blob-type") != "BlockBlob" {
+			writeError(w, r, http.StatusBadRequest, "InvalidHeaderValue", "only block blobs are supported")
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "InvalidInput", err.Error())
+			return
+		}
+		container[blobName] = data
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodGet, http.MethodHead:
+		data, ok := container[blobName]
+		if !ok {
+			writeError(w, r, http.StatusNotFound, "BlobNotFound", "the specified blob does not exist")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		writeError(w, r, http.StatusBadRequest, "UnsupportedHttpVerb", r.Method)
+	}
+}
+
+func (s *Server) listBlobs(w http.ResponseWriter, r *http.Request, containerName string, container map[string][]byte) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	marker := query.Get("marker")
+	maxResults := defaultMaxResults
+	if v := query.Get("maxresults"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			writeError(w, r, http.StatusBadRequest, "OutOfRangeQueryParameterValue", "invalid maxresults")
+			return
+		}
+		maxResults = n
+	}
+
+	var names []string
+	for name := range container {
+		if strings.HasPrefix(name, prefix) && name >= marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	result := enumerationResults{
+		ContainerName: containerName,
+		Prefix:        prefix,
+		Marker:        marker,
+		MaxResults:    maxResults,
+		Delimiter:     delimiter,
+	}
+	count := 0
+	lastPrefix := ""
+	for _, name := range names {
+		entry := listEntry{name: name}
+		if delimiter != "" {
+			if idx := strings.Index(name[len(prefix):], delimiter); idx >= 0 {
+				entry = listEntry{name: name[:len(prefix)+idx+len(delimiter)], isPrefix: true}
+			}
+		}
+		if entry.isPrefix && entry.name == lastPrefix {
+			continue
+		}
+		if count == maxResults {
+			result.NextMarker = name
+			break
+		}
+		count++
+		if entry.isPrefix {
+			lastPrefix = entry.name
+			result.Blobs.BlobPrefix = append(result.Blobs.BlobPrefix, nameEntry{Name: entry.name})
+		} else {
+			result.Blobs.Blob = append(result.Blobs.Blob, nameEntry{Name: entry.name})
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, code string, message string) {
+	w.Header().Set("x-ms-error-code", code)
+	if r.Method == http.MethodHead {
+		w.WriteHeader(statusCode)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(errorEntry{Code: code, Message: message})
+}
@@ -0,0 +1,456 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../../../LICENSE -package $GOPACKAGE -source $GOFILE -destination client_mock.go
+
+package connector
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.uber.org/multierr"
+)
+
+const (
+	// AccountKeyEnvVar is the environment variable used for the shared key when it's not set in the config.
+	AccountKeyEnvVar = "AZURE_STORAGE_KEY"
+
+	// APIVersion is the Blob service REST API version sent with every request.
+	APIVersion = "2021-08-06"
+
+	// Error codes returned by the Blob service in the x-ms-error-code header.
+	ErrCodeContainerNotFound = "ContainerNotFound"
+	ErrCodeBlobNotFound      = "BlobNotFound"
+
+	defaultEndpointTemplate = "https://%s.blob.core.windows.net"
+	defaultRequestTimeout   = 60 * time.Second
+	dialTimeout             = 10 * time.Second
+	tlsHandshakeTimeout     = 10 * time.Second
+	responseHeaderTimeout   = 30 * time.Second
+	rfc1123GMT              = "Mon, 02 Jan 2006 15:04:05 GMT"
+)
+
+var (
+	// ErrContainerNotFound is non retryable error that is returned when the container doesn't exist
+	ErrContainerNotFound = errors.New("container not found")
+	// ErrBlobNotFound is returned when the requested blob doesn't exist
+	ErrBlobNotFound = errors.New("blob not found")
+
+	errEmptyAccountName = errors.New("empty azure storage account name")
+)
+
+type (
+	// ListBlobsRequest is the request for listing blobs with the given prefix
+	ListBlobsRequest struct {
+		Prefix string
+		// Delimiter groups blob names sharing the same prefix up to the delimiter into BlobPrefixes.
+		Delimiter  string
+		Marker     string
+		MaxResults int
+	}
+
+	// ListBlobsResponse is the response for listing blobs
+	ListBlobsResponse struct {
+		BlobNames    []string
+		BlobPrefixes []string
+		// NextMarker is empty when there are no more results.
+		NextMarker string
+	}
+
+	// Client is a minimal Azure Blob Storage client. Blob names are always relative to the container.
+	Client interface {
+		Upload(ctx context.Context, container string, blobName string, data []byte) error
+		Download(ctx context.Context, container string, blobName string) ([]byte, error)
+		BlobExists(ctx context.Context, container string, blobName string) (bool, error)
+		ContainerExists(ctx context.Context, container string) (bool, error)
+		ListBlobs(ctx context.Context, container string, request *ListBlobsRequest) (*ListBlobsResponse, error)
+	}
+
+	// ResponseError is returned for any unsuccessful response from the Blob service
+	ResponseError struct {
+		StatusCode int
+		ErrorCode  string
+		Message    string
+	}
+
+	client struct {
+		httpClient  *http.Client
+		endpoint    *url.URL
+		accountName string
+		accountKey  []byte
+	}
+
+	listBlobsResult struct {
+		XMLName    xml.Name `xml:"EnumerationResults"`
+		Blobs      []string `xml:"Blobs>Blob>Name"`
+		Prefixes   []string `xml:"Blobs>BlobPrefix>Name"`
+		NextMarker string   `xml:"NextMarker"`
+	}
+
+	errorResult struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}
+)
+
+// NewClient returns a Client that talks to the Blob service REST API and authenticates with the account shared key.
+// The container must be created beforehand, this client never creates containers.
+func NewClient(cfg *config.AzblobArchiver) (Client, error) {
+	return NewClientWithHTTPClient(cfg, newHTTPClient(cfg))
+}
+
+// NewClientWithHTTPClient is the same as NewClient but uses the provided http.Client
+func NewClientWithHTTPClient(cfg *config.AzblobArchiver, httpClient *http.Client) (Client, error) {
+	if len(cfg.AccountName) == 0 {
+		return nil, errEmptyAccountName
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(defaultEndpointTemplate, cfg.AccountName)
+	}
+	endpointURL, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	accountKey := cfg.AccountKey
+	if accountKey == "" {
+		accountKey = os.Getenv(AccountKeyEnvVar)
+	}
+	var key []byte
+	if accountKey != "" {
+		key, err = base64.StdEncoding.DecodeString(accountKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode azure storage account key: %w", err)
+		}
+	}
+
+	return &client{
+		httpClient:  httpClient,
+		endpoint:    endpointURL,
+		accountName: cfg.AccountName,
+		accountKey:  key,
+	}, nil
+}
+
+func newHTTPClient(cfg *config.AzblobArchiver) *http.Client {
+	timeout := cfg.RequestTimeout
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = tlsHandshakeTimeout
+	transport.ResponseHeaderTimeout = responseHeaderTimeout
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("azure blob storage request failed with status %d: %s %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+// Upload creates or overwrites a block blob
+func (c *client) Upload(ctx context.Context, container string, blobName string, data []byte) error {
+	header := http.Header{}
+	header.Set("x-ms-blob-type", "BlockBlob")
+	header.Set("Content-Type", "application/octet-stream")
+	resp, err := c.do(ctx, http.MethodPut, blobPath(container, blobName), nil, header, data)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Download reads the whole content of a blob
+func (c *client) Download(ctx context.Context, container string, blobName string) (_ []byte, retErr error) {
+	resp, err := c.do(ctx, http.MethodGet, blobPath(container, blobName), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, resp.Body.Close())
+	}()
+	return io.ReadAll(resp.Body)
+}
+
+// BlobExists checks if the blob exists. An error is returned if the container doesn't exist.
+func (c *client) BlobExists(ctx context.Context, container string, blobName string) (bool, error) {
+	resp, err := c.do(ctx, http.MethodHead, blobPath(container, blobName), nil, nil, nil)
+	if err != nil {
+		if errors.Is(err, ErrBlobNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, resp.Body.Close()
+}
+
+// ContainerExists checks if the container exists
+func (c *client) ContainerExists(ctx context.Context, container string) (bool, error) {
+	query := url.Values{"restype": []string{"container"}}
+	resp, err := c.do(ctx, http.MethodHead, "/"+container, query, nil, nil)
+	if err != nil {
+		if errors.Is(err, ErrContainerNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, resp.Body.Close()
+}
+
+// ListBlobs lists one page of blobs in lexicographical order
+func (c *client) ListBlobs(ctx context.Context, container string, request *ListBlobsRequest) (_ *ListBlobsResponse, retErr error) {
+	query := url.Values{
+		"restype": []string{"container"},
+		"comp":    []string{"list"},
+	}
+	if request.Prefix != "" {
+		query.Set("prefix", request.Prefix)
+	}
+	if request.Delimiter != "" {
+		query.Set("delimiter", request.Delimiter)
+	}
+	if request.Marker != "" {
+		query.Set("marker", request.Marker)
+	}
+	if request.MaxResults > 0 {
+		query.Set("maxresults", strconv.Itoa(request.MaxResults))
+	}
+
+	resp, err := c.do(ctx, http.MethodGet, "/"+container, query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, resp.Body.Close())
+	}()
+
+	var result listBlobsResult
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &ListBlobsResponse{
+		BlobNames:    result.Blobs,
+		BlobPrefixes: result.Prefixes,
+		NextMarker:   result.NextMarker,
+	}, nil
+}
+
+// IsRetryableError returns true for throttling, timeout and server side errors
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var respErr *ResponseError
+	if errors.As(err, &respErr) {
+		switch respErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		}
+		return respErr.StatusCode >= http.StatusInternalServerError && respErr.StatusCode != http.StatusNotImplemented
+	}
+	if errors.Is(err, ErrContainerNotFound) || errors.Is(err, ErrBlobNotFound) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	// Any other error comes from the transport, e.g. connection reset.
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+func (c *client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	query url.Values,
+	header http.Header,
+	body []byte,
+) (*http.Response, error) {
+	reqURL := *c.endpoint
+	reqURL.Path = c.endpoint.Path + path
+	reqURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(rfc1123GMT))
+	req.Header.Set("x-ms-version", APIVersion)
+	if len(c.accountKey) > 0 {
+		req.Header.Set("Authorization", "SharedKey "+c.accountName+":"+c.sign(req))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return resp, nil
+	}
+	return nil, toError(resp)
+}
+
+// sign computes the Shared Key signature of the request as described in
+// https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (c *client) sign(req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is used instead
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalizedHeaders(req.Header) + canonicalizedResource(c.accountName, req.URL),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, c.accountKey)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func canonicalizedHeaders(header http.Header) string {
+	var names []string
+	for name := range header {
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, "x-ms-") {
+			names = append(names, lower)
+		}
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(name)
+		sb.WriteString(":")
+		sb.WriteString(strings.TrimSpace(header.Get(name)))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func canonicalizedResource(accountName string, u *url.URL) string {
+	var sb strings.Builder
+	sb.WriteString("/")
+	sb.WriteString(accountName)
+	sb.WriteString(u.EscapedPath())
+
+	// Parameter names are lowercased, and the values of names differing only in case are merged.
+	query := make(map[string][]string)
+	for name, values := range u.Query() {
+		lower := strings.ToLower(name)
+		query[lower] = append(query[lower], values...)
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values := query[name]
+		sort.Strings(values)
+		sb.WriteString("\n")
+		sb.WriteString(name)
+		sb.WriteString(":")
+		sb.WriteString(strings.Join(values, ","))
+	}
+	return sb.String()
+}
+
+func toError(resp *http.Response) error {
+	defer func() { _ = resp.Body.Close() }()
+
+	errorCode := resp.Header.Get("x-ms-error-code")
+	var message string
+	if body, err := io.ReadAll(resp.Body); err == nil && len(body) > 0 {
+		var result errorResult
+		if xml.Unmarshal(body, &result) == nil {
+			if errorCode == "" {
+				errorCode = result.Code
+			}
+			message = result.Message
+		}
+	}
+
+	switch errorCode {
+	case ErrCodeContainerNotFound:
+		return ErrContainerNotFound
+	case ErrCodeBlobNotFound:
+		return ErrBlobNotFound
+	}
+	if resp.StatusCode == http.StatusNotFound && resp.Request != nil && resp.Request.Method == http.MethodHead {
+		// HEAD responses carry no body, and some emulators omit the error code header.
+		if resp.Request.URL.Query().Get("restype") == "container" {
+			return ErrContainerNotFound
+		}
+		return ErrBlobNotFound
+	}
+	return &ResponseError{
+		StatusCode: resp.StatusCode,
+		ErrorCode:  errorCode,
+		Message:    message,
+	}
+}
+
+func blobPath(container string, blobName string) string {
+	return "/" + container + "/" + blobName
+}
@@ -0,0 +1,231 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/archiver/azblobstore/azuritetest"
+	"go.temporal.io/server/common/config"
+)
+
+const testContainer = "test-container"
+
+type clientSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	server *azuritetest.Server
+	client Client
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(clientSuite))
+}
+
+func (s *clientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.server = azuritetest.NewServer(testContainer)
+
+	var err error
+	s.client, err = NewClient(&config.AzblobArchiver{
+		AccountName: azuritetest.AccountName,
+		AccountKey:  azuritetest.AccountKey,
+		Endpoint:    s.server.AccountURL(),
+	})
+	s.NoError(err)
+}
+
+func (s *clientSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *clientSuite) TestNewClient_Validation() {
+	_, err := NewClient(&config.AzblobArchiver{})
+	s.ErrorIs(err, errEmptyAccountName)
+
+	_, err = NewClient(&config.AzblobArchiver{AccountName: "account", AccountKey: "not base64!"})
+	s.Error(err)
+}
+
+func (s *clientSuite) TestUploadAndDownload() {
+	ctx := context.Background()
+	s.NoError(s.client.Upload(ctx, testContainer, "a/b/c", []byte("content")))
+
+	data, err := s.client.Download(ctx, testContainer, "a/b/c")
+	s.NoError(err)
+	s.Equal([]byte("content"), data)
+
+	_, err = s.client.Download(ctx, testContainer, "a/b/d")
+	s.ErrorIs(err, ErrBlobNotFound)
+
+	err = s.client.Upload(ctx, "non-existent", "a/b/c", []byte("content"))
+	s.ErrorIs(err, ErrContainerNotFound)
+}
+
+func (s *clientSuite) TestBlobExists() {
+	ctx := context.Background()
+	s.server.PutBlob(testContainer, "a/b/c", []byte("content"))
+
+	exists, err := s.client.BlobExists(ctx, testContainer, "a/b/c")
+	s.NoError(err)
+	s.True(exists)
+
+	exists, err = s.client.BlobExists(ctx, testContainer, "a/b/d")
+	s.NoError(err)
+	s.False(exists)
+
+	_, err = s.client.BlobExists(ctx, "non-existent", "a/b/c")
+	s.ErrorIs(err, ErrContainerNotFound)
+}
+
+func (s *clientSuite) TestContainerExists() {
+	ctx := context.Background()
+	exists, err := s.client.ContainerExists(ctx, testContainer)
+	s.NoError(err)
+	s.True(exists)
+
+	exists, err = s.client.ContainerExists(ctx, "non-existent")
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *clientSuite) TestListBlobs_Pagination() {
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		s.server.PutBlob(testContainer, fmt.Sprintf("prefix/%d", i), nil)
+	}
+	s.server.PutBlob(testContainer, "other/0", nil)
+
+	var names []string
+	marker := ""
+	pages := 0
+	for {
+		resp, err := s.client.ListBlobs(ctx, testContainer, &ListBlobsRequest{
+			Prefix:     "prefix/",
+			Marker:     marker,
+			MaxResults: 2,
+		})
+		s.NoError(err)
+		names = append(names, resp.BlobNames...)
+		pages++
+		if resp.NextMarker == "" {
+			break
+		}
+		marker = resp.NextMarker
+	}
+	s.Equal([]string{"prefix/0", "prefix/1", "prefix/2", "prefix/3", "prefix/4"}, names)
+	s.Equal(3, pages)
+}
+
+func (s *clientSuite) TestListBlobs_Delimiter() {
+	ctx := context.Background()
+	s.server.PutBlob(testContainer, "history/1/0", nil)
+	s.server.PutBlob(testContainer, "history/1/1", nil)
+	s.server.PutBlob(testContainer, "history/100/0", nil)
+	s.server.PutBlob(testContainer, "history/blob", nil)
+
+	resp, err := s.client.ListBlobs(ctx, testContainer, &ListBlobsRequest{
+		Prefix:    "history/",
+		Delimiter: "/",
+	})
+	s.NoError(err)
+	s.Equal([]string{"history/1/", "history/100/"}, resp.BlobPrefixes)
+	s.Equal([]string{"history/blob"}, resp.BlobNames)
+	s.Empty(resp.NextMarker)
+}
+
+func (s *clientSuite) TestServerError() {
+	s.server.FailNext(1, http.StatusServiceUnavailable)
+	_, err := s.client.Download(context.Background(), testContainer, "a/b/c")
+	var respErr *ResponseError
+	s.ErrorAs(err, &respErr)
+	s.Equal(http.StatusServiceUnavailable, respErr.StatusCode)
+	s.True(IsRetryableError(err))
+}
+
+func (s *clientSuite) TestSign() {
+	c := &client{
+		accountName: "account",
+		accountKey:  []byte("key"),
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://account.blob.core.windows.net/container?restype=container&comp=list&prefix=a", nil)
+	s.NoError(err)
+	req.Header.Set("x-ms-date", "Fri, 26 Jun 2015 23:39:12 GMT")
+	req.Header.Set("x-ms-version", APIVersion)
+
+	// Same request with query parameters in a different order must produce the same signature.
+	other, err := http.NewRequest(http.MethodGet, "https://account.blob.core.windows.net/container?prefix=a&comp=list&restype=container", nil)
+	s.NoError(err)
+	other.Header = req.Header.Clone()
+	s.Equal(c.sign(req), c.sign(other))
+
+	s.Equal("/account/container\ncomp:list\nprefix:a\nrestype:container", canonicalizedResource("account", req.URL))
+	s.Equal("x-ms-date:Fri, 26 Jun 2015 23:39:12 GMT\nx-ms-version:"+APIVersion+"\n", canonicalizedHeaders(req.Header))
+}
+
+func (s *clientSuite) TestCanonicalizedResource_MixedCaseQuery() {
+	u, err := url.Parse("https://account.blob.core.windows.net/container?restype=container&Comp=list&Prefix=b&prefix=a")
+	s.NoError(err)
+	s.Equal("/account/container\ncomp:list\nprefix:a,b\nrestype:container", canonicalizedResource("account", u))
+}
+
+func (s *clientSuite) TestNewHTTPClient() {
+	httpClient := newHTTPClient(&config.AzblobArchiver{})
+	s.NotSame(http.DefaultClient, httpClient)
+	s.Equal(defaultRequestTimeout, httpClient.Timeout)
+	s.Equal(responseHeaderTimeout, httpClient.Transport.(*http.Transport).ResponseHeaderTimeout)
+
+	httpClient = newHTTPClient(&config.AzblobArchiver{RequestTimeout: 5 * time.Second})
+	s.Equal(5*time.Second, httpClient.Timeout)
+}
+
+func TestIsRetryableError(t *testing.T) {
+	testCases := []struct {
+		err       error
+		retryable bool
+	}{
+		{err: nil, retryable: false},
+		{err: errors.New("some error"), retryable: false},
+		{err: ErrBlobNotFound, retryable: false},
+		{err: ErrContainerNotFound, retryable: false},
+		{err: &ResponseError{StatusCode: http.StatusForbidden}, retryable: false},
+		{err: &ResponseError{StatusCode: http.StatusNotImplemented}, retryable: false},
+		{err: &ResponseError{StatusCode: http.StatusTooManyRequests}, retryable: true},
+		{err: &ResponseError{StatusCode: http.StatusRequestTimeout}, retryable: true},
+		{err: &ResponseError{StatusCode: http.StatusInternalServerError}, retryable: true},
+		{err: &url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("connection reset")}, retryable: true},
+		{err: &url.Error{Op: "Get", URL: "http://localhost", Err: context.DeadlineExceeded}, retryable: false},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.retryable, IsRetryableError(tc.err), "%v", tc.err)
+	}
+}
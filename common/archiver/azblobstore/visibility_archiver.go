@@ -0,0 +1,352 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblobstore
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/azblobstore/connector"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		client      connector.Client
+		queryParser QueryParser
+	}
+
+	queryVisibilityRequest struct {
+		namespaceID   string
+		pageSize      int
+		nextPageToken []byte
+		parsedQuery   *parsedQuery
+	}
+
+	indexToArchive struct {
+		primaryIndex            string
+		primaryIndexValue       string
+		secondaryIndex          string
+		secondaryIndexTimestamp time.Time
+	}
+)
+
+const (
+	errEncodeVisibilityRecord       = "failed to encode visibility record"
+	secondaryIndexKeyStartTimeout   = "startTimeout"
+	secondaryIndexKeyCloseTimeout   = "closeTimeout"
+	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
+	primaryIndexKeyWorkflowID       = "workflowID"
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on Azure Blob Storage
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.AzblobArchiver,
+) (archiver.VisibilityArchiver, error) {
+	client, err := connector.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiver(container, client), nil
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	client connector.Client,
+) *visibilityArchiver {
+	return &visibilityArchiver{
+		container:   container,
+		client:      client,
+		queryParser: NewQueryParser(),
+	}
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	handler := v.container.MetricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityArchiverScope), metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	startTime := time.Now().UTC()
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+	archiveFailReason := ""
+	defer func() {
+		metrics.ServiceLatency.With(handler).Record(time.Since(startTime))
+		if err != nil {
+			if isRetryableError(err) {
+				metrics.VisibilityArchiverArchiveTransientErrorCount.With(handler).Record(1)
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+			} else {
+				metrics.VisibilityArchiverArchiveNonRetryableErrorCount.With(handler).Record(1)
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+				if featureCatalog.NonRetryableError != nil {
+					err = featureCatalog.NonRetryableError()
+				}
+			}
+		}
+	}()
+
+	if err := softValidateURI(URI); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidArchiveRequest
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityRecord
+		return err
+	}
+	indexes := createIndexesToArchive(request)
+	// Upload archive to all indexes
+	for _, element := range indexes {
+		blobName := constructTimestampIndex(URI.Path(), request.GetNamespaceId(), element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.GetRunId())
+		if err := upload(ctx, v.client, URI, blobName, encodedVisibilityRecord); err != nil {
+			archiveFailReason = errWriteBlob
+			return err
+		}
+	}
+	metrics.VisibilityArchiveSuccessCount.With(handler).Record(1)
+	return nil
+}
+
+func createIndexesToArchive(request *archiverspb.VisibilityRecord) []indexToArchive {
+	return []indexToArchive{
+		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyCloseTimeout, timestamp.TimeValue(request.CloseTime)},
+		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyStartTimeout, timestamp.TimeValue(request.StartTime)},
+		{primaryIndexKeyWorkflowID, request.GetWorkflowId(), secondaryIndexKeyCloseTimeout, timestamp.TimeValue(request.CloseTime)},
+		{primaryIndexKeyWorkflowID, request.GetWorkflowId(), secondaryIndexKeyStartTimeout, timestamp.TimeValue(request.StartTime)},
+	}
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+
+	if err := softValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	if strings.TrimSpace(request.Query) == "" {
+		return v.queryAll(ctx, URI, request, saTypeMap)
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	return v.query(
+		ctx,
+		URI,
+		&queryVisibilityRequest{
+			namespaceID:   request.NamespaceID,
+			pageSize:      request.PageSize,
+			nextPageToken: request.NextPageToken,
+			parsedQuery:   parsedQuery,
+		},
+		saTypeMap,
+	)
+}
+
+// queryAll returns all workflow executions in the archive.
+func (v *visibilityArchiver) queryAll(
+	ctx context.Context,
+	uri archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	// remaining is the number of workflow executions left to return before we reach pageSize.
+	remaining := request.PageSize
+	nextPageToken := request.NextPageToken
+	var executions []*workflowpb.WorkflowExecutionInfo
+	// Each execution is stored under 4 indexes (see createIndexesToArchive). Only the workflowTypeName/closeTimeout
+	// entries are returned so that every execution is listed once. Since the skipped entries still count towards the
+	// maximum number of blobs returned by a single list call, we may need several calls to fill the page.
+	for {
+		searchPrefix := constructVisibilitySearchPrefix(uri.Path(), request.NamespaceID) + "/" + primaryIndexKeyWorkflowTypeName
+		res, err := v.queryPrefix(ctx, uri, &queryVisibilityRequest{
+			namespaceID:   request.NamespaceID,
+			pageSize:      remaining,
+			nextPageToken: nextPageToken,
+			parsedQuery:   &parsedQuery{},
+		}, saTypeMap, searchPrefix, func(blobName string) bool {
+			// Blob names for the closeTimeout secondary index are always of the form .../closeTimeout/<closeTimeout>/<runID>.
+			elements := strings.Split(blobName, "/")
+			return len(elements) >= 3 && elements[len(elements)-3] == secondaryIndexKeyCloseTimeout
+		})
+		if err != nil {
+			return nil, err
+		}
+		nextPageToken = res.NextPageToken
+		executions = append(executions, res.Executions...)
+		remaining -= len(res.Executions)
+		if len(nextPageToken) == 0 || remaining <= 0 {
+			break
+		}
+	}
+	return &archiver.QueryVisibilityResponse{
+		Executions:    executions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	primaryIndex := primaryIndexKeyWorkflowTypeName
+	primaryIndexValue := request.parsedQuery.workflowTypeName
+	if request.parsedQuery.workflowID != nil {
+		primaryIndex = primaryIndexKeyWorkflowID
+		primaryIndexValue = request.parsedQuery.workflowID
+	}
+
+	prefix := constructIndexedVisibilitySearchPrefix(
+		URI.Path(),
+		request.namespaceID,
+		primaryIndex,
+		*primaryIndexValue,
+		secondaryIndexKeyCloseTimeout,
+	) + "/"
+	if request.parsedQuery.closeTime != nil {
+		prefix = constructTimeBasedSearchPrefix(
+			URI.Path(),
+			request.namespaceID,
+			primaryIndex,
+			*primaryIndexValue,
+			secondaryIndexKeyCloseTimeout,
+			*request.parsedQuery.closeTime,
+			*request.parsedQuery.searchPrecision,
+		)
+	}
+	if request.parsedQuery.startTime != nil {
+		prefix = constructTimeBasedSearchPrefix(
+			URI.Path(),
+			request.namespaceID,
+			primaryIndex,
+			*primaryIndexValue,
+			secondaryIndexKeyStartTimeout,
+			*request.parsedQuery.startTime,
+			*request.parsedQuery.searchPrecision,
+		)
+	}
+
+	return v.queryPrefix(ctx, URI, request, saTypeMap, prefix, nil)
+}
+
+// queryPrefix returns all workflow executions in the archive that match the given prefix. The blobFilter function is
+// an optional filter that can be used to further filter the results. If blobFilter returns false for a given blob
+// name, that blob will be skipped and not downloaded.
+func (v *visibilityArchiver) queryPrefix(
+	ctx context.Context,
+	uri archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+	prefix string,
+	blobFilter func(blobName string) bool,
+) (*archiver.QueryVisibilityResponse, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	var marker string
+	if request.nextPageToken != nil {
+		marker = deserializeQueryVisibilityToken(request.nextPageToken)
+	}
+	results, err := v.client.ListBlobs(ctx, uri.Hostname(), &connector.ListBlobsRequest{
+		Prefix:     prefix,
+		Marker:     marker,
+		MaxResults: request.pageSize,
+	})
+	if err != nil {
+		if isRetryableError(err) {
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+		if errors.Is(err, connector.ErrContainerNotFound) {
+			return nil, serviceerror.NewInvalidArgument(errContainerNotExists.Error())
+		}
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	// The Blob service may return an empty page with a marker, so the token is always set.
+	response := &archiver.QueryVisibilityResponse{}
+	if results.NextMarker != "" {
+		response.NextPageToken = serializeQueryVisibilityToken(results.NextMarker)
+	}
+	for _, blobName := range results.BlobNames {
+		if blobFilter != nil && !blobFilter(blobName) {
+			continue
+		}
+
+		encodedRecord, err := download(ctx, v.client, uri, blobName)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	err := softValidateURI(URI)
+	if err != nil {
+		return err
+	}
+	return containerExists(context.TODO(), v.client, URI)
+}
@@ -28,6 +28,11 @@
 // hash(namespaceID, workflowID, runID)_version.history being created in the specified
 // directory. Workflow histories stored in that file are encoded in JSON format.
 
+// If compression is configured, each Archive() request instead results in a manifest file named
+// hash(namespaceID, workflowID, runID)_version.manifest. History batches are compressed and
+// written to the blobs subdirectory under the checksum of their content, and the manifest
+// records the checksum of each of them. Get() reads both layouts.
+
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
 // version and the index of the first history batch that should be returned. Instead of
@@ -49,6 +54,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/archiveformat"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
@@ -63,6 +69,8 @@ const (
 	errMakeDirectory = "failed to make directory"
 	errWriteFile     = "failed to write history to file"
 
+	blobsDirname = "blobs"
+
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
 
@@ -73,9 +81,10 @@ var (
 
 type (
 	historyArchiver struct {
-		container *archiver.HistoryBootstrapContainer
-		fileMode  os.FileMode
-		dirMode   os.FileMode
+		container   *archiver.HistoryBootstrapContainer
+		fileMode    os.FileMode
+		dirMode     os.FileMode
+		compression archiveformat.Compression
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -108,10 +117,15 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	compression, err := archiveformat.ParseCompression(config.Compression)
+	if err != nil {
+		return nil, err
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		compression:     compression,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -146,7 +160,7 @@ func (h *historyArchiver) Archive(
 		historyIterator = archiver.NewHistoryIterator(request, h.container.ExecutionManager, targetHistoryBlobSize)
 	}
 
-	var historyBatches []*historypb.History
+	var historyBlobs [][]*historypb.History
 	for historyIterator.HasNext() {
 		historyBlob, err := historyIterator.Next(ctx)
 		if err != nil {
@@ -172,9 +186,23 @@ func (h *historyArchiver) Archive(
 			return archiver.ErrHistoryMutated
 		}
 
-		historyBatches = append(historyBatches, historyBlob.Body...)
+		historyBlobs = append(historyBlobs, historyBlob.Body)
+	}
+
+	dirPath := URI.Path()
+	if err = mkdirAll(dirPath, h.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
 	}
 
+	if h.compression != archiveformat.CompressionNone {
+		return h.archiveWithManifest(logger, dirPath, request, historyBlobs)
+	}
+
+	var historyBatches []*historypb.History
+	for _, historyBlob := range historyBlobs {
+		historyBatches = append(historyBatches, historyBlob...)
+	}
 	encoder := codec.NewJSONPBEncoder()
 	encodedHistoryBatches, err := encoder.EncodeHistories(historyBatches)
 	if err != nil {
@@ -182,21 +210,77 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
-	dirPath := URI.Path()
-	if err = mkdirAll(dirPath, h.dirMode); err != nil {
+	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (h *historyArchiver) archiveWithManifest(
+	logger log.Logger,
+	dirPath string,
+	request *archiver.ArchiveHistoryRequest,
+	historyBlobs [][]*historypb.History,
+) error {
+	blobsDirPath := path.Join(dirPath, blobsDirname)
+	if err := mkdirAll(blobsDirPath, h.dirMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
 		return err
 	}
 
-	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
-	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
+	manifest := &archiveformat.Manifest{
+		FormatVersion:        archiveformat.FormatVersion,
+		Compression:          h.compression,
+		NamespaceID:          request.NamespaceID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		CloseFailoverVersion: request.CloseFailoverVersion,
+	}
+	for _, historyBlob := range historyBlobs {
+		data, ref, err := archiveformat.EncodeBatch(h.compression, historyBlob)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
+		if err := h.writeBatchFile(path.Join(blobsDirPath, ref.Checksum), ref, data); err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+			return err
+		}
+		manifest.Batches = append(manifest.Batches, ref)
+	}
+
+	encodedManifest, err := archiveformat.EncodeManifest(manifest)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	// manifest is written last so that it only ever references complete batch files
+	filename := constructManifestFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := writeFile(path.Join(dirPath, filename), encodedManifest, h.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
-
 	return nil
 }
 
+// writeBatchFile skips the write if an intact copy of the content addressed batch already exists
+func (h *historyArchiver) writeBatchFile(filepath string, ref archiveformat.BatchRef, data []byte) error {
+	exists, err := fileExists(filepath)
+	if err != nil {
+		return err
+	}
+	if exists {
+		existing, err := readFile(filepath)
+		if err == nil && archiveformat.VerifyBatch(ref, existing) == nil {
+			return nil
+		}
+	}
+	return writeFile(filepath, data, h.fileMode)
+}
+
 func (h *historyArchiver) Get(
 	ctx context.Context,
 	URI archiver.URI,
@@ -241,6 +325,15 @@ func (h *historyArchiver) Get(
 		}
 	}
 
+	manifestFilepath := path.Join(dirPath, constructManifestFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion))
+	exists, err = fileExists(manifestFilepath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if exists {
+		return getFromManifest(dirPath, manifestFilepath, request, token)
+	}
+
 	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
 	filepath := path.Join(dirPath, filename)
 	exists, err = fileExists(filepath)
@@ -287,6 +380,65 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func getFromManifest(
+	dirPath string,
+	manifestFilepath string,
+	request *archiver.GetHistoryRequest,
+	token *getHistoryToken,
+) (*archiver.GetHistoryResponse, error) {
+	encodedManifest, err := readFile(manifestFilepath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	manifest, err := archiveformat.DecodeManifest(encodedManifest)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	numOfBatches := 0
+	// index of the first history batch in the current blob
+	firstBatchIdx := 0
+	nextBatchIdx := token.NextBatchIdx
+	for _, ref := range manifest.Batches {
+		numOfBatches += ref.HistoryCount
+		if numOfEvents >= request.PageSize || firstBatchIdx+ref.HistoryCount <= nextBatchIdx {
+			firstBatchIdx += ref.HistoryCount
+			continue
+		}
+
+		data, err := readFile(path.Join(dirPath, blobsDirname, ref.Checksum))
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		historyBatches, err := archiveformat.DecodeBatch(manifest.Compression, ref, data)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		for _, batch := range historyBatches[nextBatchIdx-firstBatchIdx:] {
+			response.HistoryBatches = append(response.HistoryBatches, batch)
+			nextBatchIdx++
+			numOfEvents += len(batch.Events)
+			if numOfEvents >= request.PageSize {
+				break
+			}
+		}
+		firstBatchIdx += ref.HistoryCount
+	}
+
+	if nextBatchIdx < numOfBatches {
+		token.NextBatchIdx = nextBatchIdx
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
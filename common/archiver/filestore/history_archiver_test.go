@@ -40,6 +40,7 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/archiveformat"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/tests/testutils"
@@ -575,10 +576,161 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidCompression() {
+	config := &config.FilestoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: "lz4",
+	}
+	_, err := newHistoryArchiver(s.container, config, nil)
+	s.ErrorIs(err, archiveformat.ErrUnsupportedCompression)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_Compressed() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	expectHistoryBlobs := func() {
+		historyIterator.EXPECT().HasNext().Return(true)
+		historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{IsLast: false},
+			Body:   s.historyBatchesV100[:1],
+		}, nil)
+		historyIterator.EXPECT().HasNext().Return(true)
+		historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{IsLast: true},
+			Body:   s.historyBatchesV100[1:],
+		}, nil)
+		historyIterator.EXPECT().HasNext().Return(false)
+	}
+	expectHistoryBlobs()
+	expectHistoryBlobs()
+
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndGet_Compressed")
+
+	historyArchiver := s.newTestHistoryArchiverWithCompression(historyIterator, archiveformat.CompressionZstd)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	s.NoError(historyArchiver.Archive(context.Background(), URI, archiveRequest))
+	// archiving the same history again must not duplicate batch files
+	s.NoError(historyArchiver.Archive(context.Background(), URI, archiveRequest))
+
+	s.assertFileExists(path.Join(dir, constructManifestFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)))
+	exists, err := fileExists(path.Join(dir, constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)))
+	s.NoError(err)
+	s.False(exists)
+	blobFiles, err := listFiles(path.Join(dir, blobsDirname))
+	s.NoError(err)
+	s.Len(blobFiles, 2)
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	var combinedHistory []*historypb.History
+	getRequest.PageSize = 1
+	for {
+		response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+		s.NoError(err)
+		s.Len(response.HistoryBatches, 1)
+		combinedHistory = append(combinedHistory, response.HistoryBatches...)
+		if response.NextPageToken == nil {
+			break
+		}
+		getRequest.NextPageToken = response.NextPageToken
+	}
+	s.Equal(s.historyBatchesV100, combinedHistory)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_CorruptedBatch() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{IsLast: true},
+			Body:   s.historyBatchesV100,
+		}, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir := testutils.MkdirTemp(s.T(), "", "TestGet_Fail_CorruptedBatch")
+
+	historyArchiver := s.newTestHistoryArchiverWithCompression(historyIterator, archiveformat.CompressionGzip)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	s.NoError(historyArchiver.Archive(context.Background(), URI, archiveRequest))
+
+	blobFiles, err := listFiles(path.Join(dir, blobsDirname))
+	s.NoError(err)
+	s.Len(blobFiles, 1)
+	s.NoError(writeFile(path.Join(dir, blobsDirname, blobFiles[0]), []byte("corrupted"), testFileMode))
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.Nil(response)
+	s.IsType(&serviceerror.Internal{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_LegacyFormatWithCompression() {
+	historyArchiver := s.newTestHistoryArchiverWithCompression(nil, archiveformat.CompressionZstd)
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	return s.newTestHistoryArchiverWithCompression(historyIterator, archiveformat.CompressionNone)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiverWithCompression(
+	historyIterator archiver.HistoryIterator,
+	compression archiveformat.Compression,
+) *historyArchiver {
 	config := &config.FilestoreArchiver{
-		FileMode: testFileModeStr,
-		DirMode:  testDirModeStr,
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: string(compression),
 	}
 	archiver, err := newHistoryArchiver(s.container, config, historyIterator)
 	s.NoError(err)
@@ -27,27 +27,27 @@
 package filestore
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/temporalio/sqlparser"
 	enumspb "go.temporal.io/api/enums/v1"
-	"go.temporal.io/server/common/convert"
-	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/archiver/visibilityquery"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/util"
 )
 
 type (
-	// QueryParser parses a limited SQL where clause into a struct
+	// QueryParser parses a SQL-like where clause into a struct
 	QueryParser interface {
-		Parse(query string) (*parsedQuery, error)
+		Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
 
+	// parsedQuery holds the predicates which can be checked without decoding search attributes.
+	// They are extracted from the top level "and" of the query. filter holds the rest of the query.
 	parsedQuery struct {
 		earliestCloseTime time.Time
 		latestCloseTime   time.Time
@@ -56,10 +56,12 @@ type (
 		workflowTypeName  *string
 		status            *enumspb.WorkflowExecutionStatus
 		emptyResult       bool
+		filter            visibilityquery.Filter
 	}
 )
 
-// All allowed fields for filtering
+// Filters which are extracted into parsedQuery fields. Any other system or custom
+// search attribute can be used in the query as well.
 const (
 	WorkflowID   = "WorkflowId"
 	RunID        = "RunId"
@@ -69,146 +71,117 @@ const (
 	ExecutionStatus = "ExecutionStatus"
 )
 
-const (
-	queryTemplate = "select * from dummy where %s"
-
-	defaultDateTimeFormat = time.RFC3339
-)
+var closeTimeOperators = map[string]struct{}{
+	sqlparser.EqualStr:        {},
+	sqlparser.LessThanStr:     {},
+	sqlparser.LessEqualStr:    {},
+	sqlparser.GreaterThanStr:  {},
+	sqlparser.GreaterEqualStr: {},
+}
 
 // NewQueryParser creates a new query parser for filestore
 func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
-func (p *queryParser) Parse(query string) (*parsedQuery, error) {
+func (p *queryParser) Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error) {
 	parsedQuery := &parsedQuery{
 		earliestCloseTime: time.Time{},
 		latestCloseTime:   time.Now().UTC(),
 	}
-	if strings.TrimSpace(query) == "" {
-		return parsedQuery, nil
-	}
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	whereExpr, err := visibilityquery.ParseWhere(query)
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
-		return nil, err
+	if whereExpr == nil {
+		return parsedQuery, nil
 	}
-	return parsedQuery, nil
-}
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
+	var remaining []sqlparser.Expr
+	for _, expr := range visibilityquery.Conjuncts(whereExpr) {
+		converted, err := p.convertComparisonExpr(expr, parsedQuery)
+		if err != nil {
+			return nil, err
+		}
+		if !converted {
+			remaining = append(remaining, expr)
+		}
 	}
 
-	switch expr := expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
+	if len(remaining) > 0 {
+		parsedQuery.filter, err = visibilityquery.NewFilter(visibilityquery.And(remaining...), saTypeMap, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
+	return parsedQuery, nil
 }
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
+// convertComparisonExpr extracts expr into parsedQuery fields if possible. It returns false
+// if expr has to be evaluated by the filter.
+func (p *queryParser) convertComparisonExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) (bool, error) {
+	compExpr, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return false, nil
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
-}
-
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
+	colNameStr, ok := visibilityquery.ColumnName(compExpr.Left)
 	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
+		return false, nil
 	}
-	colNameStr := sqlparser.String(colName)
 	op := compExpr.Operator
 	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
 	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+		return false, nil
 	}
-	valStr := sqlparser.String(valExpr)
 
 	switch colNameStr {
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowID)
-		}
-		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
+	case WorkflowID, RunID, WorkflowType:
+		if op != sqlparser.EqualStr {
+			return false, nil
+		}
+		if valExpr.Type != sqlparser.StrVal {
+			return false, fmt.Errorf("value %s is not a string value", sqlparser.String(valExpr))
+		}
+		val := string(valExpr.Val)
+		target := &parsedQuery.workflowID
+		switch colNameStr {
+		case RunID:
+			target = &parsedQuery.runID
+		case WorkflowType:
+			target = &parsedQuery.workflowTypeName
+		}
+		if *target != nil && **target != val {
 			parsedQuery.emptyResult = true
-			return nil
+			return true, nil
 		}
-		parsedQuery.workflowID = util.Ptr(val)
-	case RunID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", RunID)
-		}
-		if parsedQuery.runID != nil && *parsedQuery.runID != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.runID = util.Ptr(val)
-	case WorkflowType:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowType)
-		}
-		if parsedQuery.workflowTypeName != nil && *parsedQuery.workflowTypeName != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.workflowTypeName = util.Ptr(val)
+		*target = util.Ptr(val)
 	case ExecutionStatus:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			// if failed to extract string value, it means user input close status as a number
-			val = valStr
+		if op != sqlparser.EqualStr {
+			return false, nil
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", ExecutionStatus)
-		}
-		status, err := convertStatusStr(val)
+		status, err := convertStatus(valExpr)
 		if err != nil {
-			return err
+			return false, err
 		}
 		if parsedQuery.status != nil && *parsedQuery.status != status {
 			parsedQuery.emptyResult = true
-			return nil
+			return true, nil
 		}
 		parsedQuery.status = &status
 	case CloseTime:
-		timestamp, err := convertToTime(valStr)
+		if _, ok := closeTimeOperators[op]; !ok {
+			return false, nil
+		}
+		timestamp, err := convertToTime(valExpr)
 		if err != nil {
-			return err
+			return false, err
 		}
-		return p.convertCloseTime(timestamp, op, parsedQuery)
+		return true, p.convertCloseTime(timestamp, op, parsedQuery)
 	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		return false, nil
 	}
 
-	return nil
+	return true, nil
 }
 
 func (p *queryParser) convertCloseTime(timestamp time.Time, op string, parsedQuery *parsedQuery) error {
@@ -234,45 +207,29 @@ func (p *queryParser) convertCloseTime(timestamp time.Time, op string, parsedQue
 	return nil
 }
 
-func convertToTime(timeStr string) (time.Time, error) {
-	ts, err := strconv.ParseInt(timeStr, 10, 64)
-	if err == nil {
-		return timestamp.UnixOrZeroTime(ts), nil
-	}
-	timestampStr, err := extractStringValue(timeStr)
+func convertToTime(valExpr *sqlparser.SQLVal) (time.Time, error) {
+	val, err := convertSQLVal(valExpr)
 	if err != nil {
 		return time.Time{}, err
 	}
-	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
-	if err != nil {
-		return time.Time{}, err
-	}
-	return parsedTime, nil
+	return visibilityquery.ParseTime(val)
 }
 
-func convertStatusStr(statusStr string) (enumspb.WorkflowExecutionStatus, error) {
-	statusStr = strings.ToLower(strings.TrimSpace(statusStr))
-	switch statusStr {
-	case "completed", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)):
-		return enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, nil
-	case "failed", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)):
-		return enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, nil
-	case "canceled", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED)):
-		return enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED, nil
-	case "terminated", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED)):
-		return enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, nil
-	case "continuedasnew", "continued_as_new", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW)):
-		return enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW, nil
-	case "timedout", "timed_out", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT)):
-		return enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT, nil
-	default:
-		return 0, fmt.Errorf("unknown workflow close status: %s", statusStr)
+func convertStatus(valExpr *sqlparser.SQLVal) (enumspb.WorkflowExecutionStatus, error) {
+	val, err := convertSQLVal(valExpr)
+	if err != nil {
+		return 0, err
 	}
+	return visibilityquery.ParseExecutionStatus(val)
 }
 
-func extractStringValue(s string) (string, error) {
-	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
-		return s[1 : len(s)-1], nil
+func convertSQLVal(valExpr *sqlparser.SQLVal) (interface{}, error) {
+	switch valExpr.Type {
+	case sqlparser.StrVal:
+		return string(valExpr.Val), nil
+	case sqlparser.IntVal:
+		return strconv.ParseInt(string(valExpr.Val), 10, 64)
+	default:
+		return nil, fmt.Errorf("invalid value: %s", sqlparser.String(valExpr))
 	}
-	return "", fmt.Errorf("value %s is not a string value", s)
 }
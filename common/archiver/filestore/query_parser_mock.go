@@ -36,6 +36,7 @@ package filestore
 import (
 	reflect "reflect"
 
+	searchattribute "go.temporal.io/server/common/searchattribute"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -63,16 +64,16 @@ func (m *MockQueryParser) EXPECT() *MockQueryParserMockRecorder {
 }
 
 // Parse mocks base method.
-func (m *MockQueryParser) Parse(query string) (*parsedQuery, error) {
+func (m *MockQueryParser) Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Parse", query)
+	ret := m.ctrl.Call(m, "Parse", query, saTypeMap)
 	ret0, _ := ret[0].(*parsedQuery)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Parse indicates an expected call of Parse.
-func (mr *MockQueryParserMockRecorder) Parse(query any) *gomock.Call {
+func (mr *MockQueryParserMockRecorder) Parse(query, saTypeMap any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQueryParser)(nil).Parse), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQueryParser)(nil).Parse), query, saTypeMap)
 }
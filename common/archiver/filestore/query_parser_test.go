@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/util"
 )
 
@@ -112,8 +113,9 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 			expectErr: true,
 		},
 		{
-			query:     "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
-			expectErr: true,
+			query:       "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" or runId = \"random runID\"",
@@ -130,7 +132,7 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -212,8 +214,9 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 			expectErr: true,
 		},
 		{
-			query:     "ExecutionStatus = \"Failed\" or ExecutionStatus = \"Failed\"",
-			expectErr: true,
+			query:       "ExecutionStatus = \"Failed\" or ExecutionStatus = \"Failed\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "ExecutionStatus = \"unknown\"",
@@ -237,7 +240,7 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -303,7 +306,7 @@ func (s *queryParserSuite) TestParseCloseTime() {
 	}
 
 	for i, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -352,7 +355,7 @@ func (s *queryParserSuite) TestParse() {
 	}
 
 	for i, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -364,3 +367,61 @@ func (s *queryParserSuite) TestParse() {
 		}
 	}
 }
+
+func (s *queryParserSuite) TestParseFilter() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *parsedQuery
+		hasFilter   bool
+	}{
+		{
+			query:       "WorkflowId = 'random workflowID' and (WorkflowType = 'type1' or WorkflowType = 'type2')",
+			parsedQuery: &parsedQuery{workflowID: util.Ptr("random workflowID")},
+			hasFilter:   true,
+		},
+		{
+			query:       "WorkflowType in ('type1', 'type2') and ExecutionStatus = 'Failed'",
+			parsedQuery: &parsedQuery{status: toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)},
+			hasFilter:   true,
+		},
+		{
+			query:       "WorkflowId starts_with 'random' and StartTime > '2019-01-01T11:11:11Z'",
+			parsedQuery: &parsedQuery{},
+			hasFilter:   true,
+		},
+		{
+			query:       "CustomKeywordField = 'value' and CustomIntField between 1 and 10",
+			parsedQuery: &parsedQuery{},
+			hasFilter:   true,
+		},
+		{
+			query:     "UnknownField = 'value'",
+			expectErr: true,
+		},
+		{
+			query:     "TaskQueue = 'value'",
+			expectErr: true,
+		},
+		{
+			query:     "CustomIntField = 'not a number'",
+			expectErr: true,
+		},
+		{
+			query:     "CustomBoolField > true",
+			expectErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
+		if tc.expectErr {
+			s.Error(err, "case %d", i)
+			continue
+		}
+		s.NoError(err, "case %d", i)
+		s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID, "case %d", i)
+		s.Equal(tc.parsedQuery.status, parsedQuery.status, "case %d", i)
+		s.Equal(tc.hasFilter, parsedQuery.filter != nil, "case %d", i)
+	}
+}
@@ -189,6 +189,11 @@ func constructHistoryFilename(namespaceID, workflowID, runID string, version int
 	return fmt.Sprintf("%s_%v.history", combinedHash, version)
 }
 
+func constructManifestFilename(namespaceID, workflowID, runID string, version int64) string {
+	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
+	return fmt.Sprintf("%s_%v.manifest", combinedHash, version)
+}
+
 func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
 	return strings.Join([]string{hash(namespaceID), hash(workflowID), hash(runID)}, "")
 }
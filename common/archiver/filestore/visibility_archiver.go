@@ -153,7 +153,7 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
@@ -338,6 +338,9 @@ func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
 	if query.status != nil && record.Status != *query.status {
 		return false
 	}
+	if query.filter != nil && !query.filter.Match(record) {
+		return false
+	}
 	return true
 }
 
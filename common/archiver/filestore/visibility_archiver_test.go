@@ -27,6 +27,7 @@ package filestore
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"testing"
@@ -355,7 +356,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
@@ -369,7 +370,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 101),
 	}, nil)
@@ -389,7 +390,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 101),
 	}, nil)
@@ -408,7 +409,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 10001),
 		workflowID:        util.Ptr(testWorkflowID),
@@ -434,7 +435,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 10001),
 		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
@@ -470,12 +471,121 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.Equal(ei, response.Executions[0])
 }
 
+func (s *visibilityArchiverSuite) TestQuery_Success_SQLQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
+	s.NoError(err)
+
+	testCases := []struct {
+		query           string
+		expectedRecords []int
+	}{
+		{
+			query:           "WorkflowId = 'another workflow ID' or WorkflowId starts_with 'some'",
+			expectedRecords: []int{1, 2},
+		},
+		{
+			query:           "ExecutionStatus in ('Failed', 'Terminated') and StartTime > 1 and HistoryLength != 123",
+			expectedRecords: []int{3},
+		},
+		{
+			query:           "(ExecutionStatus = 'ContinuedAsNew' or CloseTime >= 10000) and WorkflowType = '" + testWorkflowTypeName + "'",
+			expectedRecords: []int{0, 2},
+		},
+		{
+			query:           "RunId not in ('" + testRunID + "', 'some random run ID') and CloseTime between 1 and 100",
+			expectedRecords: []int{2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    10,
+			Query:       tc.query,
+		}
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err, tc.query)
+		s.Len(response.Executions, len(tc.expectedRecords), tc.query)
+		for i, recordIdx := range tc.expectedRecords {
+			s.Equal(s.visibilityRecords[recordIdx].GetRunId(), response.Executions[i].GetExecution().GetRunId(), tc.query)
+		}
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_CustomSearchAttributes() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndQuery_CustomSearchAttributes")
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	for i, searchAttributes := range []map[string]string{
+		{"CustomKeywordField": "keyword1", "CustomIntField": "1"},
+		{"CustomKeywordField": "keyword2", "CustomIntField": "2", "KeywordList01": `["a","b"]`},
+		{"CustomIntField": "3", "KeywordList01": `["b","c"]`},
+	} {
+		record := &archiverspb.VisibilityRecord{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            fmt.Sprintf("run-%d", i),
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(int64(i)),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(int64(1000 - i)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			SearchAttributes: searchAttributes,
+		}
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+
+	testCases := []struct {
+		query          string
+		expectedRunIDs []string
+	}{
+		{
+			query:          "CustomKeywordField = 'keyword2'",
+			expectedRunIDs: []string{"run-1"},
+		},
+		{
+			query:          "CustomKeywordField is null",
+			expectedRunIDs: []string{"run-2"},
+		},
+		{
+			query:          "CustomIntField >= 2",
+			expectedRunIDs: []string{"run-1", "run-2"},
+		},
+		{
+			query:          "KeywordList01 = 'b' and CustomKeywordField != 'keyword2'",
+			expectedRunIDs: []string{"run-2"},
+		},
+		{
+			query:          "CustomKeywordField starts_with 'key' or KeywordList01 in ('c')",
+			expectedRunIDs: []string{"run-0", "run-1", "run-2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    10,
+			Query:       tc.query,
+		}
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err, tc.query)
+		var runIDs []string
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.GetExecution().GetRunId())
+		}
+		s.Equal(tc.expectedRunIDs, runIDs, tc.query)
+	}
+}
+
 func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndQuery")
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 10),
 		latestCloseTime:   time.Unix(0, 10001),
 		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
@@ -515,7 +625,7 @@ func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_InvalidNamespace() {
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 10),
 		latestCloseTime:   time.Unix(0, 10001),
 		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
@@ -41,6 +41,8 @@ type (
 	ArchiveFeatureCatalog struct {
 		ProgressManager   ProgressManager
 		NonRetryableError NonRetryableError
+		// Overwrite indicates that blobs which already exist should be written again instead of skipped
+		Overwrite bool
 	}
 
 	// NonRetryableError returns an error indicating archiver has encountered an non-retryable error
@@ -90,6 +92,14 @@ func (h *heartbeatProgressManager) HasProgress(ctx context.Context) bool {
 	return activity.HasHeartbeatDetails(ctx)
 }
 
+// GetOverwriteArchiveOption returns an ArchiveOption so that archiver replaces previously
+// archived blobs. It should be used when re-archiving a history whose archived copy is corrupted.
+func GetOverwriteArchiveOption() ArchiveOption {
+	return func(catalog *ArchiveFeatureCatalog) {
+		catalog.Overwrite = true
+	}
+}
+
 // GetNonRetryableErrorOption returns an ArchiveOption so that archiver knows what should
 // be returned when an non-retryable error is encountered.
 func GetNonRetryableErrorOption(nonRetryableErr error) ArchiveOption {
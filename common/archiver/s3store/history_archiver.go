@@ -24,6 +24,9 @@
 
 // S3 History Archiver will archive workflow histories to amazon s3
 
+// If compression is configured, history batches are compressed and stored under the checksum of
+// their content and a manifest is written for each archived history. Get() reads both layouts.
+
 package s3store
 
 import (
@@ -44,6 +47,7 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/archiveformat"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
@@ -69,8 +73,9 @@ var (
 
 type (
 	historyArchiver struct {
-		container *archiver.HistoryBootstrapContainer
-		s3cli     s3iface.S3API
+		container   *archiver.HistoryBootstrapContainer
+		s3cli       s3iface.S3API
+		compression archiveformat.Compression
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 	}
@@ -81,10 +86,11 @@ type (
 	}
 
 	uploadProgress struct {
-		BatchIdx      int
-		IteratorState []byte
-		uploadedSize  int64
-		historySize   int64
+		BatchIdx        int
+		IteratorState   []byte
+		ManifestBatches []archiveformat.BatchRef
+		uploadedSize    int64
+		historySize     int64
 	}
 )
 
@@ -104,6 +110,10 @@ func newHistoryArchiver(
 	if len(config.Region) == 0 {
 		return nil, errEmptyAwsRegion
 	}
+	compression, err := archiveformat.ParseCompression(config.Compression)
+	if err != nil {
+		return nil, err
+	}
 	s3Config := &aws.Config{
 		Endpoint:         config.Endpoint,
 		Region:           aws.String(config.Region),
@@ -118,6 +128,7 @@ func newHistoryArchiver(
 	return &historyArchiver{
 		container:       container,
 		s3cli:           s3.New(sess),
+		compression:     compression,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -187,13 +198,21 @@ func (h *historyArchiver) Archive(
 			return archiver.ErrHistoryMutated
 		}
 
-		encoder := codec.NewJSONPBEncoder()
-		encodedHistoryBlob, err := encoder.Encode(historyBlob)
+		var encodedHistoryBlob []byte
+		var key string
+		var ref archiveformat.BatchRef
+		if h.compression == archiveformat.CompressionNone {
+			encoder := codec.NewJSONPBEncoder()
+			encodedHistoryBlob, err = encoder.Encode(historyBlob)
+			key = constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
+		} else {
+			encodedHistoryBlob, ref, err = archiveformat.EncodeBatch(h.compression, historyBlob.Body)
+			key = constructHistoryBatchKey(URI.Path(), request.NamespaceID, ref.Checksum)
+		}
 		if err != nil {
 			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return err
 		}
-		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
 
 		exists, err := KeyExists(ctx, h.s3cli, URI, key)
 		if err != nil {
@@ -205,7 +224,7 @@ func (h *historyArchiver) Archive(
 			return err
 		}
 		blobSize := int64(binary.Size(encodedHistoryBlob))
-		if exists {
+		if exists && !featureCatalog.Overwrite {
 			metrics.HistoryArchiverBlobExistsCount.With(handler).Record(1)
 		} else {
 			if err := Upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
@@ -220,17 +239,54 @@ func (h *historyArchiver) Archive(
 			handler.Histogram(metrics.HistoryArchiverBlobSize.Name(), metrics.HistoryArchiverBlobSize.Unit()).Record(blobSize)
 		}
 
+		if h.compression != archiveformat.CompressionNone {
+			progress.ManifestBatches = append(progress.ManifestBatches, ref)
+		}
 		progress.historySize += blobSize
 		progress.BatchIdx = progress.BatchIdx + 1
 		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
 	}
 
+	if h.compression != archiveformat.CompressionNone {
+		// manifest is uploaded last so that it only ever references uploaded batches
+		if err := h.uploadManifest(ctx, URI, request, progress.ManifestBatches); err != nil {
+			if isRetryableError(err) {
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+			} else {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+			}
+			return err
+		}
+	}
+
 	handler.Histogram(metrics.HistoryArchiverTotalUploadSize.Name(), metrics.HistoryArchiverTotalUploadSize.Unit()).Record(progress.uploadedSize)
 	handler.Histogram(metrics.HistoryArchiverHistorySize.Name(), metrics.HistoryArchiverHistorySize.Unit()).Record(progress.historySize)
 	metrics.HistoryArchiverArchiveSuccessCount.With(handler).Record(1)
 	return nil
 }
 
+func (h *historyArchiver) uploadManifest(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	batches []archiveformat.BatchRef,
+) error {
+	encodedManifest, err := archiveformat.EncodeManifest(&archiveformat.Manifest{
+		FormatVersion:        archiveformat.FormatVersion,
+		Compression:          h.compression,
+		NamespaceID:          request.NamespaceID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		CloseFailoverVersion: request.CloseFailoverVersion,
+		Batches:              batches,
+	})
+	if err != nil {
+		return err
+	}
+	key := constructHistoryManifestKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	return Upload(ctx, h.s3cli, URI, key, encodedManifest)
+}
+
 func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
 	if featureCatalog.ProgressManager != nil {
 		if featureCatalog.ProgressManager.HasProgress(ctx) {
@@ -242,6 +298,7 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 				}
 			}
 			progress.IteratorState = nil
+			progress.ManifestBatches = nil
 			progress.BatchIdx = 0
 			progress.historySize = 0
 			progress.uploadedSize = 0
@@ -301,6 +358,19 @@ func (h *historyArchiver) Get(
 			CloseFailoverVersion: *highestVersion,
 		}
 	}
+	manifestKey := constructHistoryManifestKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	encodedManifest, err := Download(ctx, h.s3cli, URI, manifestKey)
+	if err == nil {
+		manifest, err := archiveformat.DecodeManifest(encodedManifest)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		return h.getFromManifest(ctx, URI, request, manifest, token)
+	}
+	if _, isNotFound := err.(*serviceerror.NotFound); !isNotFound {
+		return nil, convertDownloadError(err)
+	}
+
 	encoder := codec.NewJSONPBEncoder()
 	response := &archiver.GetHistoryResponse{}
 	numOfEvents := 0
@@ -314,15 +384,7 @@ func (h *historyArchiver) Get(
 
 		encodedRecord, err := Download(ctx, h.s3cli, URI, key)
 		if err != nil {
-			if isRetryableError(err) {
-				return nil, serviceerror.NewUnavailable(err.Error())
-			}
-			switch err.(type) {
-			case *serviceerror.InvalidArgument, *serviceerror.Unavailable, *serviceerror.NotFound:
-				return nil, err
-			default:
-				return nil, serviceerror.NewInternal(err.Error())
-			}
+			return nil, convertDownloadError(err)
 		}
 
 		historyBlob := archiverspb.HistoryBlob{}
@@ -353,6 +415,52 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) getFromManifest(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+	manifest *archiveformat.Manifest,
+	token *getHistoryToken,
+) (*archiver.GetHistoryResponse, error) {
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	for ; token.BatchIdx < len(manifest.Batches) && numOfEvents < request.PageSize; token.BatchIdx++ {
+		ref := manifest.Batches[token.BatchIdx]
+		data, err := Download(ctx, h.s3cli, URI, constructHistoryBatchKey(URI.Path(), request.NamespaceID, ref.Checksum))
+		if err != nil {
+			return nil, convertDownloadError(err)
+		}
+		historyBatches, err := archiveformat.DecodeBatch(manifest.Compression, ref, data)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.HistoryBatches = append(response.HistoryBatches, historyBatches...)
+		numOfEvents += ref.EventCount
+	}
+
+	if token.BatchIdx < len(manifest.Batches) {
+		nextToken, err := SerializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+func convertDownloadError(err error) error {
+	if isRetryableError(err) {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	switch err.(type) {
+	case *serviceerror.InvalidArgument, *serviceerror.Unavailable, *serviceerror.NotFound:
+		return err
+	default:
+		return serviceerror.NewInternal(err.Error())
+	}
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := SoftValidateURI(URI)
 	if err != nil {
@@ -48,6 +48,7 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/archiveformat"
 	"go.temporal.io/server/common/archiver/s3store/mocks"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/log"
@@ -693,12 +694,94 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_Compressed() {
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiverWithCompression(historyIterator, archiveformat.CompressionZstd)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndGet_Compressed")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	manifestKey := constructHistoryManifestKey(URI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.assertKeyExists(manifestKey)
+	encodedManifest, err := Download(context.Background(), s.s3cli, URI, manifestKey)
+	s.NoError(err)
+	manifest, err := archiveformat.DecodeManifest(encodedManifest)
+	s.NoError(err)
+	s.Equal(archiveformat.CompressionZstd, manifest.Compression)
+	s.Len(manifest.Batches, 2)
+	for _, ref := range manifest.Batches {
+		s.assertKeyExists(constructHistoryBatchKey(URI.Path(), testNamespaceID, ref.Checksum))
+	}
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    1,
+	}
+	var combinedHistory []*historypb.History
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	getRequest.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), combinedHistory)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_LegacyFormatWithCompression() {
+	historyArchiver := s.newTestHistoryArchiverWithCompression(nil, archiveformat.CompressionGzip)
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	URI, err := archiver.NewURI(testBucketURI)
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	return s.newTestHistoryArchiverWithCompression(historyIterator, archiveformat.CompressionNone)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiverWithCompression(
+	historyIterator archiver.HistoryIterator,
+	compression archiveformat.Compression,
+) *historyArchiver {
 	// config := &config.S3Archiver{}
 	// archiver, err := newHistoryArchiver(s.container, config, historyIterator)
 	archiver := &historyArchiver{
 		container:       s.container,
 		s3cli:           s.s3cli,
+		compression:     compression,
 		historyIterator: historyIterator,
 	}
 	return archiver
@@ -33,28 +33,34 @@ import (
 	"time"
 
 	"github.com/temporalio/sqlparser"
-	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/archiver/visibilityquery"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/util"
 )
 
 type (
-	// QueryParser parses a limited SQL where clause into a struct
+	// QueryParser parses a SQL-like where clause into a struct
 	QueryParser interface {
-		Parse(query string) (*parsedQuery, error)
+		Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
 
+	// parsedQuery holds the predicates which are used to build the search prefix. They are
+	// extracted from the top level "and" of the query. filter holds the rest of the query and
+	// is evaluated against every record found under the prefix.
 	parsedQuery struct {
 		workflowTypeName *string
 		workflowID       *string
 		startTime        *time.Time
 		closeTime        *time.Time
 		searchPrecision  *string
+		filter           visibilityquery.Filter
 	}
 )
 
-// All allowed fields for filtering
+// Filters which are used to build the search prefix. Any other system or custom
+// search attribute can be used in the query as well.
 const (
 	WorkflowTypeName = "WorkflowTypeName"
 	WorkflowID       = "WorkflowId"
@@ -70,176 +76,206 @@ const (
 	PrecisionMinute = "Minute"
 	PrecisionSecond = "Second"
 )
-const (
-	queryTemplate         = "select * from dummy where %s"
-	defaultDateTimeFormat = time.RFC3339
+
+var (
+	// fieldAliases maps column names which are specific to s3store to search attribute names.
+	fieldAliases = map[string]string{
+		WorkflowTypeName: searchattribute.WorkflowType,
+	}
 )
 
-// NewQueryParser creates a new query parser for filestore
+// NewQueryParser creates a new query parser for s3store
 func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
-func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+func (p *queryParser) Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error) {
+	whereExpr, err := visibilityquery.ParseWhere(query)
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
 	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
-		return nil, err
-	}
-	if parsedQuery.workflowID == nil && parsedQuery.workflowTypeName == nil {
-		return nil, errors.New("WorkflowId or WorkflowTypeName is required in query")
-	}
-	if parsedQuery.workflowID != nil && parsedQuery.workflowTypeName != nil {
-		return nil, errors.New("only one of WorkflowId or WorkflowTypeName can be specified in a query")
-	}
-	if parsedQuery.closeTime != nil && parsedQuery.startTime != nil {
-		return nil, errors.New("only one of StartTime or CloseTime can be specified in a query")
+	if whereExpr == nil {
+		return parsedQuery, nil
 	}
-	if (parsedQuery.closeTime != nil || parsedQuery.startTime != nil) && parsedQuery.searchPrecision == nil {
-		return nil, errors.New("SearchPrecision is required when searching for a StartTime or CloseTime")
+
+	// Original expressions of the extracted predicates. They are moved to the filter if the
+	// predicate can't be used for the search prefix.
+	var workflowTypeExpr, startTimeExpr, closeTimeExpr sqlparser.Expr
+	var remaining []sqlparser.Expr
+	for _, expr := range visibilityquery.Conjuncts(whereExpr) {
+		colName, valExpr, ok := equalityComparison(expr)
+		if !ok {
+			remaining = append(remaining, expr)
+			continue
+		}
+		switch {
+		case colName == WorkflowID && parsedQuery.workflowID == nil:
+			val, err := extractStringValue(valExpr)
+			if err != nil {
+				return nil, err
+			}
+			parsedQuery.workflowID = util.Ptr(val)
+		case (colName == WorkflowTypeName || colName == searchattribute.WorkflowType) && parsedQuery.workflowTypeName == nil:
+			val, err := extractStringValue(valExpr)
+			if err != nil {
+				return nil, err
+			}
+			parsedQuery.workflowTypeName = util.Ptr(val)
+			workflowTypeExpr = expr
+		case colName == StartTime && parsedQuery.startTime == nil:
+			timestamp, err := convertToTime(valExpr)
+			if err != nil {
+				return nil, err
+			}
+			parsedQuery.startTime = &timestamp
+			startTimeExpr = expr
+		case colName == CloseTime && parsedQuery.closeTime == nil:
+			timestamp, err := convertToTime(valExpr)
+			if err != nil {
+				return nil, err
+			}
+			parsedQuery.closeTime = &timestamp
+			closeTimeExpr = expr
+		case colName == SearchPrecision:
+			if err := p.convertSearchPrecision(valExpr, parsedQuery); err != nil {
+				return nil, err
+			}
+		default:
+			remaining = append(remaining, expr)
+		}
 	}
 
+	if parsedQuery.closeTime != nil && parsedQuery.startTime != nil && parsedQuery.searchPrecision != nil {
+		return nil, errors.New("only one of StartTime or CloseTime can be specified with SearchPrecision")
+	}
 	if parsedQuery.closeTime == nil && parsedQuery.startTime == nil && parsedQuery.searchPrecision != nil {
 		return nil, errors.New("SearchPrecision requires a StartTime or CloseTime")
 	}
-	return parsedQuery, nil
-}
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
+	// Records are indexed by either workflow ID or workflow type, so the workflow type has to be
+	// filtered if both are specified.
+	if parsedQuery.workflowID != nil && parsedQuery.workflowTypeName != nil {
+		parsedQuery.workflowTypeName = nil
+		remaining = append(remaining, workflowTypeExpr)
 	}
 
-	switch expr := expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
+	indexed := parsedQuery.workflowID != nil || parsedQuery.workflowTypeName != nil
+	switch {
+	case parsedQuery.searchPrecision == nil:
+		// Without precision, the time is matched exactly.
+		if startTimeExpr != nil {
+			remaining = append(remaining, startTimeExpr)
+		}
+		if closeTimeExpr != nil {
+			remaining = append(remaining, closeTimeExpr)
+		}
+		parsedQuery.startTime = nil
+		parsedQuery.closeTime = nil
+	case !indexed:
+		// The time index can only be used together with workflow ID or workflow type,
+		// so the time is matched against the precision window instead.
+		if parsedQuery.startTime != nil {
+			remaining = append(remaining, precisionRangeExpr(StartTime, *parsedQuery.startTime, *parsedQuery.searchPrecision))
+		}
+		if parsedQuery.closeTime != nil {
+			remaining = append(remaining, precisionRangeExpr(CloseTime, *parsedQuery.closeTime, *parsedQuery.searchPrecision))
+		}
+		parsedQuery.startTime = nil
+		parsedQuery.closeTime = nil
+		parsedQuery.searchPrecision = nil
 	}
-}
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
+	if len(remaining) > 0 {
+		parsedQuery.filter, err = visibilityquery.NewFilter(visibilityquery.And(remaining...), saTypeMap, fieldAliases)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return parsedQuery, nil
 }
 
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
+func (p *queryParser) convertSearchPrecision(valExpr *sqlparser.SQLVal, parsedQuery *parsedQuery) error {
+	val, err := extractStringValue(valExpr)
+	if err != nil {
 		return err
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
+	if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
+		return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
+	}
+	switch val {
+	case PrecisionDay:
+	case PrecisionHour:
+	case PrecisionMinute:
+	case PrecisionSecond:
+	default:
+		return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
+	}
+	parsedQuery.searchPrecision = util.Ptr(val)
+	return nil
 }
 
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
+// equalityComparison returns the column name and the value if expr is "<column> = <value>".
+func equalityComparison(expr sqlparser.Expr) (string, *sqlparser.SQLVal, bool) {
+	compExpr, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok || compExpr.Operator != sqlparser.EqualStr {
+		return "", nil, false
+	}
+	colName, ok := visibilityquery.ColumnName(compExpr.Left)
 	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
+		return "", nil, false
 	}
-	colNameStr := sqlparser.String(colName)
-	op := compExpr.Operator
 	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
 	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+		return "", nil, false
 	}
-	valStr := sqlparser.String(valExpr)
-
-	switch colNameStr {
-	case WorkflowTypeName:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowTypeName)
-		}
-		if parsedQuery.workflowTypeName != nil {
-			return fmt.Errorf("can not query %s multiple times", WorkflowTypeName)
-		}
-		parsedQuery.workflowTypeName = util.Ptr(val)
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowID)
-		}
-		if parsedQuery.workflowID != nil {
-			return fmt.Errorf("can not query %s multiple times", WorkflowID)
-		}
-		parsedQuery.workflowID = util.Ptr(val)
-	case CloseTime:
-		timestamp, err := convertToTime(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
-		parsedQuery.closeTime = &timestamp
-	case StartTime:
-		timestamp, err := convertToTime(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
-		parsedQuery.startTime = &timestamp
-	case SearchPrecision:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", SearchPrecision)
-		}
-		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
-			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
-		}
-		switch val {
-		case PrecisionDay:
-		case PrecisionHour:
-		case PrecisionMinute:
-		case PrecisionSecond:
-		default:
-			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
-		}
-		parsedQuery.searchPrecision = util.Ptr(val)
+	return colName, valExpr, true
+}
 
+// precisionRangeExpr builds "<colName> between <start> and <end>" for the precision window which contains t.
+func precisionRangeExpr(colName string, t time.Time, precision string) sqlparser.Expr {
+	t = t.UTC()
+	var start, end time.Time
+	switch precision {
+	case PrecisionDay:
+		start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		end = start.AddDate(0, 0, 1)
+	case PrecisionHour:
+		start = t.Truncate(time.Hour)
+		end = start.Add(time.Hour)
+	case PrecisionMinute:
+		start = t.Truncate(time.Minute)
+		end = start.Add(time.Minute)
 	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		start = t.Truncate(time.Second)
+		end = start.Add(time.Second)
+	}
+	return &sqlparser.RangeCond{
+		Operator: sqlparser.BetweenStr,
+		Left:     &sqlparser.ColName{Name: sqlparser.NewColIdent(colName)},
+		From:     sqlparser.NewStrVal([]byte(start.Format(time.RFC3339Nano))),
+		To:       sqlparser.NewStrVal([]byte(end.Add(-time.Nanosecond).Format(time.RFC3339Nano))),
 	}
-
-	return nil
 }
 
-func convertToTime(timeStr string) (time.Time, error) {
-	ts, err := strconv.ParseInt(timeStr, 10, 64)
-	if err == nil {
-		return timestamp.UnixOrZeroTime(ts), nil
-	}
-	timestampStr, err := extractStringValue(timeStr)
-	if err != nil {
-		return time.Time{}, err
-	}
-	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
-	if err != nil {
-		return time.Time{}, err
+func convertToTime(valExpr *sqlparser.SQLVal) (time.Time, error) {
+	switch valExpr.Type {
+	case sqlparser.StrVal:
+		return visibilityquery.ParseTime(string(valExpr.Val))
+	case sqlparser.IntVal:
+		ts, err := strconv.ParseInt(string(valExpr.Val), 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return visibilityquery.ParseTime(ts)
+	default:
+		return time.Time{}, fmt.Errorf("invalid time value: %s", sqlparser.String(valExpr))
 	}
-	return parsedTime, nil
 }
 
-func extractStringValue(s string) (string, error) {
-	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
-		return s[1 : len(s)-1], nil
+func extractStringValue(valExpr *sqlparser.SQLVal) (string, error) {
+	if valExpr.Type != sqlparser.StrVal {
+		return "", fmt.Errorf("value %s is not a string value", sqlparser.String(valExpr))
 	}
-	return "", fmt.Errorf("value %s is not a string value", s)
+	return string(valExpr.Val), nil
 }
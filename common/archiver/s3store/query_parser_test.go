@@ -30,6 +30,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/util"
 )
 
@@ -71,15 +72,22 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" and WorkflowTypeName = \"random workflowTypeName\"",
-			expectErr: true,
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowID: util.Ptr("random workflowID"),
+			},
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" and WorkflowId = \"random workflowID\"",
-			expectErr: true,
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowID: util.Ptr("random workflowID"),
+			},
 		},
 		{
-			query:     "RunId = \"random runID\"",
-			expectErr: true,
+			query:       "RunId = \"random runID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "WorkflowId = 'random workflowID'",
@@ -100,8 +108,9 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 			expectErr: true,
 		},
 		{
-			query:     "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
-			expectErr: true,
+			query:       "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" or runId = \"random runID\"",
@@ -118,7 +127,7 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -176,7 +185,7 @@ func (s *queryParserSuite) TestParsePrecision() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -219,7 +228,7 @@ func (s *queryParserSuite) TestParseCloseTime() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -263,7 +272,7 @@ func (s *queryParserSuite) TestParseStartTime() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -272,3 +281,73 @@ func (s *queryParserSuite) TestParseStartTime() {
 		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime)
 	}
 }
+
+func (s *queryParserSuite) TestParseFilter() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *parsedQuery
+		hasFilter   bool
+	}{
+		{
+			query: "WorkflowTypeName = 'random workflowTypeName' and CloseTime = 1000 and SearchPrecision = 'Day'",
+			parsedQuery: &parsedQuery{
+				workflowTypeName: util.Ptr("random workflowTypeName"),
+				closeTime:        util.Ptr(time.Unix(0, 1000).UTC()),
+				searchPrecision:  util.Ptr(PrecisionDay),
+			},
+			hasFilter: false,
+		},
+		{
+			query: "WorkflowType = 'random workflowTypeName' and ExecutionStatus in ('Failed', 'Terminated')",
+			parsedQuery: &parsedQuery{
+				workflowTypeName: util.Ptr("random workflowTypeName"),
+			},
+			hasFilter: true,
+		},
+		{
+			query:       "StartTime = 1000 and SearchPrecision = 'Hour'",
+			parsedQuery: &parsedQuery{},
+			hasFilter:   true,
+		},
+		{
+			query: "WorkflowId = 'random workflowID' and CloseTime = 1000",
+			parsedQuery: &parsedQuery{
+				workflowID: util.Ptr("random workflowID"),
+			},
+			hasFilter: true,
+		},
+		{
+			query:       "WorkflowTypeName starts_with 'random' or CustomKeywordField = 'value'",
+			parsedQuery: &parsedQuery{},
+			hasFilter:   true,
+		},
+		{
+			query:     "WorkflowId = 'random workflowID' and CloseTime = 1000 and StartTime = 1000 and SearchPrecision = 'Day'",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowId = 'random workflowID' or SearchPrecision = 'Day'",
+			expectErr: true,
+		},
+		{
+			query:     "UnknownField = 'value'",
+			expectErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
+		if tc.expectErr {
+			s.Error(err, "case %d", i)
+			continue
+		}
+		s.NoError(err, "case %d", i)
+		s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID, "case %d", i)
+		s.Equal(tc.parsedQuery.workflowTypeName, parsedQuery.workflowTypeName, "case %d", i)
+		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime, "case %d", i)
+		s.Equal(tc.parsedQuery.startTime, parsedQuery.startTime, "case %d", i)
+		s.Equal(tc.parsedQuery.searchPrecision, parsedQuery.searchPrecision, "case %d", i)
+		s.Equal(tc.hasFilter, parsedQuery.filter != nil, "case %d", i)
+	}
+}
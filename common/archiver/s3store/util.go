@@ -145,6 +145,15 @@ func constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID s
 	return fmt.Sprintf("%s/%v/", prefix, version)
 }
 
+func constructHistoryManifestKey(path, namespaceID, workflowID, runID string, version int64) string {
+	prefix := constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID, version)
+	return prefix + "manifest"
+}
+
+func constructHistoryBatchKey(path, namespaceID, checksum string) string {
+	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "blobs", checksum}, "/"), "/")
+}
+
 func constructHistoryKeyPrefix(path, namespaceID, workflowID, runID string) string {
 	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "history", workflowID, runID}, "/"), "/")
 }
@@ -49,6 +49,8 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		s3cli       s3iface.S3API
 		queryParser QueryParser
+		// maxListCallsPerQuery bounds the number of S3 list calls a single Query makes while filling a page.
+		maxListCallsPerQuery int
 	}
 
 	queryVisibilityRequest struct {
@@ -72,6 +74,8 @@ const (
 	secondaryIndexKeyCloseTimeout   = "closeTimeout"
 	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
 	primaryIndexKeyWorkflowID       = "workflowID"
+
+	defaultMaxListCallsPerQuery = 10
 )
 
 // NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on s3
@@ -96,9 +100,10 @@ func newVisibilityArchiver(
 		return nil, err
 	}
 	return &visibilityArchiver{
-		container:   container,
-		s3cli:       s3.New(sess),
-		queryParser: NewQueryParser(),
+		container:            container,
+		s3cli:                s3.New(sess),
+		queryParser:          NewQueryParser(),
+		maxListCallsPerQuery: defaultMaxListCallsPerQuery,
 	}, nil
 }
 
@@ -181,11 +186,7 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
-	if strings.TrimSpace(request.Query) == "" {
-		return v.queryAll(ctx, URI, request, saTypeMap)
-	}
-
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
@@ -203,58 +204,73 @@ func (v *visibilityArchiver) Query(
 	)
 }
 
-// queryAll returns all workflow executions in the archive.
+// queryAll returns all workflow executions in the archive which match the query filter.
 func (v *visibilityArchiver) queryAll(
 	ctx context.Context,
 	uri archiver.URI,
-	request *archiver.QueryVisibilityRequest,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	// The number of workflow executions returned by each call to queryPrefix may be fewer than pageSize. This is
+	// because we may have to skip some workflow executions after querying S3 (client-side filtering) because there
+	// are 2 entries in S3 for each workflow execution indexed by workflowTypeName (one for closeTimeout and one for
+	// startTimeout), and we only want to return one entry per workflow execution. See createIndexesToArchive for a
+	// list of all indexes.
+	searchPrefix := constructVisibilitySearchPrefix(uri.Path(), request.namespaceID)
+	// We suffix searchPrefix with workflowTypeName because the data in S3 is duplicated across combinations of 2
+	// different primary indices (workflowID and workflowTypeName) and 2 different secondary indices (closeTimeout
+	// and startTimeout). We only want to return one entry per workflow execution, but the full path to the S3 key
+	// is <primaryIndexKey>/<primaryIndexValue>/<secondaryIndexKey>/<secondaryIndexValue>/<runID>, and we don't have
+	// the primaryIndexValue when we make the call to query, so we can only specify the primaryIndexKey.
+	searchPrefix += "/" + primaryIndexKeyWorkflowTypeName
+	return v.queryUntilPageFull(ctx, uri, request, saTypeMap, searchPrefix, func(key string) bool {
+		// We only want to return entries for the closeTimeout secondary index, which will always be of the form:
+		// .../closeTimeout/<closeTimeout>/<runID>, so we split the key on "/" and check that the third-to-last
+		// element is "closeTimeout".
+		elements := strings.Split(key, "/")
+		return len(elements) >= 3 && elements[len(elements)-3] == secondaryIndexKeyCloseTimeout
+	})
+}
+
+// queryUntilPageFull calls queryPrefix until pageSize workflow executions are found, there are no more keys
+// under the prefix or maxListCallsPerQuery calls were made. In the last case the page may be short, but the
+// returned nextPageToken lets the caller continue: a selective filter would otherwise make a single call list
+// and download every visibility record under the prefix.
+func (v *visibilityArchiver) queryUntilPageFull(
+	ctx context.Context,
+	uri archiver.URI,
+	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
+	prefix string,
+	keyFilter func(key string) bool,
 ) (*archiver.QueryVisibilityResponse, error) {
 	// remaining is the number of workflow executions left to return before we reach pageSize.
-	remaining := request.PageSize
-	nextPageToken := request.NextPageToken
+	remaining := request.pageSize
+	nextPageToken := request.nextPageToken
 	var executions []*workflowpb.WorkflowExecutionInfo
-	// We need to loop because the number of workflow executions returned by each call to query may be fewer than
-	// pageSize. This is because we may have to skip some workflow executions after querying S3 (client-side filtering)
-	// because there are 2 entries in S3 for each workflow execution indexed by workflowTypeName (one for closeTimeout
-	// and one for startTimeout), and we only want to return one entry per workflow execution. See
-	// createIndexesToArchive for a list of all indexes.
-	for {
-		searchPrefix := constructVisibilitySearchPrefix(uri.Path(), request.NamespaceID)
-		// We suffix searchPrefix with workflowTypeName because the data in S3 is duplicated across combinations of 2
-		// different primary indices (workflowID and workflowTypeName) and 2 different secondary indices (closeTimeout
-		// and startTimeout). We only want to return one entry per workflow execution, but the full path to the S3 key
-		// is <primaryIndexKey>/<primaryIndexValue>/<secondaryIndexKey>/<secondaryIndexValue>/<runID>, and we don't have
-		// the primaryIndexValue when we make the call to query, so we can only specify the primaryIndexKey.
-		searchPrefix += "/" + primaryIndexKeyWorkflowTypeName
+	for listCalls := 1; ; listCalls++ {
 		// The pageSize we supply here is actually the maximum number of keys to fetch from S3. For each execution,
-		// there should be 2 keys in S3 for this prefix, so you might think that we should multiply the pageSize by 2.
+		// there may be multiple keys in S3 for the prefix, so you might think that we should multiply the pageSize.
 		// However, if we do that, we may end up returning more than pageSize workflow executions to the end user of
-		// this API. This is because we aren't guaranteed that both keys for a given workflow execution will be returned
+		// this API. This is because we aren't guaranteed that all keys for a given workflow execution will be returned
 		// in the same call. For example, if the user supplies a pageSize of 1, and we specify a maximum number of keys
 		// of 2 to S3, we may get back entries from S3 for 2 different workflow executions. You might think that we can
 		// just truncate this result to 1 workflow execution, but then the nextPageToken would be incorrect. So, we may
 		// need to make multiple calls to S3 to get the correct number of workflow executions, which will probably make
 		// this API call slower.
 		res, err := v.queryPrefix(ctx, uri, &queryVisibilityRequest{
-			namespaceID:   request.NamespaceID,
+			namespaceID:   request.namespaceID,
 			pageSize:      remaining,
 			nextPageToken: nextPageToken,
-			parsedQuery:   &parsedQuery{},
-		}, saTypeMap, searchPrefix, func(key string) bool {
-			// We only want to return entries for the closeTimeout secondary index, which will always be of the form:
-			// .../closeTimeout/<closeTimeout>/<runID>, so we split the key on "/" and check that the third-to-last
-			// element is "closeTimeout".
-			elements := strings.Split(key, "/")
-			return len(elements) >= 3 && elements[len(elements)-3] == secondaryIndexKeyCloseTimeout
-		})
+			parsedQuery:   request.parsedQuery,
+		}, saTypeMap, prefix, keyFilter)
 		if err != nil {
 			return nil, err
 		}
 		nextPageToken = res.NextPageToken
 		executions = append(executions, res.Executions...)
 		remaining -= len(res.Executions)
-		if len(nextPageToken) == 0 || remaining <= 0 {
+		if len(nextPageToken) == 0 || remaining <= 0 || listCalls >= v.maxListCallsPerQuery {
 			break
 		}
 	}
@@ -270,6 +286,10 @@ func (v *visibilityArchiver) query(
 	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
 ) (*archiver.QueryVisibilityResponse, error) {
+	if request.parsedQuery.workflowID == nil && request.parsedQuery.workflowTypeName == nil {
+		return v.queryAll(ctx, URI, request, saTypeMap)
+	}
+
 	primaryIndex := primaryIndexKeyWorkflowTypeName
 	primaryIndexValue := request.parsedQuery.workflowTypeName
 	if request.parsedQuery.workflowID != nil {
@@ -307,12 +327,12 @@ func (v *visibilityArchiver) query(
 		)
 	}
 
-	return v.queryPrefix(ctx, URI, request, saTypeMap, prefix, nil)
+	return v.queryUntilPageFull(ctx, URI, request, saTypeMap, prefix, nil)
 }
 
-// queryPrefix returns all workflow executions in the archive that match the given prefix. The keyFilter function is an
-// optional filter that can be used to further filter the results. If keyFilter returns false for a given key, that key
-// will be skipped, and the object will not be downloaded from S3 or included in the results.
+// queryPrefix returns all workflow executions in the archive that match the given prefix and the query filter. The
+// keyFilter function is an optional filter that can be used to further filter the results. If keyFilter returns false
+// for a given key, that key will be skipped, and the object will not be downloaded from S3 or included in the results.
 func (v *visibilityArchiver) queryPrefix(
 	ctx context.Context,
 	uri archiver.URI,
@@ -363,6 +383,9 @@ func (v *visibilityArchiver) queryPrefix(
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
+		if request.parsedQuery.filter != nil && !request.parsedQuery.filter.Match(record) {
+			continue
+		}
 		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
@@ -117,9 +117,10 @@ func (s *visibilityArchiverSuite) TestValidateURI() {
 
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	return &visibilityArchiver{
-		container:   s.container,
-		s3cli:       s.s3cli,
-		queryParser: NewQueryParser(),
+		container:            s.container,
+		s3cli:                s.s3cli,
+		queryParser:          NewQueryParser(),
+		maxListCallsPerQuery: defaultMaxListCallsPerQuery,
 	}
 }
 
@@ -256,7 +257,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
@@ -270,7 +271,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowID:      util.Ptr(testWorkflowID),
 		closeTime:       &time.Time{},
 		searchPrecision: util.Ptr(PrecisionSecond),
@@ -291,7 +292,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       util.Ptr(time.Unix(0, int64(1*time.Hour)).UTC()),
 		searchPrecision: util.Ptr(PrecisionHour),
 		workflowID:      util.Ptr(testWorkflowID),
@@ -317,7 +318,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       util.Ptr(time.Unix(0, 0).UTC()),
 		searchPrecision: util.Ptr(PrecisionDay),
 		workflowID:      util.Ptr(testWorkflowID),
@@ -424,6 +425,35 @@ func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_Pagination() {
 	s.Len(executions, len(s.visibilityRecords))
 }
 
+func (s *visibilityArchiverSuite) TestQuery_SelectiveFilter_BoundedListCalls() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	visibilityArchiver.maxListCallsPerQuery = 2
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "ExecutionStatus = 'Failed' and CloseTime = '1970-01-01T03:00:00Z'",
+	}
+
+	// Every S3 list call fetches a single key and the only match is the last record, so the first page is
+	// returned short once the list call budget is spent.
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.NotEmpty(response.NextPageToken)
+
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for len(response.NextPageToken) != 0 {
+		request.NextPageToken = response.NextPageToken
+		response, err = visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+	}
+	s.Len(executions, 1)
+	ei, err := convertToExecutionInfo(s.visibilityRecords[2], searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(ei, executions[0])
+}
+
 type precisionTest struct {
 	day       int
 	hour      int
@@ -533,7 +563,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 
 	for i, testData := range precisionTests {
 		mockParser := NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			closeTime:       util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision: util.Ptr(testData.precision),
 			workflowID:      util.Ptr(testWorkflowID),
@@ -546,7 +576,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			startTime:       util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision: util.Ptr(testData.precision),
 			workflowID:      util.Ptr(testWorkflowID),
@@ -559,7 +589,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			closeTime:        util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision:  util.Ptr(testData.precision),
 			workflowTypeName: util.Ptr(testWorkflowTypeName),
@@ -572,7 +602,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			startTime:        util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision:  util.Ptr(testData.precision),
 			workflowTypeName: util.Ptr(testWorkflowTypeName),
@@ -596,7 +626,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	}
 
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowID: util.Ptr(testWorkflowID),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
@@ -627,7 +657,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[2])
 
 	mockParser = NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowTypeName: util.Ptr(testWorkflowTypeName),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
@@ -658,6 +688,63 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[2])
 }
 
+func (s *visibilityArchiverSuite) TestQuery_Success_SQLQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	testCases := []struct {
+		query           string
+		expectedRecords []int
+	}{
+		{
+			query:           "WorkflowId = '" + testWorkflowID + "' and CloseTime >= '1970-01-01T01:30:00Z'",
+			expectedRecords: []int{1, 2},
+		},
+		{
+			query:           "RunId = '" + testRunID + "'",
+			expectedRecords: []int{0},
+		},
+		{
+			query:           "ExecutionStatus = 'Failed' and CloseTime = '1970-01-01T03:00:00Z'",
+			expectedRecords: []int{2},
+		},
+		{
+			query:           "CloseTime = '1970-01-01T01:10:00Z' and SearchPrecision = 'Hour'",
+			expectedRecords: []int{0, 1},
+		},
+		{
+			query:           "WorkflowTypeName = '" + testWorkflowTypeName + "' and (RunId = '" + testRunID + "' or CloseTime > '1970-01-01T02:00:00Z')",
+			expectedRecords: []int{0, 2},
+		},
+		{
+			query:           "WorkflowId starts_with 'not-exist' or ExecutionStatus = 'Completed'",
+			expectedRecords: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    1,
+			Query:       tc.query,
+		}
+		var executions []*workflowpb.WorkflowExecutionInfo
+		first := true
+		for first || request.NextPageToken != nil {
+			response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
+			s.NoError(err, tc.query)
+			s.LessOrEqual(len(response.Executions), request.PageSize, tc.query)
+			executions = append(executions, response.Executions...)
+			request.NextPageToken = response.NextPageToken
+			first = false
+		}
+		s.Len(executions, len(tc.expectedRecords), tc.query)
+		for i, recordIdx := range tc.expectedRecords {
+			ei, err := convertToExecutionInfo(s.visibilityRecords[recordIdx], searchattribute.TestNameTypeMap)
+			s.NoError(err)
+			s.Equal(ei, executions[i], tc.query)
+		}
+	}
+}
+
 func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
 	s.visibilityRecords = []*archiverspb.VisibilityRecord{
 		{
@@ -0,0 +1,677 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package visibilityquery evaluates visibility queries against archived visibility records.
+// It accepts the same where clause grammar as ListWorkflowExecutions and is used by archivers
+// which have to filter archived records on the client side.
+package visibilityquery
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// Filter matches archived visibility records against a compiled where clause.
+	Filter interface {
+		Match(record *archiverspb.VisibilityRecord) bool
+	}
+
+	andFilter struct {
+		left  Filter
+		right Filter
+	}
+
+	orFilter struct {
+		left  Filter
+		right Filter
+	}
+
+	notFilter struct {
+		filter Filter
+	}
+
+	comparisonFilter struct {
+		field    *field
+		operator string
+		values   []interface{}
+	}
+
+	rangeFilter struct {
+		field  *field
+		from   interface{}
+		to     interface{}
+		negate bool
+	}
+
+	isNullFilter struct {
+		field  *field
+		negate bool
+	}
+
+	field struct {
+		name      string
+		valueType enumspb.IndexedValueType
+		saTypeMap *searchattribute.NameTypeMap
+	}
+)
+
+const (
+	queryTemplate = "select * from dummy where %s"
+)
+
+var (
+	// systemFields are the system search attributes which are stored in the archived visibility record.
+	systemFields = map[string]enumspb.IndexedValueType{
+		searchattribute.WorkflowID:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		searchattribute.RunID:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		searchattribute.WorkflowType:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		searchattribute.ExecutionStatus: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		searchattribute.StartTime:       enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		searchattribute.ExecutionTime:   enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		searchattribute.CloseTime:       enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		searchattribute.HistoryLength:   enumspb.INDEXED_VALUE_TYPE_INT,
+	}
+
+	rangeOperators = map[string]struct{}{
+		sqlparser.GreaterThanStr:  {},
+		sqlparser.GreaterEqualStr: {},
+		sqlparser.LessThanStr:     {},
+		sqlparser.LessEqualStr:    {},
+	}
+)
+
+// ParseWhere parses a visibility query into a where expression. It returns nil for an empty query.
+func ParseWhere(query string) (sqlparser.Expr, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	if err != nil {
+		return nil, err
+	}
+	selectStmt, ok := stmt.(*sqlparser.Select)
+	if !ok || selectStmt.Where == nil {
+		return nil, fmt.Errorf("invalid query: %s", query)
+	}
+	if selectStmt.OrderBy != nil || selectStmt.GroupBy != nil || selectStmt.Limit != nil {
+		return nil, errors.New("order by, group by and limit are not supported for archived visibility queries")
+	}
+	return selectStmt.Where.Expr, nil
+}
+
+// Conjuncts splits a where expression into the list of expressions joined by top level "and".
+func Conjuncts(expr sqlparser.Expr) []sqlparser.Expr {
+	switch e := expr.(type) {
+	case nil:
+		return nil
+	case *sqlparser.AndExpr:
+		return append(Conjuncts(e.Left), Conjuncts(e.Right)...)
+	case *sqlparser.ParenExpr:
+		return Conjuncts(e.Expr)
+	default:
+		return []sqlparser.Expr{expr}
+	}
+}
+
+// And joins expressions with "and". It returns nil if there are no expressions.
+func And(exprs ...sqlparser.Expr) sqlparser.Expr {
+	var result sqlparser.Expr
+	for _, expr := range exprs {
+		if result == nil {
+			result = expr
+			continue
+		}
+		result = &sqlparser.AndExpr{Left: result, Right: expr}
+	}
+	return result
+}
+
+// ColumnName returns the column name of expr if expr is a column reference.
+func ColumnName(expr sqlparser.Expr) (string, bool) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", false
+	}
+	return strings.ReplaceAll(sqlparser.String(colName), "`", ""), true
+}
+
+// NewFilter compiles a where expression into a Filter. Column names are resolved against the
+// system fields stored in archived visibility records and the search attributes in saTypeMap.
+// aliases maps additional column names accepted by an archiver to the search attribute names.
+func NewFilter(
+	expr sqlparser.Expr,
+	saTypeMap searchattribute.NameTypeMap,
+	aliases map[string]string,
+) (Filter, error) {
+	c := &compiler{
+		saTypeMap: saTypeMap,
+		aliases:   aliases,
+	}
+	return c.compile(expr)
+}
+
+type compiler struct {
+	saTypeMap searchattribute.NameTypeMap
+	aliases   map[string]string
+}
+
+func (c *compiler) compile(expr sqlparser.Expr) (Filter, error) {
+	switch e := expr.(type) {
+	case nil:
+		return nil, errors.New("where expression is nil")
+	case *sqlparser.AndExpr:
+		left, right, err := c.compileBoth(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &andFilter{left: left, right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := c.compileBoth(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &orFilter{left: left, right: right}, nil
+	case *sqlparser.NotExpr:
+		filter, err := c.compile(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &notFilter{filter: filter}, nil
+	case *sqlparser.ParenExpr:
+		return c.compile(e.Expr)
+	case *sqlparser.ComparisonExpr:
+		return c.compileComparison(e)
+	case *sqlparser.RangeCond:
+		return c.compileRange(e)
+	case *sqlparser.IsExpr:
+		return c.compileIs(e)
+	default:
+		return nil, fmt.Errorf("unsupported expression: %s", sqlparser.String(expr))
+	}
+}
+
+func (c *compiler) compileBoth(left sqlparser.Expr, right sqlparser.Expr) (Filter, Filter, error) {
+	leftFilter, err := c.compile(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightFilter, err := c.compile(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return leftFilter, rightFilter, nil
+}
+
+func (c *compiler) compileComparison(expr *sqlparser.ComparisonExpr) (Filter, error) {
+	f, err := c.resolveField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawValues []interface{}
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("right-hand side of '%s' must be a list of values", expr.Operator)
+		}
+		for _, valExpr := range tuple {
+			v, err := parseValue(valExpr)
+			if err != nil {
+				return nil, err
+			}
+			rawValues = append(rawValues, v)
+		}
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		v, err := parseValue(expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		rawValues = append(rawValues, v)
+	default:
+		if _, ok := rangeOperators[expr.Operator]; !ok {
+			return nil, fmt.Errorf("operator '%s' is not supported", expr.Operator)
+		}
+		if !f.isOrdered() {
+			return nil, fmt.Errorf("operator '%s' is not supported for %s", expr.Operator, f.name)
+		}
+		v, err := parseValue(expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		rawValues = append(rawValues, v)
+	}
+
+	if (expr.Operator == sqlparser.StartsWithStr || expr.Operator == sqlparser.NotStartsWithStr) && !f.isString() {
+		return nil, fmt.Errorf("operator '%s' is not supported for %s", expr.Operator, f.name)
+	}
+
+	values := make([]interface{}, 0, len(rawValues))
+	for _, rawValue := range rawValues {
+		v, err := f.convertValue(rawValue)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return &comparisonFilter{
+		field:    f,
+		operator: expr.Operator,
+		values:   values,
+	}, nil
+}
+
+func (c *compiler) compileRange(expr *sqlparser.RangeCond) (Filter, error) {
+	f, err := c.resolveField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Operator != sqlparser.BetweenStr && expr.Operator != sqlparser.NotBetweenStr {
+		return nil, fmt.Errorf("operator '%s' is not supported", expr.Operator)
+	}
+	if !f.isOrdered() {
+		return nil, fmt.Errorf("operator '%s' is not supported for %s", expr.Operator, f.name)
+	}
+	from, err := c.convertExprValue(f, expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.convertExprValue(f, expr.To)
+	if err != nil {
+		return nil, err
+	}
+	return &rangeFilter{
+		field:  f,
+		from:   from,
+		to:     to,
+		negate: expr.Operator == sqlparser.NotBetweenStr,
+	}, nil
+}
+
+func (c *compiler) compileIs(expr *sqlparser.IsExpr) (Filter, error) {
+	f, err := c.resolveField(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr:
+		return &isNullFilter{field: f}, nil
+	case sqlparser.IsNotNullStr:
+		return &isNullFilter{field: f, negate: true}, nil
+	default:
+		return nil, fmt.Errorf("operator '%s' is not supported", expr.Operator)
+	}
+}
+
+func (c *compiler) convertExprValue(f *field, expr sqlparser.Expr) (interface{}, error) {
+	v, err := parseValue(expr)
+	if err != nil {
+		return nil, err
+	}
+	return f.convertValue(v)
+}
+
+func (c *compiler) resolveField(expr sqlparser.Expr) (*field, error) {
+	name, ok := ColumnName(expr)
+	if !ok {
+		return nil, fmt.Errorf("invalid filter name: %s", sqlparser.String(expr))
+	}
+	if alias, ok := c.aliases[name]; ok {
+		name = alias
+	}
+	if valueType, ok := systemFields[name]; ok {
+		return &field{name: name, valueType: valueType}, nil
+	}
+	if searchattribute.IsSystem(name) {
+		return nil, fmt.Errorf("filter %s is not supported for archived visibility records", name)
+	}
+	valueType, err := c.saTypeMap.GetType(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown filter name: %s", name)
+	}
+	return &field{name: name, valueType: valueType, saTypeMap: &c.saTypeMap}, nil
+}
+
+// parseValue returns a string, int64, float64 or bool for the value expression.
+func parseValue(expr sqlparser.Expr) (interface{}, error) {
+	switch e := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch e.Type {
+		case sqlparser.StrVal:
+			return string(e.Val), nil
+		case sqlparser.IntVal:
+			return strconv.ParseInt(string(e.Val), 10, 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(e.Val), 64)
+		}
+	case sqlparser.BoolVal:
+		return bool(e), nil
+	case *sqlparser.ColName:
+		return nil, fmt.Errorf("invalid value: %s (did you forget to quote it?)", sqlparser.String(expr))
+	}
+	return nil, fmt.Errorf("invalid value: %s", sqlparser.String(expr))
+}
+
+func (f *field) isString() bool {
+	if f.name == searchattribute.ExecutionStatus {
+		return false
+	}
+	switch f.valueType {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		return true
+	default:
+		return false
+	}
+}
+
+func (f *field) isOrdered() bool {
+	if f.name == searchattribute.ExecutionStatus {
+		return false
+	}
+	return f.valueType != enumspb.INDEXED_VALUE_TYPE_BOOL
+}
+
+// convertValue converts a query value to the type which is used for the field's record values.
+func (f *field) convertValue(value interface{}) (interface{}, error) {
+	if f.name == searchattribute.ExecutionStatus {
+		status, err := ParseExecutionStatus(value)
+		if err != nil {
+			return nil, err
+		}
+		return int64(status), nil
+	}
+
+	switch f.valueType {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if v, ok := value.(int64); ok {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return ParseTime(value)
+	}
+	return nil, fmt.Errorf("invalid value %v for %s of type %v", value, f.name, f.valueType)
+}
+
+// recordValues returns the values of the field in the record. Missing values are returned as nil.
+func (f *field) recordValues(record *archiverspb.VisibilityRecord) []interface{} {
+	switch f.name {
+	case searchattribute.WorkflowID:
+		return []interface{}{record.GetWorkflowId()}
+	case searchattribute.RunID:
+		return []interface{}{record.GetRunId()}
+	case searchattribute.WorkflowType:
+		return []interface{}{record.GetWorkflowTypeName()}
+	case searchattribute.ExecutionStatus:
+		return []interface{}{int64(record.GetStatus())}
+	case searchattribute.HistoryLength:
+		return []interface{}{record.GetHistoryLength()}
+	case searchattribute.StartTime:
+		return timeValues(record.GetStartTime() != nil, record.GetStartTime().AsTime())
+	case searchattribute.ExecutionTime:
+		return timeValues(record.GetExecutionTime() != nil, record.GetExecutionTime().AsTime())
+	case searchattribute.CloseTime:
+		return timeValues(record.GetCloseTime() != nil, record.GetCloseTime().AsTime())
+	}
+
+	valueStr, ok := record.GetSearchAttributes()[f.name]
+	if !ok {
+		return nil
+	}
+	searchAttributes, err := searchattribute.Parse(map[string]string{f.name: valueStr}, f.saTypeMap)
+	if err != nil {
+		return nil
+	}
+	value, err := searchattribute.DecodeValue(searchAttributes.GetIndexedFields()[f.name], f.valueType, true)
+	if err != nil || value == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return []interface{}{value}
+	}
+	values := make([]interface{}, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		values = append(values, rv.Index(i).Interface())
+	}
+	return values
+}
+
+func timeValues(exists bool, t time.Time) []interface{} {
+	if !exists {
+		return nil
+	}
+	return []interface{}{t}
+}
+
+func (f *andFilter) Match(record *archiverspb.VisibilityRecord) bool {
+	return f.left.Match(record) && f.right.Match(record)
+}
+
+func (f *orFilter) Match(record *archiverspb.VisibilityRecord) bool {
+	return f.left.Match(record) || f.right.Match(record)
+}
+
+func (f *notFilter) Match(record *archiverspb.VisibilityRecord) bool {
+	return !f.filter.Match(record)
+}
+
+func (f *comparisonFilter) Match(record *archiverspb.VisibilityRecord) bool {
+	recordValues := f.field.recordValues(record)
+	switch f.operator {
+	case sqlparser.EqualStr, sqlparser.InStr:
+		return f.anyEqual(recordValues)
+	case sqlparser.NotEqualStr, sqlparser.NotInStr:
+		return !f.anyEqual(recordValues)
+	case sqlparser.StartsWithStr:
+		return f.anyHasPrefix(recordValues)
+	case sqlparser.NotStartsWithStr:
+		return !f.anyHasPrefix(recordValues)
+	}
+
+	for _, recordValue := range recordValues {
+		cmp, ok := compareValues(recordValue, f.values[0])
+		if !ok {
+			continue
+		}
+		switch f.operator {
+		case sqlparser.GreaterThanStr:
+			if cmp > 0 {
+				return true
+			}
+		case sqlparser.GreaterEqualStr:
+			if cmp >= 0 {
+				return true
+			}
+		case sqlparser.LessThanStr:
+			if cmp < 0 {
+				return true
+			}
+		case sqlparser.LessEqualStr:
+			if cmp <= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f *comparisonFilter) anyEqual(recordValues []interface{}) bool {
+	for _, recordValue := range recordValues {
+		for _, value := range f.values {
+			if f.field.valueType == enumspb.INDEXED_VALUE_TYPE_TEXT {
+				// Text search attributes are matched by case-insensitive substring instead of full-text search.
+				recordStr, ok1 := recordValue.(string)
+				valueStr, ok2 := value.(string)
+				if ok1 && ok2 && strings.Contains(strings.ToLower(recordStr), strings.ToLower(valueStr)) {
+					return true
+				}
+				continue
+			}
+			if cmp, ok := compareValues(recordValue, value); ok && cmp == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f *comparisonFilter) anyHasPrefix(recordValues []interface{}) bool {
+	prefix := f.values[0].(string)
+	for _, recordValue := range recordValues {
+		if s, ok := recordValue.(string); ok && strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *rangeFilter) Match(record *archiverspb.VisibilityRecord) bool {
+	inRange := false
+	for _, recordValue := range f.field.recordValues(record) {
+		cmpFrom, ok1 := compareValues(recordValue, f.from)
+		cmpTo, ok2 := compareValues(recordValue, f.to)
+		if ok1 && ok2 && cmpFrom >= 0 && cmpTo <= 0 {
+			inRange = true
+			break
+		}
+	}
+	return inRange != f.negate
+}
+
+func (f *isNullFilter) Match(record *archiverspb.VisibilityRecord) bool {
+	isNull := len(f.field.recordValues(record)) == 0
+	return isNull != f.negate
+}
+
+// compareValues compares two values of the same type. The second return value is false if
+// the values can't be compared.
+func compareValues(a interface{}, b interface{}) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case int64:
+		if b, ok := b.(int64); ok {
+			return compareOrdered(a, b), true
+		}
+	case float64:
+		if b, ok := b.(float64); ok {
+			return compareOrdered(a, b), true
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			if a == b {
+				return 0, true
+			}
+			if !a {
+				return -1, true
+			}
+			return 1, true
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b), true
+		}
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | float64](a T, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ParseTime converts a query value to time. Integers are interpreted as Unix nanoseconds and
+// strings must be in RFC3339 format.
+func ParseTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case int64:
+		return timestamp.UnixOrZeroTime(v), nil
+	case string:
+		return time.Parse(time.RFC3339Nano, v)
+	default:
+		return time.Time{}, fmt.Errorf("invalid time value: %v", value)
+	}
+}
+
+// ParseExecutionStatus converts a query value to workflow execution status. Both status numbers
+// and names are accepted. Names are case-insensitive and may contain underscores, e.g. "TimedOut",
+// "timed_out" and "WORKFLOW_EXECUTION_STATUS_TIMED_OUT" are all accepted.
+func ParseExecutionStatus(value interface{}) (enumspb.WorkflowExecutionStatus, error) {
+	switch v := value.(type) {
+	case int64:
+		if _, ok := enumspb.WorkflowExecutionStatus_name[int32(v)]; ok {
+			return enumspb.WorkflowExecutionStatus(v), nil
+		}
+	case string:
+		normalized := normalizeStatusName(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(v)), "WORKFLOW_EXECUTION_STATUS_"))
+		if n, err := strconv.ParseInt(normalized, 10, 32); err == nil {
+			return ParseExecutionStatus(n)
+		}
+		for number, name := range enumspb.WorkflowExecutionStatus_name {
+			if normalizeStatusName(strings.TrimPrefix(name, "WORKFLOW_EXECUTION_STATUS_")) == normalized {
+				return enumspb.WorkflowExecutionStatus(number), nil
+			}
+		}
+	}
+	return enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED, fmt.Errorf("unknown workflow execution status: %v", value)
+}
+
+func normalizeStatusName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
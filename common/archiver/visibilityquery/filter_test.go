@@ -0,0 +1,197 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type filterSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	record *archiverspb.VisibilityRecord
+}
+
+func TestFilterSuite(t *testing.T) {
+	suite.Run(t, new(filterSuite))
+}
+
+func (s *filterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.record = &archiverspb.VisibilityRecord{
+		WorkflowId:       "workflow-id",
+		RunId:            "run-id",
+		WorkflowTypeName: "workflow-type",
+		StartTime:        timestamppb.New(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)),
+		CloseTime:        timestamppb.New(time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT,
+		HistoryLength:    42,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField":  "keyword",
+			"CustomTextField":     "Some Text Value",
+			"CustomIntField":      "7",
+			"CustomDoubleField":   "1.5",
+			"CustomBoolField":     "true",
+			"CustomDatetimeField": "2024-01-02T00:00:00Z",
+			"KeywordList01":       `["a","b"]`,
+		},
+	}
+}
+
+func (s *filterSuite) TestMatch() {
+	testCases := []struct {
+		query string
+		match bool
+	}{
+		{query: "WorkflowId = 'workflow-id'", match: true},
+		{query: "`WorkflowId` = 'workflow-id'", match: true},
+		{query: "WorkflowId != 'workflow-id'", match: false},
+		{query: "WorkflowId = 'workflow-id' and RunId = 'other'", match: false},
+		{query: "WorkflowId = 'other' or RunId = 'run-id'", match: true},
+		{query: "not (WorkflowId = 'other')", match: true},
+		{query: "WorkflowType in ('a', 'workflow-type')", match: true},
+		{query: "WorkflowType not in ('a', 'workflow-type')", match: false},
+		{query: "WorkflowId starts_with 'workflow'", match: true},
+		{query: "WorkflowId not starts_with 'workflow'", match: false},
+		{query: "WorkflowId > 'workflow-a'", match: true},
+		{query: "ExecutionStatus = 'TimedOut'", match: true},
+		{query: "ExecutionStatus = 'timed_out'", match: true},
+		{query: "ExecutionStatus = 7", match: true},
+		{query: "ExecutionStatus in ('Failed', 'Completed')", match: false},
+		{query: "StartTime >= '2024-01-01T10:00:00Z' and CloseTime < '2024-01-01T11:00:01Z'", match: true},
+		{query: "CloseTime between '2024-01-01T00:00:00Z' and '2024-01-01T10:59:59Z'", match: false},
+		{query: "CloseTime not between '2024-01-01T00:00:00Z' and '2024-01-01T10:59:59Z'", match: true},
+		{query: "ExecutionTime is null", match: true},
+		{query: "CloseTime is not null", match: true},
+		{query: "HistoryLength <= 42", match: true},
+		{query: "HistoryLength < 42", match: false},
+		{query: "CustomKeywordField = 'keyword'", match: true},
+		{query: "CustomTextField = 'text'", match: true},
+		{query: "CustomTextField = 'other'", match: false},
+		{query: "CustomIntField between 5 and 10", match: true},
+		{query: "CustomDoubleField > 1", match: true},
+		{query: "CustomBoolField = true", match: true},
+		{query: "CustomBoolField = 'false'", match: false},
+		{query: "CustomDatetimeField > '2024-01-01T00:00:00Z'", match: true},
+		{query: "KeywordList01 = 'b'", match: true},
+		{query: "KeywordList01 != 'b'", match: false},
+		{query: "KeywordList01 in ('c', 'a')", match: true},
+		{query: "Keyword01 = 'keyword'", match: false},
+		{query: "Keyword01 != 'keyword'", match: true},
+		{query: "Keyword01 is null", match: true},
+	}
+
+	for _, tc := range testCases {
+		filter := s.newFilter(tc.query)
+		s.Equal(tc.match, filter.Match(s.record), tc.query)
+	}
+}
+
+func (s *filterSuite) TestNewFilter_Error() {
+	testCases := []string{
+		"UnknownField = 'value'",
+		"TaskQueue = 'value'",
+		"WorkflowId = workflow",
+		"ExecutionStatus > 'Failed'",
+		"ExecutionStatus = 'Unknown'",
+		"CustomBoolField < true",
+		"CustomIntField starts_with 'a'",
+		"CustomIntField = 'a'",
+		"StartTime > '2024-01-01 00:00:00'",
+		"WorkflowId in 'a'",
+		"WorkflowId = 'a' + 'b'",
+	}
+
+	for _, query := range testCases {
+		expr, err := ParseWhere(query)
+		if err != nil {
+			continue
+		}
+		_, err = NewFilter(expr, searchattribute.TestNameTypeMap, nil)
+		s.Error(err, query)
+	}
+}
+
+func (s *filterSuite) TestAliases() {
+	expr, err := ParseWhere("WorkflowTypeName = 'workflow-type'")
+	s.NoError(err)
+	filter, err := NewFilter(expr, searchattribute.TestNameTypeMap, map[string]string{"WorkflowTypeName": searchattribute.WorkflowType})
+	s.NoError(err)
+	s.True(filter.Match(s.record))
+}
+
+func (s *filterSuite) TestParseWhere() {
+	expr, err := ParseWhere("  ")
+	s.NoError(err)
+	s.Nil(expr)
+
+	_, err = ParseWhere("WorkflowId = 'a' order by StartTime")
+	s.Error(err)
+
+	expr, err = ParseWhere("A = 'a' and (B = 'b' and (C = 'c' or D = 'd'))")
+	s.NoError(err)
+	conjuncts := Conjuncts(expr)
+	s.Len(conjuncts, 3)
+
+	expr, err = ParseWhere("A = 'a' or B = 'b'")
+	s.NoError(err)
+	s.Len(Conjuncts(expr), 1)
+	s.Nil(And())
+}
+
+func (s *filterSuite) TestParseExecutionStatus() {
+	for _, value := range []interface{}{
+		"Completed",
+		"completed",
+		"COMPLETED",
+		"WORKFLOW_EXECUTION_STATUS_COMPLETED",
+		"2",
+		int64(2),
+	} {
+		status, err := ParseExecutionStatus(value)
+		s.NoError(err, value)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, status, value)
+	}
+
+	_, err := ParseExecutionStatus(int64(100))
+	s.Error(err)
+	_, err = ParseExecutionStatus(1.5)
+	s.Error(err)
+}
+
+func (s *filterSuite) newFilter(query string) Filter {
+	expr, err := ParseWhere(query)
+	s.NoError(err, query)
+	filter, err := NewFilter(expr, searchattribute.TestNameTypeMap, nil)
+	s.NoError(err, query)
+	return filter
+}
@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -83,12 +84,20 @@ type hasNamespace interface {
 }
 
 func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+	return GetAuthorizerFromConfigWithLogger(config, log.NewNoopLogger())
+}
+
+// GetAuthorizerFromConfigWithLogger is like GetAuthorizerFromConfig, and logs reloads of the policy
+// file of the policy authorizer to logger.
+func GetAuthorizerFromConfigWithLogger(config *config.Authorization, logger log.Logger) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		return NewPolicyAuthorizer(config.Policy, logger)
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
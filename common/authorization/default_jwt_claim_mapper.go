@@ -26,6 +26,7 @@ package authorization
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -52,6 +53,23 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	// issuers by their "iss" claim. If set, tokens of other issuers are rejected.
+	issuers map[string]*jwtIssuer
+}
+
+type jwtIssuer struct {
+	issuer               string
+	keyProvider          TokenKeyProvider
+	audiences            []string
+	permissionsClaimName string
+	roleMappings         []jwtRoleMapping
+}
+
+type jwtRoleMapping struct {
+	claimPath []string
+	value     string
+	namespace string
+	role      Role
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -62,6 +80,50 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
 }
 
+// NewDefaultJWTClaimMapperWithIssuers creates a default claim mapper accepting tokens of cfg.Issuers,
+// validating them with the keys of their issuer.
+func NewDefaultJWTClaimMapperWithIssuers(cfg *config.Authorization, logger log.Logger) (ClaimMapper, error) {
+	claimName := cfg.PermissionsClaimName
+	if claimName == "" {
+		claimName = defaultPermissionsClaimName
+	}
+	issuers := make(map[string]*jwtIssuer, len(cfg.Issuers))
+	for _, issuerConfig := range cfg.Issuers {
+		if issuerConfig.Issuer == "" {
+			return nil, errors.New("JWT issuer without issuer")
+		}
+		if _, ok := issuers[issuerConfig.Issuer]; ok {
+			return nil, fmt.Errorf("duplicate JWT issuer %s", issuerConfig.Issuer)
+		}
+		issuer := &jwtIssuer{
+			issuer:               issuerConfig.Issuer,
+			audiences:            issuerConfig.Audiences,
+			permissionsClaimName: issuerConfig.PermissionsClaimName,
+		}
+		if issuer.permissionsClaimName == "" {
+			issuer.permissionsClaimName = claimName
+		}
+		for _, mapping := range issuerConfig.RoleMappings {
+			role := permissionToRole(mapping.Role)
+			if role == RoleUndefined {
+				return nil, fmt.Errorf("JWT issuer %s: unknown role %q", issuerConfig.Issuer, mapping.Role)
+			}
+			if mapping.ClaimPath == "" {
+				return nil, fmt.Errorf("JWT issuer %s: role mapping without claimPath", issuerConfig.Issuer)
+			}
+			issuer.roleMappings = append(issuer.roleMappings, jwtRoleMapping{
+				claimPath: strings.Split(mapping.ClaimPath, "."),
+				value:     mapping.Value,
+				namespace: mapping.Namespace,
+				role:      role,
+			})
+		}
+		issuer.keyProvider = newIssuerTokenKeyProvider(issuerConfig, logger)
+		issuers[issuer.issuer] = issuer
+	}
+	return &defaultJWTClaimMapper{logger: logger, permissionsClaimName: claimName, issuers: issuers}, nil
+}
+
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
 
 func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
@@ -79,25 +141,115 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if !strings.EqualFold(parts[0], authorizationBearer) {
 		return nil, serviceerror.NewPermissionDenied("unexpected name in authorization token", "")
 	}
-	jwtClaims, err := parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	keyProvider, permissionsClaimName := a.keyProvider, a.permissionsClaimName
+	var issuer *jwtIssuer
+	if a.issuers != nil {
+		var err error
+		if issuer, err = a.tokenIssuer(parts[1]); err != nil {
+			return nil, err
+		}
+		keyProvider, permissionsClaimName = issuer.keyProvider, issuer.permissionsClaimName
+	}
+	jwtClaims, err := parseJWTWithAudience(parts[1], keyProvider, authInfo.Audience)
 	if err != nil {
 		return nil, err
 	}
+	if issuer != nil {
+		if err := issuer.verify(jwtClaims); err != nil {
+			return nil, err
+		}
+	}
 	subject, ok := jwtClaims[headerSubject].(string)
 	if !ok {
 		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
 	}
 	claims.Subject = subject
-	permissions, ok := jwtClaims[a.permissionsClaimName].([]interface{})
+	// The verified claims of the token, e.g. for the "claims" conditions of the policy authorizer.
+	claims.Extensions = map[string]any(jwtClaims)
+	permissions, ok := jwtClaims[permissionsClaimName].([]interface{})
 	if ok {
 		err := a.extractPermissions(permissions, &claims)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if issuer != nil {
+		issuer.mapRoles(jwtClaims, &claims)
+	}
 	return &claims, nil
 }
 
+// tokenIssuer returns the issuer of the "iss" claim of an unverified token. The token is verified
+// with the keys of the issuer afterwards.
+func (a *defaultJWTClaimMapper) tokenIssuer(tokenString string) (*jwtIssuer, error) {
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return nil, err
+	}
+	iss, _ := token.Claims.(jwt.MapClaims)["iss"].(string)
+	issuer, ok := a.issuers[iss]
+	if !ok {
+		return nil, serviceerror.NewPermissionDenied("unknown token issuer", "")
+	}
+	return issuer, nil
+}
+
+func (i *jwtIssuer) verify(claims jwt.MapClaims) error {
+	if !claims.VerifyIssuer(i.issuer, true) {
+		return serviceerror.NewPermissionDenied("issuer mismatch", "")
+	}
+	if len(i.audiences) == 0 {
+		return nil
+	}
+	for _, audience := range i.audiences {
+		if claims.VerifyAudience(audience, true) {
+			return nil
+		}
+	}
+	return serviceerror.NewPermissionDenied("audience mismatch", "")
+}
+
+func (i *jwtIssuer) mapRoles(jwtClaims jwt.MapClaims, claims *Claims) {
+	for _, mapping := range i.roleMappings {
+		if !claimHasValue(jwtClaims, mapping.claimPath, mapping.value) {
+			continue
+		}
+		if mapping.namespace == "" {
+			claims.System |= mapping.role
+			continue
+		}
+		if claims.Namespaces == nil {
+			claims.Namespaces = make(map[string]Role)
+		}
+		claims.Namespaces[mapping.namespace] |= mapping.role
+	}
+}
+
+// claimHasValue returns true if the claim at path equals value, or is a list containing it.
+func claimHasValue(jwtClaims jwt.MapClaims, path []string, value string) bool {
+	var claim interface{} = map[string]interface{}(jwtClaims)
+	for _, key := range path {
+		m, ok := claim.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if claim, ok = m[key]; !ok {
+			return false
+		}
+	}
+	switch c := claim.(type) {
+	case string:
+		return c == value
+	case []interface{}:
+		for _, e := range c {
+			if s, ok := e.(string); ok && s == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
@@ -25,11 +25,15 @@
 package authorization
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
@@ -41,6 +45,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/primitives"
 	"go.uber.org/mock/gomock"
+	"gopkg.in/go-jose/go-jose.v2"
 )
 
 type errorTestOptions int16
@@ -380,3 +385,176 @@ func (tg *tokenGenerator) SupportedMethods() []string {
 }
 func (tg *tokenGenerator) Close() {
 }
+
+func (s *defaultClaimMapperSuite) TestIssuers() {
+	issuerA := newStubIssuer(s.T())
+	issuerB := newStubIssuer(s.T())
+	cfg := &config.Authorization{
+		ClaimMapper: "default",
+		Issuers: []config.JWTIssuer{
+			{
+				Issuer:    issuerA.URL(),
+				Audiences: []string{"temporal", "temporal-ui"},
+				RoleMappings: []config.JWTRoleMapping{
+					{ClaimPath: "groups", Value: "ops", Role: "admin"},
+					{ClaimPath: "realm_access.roles", Value: "default-writer", Namespace: defaultNamespace, Role: "write"},
+				},
+			},
+			{
+				Issuer:               issuerB.URL(),
+				KeySourceURIs:        []string{issuerB.URL() + "/jwks"},
+				PermissionsClaimName: "perms",
+			},
+		},
+	}
+	claimMapper, err := GetClaimMapperFromConfig(cfg, s.logger)
+	s.NoError(err)
+	getClaims := func(token string) (*Claims, error) {
+		claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+		if claims != nil {
+			// extensions are covered by TestIssuersExtensions
+			claims.Extensions = nil
+		}
+		return claims, err
+	}
+
+	claims, err := getClaims(issuerA.token(s.T(), jwt.MapClaims{
+		"aud":          []string{"other", "temporal-ui"},
+		"groups":       []string{"dev", "ops"},
+		"realm_access": map[string]interface{}{"roles": []string{"default-writer"}},
+	}))
+	s.NoError(err)
+	s.Equal(&Claims{
+		Subject:    testSubject,
+		System:     RoleAdmin,
+		Namespaces: map[string]Role{defaultNamespace: RoleWriter},
+	}, claims)
+
+	claims, err = getClaims(issuerA.token(s.T(), jwt.MapClaims{"aud": "temporal", "groups": "dev"}))
+	s.NoError(err)
+	s.Equal(&Claims{Subject: testSubject}, claims)
+
+	_, err = getClaims(issuerA.token(s.T(), jwt.MapClaims{"aud": "other", "groups": "ops"}))
+	s.ErrorContains(err, "audience mismatch")
+
+	claims, err = getClaims(issuerB.token(s.T(), jwt.MapClaims{"perms": []string{"default:read"}}))
+	s.NoError(err)
+	s.Equal(&Claims{Subject: testSubject, Namespaces: map[string]Role{defaultNamespace: RoleReader}}, claims)
+
+	// Tokens are verified with the keys of the issuer they claim.
+	_, err = getClaims(issuerB.token(s.T(), jwt.MapClaims{"iss": issuerA.URL(), "aud": "temporal", "groups": "ops"}))
+	s.Error(err)
+
+	_, err = getClaims(issuerB.token(s.T(), jwt.MapClaims{"iss": "https://unknown.example.com"}))
+	s.ErrorContains(err, "unknown token issuer")
+}
+
+func (s *defaultClaimMapperSuite) TestIssuersExtensions() {
+	issuer := newStubIssuer(s.T())
+	claimMapper, err := GetClaimMapperFromConfig(&config.Authorization{
+		ClaimMapper: "default",
+		Issuers:     []config.JWTIssuer{{Issuer: issuer.URL()}},
+	}, s.logger)
+	s.NoError(err)
+	authorizer, err := NewPolicyAuthorizer(config.AuthorizationPolicy{File: writePolicy(s.T(), `
+rules:
+  - effect: allow
+    claims:
+      groups: [team-x]
+      org.team: [payments]
+`)}, s.logger)
+	s.NoError(err)
+	authorize := func(tokenClaims jwt.MapClaims) Decision {
+		claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(issuer.token(s.T(), tokenClaims))})
+		s.NoError(err)
+		result, err := authorizer.Authorize(context.Background(), claims, &targetStartWorkflow)
+		s.NoError(err)
+		return result.Decision
+	}
+
+	s.Equal(DecisionAllow, authorize(jwt.MapClaims{
+		"groups": []string{"dev", "team-x"},
+		"org":    map[string]any{"team": "payments"},
+	}))
+	s.Equal(DecisionDeny, authorize(jwt.MapClaims{
+		"groups": []string{"dev"},
+		"org":    map[string]any{"team": "payments"},
+	}))
+	s.Equal(DecisionDeny, authorize(jwt.MapClaims{"groups": []string{"team-x"}}))
+}
+
+func (s *defaultClaimMapperSuite) TestIssuersInvalidConfig() {
+	_, err := NewDefaultJWTClaimMapperWithIssuers(&config.Authorization{
+		Issuers: []config.JWTIssuer{{
+			Issuer:        "https://issuer.example.com",
+			KeySourceURIs: []string{"https://issuer.example.com/jwks"},
+			RoleMappings:  []config.JWTRoleMapping{{ClaimPath: "groups", Value: "ops", Role: "owner"}},
+		}},
+	}, s.logger)
+	s.Error(err)
+}
+
+func (s *defaultClaimMapperSuite) TestDiscoverJWKSURI() {
+	issuer := newStubIssuer(s.T())
+	uri, err := discoverJWKSURI(issuer.URL())
+	s.NoError(err)
+	s.Equal(issuer.URL()+"/jwks", uri)
+
+	issuer.configurationIssuer = "https://other.example.com"
+	_, err = discoverJWKSURI(issuer.URL())
+	s.Error(err)
+}
+
+// stubIssuer is an OpenID provider serving its configuration and signing keys.
+type stubIssuer struct {
+	server              *httptest.Server
+	key                 *rsa.PrivateKey
+	configurationIssuer string
+}
+
+func newStubIssuer(t *testing.T) *stubIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer := &stubIssuer{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		configurationIssuer := issuer.URL()
+		if issuer.configurationIssuer != "" {
+			configurationIssuer = issuer.configurationIssuer
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   configurationIssuer,
+			"jwks_uri": issuer.URL() + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "test-key", Algorithm: jwt.SigningMethodRS256.Name, Use: "sig"},
+		}})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *stubIssuer) URL() string {
+	return i.server.URL
+}
+
+// token returns a token of the issuer for testSubject with claims, which may override the defaults.
+func (i *stubIssuer) token(t *testing.T, claims jwt.MapClaims) string {
+	tokenClaims := jwt.MapClaims{
+		"iss": i.URL(),
+		"sub": testSubject,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		tokenClaims[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, tokenClaims)
+	token.Header["kid"] = "test-key"
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+	return signed
+}
@@ -42,9 +42,13 @@ import (
 	"gopkg.in/go-jose/go-jose.v2"
 )
 
+const oidcDiscoveryPath = "/.well-known/openid-configuration"
+
 // Default token key provider
 type defaultTokenKeyProvider struct {
-	config   config.JWTKeyProvider
+	config config.JWTKeyProvider
+	// issuer to discover the JWKS URI of, if config has no KeySourceURIs
+	issuer   string
 	rsaKeys  map[string]*rsa.PublicKey
 	ecKeys   map[string]*ecdsa.PublicKey
 	keysLock sync.RWMutex
@@ -61,10 +65,27 @@ func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *d
 	return &provider
 }
 
+// newIssuerTokenKeyProvider creates a token key provider for the signing keys of an issuer, which are
+// discovered from its OpenID configuration unless the issuer has KeySourceURIs.
+func newIssuerTokenKeyProvider(issuer config.JWTIssuer, logger log.Logger) *defaultTokenKeyProvider {
+	provider := defaultTokenKeyProvider{
+		config: config.JWTKeyProvider{
+			KeySourceURIs:   issuer.KeySourceURIs,
+			RefreshInterval: issuer.RefreshInterval,
+		},
+		logger: logger,
+	}
+	if !provider.config.HasSourceURIsConfigured() {
+		provider.issuer = issuer.Issuer
+	}
+	provider.initialize()
+	return &provider
+}
+
 func (a *defaultTokenKeyProvider) initialize() {
 	a.rsaKeys = make(map[string]*rsa.PublicKey)
 	a.ecKeys = make(map[string]*ecdsa.PublicKey)
-	if a.config.HasSourceURIsConfigured() {
+	if a.hasKeySources() {
 		err := a.updateKeys()
 		if err != nil {
 			a.logger.Error("error during initial retrieval of token keys: ", tag.Error(err))
@@ -122,7 +143,7 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 			return
 		case <-a.ticker.C:
 		}
-		if a.config.HasSourceURIsConfigured() {
+		if a.hasKeySources() {
 			err := a.updateKeys()
 			if err != nil {
 				a.logger.Error("error while refreshing token keys: ", tag.Error(err))
@@ -131,15 +152,28 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 	}
 }
 
+func (a *defaultTokenKeyProvider) hasKeySources() bool {
+	return a.config.HasSourceURIsConfigured() || a.issuer != ""
+}
+
 func (a *defaultTokenKeyProvider) updateKeys() error {
-	if !a.config.HasSourceURIsConfigured() {
+	if !a.hasKeySources() {
 		return fmt.Errorf("no URIs configured for retrieving token keys")
 	}
 
+	uris := a.config.KeySourceURIs
+	if a.issuer != "" {
+		uri, err := discoverJWKSURI(a.issuer)
+		if err != nil {
+			return err
+		}
+		uris = []string{uri}
+	}
+
 	rsaKeys := make(map[string]*rsa.PublicKey)
 	ecKeys := make(map[string]*ecdsa.PublicKey)
 
-	for _, uri := range a.config.KeySourceURIs {
+	for _, uri := range uris {
 		if strings.TrimSpace(uri) == "" {
 			continue
 		}
@@ -189,6 +223,35 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	return nil
 }
 
+// discoverJWKSURI returns the JWKS URI of the OpenID configuration of issuer.
+func discoverJWKSURI(issuer string) (_ string, err error) {
+	resp, err := http.Get(strings.TrimSuffix(issuer, "/") + oidcDiscoveryPath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = multierr.Combine(err, resp.Body.Close())
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status of OpenID configuration of issuer %s: %s", issuer, resp.Status)
+	}
+
+	var configuration struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&configuration); err != nil {
+		return "", err
+	}
+	if configuration.Issuer != issuer {
+		return "", fmt.Errorf("OpenID configuration of issuer %s is for issuer %s", issuer, configuration.Issuer)
+	}
+	if configuration.JWKSURI == "" {
+		return "", fmt.Errorf("OpenID configuration of issuer %s has no jwks_uri", issuer)
+	}
+	return configuration.JWKSURI, nil
+}
+
 func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
 	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
 }
@@ -0,0 +1,429 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	policyEffectAllow = "allow"
+	policyEffectDeny  = "deny"
+
+	policyFallbackDeny    = "deny"
+	policyFallbackAllow   = "allow"
+	policyFallbackDefault = "default"
+)
+
+type (
+	// Policy is the format of the policy file of policyAuthorizer.
+	Policy struct {
+		// Rules are evaluated in order, the first rule matching a call decides it.
+		Rules []PolicyRule `yaml:"rules"`
+		// Fallback decides calls which no rule matches: "deny" (the default), "allow", or "default" to
+		// decide them with the default authorizer, from the roles of the claims.
+		Fallback string `yaml:"fallback"`
+	}
+
+	// PolicyRule allows or denies calls matching all of its conditions. A condition which is not set
+	// matches every call. Patterns may contain "*", which matches any sequence of characters.
+	PolicyRule struct {
+		// Name is reported as the reason of decisions made by the rule.
+		Name string `yaml:"name"`
+		// Effect is "allow" or "deny".
+		Effect string `yaml:"effect"`
+		// APIs are patterns of API names. Patterns containing "/" match the full API name, e.g.
+		// "/temporal.api.workflowservice.v1.WorkflowService/*", other patterns match the method name,
+		// e.g. "ResetWorkflowExecution".
+		APIs []string `yaml:"apis"`
+		// Namespaces are patterns of the target namespace. Calls without a namespace match "*".
+		Namespaces []string `yaml:"namespaces"`
+		// Subjects are patterns of the subject of the claims.
+		Subjects []string `yaml:"subjects"`
+		// Role is the minimum role of the claims on the target namespace, including the system role:
+		// "worker", "reader", "writer" or "admin".
+		Role string `yaml:"role"`
+		// Claims map paths of the extensions of the claims, e.g. "groups" or "org.team", to patterns
+		// of their value. The extensions must be a map, a list value matches if any of its elements does.
+		Claims map[string][]string `yaml:"claims"`
+		// WorkflowTypes are patterns of the workflow type of the request. Only StartWorkflowExecution and
+		// SignalWithStartWorkflowExecution requests carry one, so rules with WorkflowTypes must limit APIs
+		// to these.
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		// TaskQueues are patterns of the task queue of the request. For requests without one, e.g.
+		// SignalWorkflowExecution, the condition matches deny rules and doesn't match allow rules.
+		TaskQueues []string `yaml:"taskQueues"`
+	}
+
+	policyAuthorizer struct {
+		config            config.AuthorizationPolicy
+		policy            atomic.Pointer[compiledPolicy]
+		modTime           time.Time
+		defaultAuthorizer Authorizer
+		logger            log.Logger
+		stop              chan struct{}
+		stopOnce          sync.Once
+	}
+
+	compiledPolicy struct {
+		rules    []compiledRule
+		fallback string
+	}
+
+	compiledRule struct {
+		reason        string
+		decision      Decision
+		apis          patterns
+		namespaces    patterns
+		subjects      patterns
+		role          Role
+		claims        map[string]patterns
+		workflowTypes patterns
+		taskQueues    patterns
+	}
+
+	// patterns match any value if nil.
+	patterns []*regexp.Regexp
+
+	hasWorkflowType interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+
+	hasTaskQueue interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+
+	hasTaskQueueName interface {
+		GetTaskQueue() string
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// workflowTypeAPIs are the methods whose requests carry a workflow type.
+var workflowTypeAPIs = []string{"StartWorkflowExecution", "SignalWithStartWorkflowExecution"}
+
+// NewPolicyAuthorizer creates an authorizer deciding calls with the rules of a policy file, see
+// Policy. If cfg.RefreshInterval is set, the file is reloaded when it changes. A file which fails to
+// load is logged and the previous policy stays in effect.
+func NewPolicyAuthorizer(cfg config.AuthorizationPolicy, logger log.Logger) (*policyAuthorizer, error) {
+	if cfg.File == "" {
+		return nil, errors.New("policy authorizer requires a policy file")
+	}
+	a := &policyAuthorizer{
+		config:            cfg,
+		defaultAuthorizer: NewDefaultAuthorizer(),
+		logger:            logger,
+	}
+	if _, err := a.reload(); err != nil {
+		return nil, err
+	}
+	if cfg.RefreshInterval > 0 {
+		a.stop = make(chan struct{})
+		go a.refreshLoop()
+	}
+	return a, nil
+}
+
+// Close stops reloading the policy file. The server calls it when it stops, see
+// temporal.WithAuthorizer.
+func (a *policyAuthorizer) Close() {
+	a.stopOnce.Do(func() {
+		if a.stop != nil {
+			close(a.stop)
+		}
+	})
+}
+
+func (a *policyAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+	policy := a.policy.Load()
+	for _, rule := range policy.rules {
+		if rule.matches(claims, target) {
+			return Result{Decision: rule.decision, Reason: rule.reason}, nil
+		}
+	}
+	switch policy.fallback {
+	case policyFallbackAllow:
+		return resultAllow, nil
+	case policyFallbackDefault:
+		return a.defaultAuthorizer.Authorize(ctx, claims, target)
+	default:
+		return Result{Decision: DecisionDeny, Reason: "no policy rule matched"}, nil
+	}
+}
+
+func (a *policyAuthorizer) refreshLoop() {
+	ticker := time.NewTicker(a.config.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-ticker.C:
+		}
+		reloaded, err := a.reload()
+		if err != nil {
+			a.logger.Error("Unable to reload authorization policy, keeping the previous policy.",
+				tag.NewStringTag("file", a.config.File), tag.Error(err))
+		} else if reloaded {
+			a.logger.Info("Reloaded authorization policy.", tag.NewStringTag("file", a.config.File))
+		}
+	}
+}
+
+// reload loads the policy file if it changed since the last load.
+func (a *policyAuthorizer) reload() (bool, error) {
+	info, err := os.Stat(a.config.File)
+	if err != nil {
+		return false, err
+	}
+	if !info.ModTime().After(a.modTime) {
+		return false, nil
+	}
+	contents, err := os.ReadFile(a.config.File)
+	if err != nil {
+		return false, err
+	}
+	policy, err := parsePolicy(contents)
+	if err != nil {
+		return false, fmt.Errorf("policy file %s: %w", a.config.File, err)
+	}
+	a.policy.Store(policy)
+	a.modTime = info.ModTime()
+	return true, nil
+}
+
+func parsePolicy(contents []byte) (*compiledPolicy, error) {
+	var policy Policy
+	decoder := yaml.NewDecoder(bytes.NewReader(contents))
+	// Misspelled conditions would silently widen rules.
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("unable to decode policy: %w", err)
+	}
+
+	compiled := &compiledPolicy{fallback: strings.ToLower(policy.Fallback)}
+	switch compiled.fallback {
+	case "":
+		compiled.fallback = policyFallbackDeny
+	case policyFallbackDeny, policyFallbackAllow, policyFallbackDefault:
+	default:
+		return nil, fmt.Errorf("unknown fallback %q", policy.Fallback)
+	}
+	for i, rule := range policy.Rules {
+		r, err := compileRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		if rule.Name != "" {
+			r.reason = fmt.Sprintf("policy rule %q", rule.Name)
+		} else {
+			r.reason = fmt.Sprintf("policy rule %d", i+1)
+		}
+		compiled.rules = append(compiled.rules, r)
+	}
+	return compiled, nil
+}
+
+func compileRule(rule PolicyRule) (compiledRule, error) {
+	var r compiledRule
+	switch strings.ToLower(rule.Effect) {
+	case policyEffectAllow:
+		r.decision = DecisionAllow
+	case policyEffectDeny:
+		r.decision = DecisionDeny
+	default:
+		return r, fmt.Errorf("effect must be %q or %q, got %q", policyEffectAllow, policyEffectDeny, rule.Effect)
+	}
+	switch strings.ToLower(rule.Role) {
+	case "":
+	case "worker":
+		r.role = RoleWorker
+	case "reader":
+		r.role = RoleReader
+	case "writer":
+		r.role = RoleWriter
+	case "admin":
+		r.role = RoleAdmin
+	default:
+		return r, fmt.Errorf("unknown role %q", rule.Role)
+	}
+	if rule.WorkflowTypes != nil {
+		if len(rule.APIs) == 0 {
+			return r, fmt.Errorf("workflowTypes requires apis limited to %s", strings.Join(workflowTypeAPIs, ", "))
+		}
+		for _, apiName := range rule.APIs {
+			if !slices.Contains(workflowTypeAPIs, strings.TrimPrefix(apiName, api.WorkflowServicePrefix)) {
+				return r, fmt.Errorf("workflowTypes can't be combined with api %q, only with %s",
+					apiName, strings.Join(workflowTypeAPIs, ", "))
+			}
+		}
+	}
+	r.apis = compilePatterns(rule.APIs)
+	r.namespaces = compilePatterns(rule.Namespaces)
+	r.subjects = compilePatterns(rule.Subjects)
+	r.workflowTypes = compilePatterns(rule.WorkflowTypes)
+	r.taskQueues = compilePatterns(rule.TaskQueues)
+	if len(rule.Claims) > 0 {
+		r.claims = make(map[string]patterns, len(rule.Claims))
+		for path, values := range rule.Claims {
+			r.claims[path] = compilePatterns(values)
+		}
+	}
+	return r, nil
+}
+
+func compilePatterns(values []string) patterns {
+	if values == nil {
+		return nil
+	}
+	ps := make(patterns, 0, len(values))
+	for _, v := range values {
+		parts := strings.Split(v, "*")
+		for i := range parts {
+			parts[i] = regexp.QuoteMeta(parts[i])
+		}
+		ps = append(ps, regexp.MustCompile("^"+strings.Join(parts, ".*")+"$"))
+	}
+	return ps
+}
+
+func (ps patterns) match(value string) bool {
+	if ps == nil {
+		return true
+	}
+	for _, p := range ps {
+		if p.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *compiledRule) matches(claims *Claims, target *CallTarget) bool {
+	if claims == nil {
+		claims = &Claims{}
+	}
+	apiName := target.APIName
+	if r.apis != nil && !r.apis.match(apiName) && !r.apis.match(apiName[strings.LastIndex(apiName, "/")+1:]) {
+		return false
+	}
+	if !r.namespaces.match(target.Namespace) || !r.subjects.match(claims.Subject) {
+		return false
+	}
+	if r.role != RoleUndefined && claims.System|claims.Namespaces[target.Namespace] < r.role {
+		return false
+	}
+	for path, ps := range r.claims {
+		if !matchClaim(claims.Extensions, path, ps) {
+			return false
+		}
+	}
+	return r.matchRequestField(r.workflowTypes, workflowTypeOf(target.Request)) &&
+		r.matchRequestField(r.taskQueues, taskQueueOf(target.Request))
+}
+
+// matchRequestField matches a field of the request, which is nil if the request doesn't have it. Rules
+// fail closed: deny rules match requests without the field, allow rules don't.
+func (r *compiledRule) matchRequestField(ps patterns, value *string) bool {
+	if ps == nil {
+		return true
+	}
+	if value == nil {
+		return r.decision == DecisionDeny
+	}
+	return ps.match(*value)
+}
+
+func matchClaim(extensions any, path string, ps patterns) bool {
+	value := extensions
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		if value, ok = m[key]; !ok {
+			return false
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return ps.match(v)
+	case []string:
+		for _, e := range v {
+			if ps.match(e) {
+				return true
+			}
+		}
+	case []any:
+		for _, e := range v {
+			if s, ok := e.(string); ok && ps.match(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func workflowTypeOf(request any) *string {
+	if r, ok := request.(hasWorkflowType); ok && r.GetWorkflowType() != nil {
+		name := r.GetWorkflowType().GetName()
+		return &name
+	}
+	return nil
+}
+
+func taskQueueOf(request any) *string {
+	switch r := request.(type) {
+	case hasTaskQueue:
+		if r.GetTaskQueue() != nil {
+			name := r.GetTaskQueue().GetName()
+			return &name
+		}
+	case hasTaskQueueName:
+		name := r.GetTaskQueue()
+		return &name
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const testPolicy = `
+rules:
+  - name: ci-reset
+    effect: allow
+    apis: [ResetWorkflowExecution]
+    subjects: ["ci-*"]
+  - name: ci-only-reset
+    effect: deny
+    apis: [ResetWorkflowExecution]
+    subjects: ["*"]
+  - name: team-x-no-terminate
+    effect: deny
+    apis: [TerminateWorkflowExecution]
+    namespaces: [ns-y]
+    claims:
+      groups: [team-x]
+  - name: team-x-no-bar
+    effect: deny
+    apis: [StartWorkflowExecution, "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution"]
+    claims:
+      groups: [team-x]
+    workflowTypes: [Bar]
+  - name: team-x-signal
+    effect: allow
+    apis: ["Signal*"]
+    namespaces: [ns-y]
+    claims:
+      groups: [team-x]
+  - name: team-x-start-foo
+    effect: allow
+    apis: [StartWorkflowExecution]
+    claims:
+      groups: [team-x]
+    workflowTypes: [Foo]
+    taskQueues: ["foo-*"]
+fallback: default
+`
+
+func writePolicy(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	return path
+}
+
+func TestPolicyAuthorizer(t *testing.T) {
+	authorizer, err := NewPolicyAuthorizer(config.AuthorizationPolicy{File: writePolicy(t, testPolicy)}, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	teamX := &Claims{Subject: "alice", Extensions: map[string]any{"groups": []any{"team-a", "team-x"}}}
+	teamXWriter := &Claims{Subject: "alice", Namespaces: map[string]Role{"ns-y": RoleWriter}, Extensions: map[string]any{"groups": []any{"team-x"}}}
+	ci := &Claims{Subject: "ci-pipeline", System: RoleAdmin}
+	admin := &Claims{Subject: "bob", System: RoleAdmin}
+	api := func(method string) string {
+		return "/temporal.api.workflowservice.v1.WorkflowService/" + method
+	}
+	startFoo := &workflowservice.StartWorkflowExecutionRequest{
+		WorkflowType: &commonpb.WorkflowType{Name: "Foo"},
+		TaskQueue:    &taskqueuepb.TaskQueue{Name: "foo-1"},
+	}
+
+	testCases := []struct {
+		name     string
+		claims   *Claims
+		target   *CallTarget
+		decision Decision
+		reason   string
+	}{
+		{"CIReset", ci, &CallTarget{APIName: api("ResetWorkflowExecution"), Namespace: "ns-y"}, DecisionAllow, `policy rule "ci-reset"`},
+		{"AdminReset", admin, &CallTarget{APIName: api("ResetWorkflowExecution"), Namespace: "ns-y"}, DecisionDeny, `policy rule "ci-only-reset"`},
+		{"TeamXSignal", teamX, &CallTarget{APIName: api("SignalWorkflowExecution"), Namespace: "ns-y"}, DecisionAllow, `policy rule "team-x-signal"`},
+		{"TeamXSignalWithStart", teamX, &CallTarget{APIName: api("SignalWithStartWorkflowExecution"), Namespace: "ns-y", Request: &workflowservice.SignalWithStartWorkflowExecutionRequest{
+			WorkflowType: &commonpb.WorkflowType{Name: "Foo"},
+		}}, DecisionAllow, `policy rule "team-x-signal"`},
+		{"TeamXSignalOtherNamespace", teamX, &CallTarget{APIName: api("SignalWorkflowExecution"), Namespace: "ns-z"}, DecisionDeny, ""},
+		{"TeamXTerminate", teamXWriter, &CallTarget{APIName: api("TerminateWorkflowExecution"), Namespace: "ns-y"}, DecisionDeny, `policy rule "team-x-no-terminate"`},
+		{"TeamXStartFoo", teamX, &CallTarget{APIName: api("StartWorkflowExecution"), Namespace: "ns-y", Request: startFoo}, DecisionAllow, `policy rule "team-x-start-foo"`},
+		{"TeamXStartBar", teamX, &CallTarget{APIName: api("StartWorkflowExecution"), Namespace: "ns-y", Request: &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowType: &commonpb.WorkflowType{Name: "Bar"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "foo-1"},
+		}}, DecisionDeny, `policy rule "team-x-no-bar"`},
+		{"TeamXSignalWithStartBar", teamX, &CallTarget{APIName: api("SignalWithStartWorkflowExecution"), Namespace: "ns-y", Request: &workflowservice.SignalWithStartWorkflowExecutionRequest{
+			WorkflowType: &commonpb.WorkflowType{Name: "Bar"},
+		}}, DecisionDeny, `policy rule "team-x-no-bar"`},
+		// Deny rules match requests without the workflow type, allow rules don't.
+		{"TeamXStartWithoutRequest", teamX, &CallTarget{APIName: api("StartWorkflowExecution"), Namespace: "ns-y"}, DecisionDeny, `policy rule "team-x-no-bar"`},
+		// Fallback to the default authorizer.
+		{"TeamXWriterTerminateOtherNamespace", teamXWriter, &CallTarget{APIName: api("TerminateWorkflowExecution"), Namespace: "ns-z"}, DecisionDeny, ""},
+		{"AdminTerminate", admin, &CallTarget{APIName: api("TerminateWorkflowExecution"), Namespace: "ns-y"}, DecisionAllow, ""},
+		{"NoClaimsHealthCheck", nil, &targetGrpcHealthCheck, DecisionAllow, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := authorizer.Authorize(context.Background(), tc.claims, tc.target)
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, result.Decision)
+			require.Equal(t, tc.reason, result.Reason)
+		})
+	}
+}
+
+func TestPolicyAuthorizer_Role(t *testing.T) {
+	authorizer, err := NewPolicyAuthorizer(config.AuthorizationPolicy{File: writePolicy(t, `
+rules:
+  - effect: allow
+    apis: ["/temporal.api.workflowservice.v1.WorkflowService/*"]
+    role: writer
+`)}, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	target := &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution", Namespace: testNamespace}
+	result, err := authorizer.Authorize(context.Background(), &claimsNamespaceWriter, target)
+	require.NoError(t, err)
+	require.Equal(t, Result{Decision: DecisionAllow, Reason: "policy rule 1"}, result)
+
+	result, err = authorizer.Authorize(context.Background(), &claimsNamespaceReader, target)
+	require.NoError(t, err)
+	require.Equal(t, Result{Decision: DecisionDeny, Reason: "no policy rule matched"}, result)
+
+	result, err = authorizer.Authorize(context.Background(), &claimsSystemAdmin, &targetAdminAPI)
+	require.NoError(t, err)
+	require.Equal(t, DecisionDeny, result.Decision)
+}
+
+func TestPolicyAuthorizer_Invalid(t *testing.T) {
+	for name, policy := range map[string]string{
+		"UnknownField":              "rules:\n  - effect: allow\n    namespace: [foo]\n",
+		"UnknownEffect":             "rules:\n  - effect: permit\n",
+		"UnknownRole":               "rules:\n  - effect: allow\n    role: owner\n",
+		"UnknownFallback":           "fallback: roles\n",
+		"WorkflowTypesWithoutAPIs":  "rules:\n  - effect: deny\n    workflowTypes: [Foo]\n",
+		"WorkflowTypesWithOtherAPI": "rules:\n  - effect: deny\n    apis: [StartWorkflowExecution, TerminateWorkflowExecution]\n    workflowTypes: [Foo]\n",
+		"WorkflowTypesWithPattern":  "rules:\n  - effect: deny\n    apis: [\"Start*\"]\n    workflowTypes: [Foo]\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewPolicyAuthorizer(config.AuthorizationPolicy{File: writePolicy(t, policy)}, log.NewNoopLogger())
+			require.Error(t, err)
+		})
+	}
+
+	_, err := NewPolicyAuthorizer(config.AuthorizationPolicy{}, log.NewNoopLogger())
+	require.Error(t, err)
+}
+
+func TestPolicyAuthorizer_Reload(t *testing.T) {
+	path := writePolicy(t, "fallback: allow\n")
+	authorizer, err := NewPolicyAuthorizer(config.AuthorizationPolicy{File: path, RefreshInterval: time.Millisecond}, log.NewNoopLogger())
+	require.NoError(t, err)
+	defer authorizer.Close()
+
+	authorize := func() Decision {
+		result, err := authorizer.Authorize(context.Background(), &claimsNone, &targetStartWorkflow)
+		require.NoError(t, err)
+		return result.Decision
+	}
+	require.Equal(t, DecisionAllow, authorize())
+
+	// An invalid policy keeps the previous one in effect.
+	require.NoError(t, os.WriteFile(path, []byte("fallback: roles\n"), 0644))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, DecisionAllow, authorize())
+
+	require.NoError(t, os.WriteFile(path, []byte("fallback: deny\n"), 0644))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	require.Eventually(t, func() bool { return authorize() == DecisionDeny }, 5*time.Second, time.Millisecond)
+}
+
+func TestGetAuthorizerFromConfigPolicy(t *testing.T) {
+	authorizer, err := GetAuthorizerFromConfigWithLogger(&config.Authorization{
+		Authorizer: "policy",
+		Policy:     config.AuthorizationPolicy{File: writePolicy(t, testPolicy)},
+	}, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &policyAuthorizer{}, authorizer)
+}
@@ -251,6 +251,49 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// EnableBlobCompression enables compression of history events and mutable state blobs
+		EnableBlobCompression dynamicconfig.BoolPropertyFn `yaml:"-" json:"-"`
+		// FaultInjectionTargets are the fault injection targets of the default store from dynamic config. When set,
+		// they replace the targets of the faultInjection config of the default store at runtime.
+		FaultInjectionTargets dynamicconfig.TypedSubscribable[FaultInjectionTargets] `yaml:"-" json:"-"`
+		// Recorder records the shard and execution persistence calls of history shards, so that they can be
+		// replayed in tests. Recording is disabled if not set.
+		Recorder *PersistenceRecorder `yaml:"recorder"`
+		// Migration migrates the default store to another data store while the cluster is running.
+		Migration *DataStoreMigration `yaml:"migration"`
+		// TieredHistory offloads the old history of open workflows from the default store to a blob store.
+		TieredHistory *TieredHistory `yaml:"tieredHistory"`
+	}
+
+	// TieredHistory is the configuration for offloading history to a blob store. The worker history scanner
+	// offloads the history nodes of open workflows which are older than the worker.historyTieringMinAge dynamic
+	// config, and history reads page through the blob store transparently.
+	TieredHistory struct {
+		// BlobStoreURI is the URI of the blob store, e.g. file:///var/temporal/history.
+		BlobStoreURI string `yaml:"blobStoreURI" validate:"nonzero"`
+	}
+
+	// DataStoreMigration is the configuration for migrating the default store to another data store. Writes are
+	// shadowed to the target store, and the persistence migration workflow backfills the target store and cuts
+	// history shards over to it one by one. Once every shard is cut over, the target store becomes the default
+	// store by updating defaultStore and removing the migration config.
+	DataStoreMigration struct {
+		// TargetStore is the name of the data store to migrate to.
+		TargetStore string `yaml:"targetStore" validate:"nonzero"`
+	}
+
+	// PersistenceRecorder is the configuration for recording persistence calls. Each shard is recorded to
+	// shard-<shardID>.jsonl in Dir. See the persistence/recorder package for replaying the recordings.
+	PersistenceRecorder struct {
+		// Dir is the directory of the recordings. It is created if it doesn't exist.
+		Dir string `yaml:"dir" validate:"nonzero"`
+		// ShardIDs limits recording to these shards. All shards are recorded if empty.
+		ShardIDs []int32 `yaml:"shardIDs"`
+		// MaxFileSize is the size in bytes after which the recording of a shard is rotated. Default is 64MiB.
+		MaxFileSize int64 `yaml:"maxFileSize"`
+		// MaxFiles is the number of rotated recordings kept per shard, in addition to the current one.
+		// Default is 4.
+		MaxFiles int `yaml:"maxFiles"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -263,6 +306,8 @@ type (
 		SQL *SQL `yaml:"sql"`
 		// Custom contains the config for custom datastore implementation
 		CustomDataStoreConfig *CustomDatastoreConfig `yaml:"customDatastore"`
+		// Pebble contains the config for an embedded pebble datastore
+		Pebble *Pebble `yaml:"pebble"`
 		// ElasticSearch contains the config for a ElasticSearch datastore
 		Elasticsearch *client.Config `yaml:"elasticsearch"`
 	}
@@ -283,6 +328,8 @@ type (
 		*/
 		// This will cause the UpdateShard method of the ShardStore to always return ShardOwnershipLostError.
 		// See config/development-cass-es-fi.yaml for a more detailed example.
+		// The targets can be replaced at runtime with the system.persistenceFaultInjectionTargets dynamic config,
+		// which uses the same structure. Removing that dynamic config restores these targets.
 		Targets FaultInjectionTargets `yaml:"targets"`
 	}
 
@@ -318,8 +365,21 @@ type (
 		// For example, if there are two errors with probabilities 0.1 and 0.2, then the first error will be returned
 		// 10% of the time, the second error will be returned 20% of the time,
 		// and the underlying method will be called 70% of the time.
+		// Prefixing an error type with "ExecuteAnd", e.g. `ExecuteAndUnavailable`, executes the call against the
+		// underlying datastore before returning the error. This emulates ambiguous writes, such as a timeout after
+		// commit.
 		Errors map[string]float64 `yaml:"errors"`
 
+		// Latency is the latency injected into calls of the method. It is injected before the underlying datastore
+		// is called or an error is returned, independently of Errors.
+		Latency FaultInjectionLatencyConfig `yaml:"latency"`
+
+		// ShardIDs limits fault injection to requests for these shards. Requests without a shard ID are not affected.
+		ShardIDs []int32 `yaml:"shardIDs"`
+		// NamespaceIDs limits fault injection to requests for these namespace IDs. Requests without a namespace ID
+		// are not affected.
+		NamespaceIDs []string `yaml:"namespaceIDs"`
+
 		// Seed is the seed for the random number generator used to sample faults from the Errors map. You can use this
 		// to make the fault injection deterministic.
 		// If the test config does not set this to a non-zero number, the fault injector will set it to the current time
@@ -327,6 +387,22 @@ type (
 		Seed int64 `yaml:"seed"`
 	}
 
+	// FaultInjectionLatencyConfig is the latency injected into calls of a single method of a data store.
+	FaultInjectionLatencyConfig struct {
+		// Rate is the probability of delaying a call.
+		Rate float64 `yaml:"rate"`
+		// Distribution is the distribution of the delay:
+		//   - "uniform" (default) samples the delay uniformly between Min and Max. If Max is not greater than Min,
+		//     every delay is Min.
+		//   - "exponential" adds an exponentially distributed delay with mean Mean to Min, capped at Max if Max is
+		//     greater than Min.
+		// If the context of the call expires during the delay, the call fails with a persistence.TimeoutError.
+		Distribution string        `yaml:"distribution"`
+		Min          time.Duration `yaml:"min"`
+		Max          time.Duration `yaml:"max"`
+		Mean         time.Duration `yaml:"mean"`
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
@@ -359,6 +435,9 @@ type (
 		DisableInitialHostLookup bool `yaml:"disableInitialHostLookup"`
 		// AddressTranslator translates Cassandra IP addresses, used for cases when IP addresses gocql driver returns are not accessible from the server
 		AddressTranslator *CassandraAddressTranslator `yaml:"addressTranslator"`
+		// Scylla switches the gocql client to ScyllaDB compatible conditional batch handling and requires a quorum
+		// based consistency. Conditional writes are still used, see docs/architecture/cassandra-conditional-writes.md
+		Scylla bool `yaml:"scylla"`
 	}
 
 	// CassandraStoreConsistency enables you to set the consistency settings for each Cassandra Persistence Store for Temporal
@@ -413,6 +492,37 @@ type (
 		TaskScanPartitions int `yaml:"taskScanPartitions"`
 		// TLS is the configuration for TLS connections
 		TLS *auth.TLS `yaml:"tls"`
+		// SearchAttributesStorage selects how a PostgreSQL visibility database stores custom search attributes.
+		// The default keeps them in the pre-allocated columns of the visibility schema, which caps the number of
+		// custom search attributes of each type. SQLSearchAttributesStorageJSONB keeps them only in the
+		// search_attributes JSONB column and removes the cap. It requires the visibility_jsonb schema.
+		SearchAttributesStorage string `yaml:"searchAttributesStorage"`
+		// ReadReplicas are read replicas of the database. Only supported by the MySQL and PostgreSQL plugins.
+		ReadReplicas *SQLReadReplicas `yaml:"readReplicas"`
+	}
+
+	// SQLReadReplicas is the configuration of the read replicas of a SQL database. Reads which tolerate stale
+	// data, like namespace listing and reading the history of closed workflows, are served by a healthy replica.
+	// They fall back to the primary if no replica is healthy.
+	SQLReadReplicas struct {
+		// ConnectAddrs are the addresses of the replicas. The replicas use the other connection settings of the
+		// primary, e.g. User and TLS.
+		ConnectAddrs []string `yaml:"connectAddrs" validate:"nonzero"`
+		// HealthCheckInterval is the interval between health checks of the replicas. Default is 10s.
+		HealthCheckInterval time.Duration `yaml:"healthCheckInterval"`
+		// MaxReplicationLag is the replication lag above which a replica is unhealthy. The replication lag is not
+		// checked if zero, which avoids the privileges needed to read it.
+		MaxReplicationLag time.Duration `yaml:"maxReplicationLag"`
+	}
+
+	// Pebble is the configuration for an embedded pebble datastore. It keeps all data in a local directory
+	// and is meant for single node deployments. Data stores configured with the same path share the database.
+	Pebble struct {
+		// Path is the directory of the database
+		Path string `yaml:"path" validate:"nonzero"`
+		// DisableSync skips syncing the write-ahead log on every write. Writes acknowledged since the last
+		// sync are lost if the host crashes.
+		DisableSync bool `yaml:"disableSync"`
 	}
 
 	// CustomDatastoreConfig is the configuration for connecting to a custom datastore that is not supported by temporal core
@@ -461,6 +571,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -478,12 +589,16 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// Compression enables the compressed, content-addressed history archive format.
+		// Supported values are "gzip" and "zstd". Empty keeps the legacy uncompressed format.
+		Compression string `yaml:"compression"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
@@ -497,6 +612,24 @@ type (
 		Endpoint         *string `yaml:"endpoint"`
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
 		LogLevel         uint    `yaml:"logLevel"`
+		// Compression enables the compressed, content-addressed history archive format.
+		// Supported values are "gzip" and "zstd". Empty keeps the legacy uncompressed format.
+		Compression string `yaml:"compression"`
+	}
+
+	// AzblobArchiver contains the config for Azure Blob Storage archiver
+	AzblobArchiver struct {
+		// AccountName is the storage account name. It's required.
+		AccountName string `yaml:"accountName"`
+		// AccountKey is the base64 encoded shared key of the storage account. If empty,
+		// the AZURE_STORAGE_KEY environment variable is used instead.
+		AccountKey string `yaml:"accountKey"`
+		// Endpoint overrides the default https://<accountName>.blob.core.windows.net endpoint.
+		// Set it to the account URL of a local emulator, e.g. http://127.0.0.1:10000/devstoreaccount1 for Azurite.
+		Endpoint string `yaml:"endpoint"`
+		// RequestTimeout bounds every request to the Blob service, including reading the response body.
+		// Defaults to 60s.
+		RequestTimeout time.Duration `yaml:"requestTimeout"`
 	}
 
 	// PublicClient is the config for internal nodes (history/matching/worker) connecting to
@@ -562,8 +695,13 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Issuers of JWT tokens accepted by defaultJWTClaimMapper. If set, tokens of other issuers are
+		// rejected and the keys of each issuer are used instead of JWTKeyProvider.
+		Issuers []JWTIssuer `yaml:"issuers"`
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
+		// Policy file of policyAuthorizer
+		Policy AuthorizationPolicy `yaml:"policy"`
 		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
 		// Name of main auth header to pass to ClaimMapper (as `AuthToken`). Defaults to `authorization`.
@@ -572,6 +710,44 @@ type (
 		AuthExtraHeaderName string `yaml:"authExtraHeaderName"`
 	}
 
+	// AuthorizationPolicy is the config of the policy file of policyAuthorizer
+	AuthorizationPolicy struct {
+		// Path of the policy file
+		File string `yaml:"file"`
+		// Interval to check the policy file for changes, the file is not reloaded if zero
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+	}
+
+	// JWTIssuer is the config of an issuer of JWT tokens
+	JWTIssuer struct {
+		// Issuer must equal the "iss" claim of tokens of this issuer
+		Issuer string `yaml:"issuer"`
+		// Audiences of the issuer, the "aud" claim of tokens must contain one of them. Not checked if empty.
+		Audiences []string `yaml:"audiences"`
+		// KeySourceURIs are JWKS URIs of the signing keys of the issuer. If empty, the JWKS URI is
+		// discovered from the OpenID configuration of the issuer at <issuer>/.well-known/openid-configuration.
+		KeySourceURIs []string `yaml:"keySourceURIs"`
+		// RefreshInterval of the signing keys of the issuer, keys are not refreshed if zero
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// PermissionsClaimName of tokens of the issuer, defaults to Authorization.PermissionsClaimName
+		PermissionsClaimName string `yaml:"permissionsClaimName"`
+		// RoleMappings grant roles to tokens with claim values
+		RoleMappings []JWTRoleMapping `yaml:"roleMappings"`
+	}
+
+	// JWTRoleMapping grants a role to tokens whose claim at ClaimPath has Value
+	JWTRoleMapping struct {
+		// ClaimPath is the path of the claim, with "." separating nested claims, e.g. "groups" or
+		// "realm_access.roles"
+		ClaimPath string `yaml:"claimPath"`
+		// Value the claim must equal, or contain if it is a list
+		Value string `yaml:"value"`
+		// Namespace the role is granted on, the role is granted on the system if empty
+		Namespace string `yaml:"namespace"`
+		// Role is "read", "write", "worker" or "admin", like in permissions claims
+		Role string `yaml:"role"`
+	}
+
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
 	// Contains the config for signing key provider for validating JWT tokens
 	JWTKeyProvider struct {
@@ -39,6 +39,12 @@ const (
 	StoreTypeSQL = "sql"
 	// StoreTypeNoSQL refers to nosql based storage as persistence store
 	StoreTypeNoSQL = "nosql"
+	// StoreTypePebble refers to embedded pebble storage as persistence store
+	StoreTypePebble = "pebble"
+
+	// SQLSearchAttributesStorageJSONB stores custom search attributes of a PostgreSQL visibility database
+	// only in the search_attributes JSONB column.
+	SQLSearchAttributesStorageJSONB = "jsonb"
 )
 
 var ErrPersistenceConfig = errors.New("persistence config error")
@@ -48,6 +54,9 @@ func (c *Persistence) DefaultStoreType() string {
 	if c.DataStores[c.DefaultStore].SQL != nil {
 		return StoreTypeSQL
 	}
+	if c.DataStores[c.DefaultStore].Pebble != nil {
+		return StoreTypePebble
+	}
 	return StoreTypeNoSQL
 }
 
@@ -79,6 +88,11 @@ func (c *Persistence) Validate() error {
 	if c.VisibilityStore == "" {
 		return fmt.Errorf("%w: visibilityStore must be specified", ErrPersistenceConfig)
 	}
+	for _, st := range []string{c.VisibilityStore, c.SecondaryVisibilityStore} {
+		if st != "" && c.DataStores[st].Pebble != nil {
+			return fmt.Errorf("%w: pebble datastore %q cannot be used as visibility store", ErrPersistenceConfig, st)
+		}
+	}
 	if c.SecondaryVisibilityStore != "" {
 		isAnyCustom := c.DataStores[c.VisibilityStore].CustomDataStoreConfig != nil ||
 			c.DataStores[c.SecondaryVisibilityStore].CustomDataStoreConfig != nil
@@ -117,6 +131,29 @@ func (c *Persistence) Validate() error {
 		}
 	}
 
+	if c.Recorder != nil {
+		if c.Recorder.Dir == "" {
+			return fmt.Errorf("%w: recorder dir must be specified", ErrPersistenceConfig)
+		}
+		if c.Recorder.MaxFileSize < 0 || c.Recorder.MaxFiles < 0 {
+			return fmt.Errorf("%w: recorder maxFileSize and maxFiles must not be negative", ErrPersistenceConfig)
+		}
+	}
+
+	if c.Migration != nil {
+		if c.Migration.TargetStore == "" || c.Migration.TargetStore == c.DefaultStore {
+			return fmt.Errorf("%w: migration targetStore must be set to a store other than the default store", ErrPersistenceConfig)
+		}
+		if c.DataStores[c.Migration.TargetStore].Elasticsearch != nil {
+			return fmt.Errorf("%w: elasticsearch datastore %q cannot be used as migration target store", ErrPersistenceConfig, c.Migration.TargetStore)
+		}
+		stores = append(stores, c.Migration.TargetStore)
+	}
+
+	if c.TieredHistory != nil && c.TieredHistory.BlobStoreURI == "" {
+		return fmt.Errorf("%w: tieredHistory blobStoreURI must be specified", ErrPersistenceConfig)
+	}
+
 	for _, st := range stores {
 		ds, ok := c.DataStores[st]
 		if !ok {
@@ -166,6 +203,17 @@ func (c *Persistence) GetSecondaryVisibilityStoreConfig() DataStore {
 	return DataStore{}
 }
 
+// IsJSONBSearchAttributesVisibilityStore returns true if the SQL visibility store with the given index name
+// stores custom search attributes in a JSONB column instead of pre-allocated columns.
+func (c *Persistence) IsJSONBSearchAttributesVisibilityStore(indexName string) bool {
+	for _, ds := range []DataStore{c.GetVisibilityStoreConfig(), c.GetSecondaryVisibilityStoreConfig()} {
+		if ds.SQL != nil && ds.GetIndexName() == indexName {
+			return ds.SQL.SearchAttributesStorage == SQLSearchAttributesStorageJSONB
+		}
+	}
+	return false
+}
+
 func (ds *DataStore) GetIndexName() string {
 	switch {
 	case ds.SQL != nil:
@@ -194,21 +242,37 @@ func (ds *DataStore) Validate() error {
 	if ds.Elasticsearch != nil {
 		storeConfigCount++
 	}
+	if ds.Pebble != nil {
+		storeConfigCount++
+	}
 	if storeConfigCount != 1 {
 		return errors.New(
 			"must provide config for one and only one datastore: " +
-				"elasticsearch, cassandra, sql or custom store",
+				"elasticsearch, cassandra, sql, pebble or custom store",
 		)
 	}
 
 	if ds.SQL != nil && ds.SQL.TaskScanPartitions == 0 {
 		ds.SQL.TaskScanPartitions = 1
 	}
+	if ds.SQL != nil {
+		switch ds.SQL.SearchAttributesStorage {
+		case "", SQLSearchAttributesStorageJSONB:
+		default:
+			return fmt.Errorf("unknown sql search attributes storage: %s", ds.SQL.SearchAttributesStorage)
+		}
+		if ds.SQL.ReadReplicas != nil && len(ds.SQL.ReadReplicas.ConnectAddrs) == 0 {
+			return errors.New("sql read replicas must have at least one connect address")
+		}
+	}
 	if ds.Cassandra != nil {
 		if err := ds.Cassandra.validate(); err != nil {
 			return err
 		}
 	}
+	if ds.Pebble != nil && ds.Pebble.Path == "" {
+		return errors.New("pebble datastore path must be set")
+	}
 	if ds.Elasticsearch != nil {
 		if err := ds.Elasticsearch.Validate(); err != nil {
 			return err
@@ -253,7 +317,24 @@ func ensureStoreConsistencyNotNil(c *CassandraStoreConsistency) *CassandraStoreC
 }
 
 func (c *Cassandra) validate() error {
-	return c.Consistency.validate()
+	if err := c.Consistency.validate(); err != nil {
+		return err
+	}
+	if c.Scylla {
+		return c.validateScyllaConsistency()
+	}
+	return nil
+}
+
+// validateScyllaConsistency requires a quorum based consistency in Scylla mode. ScyllaDB commits conditional writes
+// with the regular consistency, and reads with a weaker consistency may miss them.
+func (c *Cassandra) validateScyllaConsistency() error {
+	switch consistency := c.Consistency.GetConsistency(); consistency {
+	case gocql.Quorum, gocql.LocalQuorum, gocql.EachQuorum, gocql.All:
+		return nil
+	default:
+		return fmt.Errorf("cassandra consistency %v is not supported with scylla, use a quorum based consistency", consistency)
+	}
 }
 
 func (c *CassandraStoreConsistency) validate() error {
@@ -29,6 +29,7 @@ import (
 	"testing"
 
 	"github.com/gocql/gocql"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 )
 
 func TestCassandraStoreConsistency_GetConsistency(t *testing.T) {
@@ -230,3 +231,258 @@ func TestCassandraStoreConsistency_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCassandra_validateScylla(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     *Cassandra
+		wantErr bool
+	}{
+		{
+			name:    "default consistency",
+			cfg:     &Cassandra{Scylla: true},
+			wantErr: false,
+		},
+		{
+			name: "quorum consistency",
+			cfg: &Cassandra{
+				Scylla:      true,
+				Consistency: &CassandraStoreConsistency{Default: &CassandraConsistencySettings{Consistency: "quorum"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "weak consistency",
+			cfg: &Cassandra{
+				Scylla:      true,
+				Consistency: &CassandraStoreConsistency{Default: &CassandraConsistencySettings{Consistency: "local_one"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "weak consistency without scylla",
+			cfg: &Cassandra{
+				Consistency: &CassandraStoreConsistency{Default: &CassandraConsistencySettings{Consistency: "local_one"}},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Cassandra.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPersistence_IsJSONBSearchAttributesVisibilityStore(t *testing.T) {
+	t.Parallel()
+
+	c := &Persistence{
+		VisibilityStore:          "primary",
+		SecondaryVisibilityStore: "secondary",
+		DataStores: map[string]DataStore{
+			"primary": {
+				SQL: &SQL{DatabaseName: "temporal_visibility"},
+			},
+			"secondary": {
+				SQL: &SQL{
+					DatabaseName:            "temporal_visibility_jsonb",
+					SearchAttributesStorage: SQLSearchAttributesStorageJSONB,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		indexName string
+		want      bool
+	}{
+		{indexName: "temporal_visibility", want: false},
+		{indexName: "temporal_visibility_jsonb", want: true},
+		{indexName: "unknown", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.indexName, func(t *testing.T) {
+			if got := c.IsJSONBSearchAttributesVisibilityStore(tt.indexName); got != tt.want {
+				t.Errorf("Persistence.IsJSONBSearchAttributesVisibilityStore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDataStore_Validate_SQLSearchAttributesStorage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		storage string
+		wantErr bool
+	}{
+		{
+			name:    "default",
+			storage: "",
+			wantErr: false,
+		},
+		{
+			name:    "jsonb",
+			storage: SQLSearchAttributesStorageJSONB,
+			wantErr: false,
+		},
+		{
+			name:    "unknown",
+			storage: "fake_value",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &DataStore{SQL: &SQL{SearchAttributesStorage: tt.storage}}
+			if err := ds.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("DataStore.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPersistence_Validate_Pebble(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		visibilityStore string
+		pebble          *Pebble
+		wantErr         bool
+	}{
+		{
+			name:            "default store",
+			visibilityStore: "visibility",
+			pebble:          &Pebble{Path: "/tmp/temporal"},
+			wantErr:         false,
+		},
+		{
+			name:            "missing path",
+			visibilityStore: "visibility",
+			pebble:          &Pebble{},
+			wantErr:         true,
+		},
+		{
+			name:            "visibility store",
+			visibilityStore: "default",
+			pebble:          &Pebble{Path: "/tmp/temporal"},
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Persistence{
+				DefaultStore:    "default",
+				VisibilityStore: tt.visibilityStore,
+				DataStores: map[string]DataStore{
+					"default":    {Pebble: tt.pebble},
+					"visibility": {SQL: &SQL{DatabaseName: "temporal_visibility"}},
+				},
+			}
+			if err := c.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Persistence.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := c.DefaultStoreType(); got != StoreTypePebble {
+				t.Errorf("Persistence.DefaultStoreType() = %v, want %v", got, StoreTypePebble)
+			}
+		})
+	}
+}
+
+func TestPersistence_Validate_Migration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		targetStore string
+		wantErr     bool
+	}{
+		{
+			name:        "target store",
+			targetStore: "target",
+			wantErr:     false,
+		},
+		{
+			name:        "missing target store",
+			targetStore: "missing",
+			wantErr:     true,
+		},
+		{
+			name:        "default store",
+			targetStore: "default",
+			wantErr:     true,
+		},
+		{
+			name:        "elasticsearch store",
+			targetStore: "es",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Persistence{
+				DefaultStore:    "default",
+				VisibilityStore: "visibility",
+				DataStores: map[string]DataStore{
+					"default":    {Cassandra: &Cassandra{Hosts: "127.0.0.1", Keyspace: "temporal"}},
+					"target":     {SQL: &SQL{DatabaseName: "temporal"}},
+					"visibility": {SQL: &SQL{DatabaseName: "temporal_visibility"}},
+					"es":         {Elasticsearch: &client.Config{}},
+				},
+				Migration: &DataStoreMigration{TargetStore: tt.targetStore},
+			}
+			if err := c.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Persistence.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPersistence_Validate_TieredHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		tieredHistory *TieredHistory
+		wantErr       bool
+	}{
+		{
+			name:          "not configured",
+			tieredHistory: nil,
+			wantErr:       false,
+		},
+		{
+			name:          "blob store URI",
+			tieredHistory: &TieredHistory{BlobStoreURI: "file:///tmp/temporal/history"},
+			wantErr:       false,
+		},
+		{
+			name:          "missing blob store URI",
+			tieredHistory: &TieredHistory{},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Persistence{
+				DefaultStore:    "default",
+				VisibilityStore: "visibility",
+				DataStores: map[string]DataStore{
+					"default":    {Cassandra: &Cassandra{Hosts: "127.0.0.1", Keyspace: "temporal"}},
+					"visibility": {SQL: &SQL{DatabaseName: "temporal_visibility"}},
+				},
+				TieredHistory: tt.tieredHistory,
+			}
+			if err := c.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Persistence.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -25,6 +25,8 @@
 package dynamicconfig
 
 import (
+	"time"
+
 	enumspb "go.temporal.io/api/enums/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 )
@@ -76,9 +78,24 @@ type (
 	// timestamp.ParseDurationDefaultDays. If float64 is expected, int is also accepted. In
 	// other cases, the exact type must be used. If a Value is returned with an unexpected
 	// type, it will be ignored.
+	//
+	// Until and RolloutPercent further limit where the value is used, e.g. for time-boxed
+	// changes or gradual rollouts. Unlike Constraints, they are not part of the precedence
+	// order: a value is matched by its Constraints as usual, but skipped if it has expired or
+	// the namespace or shard is not part of the rollout. If several values have the same
+	// Constraints, the first one that applies is used.
 	ConstrainedValue struct {
 		Constraints Constraints
 		Value       any
+		// Until, if set, is the time from which the value is ignored.
+		Until time.Time
+		// RolloutPercent, if set, limits the value to this percentage (0 < RolloutPercent <=
+		// 100) of namespaces or shards, selected by hashing them together with the key. The
+		// namespace (or namespace ID) is used when the key is looked up with one, otherwise
+		// the shard ID. Rollouts of different keys select different namespaces or shards, and
+		// increasing the rollout of a key only adds to them. Values of keys looked up with
+		// neither namespace nor shard only apply at 100 percent.
+		RolloutPercent float64
 	}
 	TypedConstrainedValue[T any] struct {
 		Constraints Constraints
@@ -35,6 +35,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/dgryski/go-farm"
 	"github.com/mitchellh/mapstructure"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
@@ -51,9 +52,10 @@ type (
 	// The rest of the server code should use Collection as the interface to dynamic config,
 	// instead of the low-level Client.
 	Collection struct {
-		client   Client
-		logger   log.Logger
-		errCount int64
+		client     Client
+		logger     log.Logger
+		timeSource clock.TimeSource
+		errCount   int64
 
 		cancelClientSubscription func()
 
@@ -102,6 +104,9 @@ type (
 
 const (
 	errCountLogThreshold = 1000
+
+	// rolloutBuckets is the granularity of ConstrainedValue.RolloutPercent.
+	rolloutBuckets = 10000
 )
 
 var (
@@ -116,9 +121,16 @@ var (
 // NewCollection creates a new collection. For subscriptions to work, you must call Start/Stop.
 // Get will work without Start/Stop.
 func NewCollection(client Client, logger log.Logger) *Collection {
+	return NewCollectionWithTimeSource(client, logger, clock.NewRealTimeSource())
+}
+
+// NewCollectionWithTimeSource creates a new collection like NewCollection, which checks
+// ConstrainedValue.Until against timeSource.
+func NewCollectionWithTimeSource(client Client, logger log.Logger, timeSource clock.TimeSource) *Collection {
 	return &Collection{
 		client:        client,
 		logger:        logger,
+		timeSource:    timeSource,
 		errCount:      -1,
 		subscriptions: make(map[Key]map[int]any),
 	}
@@ -225,24 +237,57 @@ func (c *Collection) throttleLog() bool {
 	return errCount < errCountLogThreshold || errCount%errCountLogThreshold == 0
 }
 
-func findMatch[T any](cvs []ConstrainedValue, defaultCVs []TypedConstrainedValue[T], precedence []Constraints) (any, error) {
+// findMatch returns the first value of cvs or defaultCVs that matches precedence and applies at
+// now, and the index of the value in cvs, or -1 if the value is a default.
+func findMatch[T any](
+	key Key,
+	cvs []ConstrainedValue,
+	defaultCVs []TypedConstrainedValue[T],
+	precedence []Constraints,
+	now time.Time,
+) (any, int, error) {
 	if len(cvs)+len(defaultCVs) == 0 {
-		return nil, errKeyNotPresent
+		return nil, -1, errKeyNotPresent
 	}
 	for _, m := range precedence {
-		for _, cv := range cvs {
-			if m == cv.Constraints {
-				return cv.Value, nil
+		for i, cv := range cvs {
+			if m == cv.Constraints && cv.appliesTo(key, precedence[0], now) {
+				return cv.Value, i, nil
 			}
 		}
 		for _, cv := range defaultCVs {
 			if m == cv.Constraints {
-				return cv.Value, nil
+				return cv.Value, -1, nil
 			}
 		}
 	}
 	// key is present but no constraint section matches
-	return nil, errNoMatchingConstraint
+	return nil, -1, errNoMatchingConstraint
+}
+
+// appliesTo returns false if cv has expired at now, or if the namespace or shard of filter, the
+// most specific constraints key is looked up with, is not part of the rollout of cv.
+func (cv *ConstrainedValue) appliesTo(key Key, filter Constraints, now time.Time) bool {
+	if !cv.Until.IsZero() && !now.Before(cv.Until) {
+		return false
+	}
+	if cv.RolloutPercent == 0 || cv.RolloutPercent >= 100 {
+		return true
+	}
+	var subject string
+	switch {
+	case filter.Namespace != "":
+		subject = filter.Namespace
+	case filter.NamespaceID != "":
+		subject = filter.NamespaceID
+	case filter.ShardID != 0:
+		subject = strconv.Itoa(int(filter.ShardID))
+	default:
+		return false
+	}
+	// salt with the key, so that rollouts of different keys select different subjects
+	bucket := farm.Fingerprint32([]byte(key.String()+"/"+subject)) % rolloutBuckets
+	return float64(bucket) < cv.RolloutPercent*rolloutBuckets/100
 }
 
 // matchAndConvert can't be a method of Collection because methods can't be generic, but we can
@@ -268,14 +313,7 @@ func matchAndConvertCvs[T any](
 	precedence []Constraints,
 	cvs []ConstrainedValue,
 ) T {
-	var defaultCVs []TypedConstrainedValue[T]
-	if cdef != nil {
-		defaultCVs = *cdef
-	} else {
-		defaultCVs = []TypedConstrainedValue[T]{{Value: def}}
-	}
-
-	val, matchErr := findMatch(cvs, defaultCVs, precedence)
+	val, _, matchErr := findMatch(key, cvs, defaultValues(def, cdef), precedence, c.timeSource.Now())
 	if matchErr != nil {
 		if c.throttleLog() {
 			c.logger.Debug("No such key in dynamic config, using default", tag.Key(key.String()), tag.Error(matchErr))
@@ -301,6 +339,13 @@ func matchAndConvertCvs[T any](
 	return typedVal
 }
 
+func defaultValues[T any](def T, cdef *[]TypedConstrainedValue[T]) []TypedConstrainedValue[T] {
+	if cdef != nil {
+		return *cdef
+	}
+	return []TypedConstrainedValue[T]{{Value: def}}
+}
+
 func subscribe[T any](
 	c *Collection,
 	key Key,
@@ -157,6 +157,13 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	EnablePersistenceBlobCompression = NewGlobalBoolSetting(
+		"system.enablePersistenceBlobCompression",
+		false,
+		`EnablePersistenceBlobCompression enables zstd compression of history events and mutable state blobs written
+to persistence. Blobs are only compressed if that makes them smaller. Only enable it once every host of the cluster
+runs a version which can read compressed blobs. Blobs which are already compressed stay readable after disabling it.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -172,6 +179,12 @@ for signal / start / signal with start API if namespace is not active`,
 		time.Minute,
 		`ClusterMetadataRefreshInterval is config to manage cluster metadata table refresh interval`,
 	)
+	DynamicConfigOverridesRefreshInterval = NewGlobalDurationSetting(
+		"system.dynamicConfigOverridesRefreshInterval",
+		10*time.Second,
+		`DynamicConfigOverridesRefreshInterval is how often hosts reload the dynamic config overrides set through the
+admin API from the cluster metadata. Overrides set on a host are applied there immediately.`,
+	)
 	ForceSearchAttributesCacheRefreshOnRead = NewGlobalBoolSetting(
 		"system.forceSearchAttributesCacheRefreshOnRead",
 		false,
@@ -2501,6 +2514,12 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		true,
 		`HistoryScannerVerifyRetention indicates the history scanner verify data retention.
 If the service configures with archival feature enabled, update worker.historyScannerVerifyRetention to be double of the data retention.`,
+	)
+	HistoryTieringMinAge = NewGlobalDurationSetting(
+		"worker.historyTieringMinAge",
+		30*24*time.Hour,
+		`HistoryTieringMinAge is the age after which the history of open workflows is offloaded to the blob store by the
+history scanner. It only applies if persistence is configured with tieredHistory.`,
 	)
 	EnableBatcherNamespace = NewNamespaceBoolSetting(
 		"worker.enableNamespaceBatcher",
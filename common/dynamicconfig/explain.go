@@ -0,0 +1,228 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// Explanation is the effective value of a setting when looked up with some constraints, and
+	// how it was selected.
+	Explanation struct {
+		Key        Key
+		Precedence Precedence
+		// Constraints are the constraints the setting is looked up with, in order of precedence.
+		Constraints []Constraints
+		// Values are the values of the key returned by the Client.
+		Values []ConstrainedValue
+		// Value is the effective value.
+		Value any
+		// Matched is the value of Values that Value was taken from, or nil if Value is a default
+		// of the setting.
+		Matched *ConstrainedValue
+		// ConvertError is set if the matched value could not be converted to the type of the
+		// setting, in which case Value is the default.
+		ConvertError error
+	}
+
+	// SettingDescription describes a registered setting.
+	SettingDescription struct {
+		Key        Key
+		Precedence Precedence
+		// Type is the Go type of the values of the setting.
+		Type string
+		// Default is the default value, unless the setting has ConstrainedDefault.
+		Default            any
+		ConstrainedDefault []ConstrainedValue
+		Description        string
+	}
+)
+
+// Explain looks up the registered setting of key with constraints, like the server does, and
+// returns the effective value and how it was selected. Fields of constraints which are not part
+// of the precedence of the setting are ignored.
+func (c *Collection) Explain(key Key, constraints Constraints) (Explanation, error) {
+	setting := queryRegistry(key)
+	if setting == nil {
+		return Explanation{}, fmt.Errorf("unregistered key %q", key)
+	}
+	return setting.explain(c, constraints), nil
+}
+
+// RegisteredSettings returns descriptions of all registered settings, sorted by key.
+func RegisteredSettings() []SettingDescription {
+	globalRegistry.queried.Store(true)
+	descriptions := make([]SettingDescription, 0, len(globalRegistry.settings))
+	for _, setting := range globalRegistry.settings {
+		descriptions = append(descriptions, setting.describe())
+	}
+	slices.SortFunc(descriptions, func(a, b SettingDescription) int {
+		return strings.Compare(strings.ToLower(a.Key.String()), strings.ToLower(b.Key.String()))
+	})
+	return descriptions
+}
+
+func explain[T any](
+	c *Collection,
+	key Key,
+	precedence Precedence,
+	def T,
+	cdef *[]TypedConstrainedValue[T],
+	convert func(value any) (T, error),
+	constraints []Constraints,
+) Explanation {
+	cvs := c.client.GetValue(key)
+	e := Explanation{
+		Key:         key,
+		Precedence:  precedence,
+		Constraints: constraints,
+		Values:      cvs,
+	}
+
+	val, matched, matchErr := findMatch(key, cvs, defaultValues(def, cdef), constraints, c.timeSource.Now())
+	if matchErr != nil {
+		val = def
+	} else if matched >= 0 {
+		e.Matched = &cvs[matched]
+	}
+	typedVal, convertErr := convert(val)
+	if convertErr != nil && matchErr == nil {
+		e.ConvertError = convertErr
+		e.Matched = nil
+		typedVal, _ = convert(def)
+	}
+	e.Value = typedVal
+	return e
+}
+
+func describe[T any](key Key, precedence Precedence, def T, cdef *[]TypedConstrainedValue[T], description string) SettingDescription {
+	d := SettingDescription{
+		Key:         key,
+		Precedence:  precedence,
+		Type:        reflect.TypeOf((*T)(nil)).Elem().String(),
+		Description: description,
+	}
+	if cdef == nil {
+		d.Default = def
+		return d
+	}
+	for _, cv := range *cdef {
+		d.ConstrainedDefault = append(d.ConstrainedDefault, ConstrainedValue{Constraints: cv.Constraints, Value: cv.Value})
+	}
+	return d
+}
+
+func ConstraintsFromProto(constraints *persistencespb.DynamicConfigConstraints) Constraints {
+	return Constraints{
+		Namespace:     constraints.GetNamespace(),
+		NamespaceID:   constraints.GetNamespaceId(),
+		TaskQueueName: constraints.GetTaskQueueName(),
+		TaskQueueType: constraints.GetTaskQueueType(),
+		ShardID:       constraints.GetShardId(),
+		TaskType:      constraints.GetTaskType(),
+		Destination:   constraints.GetDestination(),
+	}
+}
+
+func ConstraintsToProto(constraints Constraints) *persistencespb.DynamicConfigConstraints {
+	return &persistencespb.DynamicConfigConstraints{
+		Namespace:     constraints.Namespace,
+		NamespaceId:   constraints.NamespaceID,
+		TaskQueueName: constraints.TaskQueueName,
+		TaskQueueType: constraints.TaskQueueType,
+		ShardId:       constraints.ShardID,
+		TaskType:      constraints.TaskType,
+		Destination:   constraints.Destination,
+	}
+}
+
+func ValueToProto(cv ConstrainedValue) *persistencespb.DynamicConfigValue {
+	value := &persistencespb.DynamicConfigValue{
+		Constraints:    ConstraintsToProto(cv.Constraints),
+		Value:          encodeValue(cv.Value),
+		RolloutPercent: cv.RolloutPercent,
+	}
+	if !cv.Until.IsZero() {
+		value.Until = timestamppb.New(cv.Until)
+	}
+	return value
+}
+
+func ExplanationToProto(e Explanation) *persistencespb.DynamicConfigEvaluation {
+	evaluation := &persistencespb.DynamicConfigEvaluation{
+		Key:        e.Key.String(),
+		Precedence: e.Precedence.String(),
+		Value:      encodeValue(e.Value),
+	}
+	for _, constraints := range e.Constraints {
+		evaluation.LookupConstraints = append(evaluation.LookupConstraints, ConstraintsToProto(constraints))
+	}
+	for _, cv := range e.Values {
+		evaluation.Values = append(evaluation.Values, ValueToProto(cv))
+	}
+	if e.Matched != nil {
+		evaluation.MatchedValue = ValueToProto(*e.Matched)
+	}
+	if e.ConvertError != nil {
+		evaluation.ConversionError = e.ConvertError.Error()
+	}
+	return evaluation
+}
+
+func SettingDescriptionToProto(d SettingDescription) *persistencespb.DynamicConfigSetting {
+	setting := &persistencespb.DynamicConfigSetting{
+		Key:         d.Key.String(),
+		Precedence:  d.Precedence.String(),
+		Type:        d.Type,
+		Description: d.Description,
+	}
+	if d.ConstrainedDefault == nil {
+		setting.DefaultValue = encodeValue(d.Default)
+	}
+	for _, cv := range d.ConstrainedDefault {
+		setting.ConstrainedDefaults = append(setting.ConstrainedDefaults, ValueToProto(cv))
+	}
+	return setting
+}
+
+// encodeValue JSON encodes a value of a setting. Durations are encoded as strings, in the format
+// of the dynamic config file.
+func encodeValue(v any) string {
+	if d, ok := v.(time.Duration); ok {
+		v = d.String()
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		data, _ = json.Marshal(fmt.Sprint(v))
+	}
+	return string(data)
+}
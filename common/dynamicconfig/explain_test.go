@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+func TestExplain(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	dynamicconfig.NewTaskQueueIntSetting(testGetIntPropertyFilteredByTaskQueueInfoKey, 10, "")
+	cvs := []dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 20},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq"}, Value: "not an int"},
+	}
+	client := dynamicconfig.StaticClient{testGetIntPropertyFilteredByTaskQueueInfoKey: cvs}
+	c := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+
+	e, err := c.Explain(testGetIntPropertyFilteredByTaskQueueInfoKey, dynamicconfig.Constraints{
+		Namespace:     "ns",
+		TaskQueueName: "other-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		ShardID:       5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, dynamicconfig.PrecedenceTaskQueue, e.Precedence)
+	require.Equal(t, dynamicconfig.Constraints{
+		Namespace:     "ns",
+		TaskQueueName: "other-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}, e.Constraints[0])
+	require.Equal(t, 20, e.Value)
+	require.Equal(t, &cvs[0], e.Matched)
+	require.NoError(t, e.ConvertError)
+
+	e, err = c.Explain(testGetIntPropertyFilteredByTaskQueueInfoKey, dynamicconfig.Constraints{Namespace: "other-ns"})
+	require.NoError(t, err)
+	require.Equal(t, 10, e.Value)
+	require.Nil(t, e.Matched)
+
+	e, err = c.Explain(testGetIntPropertyFilteredByTaskQueueInfoKey, dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq"})
+	require.NoError(t, err)
+	require.Equal(t, 10, e.Value)
+	require.Nil(t, e.Matched)
+	require.Error(t, e.ConvertError)
+
+	_, err = c.Explain(unknownKey, dynamicconfig.Constraints{})
+	require.Error(t, err)
+}
+
+func TestRegisteredSettings(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	dynamicconfig.NewNamespaceDurationSetting(testGetDurationPropertyFilteredByNamespaceKey, time.Minute, "duration")
+	dynamicconfig.NewGlobalIntSettingWithConstrainedDefault(testGetIntPropertyKey, []dynamicconfig.TypedConstrainedValue[int]{
+		{Constraints: dynamicconfig.Constraints{ShardID: 1}, Value: 5},
+		{Value: 1},
+	}, "int")
+
+	settings := dynamicconfig.RegisteredSettings()
+	require.Equal(t, []dynamicconfig.SettingDescription{
+		{
+			Key:         testGetDurationPropertyFilteredByNamespaceKey,
+			Precedence:  dynamicconfig.PrecedenceNamespace,
+			Type:        "time.Duration",
+			Default:     time.Minute,
+			Description: "duration",
+		},
+		{
+			Key:        testGetIntPropertyKey,
+			Precedence: dynamicconfig.PrecedenceGlobal,
+			Type:       "int",
+			ConstrainedDefault: []dynamicconfig.ConstrainedValue{
+				{Constraints: dynamicconfig.Constraints{ShardID: 1}, Value: 5},
+				{Value: 1},
+			},
+			Description: "int",
+		},
+	}, settings)
+}
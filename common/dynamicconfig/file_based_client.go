@@ -31,6 +31,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -63,6 +64,10 @@ type (
 	FileBasedClientConfig struct {
 		Filepath     string        `yaml:"filepath"`
 		PollInterval time.Duration `yaml:"pollInterval"`
+		// Strict treats warnings of the file, e.g. unregistered keys or values failing validation, as errors:
+		// the server refuses to start and reloads are rejected, keeping the previous values. Values which
+		// expired are still only warned about.
+		Strict bool `yaml:"strict"`
 	}
 
 	configValueMap map[string][]ConstrainedValue
@@ -74,6 +79,8 @@ type (
 		lastUpdatedTime time.Time
 		config          *FileBasedClientConfig
 		doneCh          <-chan interface{}
+		// updateLock serializes Update and RemoveExpired.
+		updateLock sync.Mutex
 
 		subscriptionLock sync.Mutex
 		subscriptionIdx  int
@@ -91,6 +98,12 @@ type (
 		Warnings []error
 		Errors   []error
 	}
+
+	// expiredValueError is the warning for a value which expired. It doesn't fail strict loading, since
+	// files become stale as their values expire.
+	expiredValueError struct {
+		error
+	}
 )
 
 func ValidateFile(contents []byte) *LoadResult {
@@ -162,6 +175,7 @@ func (fc *fileBasedClient) init() error {
 				if err != nil {
 					fc.logger.Error("Unable to update dynamic config.", tag.Error(err))
 				}
+				fc.RemoveExpired(time.Now())
 			case <-fc.doneCh:
 				ticker.Stop()
 				return
@@ -175,6 +189,9 @@ func (fc *fileBasedClient) init() error {
 // This is public mainly for testing. The update loop will call this periodically, you don't
 // have to call it explicitly.
 func (fc *fileBasedClient) Update() error {
+	fc.updateLock.Lock()
+	defer fc.updateLock.Unlock()
+
 	modtime, err := fc.reader.GetModTime()
 	if err != nil {
 		return fmt.Errorf("dynamic config file: %s: %w", fc.config.Filepath, err)
@@ -200,14 +217,67 @@ func (fc *fileBasedClient) Update() error {
 		return fmt.Errorf("loading dynamic config failed: %d errors, %d warnings",
 			len(lr.Errors), len(lr.Warnings))
 	}
+	if fc.config.Strict && lr.strictWarnings() > 0 {
+		return fmt.Errorf("loading dynamic config failed in strict mode: %d warnings", lr.strictWarnings())
+	}
 
 	prev := fc.values.Swap(newValues)
 	oldValues, _ := prev.(configValueMap)
 	changedMap := fc.diffAndLog(oldValues, newValues)
 	fc.logger.Info("Updated dynamic config")
+	fc.notify(changedMap)
 
+	return nil
+}
+
+// RemoveExpired removes values which expired at now, see ConstrainedValue.Until. Expired values
+// are already ignored by Collection, this notifies subscriptions of them. This is public mainly
+// for testing, the update loop calls it periodically.
+func (fc *fileBasedClient) RemoveExpired(now time.Time) {
+	fc.updateLock.Lock()
+	defer fc.updateLock.Unlock()
+
+	values := fc.values.Load().(configValueMap)
+	changedMap := make(map[Key][]ConstrainedValue)
+	for key, cvs := range values {
+		if !slices.ContainsFunc(cvs, func(cv ConstrainedValue) bool { return isExpired(cv, now) }) {
+			continue
+		}
+		for _, cv := range cvs {
+			if isExpired(cv, now) {
+				fc.logger.Info("Dynamic config value expired.",
+					tag.Key(key),
+					tag.Value(cv.Value),
+					tag.NewStringTag("constraints", fmt.Sprintf("%+v", cv.Constraints)),
+					tag.NewTimeTag("until", cv.Until),
+				)
+			}
+		}
+		changedMap[Key(key)] = slices.DeleteFunc(slices.Clone(cvs), func(cv ConstrainedValue) bool { return isExpired(cv, now) })
+	}
 	if len(changedMap) == 0 {
-		return nil
+		return
+	}
+
+	newValues := make(configValueMap, len(values))
+	for key, cvs := range values {
+		newValues[key] = cvs
+	}
+	for key, cvs := range changedMap {
+		if len(cvs) == 0 {
+			delete(newValues, key.String())
+			changedMap[key] = nil
+		} else {
+			newValues[key.String()] = cvs
+		}
+	}
+	fc.values.Store(newValues)
+	fc.notify(changedMap)
+}
+
+func (fc *fileBasedClient) notify(changedMap map[Key][]ConstrainedValue) {
+	if len(changedMap) == 0 {
+		return
 	}
 
 	fc.subscriptionLock.Lock()
@@ -217,21 +287,26 @@ func (fc *fileBasedClient) Update() error {
 	for _, update := range subscriptions {
 		update(changedMap)
 	}
+}
 
-	return nil
+func isExpired(cv ConstrainedValue, now time.Time) bool {
+	return !cv.Until.IsZero() && !now.Before(cv.Until)
 }
 
 func loadFile(contents []byte) (configValueMap, *LoadResult) {
 	lr := &LoadResult{}
 
 	var yamlValues map[string][]struct {
-		Constraints map[string]any
-		Value       any
+		Constraints    map[string]any
+		Value          any
+		Until          time.Time `yaml:"until"`
+		RolloutPercent *float64  `yaml:"rolloutPercent"`
 	}
 	if err := yaml.Unmarshal(contents, &yamlValues); err != nil {
 		return nil, lr.errorf("decode error: %w", err)
 	}
 
+	now := time.Now()
 	newValues := make(configValueMap, len(yamlValues))
 	for key, yamlCV := range yamlValues {
 		precedence := PrecedenceUnknown
@@ -242,8 +317,8 @@ func loadFile(contents []byte) (configValueMap, *LoadResult) {
 			precedence = setting.Precedence()
 		}
 
-		cvs := make([]ConstrainedValue, len(yamlCV))
-		for i, cv := range yamlCV {
+		cvs := make([]ConstrainedValue, 0, len(yamlCV))
+		for _, cv := range yamlCV {
 			// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
 			// manually convert key type to string for all values here
 			val, err := convertKeyTypeToString(cv.Value)
@@ -260,10 +335,32 @@ func loadFile(contents []byte) (configValueMap, *LoadResult) {
 				}
 			}
 
-			cvs[i].Value = val
-			cvs[i].Constraints = convertYamlConstraints(key, cv.Constraints, precedence, lr)
+			if !cv.Until.IsZero() && !now.Before(cv.Until) {
+				lr.warn(expiredValueError{fmt.Errorf("value of key %q expired at %v, ignoring it", key, cv.Until)})
+				continue
+			}
+			var rolloutPercent float64
+			if cv.RolloutPercent != nil {
+				rolloutPercent = *cv.RolloutPercent
+				if rolloutPercent <= 0 || rolloutPercent > 100 {
+					lr.errorf("rolloutPercent of key %q must be greater than 0 and at most 100", key)
+					continue
+				}
+				if precedence == PrecedenceGlobal || precedence == PrecedenceTaskType {
+					lr.warnf("rolloutPercent of key %q only applies at 100 percent, since the key has no namespace or shard constraints", key)
+				}
+			}
+
+			cvs = append(cvs, ConstrainedValue{
+				Constraints:    convertYamlConstraints(key, cv.Constraints, precedence, lr),
+				Value:          val,
+				Until:          cv.Until,
+				RolloutPercent: rolloutPercent,
+			})
+		}
+		if len(cvs) > 0 {
+			newValues[strings.ToLower(key)] = cvs
 		}
-		newValues[strings.ToLower(key)] = cvs
 	}
 
 	return newValues, lr
@@ -320,7 +417,7 @@ func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []Constra
 	for _, oldValue := range oldValues {
 		matchFound := false
 		for _, newValue := range newValues {
-			if oldValue.Constraints == newValue.Constraints {
+			if sameConditions(oldValue, newValue) {
 				matchFound = true
 				if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
 					fc.diffAndLogValue(key, &oldValue, &newValue)
@@ -337,7 +434,7 @@ func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []Constra
 	for _, newValue := range newValues {
 		matchFound := false
 		for _, oldValue := range oldValues {
-			if oldValue.Constraints == newValue.Constraints {
+			if sameConditions(oldValue, newValue) {
 				matchFound = true
 			}
 		}
@@ -349,6 +446,12 @@ func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []Constra
 	return changed
 }
 
+// sameConditions returns true if a and b apply under the same conditions, i.e. have the same
+// constraints, expiry and rollout.
+func sameConditions(a ConstrainedValue, b ConstrainedValue) bool {
+	return a.Constraints == b.Constraints && a.Until.Equal(b.Until) && a.RolloutPercent == b.RolloutPercent
+}
+
 func (fc *fileBasedClient) diffAndLogValue(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
 	logLine := &strings.Builder{}
 	logLine.Grow(128)
@@ -387,7 +490,14 @@ func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, valu
 		if value.Constraints.Destination != "" {
 			logLine.WriteString(fmt.Sprintf("{Destination:%s}", value.Constraints.Destination))
 		}
-		logLine.WriteString(fmt.Sprint("} value: ", value.Value, " }"))
+		logLine.WriteString("}")
+		if !value.Until.IsZero() {
+			logLine.WriteString(fmt.Sprint(" until: ", value.Until.Format(time.RFC3339)))
+		}
+		if value.RolloutPercent != 0 {
+			logLine.WriteString(fmt.Sprint(" rolloutPercent: ", value.RolloutPercent))
+		}
+		logLine.WriteString(fmt.Sprint(" value: ", value.Value, " }"))
 	}
 }
 
@@ -541,6 +651,17 @@ func (lr *LoadResult) warnf(format string, args ...any) *LoadResult {
 	return lr.warn(fmt.Errorf(format, args...))
 }
 
+// strictWarnings returns the number of warnings failing strict loading.
+func (lr *LoadResult) strictWarnings() int {
+	count := 0
+	for _, w := range lr.Warnings {
+		if _, ok := w.(expiredValueError); !ok {
+			count++
+		}
+	}
+	return count
+}
+
 func (lr *LoadResult) error(err error) *LoadResult {
 	lr.Errors = append(lr.Errors, err)
 	return lr
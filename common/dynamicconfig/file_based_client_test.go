@@ -25,6 +25,7 @@
 package dynamicconfig_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/retrypolicy"
@@ -665,3 +667,201 @@ testGetBoolPropertyKey:
 	}
 	s.Equal(3, found)
 }
+
+func (s *fileBasedClientSuite) TestUntilAndRollout() {
+	setting := dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	doneCh := make(chan interface{})
+	defer close(doneCh)
+	reader := dynamicconfig.NewMockFileReader(ctrl)
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	reader.EXPECT().GetModTime().Return(time.Now(), nil).Times(2)
+	reader.EXPECT().ReadFile().Return([]byte(`
+testGetIntPropertyKey:
+- value: 20
+  rolloutPercent: 20
+- value: 50
+  rolloutPercent: 50
+- value: 100
+  until: `+until.Format(time.RFC3339)+`
+`), nil)
+
+	client, err := dynamicconfig.NewFileBasedClientWithReader(reader,
+		&dynamicconfig.FileBasedClientConfig{
+			Filepath:     "anyValue",
+			PollInterval: time.Minute,
+		}, log.NewNoopLogger(), doneCh)
+	s.NoError(err)
+	s.Equal([]dynamicconfig.ConstrainedValue{
+		{Value: 20, RolloutPercent: 20},
+		{Value: 50, RolloutPercent: 50},
+		{Value: 100, Until: until},
+	}, client.GetValue(testGetIntPropertyKey))
+
+	collection := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+	collection.Start()
+	defer collection.Stop()
+	get := setting.Get(collection)
+
+	// the namespaces of the 20% rollout are part of the 50% rollout, which adds 30% of namespaces
+	counts := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		counts[get(fmt.Sprintf("namespace-%d", i))]++
+	}
+	s.InDelta(200, counts[20], 50)
+	s.InDelta(300, counts[50], 50)
+	s.InDelta(500, counts[100], 50)
+
+	var updates []map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue
+	client.Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		updates = append(updates, changed)
+	})
+	client.RemoveExpired(until.Add(-time.Second))
+	s.Empty(updates)
+	client.RemoveExpired(until)
+	s.Len(updates, 1)
+	expected := []dynamicconfig.ConstrainedValue{
+		{Value: 20, RolloutPercent: 20},
+		{Value: 50, RolloutPercent: 50},
+	}
+	s.Equal(expected, updates[0][dynamicconfig.Key(strings.ToLower(testGetIntPropertyKey))])
+	s.Equal(expected, client.GetValue(testGetIntPropertyKey))
+}
+
+func (s *fileBasedClientSuite) TestRolloutWithoutNamespace() {
+	setting := dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+	client := dynamicconfig.StaticClient{
+		testGetIntPropertyKey: []dynamicconfig.ConstrainedValue{
+			{Value: 50, RolloutPercent: 50},
+			{Value: 100, RolloutPercent: 100},
+		},
+	}
+	s.Equal(100, setting.Get(dynamicconfig.NewCollection(client, log.NewNoopLogger()))())
+}
+
+func (s *fileBasedClientSuite) TestUntilExpired() {
+	setting := dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+	until := time.Unix(1000, 0)
+	client := dynamicconfig.StaticClient{
+		testGetIntPropertyKey: []dynamicconfig.ConstrainedValue{
+			{Value: 50, Until: until},
+			{Value: 100},
+		},
+	}
+	timeSource := clock.NewEventTimeSource().Update(until.Add(-time.Second))
+	get := setting.Get(dynamicconfig.NewCollectionWithTimeSource(client, log.NewNoopLogger(), timeSource))
+	s.Equal(50, get())
+	timeSource.Update(until)
+	s.Equal(100, get())
+}
+
+func (s *fileBasedClientSuite) TestRolloutSaltedWithKey() {
+	setting1 := dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+	setting2 := dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey+"2", 0, "")
+	client := dynamicconfig.StaticClient{
+		testGetIntPropertyKey:       []dynamicconfig.ConstrainedValue{{Value: 1, RolloutPercent: 50}},
+		testGetIntPropertyKey + "2": []dynamicconfig.ConstrainedValue{{Value: 1, RolloutPercent: 50}},
+	}
+	collection := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+	get1 := setting1.Get(collection)
+	get2 := setting2.Get(collection)
+
+	// independent rollouts of 50% overlap in about 25% of namespaces
+	both := 0
+	for i := 0; i < 1000; i++ {
+		namespace := fmt.Sprintf("namespace-%d", i)
+		if get1(namespace) == 1 && get2(namespace) == 1 {
+			both++
+		}
+	}
+	s.InDelta(250, both, 60)
+}
+
+func (s *fileBasedClientSuite) TestWarnExpired() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: 2000
+  until: 2020-01-01T00:00:00Z
+- value: 1000
+`))
+	s.Empty(lr.Errors)
+	s.Equal(1, len(lr.Warnings))
+	s.ErrorContains(lr.Warnings[0], `value of key "testGetIntPropertyKey" expired at 2020-01-01 00:00:00 +0000 UTC`)
+}
+
+func (s *fileBasedClientSuite) TestWarnRolloutWithoutNamespace() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: 2000
+  rolloutPercent: 10
+`))
+	s.Empty(lr.Errors)
+	s.Equal(1, len(lr.Warnings))
+	s.ErrorContains(lr.Warnings[0], `rolloutPercent of key "testGetIntPropertyKey" only applies at 100 percent`)
+}
+
+func (s *fileBasedClientSuite) TestErrorRolloutPercent() {
+	dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: 2000
+  rolloutPercent: 0
+- value: 1000
+  rolloutPercent: 101
+`))
+	s.Equal(2, len(lr.Errors))
+	s.ErrorContains(lr.Errors[0], `rolloutPercent of key "testGetIntPropertyKey" must be greater than 0 and at most 100`)
+}
+
+func (s *fileBasedClientSuite) TestStrict() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	reader := dynamicconfig.NewMockFileReader(ctrl)
+	config := &dynamicconfig.FileBasedClientConfig{
+		Filepath:     "anyValue",
+		PollInterval: time.Minute,
+		Strict:       true,
+	}
+	modTime := time.Now()
+
+	reader.EXPECT().GetModTime().Return(modTime, nil).Times(2)
+	reader.EXPECT().ReadFile().Return([]byte(`
+testGetIntPropertyKey:
+- value: 1000
+unknownKey:
+- value: 2000
+`), nil)
+	_, err := dynamicconfig.NewFileBasedClientWithReader(reader, config, log.NewNoopLogger(), s.doneCh)
+	s.ErrorContains(err, "loading dynamic config failed in strict mode: 1 warnings")
+
+	reader.EXPECT().GetModTime().Return(modTime, nil).Times(2)
+	reader.EXPECT().ReadFile().Return([]byte(`
+testGetIntPropertyKey:
+- value: 2000
+  until: 2020-01-01T00:00:00Z
+- value: 1000
+`), nil)
+	client, err := dynamicconfig.NewFileBasedClientWithReader(reader, config, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+
+	reader.EXPECT().GetModTime().Return(modTime.Add(time.Minute), nil)
+	reader.EXPECT().ReadFile().Return([]byte(`
+testGetIntPropertyKey:
+- value: 3000
+  constraints:
+    namespace: samples-namespace
+`), nil)
+	s.Error(client.Update())
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 1000}}, client.GetValue(testGetIntPropertyKey))
+}
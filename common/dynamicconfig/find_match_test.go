@@ -26,6 +26,7 @@ package dynamicconfig
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -78,7 +79,7 @@ func TestFindMatch(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		_, err := findMatch[struct{}](tc.v, nil, tc.filters)
+		_, _, err := findMatch[struct{}]("key", tc.v, nil, tc.filters, time.Now())
 		assert.Equal(t, tc.matched, err == nil)
 	}
 }
@@ -128,7 +129,7 @@ func TestFindMatchWithTyped(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		_, err := findMatch(nil, tc.tv, tc.filters)
+		_, _, err := findMatch("key", nil, tc.tv, tc.filters, time.Now())
 		assert.Equal(t, tc.matched, err == nil)
 	}
 }
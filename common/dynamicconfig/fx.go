@@ -25,15 +25,29 @@
 package dynamicconfig
 
 import (
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/pingable"
 	"go.uber.org/fx"
 )
 
+type collectionParams struct {
+	fx.In
+
+	Client     Client
+	Logger     log.Logger
+	TimeSource clock.TimeSource `optional:"true"`
+	Lifecycle  fx.Lifecycle
+}
+
 var Module = fx.Options(
-	fx.Provide(func(client Client, logger log.Logger, lc fx.Lifecycle) *Collection {
-		col := NewCollection(client, logger)
-		lc.Append(fx.StartStopHook(col.Start, col.Stop))
+	fx.Provide(func(params collectionParams) *Collection {
+		timeSource := params.TimeSource
+		if timeSource == nil {
+			timeSource = clock.NewRealTimeSource()
+		}
+		col := NewCollectionWithTimeSource(params.Client, params.Logger, timeSource)
+		params.Lifecycle.Append(fx.StartStopHook(col.Start, col.Stop))
 		return col
 	}),
 	fx.Provide(fx.Annotate(
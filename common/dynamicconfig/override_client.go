@@ -0,0 +1,228 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	expmaps "golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+)
+
+var _ Client = (*OverrideClient)(nil)
+var _ NotifyingClient = (*OverrideClient)(nil)
+
+type (
+	// OverrideClient is a Client that overlays values set at runtime, e.g. through the admin API, on the values
+	// of another Client. An override replaces the value of the other Client with the same key and constraints.
+	OverrideClient struct {
+		base      Client
+		overrides atomic.Value // configValueMap
+		logger    log.Logger
+
+		// updateLock serializes calls to subscriptions for updates of the base client and of overrides.
+		updateLock sync.Mutex
+
+		subscriptionLock sync.Mutex
+		subscriptionIdx  int
+		subscriptions    map[int]ClientUpdateFunc
+	}
+)
+
+// NewOverrideClient returns a client that overlays overrides on base. It has no overrides until SetOverrides is
+// called.
+func NewOverrideClient(base Client, logger log.Logger) *OverrideClient {
+	client := &OverrideClient{
+		base:          base,
+		logger:        logger,
+		subscriptions: make(map[int]ClientUpdateFunc),
+	}
+	client.overrides.Store(configValueMap{})
+	if notifyingBase, ok := base.(NotifyingClient); ok {
+		notifyingBase.Subscribe(client.baseUpdated)
+	}
+	return client
+}
+
+func (c *OverrideClient) GetValue(key Key) []ConstrainedValue {
+	overrides := c.overrides.Load().(configValueMap)
+	return mergeOverrides(c.base.GetValue(key), overrides[strings.ToLower(key.String())])
+}
+
+func (c *OverrideClient) Subscribe(f ClientUpdateFunc) (cancel func()) {
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+
+	c.subscriptionIdx++
+	id := c.subscriptionIdx
+	c.subscriptions[id] = f
+
+	return func() {
+		c.subscriptionLock.Lock()
+		defer c.subscriptionLock.Unlock()
+		delete(c.subscriptions, id)
+	}
+}
+
+// SetOverrides replaces all overrides, and notifies subscriptions of the keys whose overrides changed.
+func (c *OverrideClient) SetOverrides(overrides map[Key][]ConstrainedValue) {
+	c.updateLock.Lock()
+	defer c.updateLock.Unlock()
+
+	newOverrides := make(configValueMap, len(overrides))
+	for key, values := range overrides {
+		if len(values) > 0 {
+			newOverrides[strings.ToLower(key.String())] = values
+		}
+	}
+	oldOverrides := c.overrides.Swap(newOverrides).(configValueMap)
+
+	changed := make(map[Key][]ConstrainedValue)
+	for key, values := range newOverrides {
+		if !reflect.DeepEqual(oldOverrides[key], values) {
+			c.logger.Info("Dynamic config override changed.", tag.Key(key), tag.Value(values))
+			changed[Key(key)] = nil
+		}
+	}
+	for key := range oldOverrides {
+		if _, ok := newOverrides[key]; !ok {
+			c.logger.Info("Dynamic config override removed.", tag.Key(key))
+			changed[Key(key)] = nil
+		}
+	}
+	for key := range changed {
+		changed[key] = c.GetValue(key)
+	}
+	c.notifyLocked(changed)
+}
+
+func (c *OverrideClient) baseUpdated(changed map[Key][]ConstrainedValue) {
+	c.updateLock.Lock()
+	defer c.updateLock.Unlock()
+
+	overrides := c.overrides.Load().(configValueMap)
+	merged := make(map[Key][]ConstrainedValue, len(changed))
+	for key, values := range changed {
+		merged[key] = mergeOverrides(values, overrides[strings.ToLower(key.String())])
+	}
+	c.notifyLocked(merged)
+}
+
+func (c *OverrideClient) notifyLocked(changed map[Key][]ConstrainedValue) {
+	if len(changed) == 0 {
+		return
+	}
+
+	c.subscriptionLock.Lock()
+	subscriptions := expmaps.Values(c.subscriptions)
+	c.subscriptionLock.Unlock()
+
+	for _, update := range subscriptions {
+		update(changed)
+	}
+}
+
+func mergeOverrides(values []ConstrainedValue, overrides []ConstrainedValue) []ConstrainedValue {
+	if len(overrides) == 0 {
+		return values
+	}
+	merged := slices.Clone(overrides)
+	for _, value := range values {
+		if !slices.ContainsFunc(overrides, func(o ConstrainedValue) bool { return o.Constraints == value.Constraints }) {
+			merged = append(merged, value)
+		}
+	}
+	return merged
+}
+
+// ParseOverride decodes a YAML or JSON encoded value of key, in the format of values in the dynamic config file,
+// and validates the value and constraints against the registered setting of key. It returns the key as registered.
+func ParseOverride(key Key, constraints Constraints, encodedValue string) (Key, ConstrainedValue, error) {
+	setting := queryRegistry(key)
+	if setting == nil {
+		return "", ConstrainedValue{}, fmt.Errorf("unregistered key %q", key)
+	}
+	if err := validateConstraints(setting.Precedence(), constraints); err != nil {
+		return "", ConstrainedValue{}, fmt.Errorf("key %q: %w", key, err)
+	}
+
+	var decoded any
+	if err := yaml.Unmarshal([]byte(encodedValue), &decoded); err != nil {
+		return "", ConstrainedValue{}, fmt.Errorf("decode error: %w", err)
+	}
+	if decoded == nil {
+		return "", ConstrainedValue{}, errors.New("value is empty")
+	}
+	value, err := convertKeyTypeToString(decoded)
+	if err != nil {
+		return "", ConstrainedValue{}, err
+	}
+	if err := setting.Validate(value); err != nil {
+		return "", ConstrainedValue{}, fmt.Errorf("validation failed: key %q value %v: %w", key, value, err)
+	}
+	return setting.Key(), ConstrainedValue{Constraints: constraints, Value: value}, nil
+}
+
+// validateConstraints returns an error if constraints has fields that are not part of precedence.
+func validateConstraints(precedence Precedence, constraints Constraints) error {
+	var allowed Constraints
+	switch precedence {
+	case PrecedenceNamespace:
+		allowed.Namespace = constraints.Namespace
+	case PrecedenceNamespaceID:
+		allowed.NamespaceID = constraints.NamespaceID
+	case PrecedenceTaskQueue:
+		allowed.Namespace = constraints.Namespace
+		allowed.TaskQueueName = constraints.TaskQueueName
+		allowed.TaskQueueType = constraints.TaskQueueType
+	case PrecedenceShardID:
+		allowed.ShardID = constraints.ShardID
+	case PrecedenceTaskType:
+		allowed.TaskType = constraints.TaskType
+	case PrecedenceDestination:
+		allowed.Namespace = constraints.Namespace
+		allowed.Destination = constraints.Destination
+	}
+	if allowed != constraints {
+		return errors.New("constraints are not valid for the precedence of the key")
+	}
+	if constraints.TaskQueueType != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED && constraints.TaskQueueName == "" {
+		return errors.New("task queue type constraint requires a task queue name")
+	}
+	if constraints.TaskType != enumsspb.TASK_TYPE_UNSPECIFIED {
+		if _, ok := enumsspb.TaskType_name[int32(constraints.TaskType)]; !ok {
+			return fmt.Errorf("unknown task type %v", constraints.TaskType)
+		}
+	}
+	return nil
+}
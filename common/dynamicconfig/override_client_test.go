@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+func TestOverrideClient(t *testing.T) {
+	base := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewOverrideClient(base, log.NewNoopLogger())
+	k := dynamicconfig.Key("key")
+	ns1 := dynamicconfig.Constraints{Namespace: "ns-1"}
+	ns2 := dynamicconfig.Constraints{Namespace: "ns-2"}
+
+	base.OverrideValue(k, []dynamicconfig.ConstrainedValue{{Value: 1}, {Constraints: ns1, Value: 2}})
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 1}, {Constraints: ns1, Value: 2}}, c.GetValue(k))
+
+	// overrides replace base values with the same constraints only
+	c.SetOverrides(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue{
+		"KEY": {{Constraints: ns1, Value: 3}, {Constraints: ns2, Value: 4}},
+	})
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{
+		{Constraints: ns1, Value: 3},
+		{Constraints: ns2, Value: 4},
+		{Value: 1},
+	}, c.GetValue(k))
+
+	c.SetOverrides(nil)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 1}, {Constraints: ns1, Value: 2}}, c.GetValue(k))
+}
+
+func TestOverrideClientSubscriptions(t *testing.T) {
+	base := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewOverrideClient(base, log.NewNoopLogger())
+	k := dynamicconfig.Key("key")
+
+	var updates []map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue
+	c.Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		updates = append(updates, changed)
+	})
+
+	c.SetOverrides(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue{k: {{Value: 2}}})
+	require.Len(t, updates, 1)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 2}}, updates[0][k])
+
+	// unchanged overrides do not notify
+	c.SetOverrides(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue{k: {{Value: 2}}})
+	require.Len(t, updates, 1)
+
+	// updates of the base client are merged with overrides
+	base.OverrideValue(k, []dynamicconfig.ConstrainedValue{{Value: 1}, {Constraints: dynamicconfig.Constraints{Namespace: "ns-1"}, Value: 3}})
+	require.Len(t, updates, 2)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{
+		{Value: 2},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns-1"}, Value: 3},
+	}, updates[1][k])
+
+	c.SetOverrides(nil)
+	require.Len(t, updates, 3)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{
+		{Value: 1},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns-1"}, Value: 3},
+	}, updates[2][k])
+}
+
+func TestParseOverride(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	globalSetting := dynamicconfig.NewGlobalIntSetting("testParseOverride.global", 1, "")
+	taskQueueSetting := dynamicconfig.NewTaskQueueIntSetting("testParseOverride.taskQueue", 1, "")
+	taskTypeSetting := dynamicconfig.NewTaskTypeDurationSetting("testParseOverride.taskType", time.Second, "")
+
+	key, value, err := dynamicconfig.ParseOverride(
+		"TESTPARSEOVERRIDE.taskqueue",
+		dynamicconfig.Constraints{Namespace: "ns-1", TaskQueueName: "tq"},
+		"8",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, taskQueueSetting.Key(), key)
+	assert.Equal(t, dynamicconfig.ConstrainedValue{
+		Constraints: dynamicconfig.Constraints{Namespace: "ns-1", TaskQueueName: "tq"},
+		Value:       8,
+	}, value)
+
+	testCases := []struct {
+		name        string
+		key         dynamicconfig.Key
+		constraints dynamicconfig.Constraints
+		value       string
+	}{
+		{name: "unregistered key", key: "testParseOverride.unknown", value: "1"},
+		{name: "empty value", key: globalSetting.Key(), value: ""},
+		{name: "malformed value", key: globalSetting.Key(), value: "[1"},
+		{name: "wrong type", key: globalSetting.Key(), value: "fast"},
+		{
+			name:        "constraint of another precedence",
+			key:         globalSetting.Key(),
+			constraints: dynamicconfig.Constraints{Namespace: "ns-1"},
+			value:       "1",
+		},
+		{
+			name:        "task queue type without name",
+			key:         taskQueueSetting.Key(),
+			constraints: dynamicconfig.Constraints{Namespace: "ns-1", TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW},
+			value:       "1",
+		},
+		{
+			name:        "unknown task type",
+			key:         taskTypeSetting.Key(),
+			constraints: dynamicconfig.Constraints{TaskType: enumsspb.TaskType(1000)},
+			value:       "1m",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := dynamicconfig.ParseOverride(tc.key, tc.constraints, tc.value)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package overrides
+
+import (
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(ManagerProvider),
+	fx.Invoke(ManagerLifetimeHooks),
+)
+
+type managerProviderParams struct {
+	fx.In
+
+	ClusterMetadataManager persistence.ClusterMetadataManager
+	// OverrideClient is only provided by the server, not by services created without it, e.g. in tests.
+	OverrideClient *dynamicconfig.OverrideClient `optional:"true"`
+	Collection     *dynamicconfig.Collection
+	TimeSource     clock.TimeSource
+	Logger         log.SnTaggedLogger
+}
+
+func ManagerProvider(params managerProviderParams) *Manager {
+	return NewManager(
+		params.ClusterMetadataManager,
+		params.OverrideClient,
+		params.Collection,
+		params.TimeSource,
+		params.Logger,
+	)
+}
+
+func ManagerLifetimeHooks(lc fx.Lifecycle, manager *Manager) {
+	lc.Append(fx.StartStopHook(manager.Start, manager.Stop))
+}
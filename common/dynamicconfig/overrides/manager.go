@@ -0,0 +1,335 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package overrides stores the dynamic config overrides set through the admin API in the metadata of the current
+// cluster, and loads them into the dynamic config of every host.
+package overrides
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxAuditEntries bounds the audit log stored with the overrides. Older entries are dropped.
+	maxAuditEntries = 100
+	// maxUpdateAttempts bounds the attempts to save the overrides on concurrent updates of the cluster metadata.
+	maxUpdateAttempts = 5
+
+	refreshTimeout = 10 * time.Second
+
+	expiredReason = "override expired"
+)
+
+type (
+	// Manager reads and writes dynamic config overrides, and periodically loads them into an
+	// dynamicconfig.OverrideClient.
+	Manager struct {
+		status                 int32
+		clusterMetadataManager persistence.ClusterMetadataManager
+		client                 *dynamicconfig.OverrideClient
+		refreshInterval        dynamicconfig.DurationPropertyFn
+		timeSource             clock.TimeSource
+		logger                 log.Logger
+		shutdownChan           chan struct{}
+	}
+)
+
+// NewManager returns a Manager. client may be nil, in which case overrides can be managed, but are not loaded
+// into the dynamic config of this host.
+func NewManager(
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	client *dynamicconfig.OverrideClient,
+	dc *dynamicconfig.Collection,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *Manager {
+	return &Manager{
+		status:                 common.DaemonStatusInitialized,
+		clusterMetadataManager: clusterMetadataManager,
+		client:                 client,
+		refreshInterval:        dynamicconfig.DynamicConfigOverridesRefreshInterval.Get(dc),
+		timeSource:             timeSource,
+		logger:                 logger,
+		shutdownChan:           make(chan struct{}),
+	}
+}
+
+func (m *Manager) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	if m.client == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	defer cancel()
+	if err := m.Refresh(ctx); err != nil {
+		m.logger.Warn("Unable to load dynamic config overrides.", tag.Error(err))
+	}
+	go m.refreshLoop()
+}
+
+func (m *Manager) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(m.shutdownChan)
+}
+
+func (m *Manager) refreshLoop() {
+	timer := time.NewTimer(m.refreshInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-m.shutdownChan:
+			return
+		case <-timer.C:
+			ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+			if err := m.Refresh(ctx); err != nil {
+				m.logger.Warn("Unable to refresh dynamic config overrides.", tag.Error(err))
+			}
+			cancel()
+			timer.Reset(m.refreshInterval())
+		}
+	}
+}
+
+// Refresh loads the overrides into the dynamic config of this host. Expired and invalid overrides are ignored.
+func (m *Manager) Refresh(ctx context.Context) error {
+	if m.client == nil {
+		return nil
+	}
+	overrides, err := m.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := m.timeSource.Now()
+	values := make(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue)
+	for _, override := range overrides.GetOverrides() {
+		if isExpired(override, now) {
+			continue
+		}
+		key, value, err := Parse(override)
+		if err != nil {
+			m.logger.Warn("Ignoring invalid dynamic config override.", tag.Key(override.GetKey()), tag.Error(err))
+			continue
+		}
+		values[key] = append(values[key], value)
+	}
+	m.client.SetOverrides(values)
+	return nil
+}
+
+// Get returns the stored overrides, including expired ones which were not removed yet.
+func (m *Manager) Get(ctx context.Context) (*persistencespb.DynamicConfigOverrides, error) {
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if overrides := resp.GetDynamicConfigOverrides(); overrides != nil {
+		return overrides, nil
+	}
+	return &persistencespb.DynamicConfigOverrides{}, nil
+}
+
+// Set creates or replaces the override of the key and constraints of override. The override must be valid, see
+// Parse.
+func (m *Manager) Set(ctx context.Context, override *persistencespb.DynamicConfigOverride) error {
+	return m.update(ctx, func(overrides *persistencespb.DynamicConfigOverrides, now time.Time) error {
+		override = common.CloneProto(override)
+		override.UpdateTime = timestamppb.New(now)
+
+		var previous *persistencespb.DynamicConfigOverride
+		if i := indexOf(overrides, override.GetKey(), override.GetConstraints()); i >= 0 {
+			previous = overrides.Overrides[i]
+			overrides.Overrides[i] = override
+		} else {
+			overrides.Overrides = append(overrides.Overrides, override)
+		}
+		m.audit(overrides, &persistencespb.DynamicConfigAuditEntry{
+			Time:           timestamppb.New(now),
+			Identity:       override.GetIdentity(),
+			ClientIdentity: override.GetClientIdentity(),
+			Reason:         override.GetReason(),
+			Previous:       previous,
+			Current:        override,
+		})
+		return nil
+	})
+}
+
+// Delete deletes the override of key and constraints. It returns a serviceerror.NotFound if there is no such
+// override. identity and clientIdentity are recorded in the audit log, see DynamicConfigAuditEntry.
+func (m *Manager) Delete(
+	ctx context.Context,
+	key string,
+	constraints *persistencespb.DynamicConfigConstraints,
+	identity string,
+	clientIdentity string,
+	reason string,
+) error {
+	return m.update(ctx, func(overrides *persistencespb.DynamicConfigOverrides, now time.Time) error {
+		i := indexOf(overrides, key, constraints)
+		if i < 0 {
+			return serviceerror.NewNotFound(fmt.Sprintf("dynamic config override of key %q is not found", key))
+		}
+		previous := overrides.Overrides[i]
+		overrides.Overrides = slices.Delete(overrides.Overrides, i, i+1)
+		m.audit(overrides, &persistencespb.DynamicConfigAuditEntry{
+			Time:           timestamppb.New(now),
+			Identity:       identity,
+			ClientIdentity: clientIdentity,
+			Reason:         reason,
+			Previous:       previous,
+		})
+		return nil
+	})
+}
+
+// update applies fn to the stored overrides after removing expired ones, and saves them with the cluster
+// metadata. It retries on concurrent updates of the cluster metadata.
+func (m *Manager) update(
+	ctx context.Context,
+	fn func(overrides *persistencespb.DynamicConfigOverrides, now time.Time) error,
+) error {
+	for attempt := 1; ; attempt++ {
+		resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		metadata := common.CloneProto(resp.ClusterMetadata)
+		if metadata.DynamicConfigOverrides == nil {
+			metadata.DynamicConfigOverrides = &persistencespb.DynamicConfigOverrides{}
+		}
+		overrides := metadata.DynamicConfigOverrides
+
+		now := m.timeSource.Now()
+		m.removeExpired(overrides, now)
+		if err := fn(overrides, now); err != nil {
+			return err
+		}
+
+		applied, err := m.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+			ClusterMetadata: metadata,
+			Version:         resp.Version,
+		})
+		if err != nil {
+			return err
+		}
+		if applied {
+			return nil
+		}
+		if attempt >= maxUpdateAttempts {
+			return serviceerror.NewUnavailable("unable to save dynamic config overrides due to concurrent updates of the cluster metadata")
+		}
+	}
+}
+
+func (m *Manager) removeExpired(overrides *persistencespb.DynamicConfigOverrides, now time.Time) {
+	overrides.Overrides = slices.DeleteFunc(overrides.Overrides, func(override *persistencespb.DynamicConfigOverride) bool {
+		if !isExpired(override, now) {
+			return false
+		}
+		m.audit(overrides, &persistencespb.DynamicConfigAuditEntry{
+			Time:     timestamppb.New(now),
+			Reason:   expiredReason,
+			Previous: override,
+		})
+		return true
+	})
+}
+
+// audit appends entry to the audit log of overrides and logs it.
+func (m *Manager) audit(overrides *persistencespb.DynamicConfigOverrides, entry *persistencespb.DynamicConfigAuditEntry) {
+	overrides.AuditLog = append(overrides.AuditLog, entry)
+	if excess := len(overrides.AuditLog) - maxAuditEntries; excess > 0 {
+		overrides.AuditLog = slices.Delete(overrides.AuditLog, 0, excess)
+	}
+
+	override := entry.GetCurrent()
+	if override == nil {
+		override = entry.GetPrevious()
+	}
+	m.logger.Info("Dynamic config override changed.",
+		tag.Key(override.GetKey()),
+		tag.NewStringTag("constraints", override.GetConstraints().String()),
+		tag.NewStringTag("previous-value", entry.GetPrevious().GetValue()),
+		tag.NewStringTag("value", entry.GetCurrent().GetValue()),
+		tag.NewStringTag("identity", entry.GetIdentity()),
+		tag.NewStringTag("client-identity", entry.GetClientIdentity()),
+		tag.NewStringTag("reason", entry.GetReason()),
+	)
+}
+
+// Parse decodes and validates an override, see dynamicconfig.ParseOverride. The expire time of
+// the override is carried over to ConstrainedValue.Until, so the value stops applying at that
+// time and not at the next refresh.
+func Parse(override *persistencespb.DynamicConfigOverride) (dynamicconfig.Key, dynamicconfig.ConstrainedValue, error) {
+	key, value, err := dynamicconfig.ParseOverride(
+		dynamicconfig.Key(override.GetKey()),
+		dynamicconfig.ConstraintsFromProto(override.GetConstraints()),
+		override.GetValue(),
+	)
+	if err != nil {
+		return key, value, err
+	}
+	if override.GetExpireTime() != nil {
+		value.Until = override.GetExpireTime().AsTime()
+	}
+	return key, value, nil
+}
+
+func indexOf(overrides *persistencespb.DynamicConfigOverrides, key string, constraints *persistencespb.DynamicConfigConstraints) int {
+	if constraints == nil {
+		constraints = &persistencespb.DynamicConfigConstraints{}
+	}
+	return slices.IndexFunc(overrides.GetOverrides(), func(override *persistencespb.DynamicConfigOverride) bool {
+		overrideConstraints := override.GetConstraints()
+		if overrideConstraints == nil {
+			overrideConstraints = &persistencespb.DynamicConfigConstraints{}
+		}
+		return strings.EqualFold(override.GetKey(), key) && proto.Equal(overrideConstraints, constraints)
+	})
+}
+
+func isExpired(override *persistencespb.DynamicConfigOverride, now time.Time) bool {
+	return override.GetExpireTime() != nil && !override.GetExpireTime().AsTime().After(now)
+}
@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package overrides
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// clusterMetadataStore keeps the cluster metadata in memory for a MockClusterMetadataManager.
+type clusterMetadataStore struct {
+	metadata *persistencespb.ClusterMetadata
+	version  int64
+	// conflicts is the number of saves to reject as concurrent updates.
+	conflicts int
+}
+
+func newTestManager(t *testing.T, now time.Time) (*Manager, *clusterMetadataStore, *dynamicconfig.OverrideClient) {
+	store := &clusterMetadataStore{metadata: &persistencespb.ClusterMetadata{ClusterName: "active"}, version: 1}
+	cmm := persistence.NewMockClusterMetadataManager(gomock.NewController(t))
+	cmm.EXPECT().GetCurrentClusterMetadata(gomock.Any()).DoAndReturn(
+		func(context.Context) (*persistence.GetClusterMetadataResponse, error) {
+			return &persistence.GetClusterMetadataResponse{ClusterMetadata: store.metadata, Version: store.version}, nil
+		}).AnyTimes()
+	cmm.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			if store.conflicts > 0 {
+				store.conflicts--
+				store.version++
+				return false, nil
+			}
+			if request.Version != store.version {
+				return false, nil
+			}
+			store.metadata = request.ClusterMetadata
+			store.version++
+			return true, nil
+		}).AnyTimes()
+
+	client := dynamicconfig.NewOverrideClient(dynamicconfig.NewNoopClient(), log.NewNoopLogger())
+	timeSource := clock.NewEventTimeSource().Update(now)
+	return NewManager(cmm, client, dynamicconfig.NewNoopCollection(), timeSource, log.NewNoopLogger()), store, client
+}
+
+func TestManager_SetAndDelete(t *testing.T) {
+	now := time.Unix(1000, 0)
+	manager, store, client := newTestManager(t, now)
+	ctx := context.Background()
+	constraints := &persistencespb.DynamicConfigConstraints{Namespace: "ns-1", TaskQueueName: "tq"}
+	key := dynamicconfig.MatchingNumTaskqueueReadPartitions.Key()
+
+	require.NoError(t, manager.Set(ctx, &persistencespb.DynamicConfigOverride{
+		Key: key.String(), Constraints: constraints, Value: "8", Identity: "admin", Reason: "incident",
+	}))
+	require.NoError(t, manager.Set(ctx, &persistencespb.DynamicConfigOverride{
+		Key: key.String(), Constraints: constraints, Value: "16", Identity: "admin", Reason: "still slow",
+	}))
+	assert.Equal(t, "active", store.metadata.GetClusterName())
+	overrides := store.metadata.GetDynamicConfigOverrides()
+	require.Len(t, overrides.GetOverrides(), 1)
+	assert.Equal(t, "16", overrides.GetOverrides()[0].GetValue())
+	assert.True(t, now.Equal(overrides.GetOverrides()[0].GetUpdateTime().AsTime()))
+	require.Len(t, overrides.GetAuditLog(), 2)
+	assert.Equal(t, "8", overrides.GetAuditLog()[1].GetPrevious().GetValue())
+	assert.Equal(t, "16", overrides.GetAuditLog()[1].GetCurrent().GetValue())
+	assert.Equal(t, "still slow", overrides.GetAuditLog()[1].GetReason())
+
+	require.NoError(t, manager.Refresh(ctx))
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{
+		Constraints: dynamicconfig.Constraints{Namespace: "ns-1", TaskQueueName: "tq"},
+		Value:       16,
+	}}, client.GetValue(key))
+
+	var notFound *serviceerror.NotFound
+	err := manager.Delete(ctx, key.String(), &persistencespb.DynamicConfigConstraints{Namespace: "ns-2"}, "admin", "", "")
+	assert.ErrorAs(t, err, &notFound)
+
+	require.NoError(t, manager.Delete(ctx, key.String(), constraints, "admin", "cli", "resolved"))
+	overrides = store.metadata.GetDynamicConfigOverrides()
+	assert.Empty(t, overrides.GetOverrides())
+	require.Len(t, overrides.GetAuditLog(), 3)
+	assert.Equal(t, "16", overrides.GetAuditLog()[2].GetPrevious().GetValue())
+	assert.Nil(t, overrides.GetAuditLog()[2].GetCurrent())
+	assert.Equal(t, "admin", overrides.GetAuditLog()[2].GetIdentity())
+	assert.Equal(t, "cli", overrides.GetAuditLog()[2].GetClientIdentity())
+
+	require.NoError(t, manager.Refresh(ctx))
+	assert.Empty(t, client.GetValue(key))
+}
+
+func TestManager_Expiry(t *testing.T) {
+	now := time.Unix(1000, 0)
+	manager, store, client := newTestManager(t, now)
+	ctx := context.Background()
+	key := dynamicconfig.FrontendRPS.Key()
+	globalKey := dynamicconfig.FrontendGlobalRPS.Key()
+
+	store.metadata.DynamicConfigOverrides = &persistencespb.DynamicConfigOverrides{
+		Overrides: []*persistencespb.DynamicConfigOverride{
+			{Key: key.String(), Value: "100", ExpireTime: timestamppb.New(now)},
+			{Key: globalKey.String(), Value: "200", ExpireTime: timestamppb.New(now.Add(time.Minute))},
+		},
+	}
+	require.NoError(t, manager.Refresh(ctx))
+	assert.Empty(t, client.GetValue(key))
+	// values which haven't expired yet stop applying at the expire time
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{
+		Value: 200,
+		Until: now.Add(time.Minute).UTC(),
+	}}, client.GetValue(globalKey))
+
+	// expired overrides are removed on the next update
+	require.NoError(t, manager.Set(ctx, &persistencespb.DynamicConfigOverride{
+		Key: dynamicconfig.FrontendNamespaceReplicationInducingAPIsRPS.Key().String(), Value: "10", Identity: "admin",
+	}))
+	overrides := store.metadata.GetDynamicConfigOverrides()
+	require.Len(t, overrides.GetOverrides(), 2)
+	require.Len(t, overrides.GetAuditLog(), 2)
+	assert.Equal(t, expiredReason, overrides.GetAuditLog()[0].GetReason())
+	assert.Equal(t, "100", overrides.GetAuditLog()[0].GetPrevious().GetValue())
+}
+
+func TestManager_ConcurrentUpdate(t *testing.T) {
+	manager, store, _ := newTestManager(t, time.Unix(1000, 0))
+	ctx := context.Background()
+	override := &persistencespb.DynamicConfigOverride{Key: dynamicconfig.FrontendRPS.Key().String(), Value: "100", Identity: "admin"}
+
+	store.conflicts = maxUpdateAttempts - 1
+	require.NoError(t, manager.Set(ctx, override))
+	assert.Len(t, store.metadata.GetDynamicConfigOverrides().GetOverrides(), 1)
+
+	store.conflicts = maxUpdateAttempts
+	var unavailable *serviceerror.Unavailable
+	assert.ErrorAs(t, manager.Set(ctx, override), &unavailable)
+}
+
+func TestManager_AuditLogLimit(t *testing.T) {
+	manager, store, _ := newTestManager(t, time.Unix(1000, 0))
+	ctx := context.Background()
+
+	for i := 0; i < maxAuditEntries+10; i++ {
+		require.NoError(t, manager.Set(ctx, &persistencespb.DynamicConfigOverride{
+			Key: dynamicconfig.FrontendRPS.Key().String(), Value: "100", Identity: "admin",
+		}))
+	}
+	auditLog := store.metadata.GetDynamicConfigOverrides().GetAuditLog()
+	assert.Len(t, auditLog, maxAuditEntries)
+	assert.NotNil(t, auditLog[0].GetPrevious())
+}
@@ -55,5 +55,7 @@ type (
 
 		// for internal use:
 		dispatchUpdate(*Collection, any, []ConstrainedValue)
+		explain(*Collection, Constraints) Explanation
+		describe() SettingDescription
 	}
 )
@@ -49,6 +49,27 @@ const PrecedenceTaskType Precedence = 6
 
 const PrecedenceDestination Precedence = 7
 
+func (p Precedence) String() string {
+	switch p {
+	case PrecedenceGlobal:
+		return "Global"
+	case PrecedenceNamespace:
+		return "Namespace"
+	case PrecedenceNamespaceID:
+		return "NamespaceID"
+	case PrecedenceTaskQueue:
+		return "TaskQueue"
+	case PrecedenceShardID:
+		return "ShardID"
+	case PrecedenceTaskType:
+		return "TaskType"
+	case PrecedenceDestination:
+		return "Destination"
+	default:
+		return "Unknown"
+	}
+}
+
 type GlobalBoolSetting = GlobalTypedSetting[bool]
 
 func NewGlobalBoolSetting(key Key, def bool, description string) GlobalBoolSetting {
@@ -809,6 +830,15 @@ func (s GlobalTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []Cons
 	)
 }
 
+func (s GlobalTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	prec := []Constraints{{}}
+	return explain(c, s.key, PrecedenceGlobal, s.def, s.cdef, s.convert, prec)
+}
+
+func (s GlobalTypedSetting[T]) describe() SettingDescription {
+	return describe(s.key, PrecedenceGlobal, s.def, s.cdef, s.description)
+}
+
 func GetTypedPropertyFn[T any](value T) TypedPropertyFn[T] {
 	return func() T {
 		return value
@@ -903,6 +933,16 @@ func (s NamespaceTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []C
 	)
 }
 
+func (s NamespaceTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	namespace := cs.Namespace
+	prec := []Constraints{{Namespace: namespace}, {}}
+	return explain(c, s.key, PrecedenceNamespace, s.def, s.cdef, s.convert, prec)
+}
+
+func (s NamespaceTypedSetting[T]) describe() SettingDescription {
+	return describe(s.key, PrecedenceNamespace, s.def, s.cdef, s.description)
+}
+
 func GetTypedPropertyFnFilteredByNamespace[T any](value T) TypedPropertyFnWithNamespaceFilter[T] {
 	return func(namespace string) T {
 		return value
@@ -997,6 +1037,16 @@ func (s NamespaceIDTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs [
 	)
 }
 
+func (s NamespaceIDTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	namespaceID := cs.NamespaceID
+	prec := []Constraints{{NamespaceID: namespaceID}, {}}
+	return explain(c, s.key, PrecedenceNamespaceID, s.def, s.cdef, s.convert, prec)
+}
+
+func (s NamespaceIDTypedSetting[T]) describe() SettingDescription {
+	return describe(s.key, PrecedenceNamespaceID, s.def, s.cdef, s.description)
+}
+
 func GetTypedPropertyFnFilteredByNamespaceID[T any](value T) TypedPropertyFnWithNamespaceIDFilter[T] {
 	return func(namespaceID string) T {
 		return value
@@ -1103,6 +1153,22 @@ func (s TaskQueueTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []C
 	)
 }
 
+func (s TaskQueueTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	namespace, taskQueue, taskQueueType := cs.Namespace, cs.TaskQueueName, cs.TaskQueueType
+	prec := []Constraints{
+		{Namespace: namespace, TaskQueueName: taskQueue, TaskQueueType: taskQueueType},
+		{Namespace: namespace, TaskQueueName: taskQueue},
+		{TaskQueueName: taskQueue},
+		{Namespace: namespace},
+		{},
+	}
+	return explain(c, s.key, PrecedenceTaskQueue, s.def, s.cdef, s.convert, prec)
+}
+
+func (s TaskQueueTypedSetting[T]) describe() SettingDescription {
+	return describe(s.key, PrecedenceTaskQueue, s.def, s.cdef, s.description)
+}
+
 func GetTypedPropertyFnFilteredByTaskQueue[T any](value T) TypedPropertyFnWithTaskQueueFilter[T] {
 	return func(namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType) T {
 		return value
@@ -1197,6 +1263,16 @@ func (s ShardIDTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []Con
 	)
 }
 
+func (s ShardIDTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	shardID := cs.ShardID
+	prec := []Constraints{{ShardID: shardID}, {}}
+	return explain(c, s.key, PrecedenceShardID, s.def, s.cdef, s.convert, prec)
+}
+
+func (s ShardIDTypedSetting[T]) describe() SettingDescription {
+	return describe(s.key, PrecedenceShardID, s.def, s.cdef, s.description)
+}
+
 func GetTypedPropertyFnFilteredByShardID[T any](value T) TypedPropertyFnWithShardIDFilter[T] {
 	return func(shardID int32) T {
 		return value
@@ -1291,6 +1367,16 @@ func (s TaskTypeTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []Co
 	)
 }
 
+func (s TaskTypeTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	taskType := cs.TaskType
+	prec := []Constraints{{TaskType: taskType}, {}}
+	return explain(c, s.key, PrecedenceTaskType, s.def, s.cdef, s.convert, prec)
+}
+
+func (s TaskTypeTypedSetting[T]) describe() SettingDescription {
+	return describe(s.key, PrecedenceTaskType, s.def, s.cdef, s.description)
+}
+
 func GetTypedPropertyFnFilteredByTaskType[T any](value T) TypedPropertyFnWithTaskTypeFilter[T] {
 	return func(taskType enumsspb.TaskType) T {
 		return value
@@ -1395,6 +1481,21 @@ func (s DestinationTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs [
 	)
 }
 
+func (s DestinationTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	namespace, destination := cs.Namespace, cs.Destination
+	prec := []Constraints{
+		{Namespace: namespace, Destination: destination},
+		{Destination: destination},
+		{Namespace: namespace},
+		{},
+	}
+	return explain(c, s.key, PrecedenceDestination, s.def, s.cdef, s.convert, prec)
+}
+
+func (s DestinationTypedSetting[T]) describe() SettingDescription {
+	return describe(s.key, PrecedenceDestination, s.def, s.cdef, s.description)
+}
+
 func GetTypedPropertyFnFilteredByDestination[T any](value T) TypedPropertyFnWithDestinationFilter[T] {
 	return func(namespace string, destination string) T {
 		return value
@@ -300,6 +300,8 @@ const (
 	VisibilityPersistenceScanWorkflowExecutionsScope = "ScanWorkflowExecutions"
 	// VisibilityPersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to visibility persistence layer
 	VisibilityPersistenceCountWorkflowExecutionsScope = "CountWorkflowExecutions"
+	// VisibilityPersistenceCountWorkflowExecutionsHistogramScope tracks CountWorkflowExecutionsHistogram calls made by service to visibility persistence layer
+	VisibilityPersistenceCountWorkflowExecutionsHistogramScope = "CountWorkflowExecutionsHistogram"
 	// VisibilityPersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to visibility persistence layer
 	VisibilityPersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
 )
@@ -1070,6 +1072,7 @@ var (
 	HistoryScavengerSuccessCount                    = NewCounterDef("scavenger_success")
 	HistoryScavengerErrorCount                      = NewCounterDef("scavenger_errors")
 	HistoryScavengerSkipCount                       = NewCounterDef("scavenger_skips")
+	HistoryScavengerOffloadedNodeCount              = NewCounterDef("scavenger_offloaded_history_nodes")
 	ExecutionsOutstandingCount                      = NewGaugeDef("executions_outstanding")
 	ScavengerValidationRequestsCount                = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
@@ -1183,6 +1186,18 @@ var (
 		"persistence_latency",
 		WithDescription("Persistence latency, keyed by `operation`"),
 	)
+	PersistenceNamespaceRPS = NewGaugeDef(
+		"persistence_namespace_rps",
+		WithDescription("Persistence requests per second of a namespace on this host, keyed by `operation_class`"),
+	)
+	PersistenceNamespaceThrottledRPS = NewGaugeDef(
+		"persistence_namespace_throttled_rps",
+		WithDescription("Persistence requests per second of a namespace rejected by persistence rate limits on this host, keyed by `operation_class`"),
+	)
+	PersistenceNamespaceQuotaRPS = NewGaugeDef(
+		"persistence_namespace_quota_rps",
+		WithDescription("Persistence QPS limit of a namespace on this host"),
+	)
 	PersistenceShardRPS                    = NewDimensionlessHistogramDef("persistence_shard_rps")
 	PersistenceErrResourceExhaustedCounter = NewCounterDef("persistence_errors_resource_exhausted")
 	VisibilityPersistenceRequests          = NewCounterDef("visibility_persistence_requests")
@@ -1195,6 +1210,11 @@ var (
 	PersistenceSessionRefreshFailures      = NewCounterDef("persistence_session_refresh_failures")
 	PersistenceSessionRefreshAttempts      = NewCounterDef("persistence_session_refresh_attempts")
 
+	PersistenceMigrationShadowWriteFailures = NewCounterDef(
+		"persistence_migration_shadow_write_failures",
+		WithDescription("Writes which could not be shadowed to the secondary data store of a data store migration, keyed by `operation`"),
+	)
+
 	// Common service base metrics
 	RestartCount         = NewCounterDef("restarts")
 	NumGoRoutinesGauge   = NewGaugeDef("num_goroutines")
@@ -59,6 +59,7 @@ const (
 	actionType     = "action_type"
 	workerBuildId  = "worker-build-id"
 	destination    = "destination"
+	operationClass = "operation_class"
 	// Generic reason tag can be used anywhere a reason is needed.
 	reason = "reason"
 	// See server.api.enums.v1.ReplicationTaskType
@@ -352,6 +353,11 @@ func OperationTag(value string) Tag {
 	return &tagImpl{key: OperationTagName, value: value}
 }
 
+// OperationClassTag returns a new tag for the class of a persistence operation, e.g. "read" or "write".
+func OperationClassTag(value string) Tag {
+	return &tagImpl{key: operationClass, value: value}
+}
+
 func StringTag(key string, value string) Tag {
 	return &tagImpl{key: key, value: value}
 }
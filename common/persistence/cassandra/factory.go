@@ -61,6 +61,7 @@ func NewFactory(
 		func() (*gocql.ClusterConfig, error) {
 			return commongocql.NewCassandraCluster(cfg, r)
 		},
+		cfg.Scylla,
 		logger,
 		metricsHandler,
 	)
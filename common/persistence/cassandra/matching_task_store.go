@@ -87,6 +87,16 @@ const (
 		`task_queue_encoding ` +
 		`) VALUES (?, ?, ?, ?, ?, ?, ?, ?) IF NOT EXISTS`
 
+	// templateListTaskQueuesQuery scans the whole table, it is only meant for tools like data store migration.
+	templateListTaskQueuesQuery = `SELECT ` +
+		`range_id, ` +
+		`task_queue, ` +
+		`task_queue_encoding ` +
+		`FROM tasks ` +
+		`WHERE type = ? ` +
+		`and task_id = ? ` +
+		`ALLOW FILTERING`
+
 	templateUpdateTaskQueueQuery = `UPDATE tasks SET ` +
 		`range_id = ?, ` +
 		`task_queue = ?, ` +
@@ -303,10 +313,33 @@ func (d *MatchingTaskStore) UpdateTaskQueue(
 }
 
 func (d *MatchingTaskStore) ListTaskQueue(
-	_ context.Context,
-	_ *p.ListTaskQueueRequest,
+	ctx context.Context,
+	request *p.ListTaskQueueRequest,
 ) (*p.InternalListTaskQueueResponse, error) {
-	return nil, serviceerror.NewUnavailable("unsupported operation")
+	query := d.Session.Query(templateListTaskQueuesQuery,
+		rowTypeTaskQueue,
+		taskQueueTaskID,
+	).WithContext(ctx)
+	iter := query.PageSize(request.PageSize).PageState(request.PageToken).Iter()
+
+	response := &p.InternalListTaskQueueResponse{}
+	var rangeID int64
+	var tlBytes []byte
+	var tlEncoding string
+	for iter.Scan(&rangeID, &tlBytes, &tlEncoding) {
+		response.Items = append(response.Items, &p.InternalListTaskQueueItem{
+			TaskQueue: p.NewDataBlob(tlBytes, tlEncoding),
+			RangeID:   rangeID,
+		})
+		tlBytes = nil
+	}
+	if len(iter.PageState()) > 0 {
+		response.NextPageToken = iter.PageState()
+	}
+	if err := iter.Close(); err != nil {
+		return nil, gocql.ConvertError("ListTaskQueue", err)
+	}
+	return response, nil
 }
 
 func (d *MatchingTaskStore) DeleteTaskQueue(
@@ -82,6 +82,7 @@ func NewTestCluster(keyspace, username, password, host string, port int, schemaD
 		MaxConns:       2,
 		ConnectTimeout: 30 * time.Second * debug.TimeoutMultiplier,
 		Keyspace:       keyspace,
+		Scylla:         environment.GetCassandraScylla(),
 	}
 	result.faultInjection = faultInjection
 	return &result
@@ -154,6 +155,7 @@ func (s *TestCluster) CreateSession(
 					resolver.NewNoopResolver(),
 				)
 			},
+			s.cfg.Scylla,
 			log.NewNoopLogger(),
 			metrics.NoopMetricsHandler,
 		)
@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/convert"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -1112,7 +1113,7 @@ func createHistoryEventBatchBlob(
 		switch k {
 		case "encoding_type":
 			encodingStr := v.(string)
-			if encoding, err := enumspb.EncodingTypeFromString(encodingStr); err == nil {
+			if encoding, err := serialization.EncodingTypeFromString(encodingStr); err == nil {
 				eventBatch.EncodingType = enumspb.EncodingType(encoding)
 			}
 		case "data":
@@ -73,6 +73,7 @@ func CheckCompatibleVersion(
 		func() (*gocql.ClusterConfig, error) {
 			return commongocql.NewCassandraCluster(cfg, r)
 		},
+		cfg.Scylla,
 		log.NewNoopLogger(),
 		metrics.NoopMetricsHandler,
 	)
@@ -31,9 +31,12 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/recorder"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/quotas"
 )
@@ -80,6 +83,8 @@ type (
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
 		healthSignals        persistence.HealthSignalAggregator
+		usageTracker         persistence.UsageTracker
+		recorder             *recorder.Recorder
 	}
 )
 
@@ -102,6 +107,7 @@ func NewFactory(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	healthSignals persistence.HealthSignalAggregator,
+	usageTracker persistence.UsageTracker,
 ) Factory {
 	factory := &factoryImpl{
 		dataStoreFactory:     dataStoreFactory,
@@ -115,6 +121,7 @@ func NewFactory(
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
 		healthSignals:        healthSignals,
+		usageTracker:         usageTracker,
 	}
 	factory.initDependencies()
 	return factory
@@ -132,7 +139,7 @@ func (f *factoryImpl) NewTaskManager() (persistence.TaskManager, error) {
 		result = persistence.NewTaskPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = persistence.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = persistence.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.usageTracker, f.logger)
 	}
 	result = persistence.NewTaskPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -146,11 +153,14 @@ func (f *factoryImpl) NewShardManager() (persistence.ShardManager, error) {
 	}
 
 	result := persistence.NewShardManager(shardStore, f.serializer)
+	if f.recorder != nil {
+		result = recorder.NewShardPersistenceRecordingClient(result, f.recorder)
+	}
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewShardPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = persistence.NewShardPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = persistence.NewShardPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.usageTracker, f.logger)
 	}
 	result = persistence.NewShardPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -168,7 +178,7 @@ func (f *factoryImpl) NewMetadataManager() (persistence.MetadataManager, error)
 		result = persistence.NewMetadataPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = persistence.NewMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = persistence.NewMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.usageTracker, f.logger)
 	}
 	result = persistence.NewMetadataPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -186,7 +196,7 @@ func (f *factoryImpl) NewClusterMetadataManager() (persistence.ClusterMetadataMa
 		result = persistence.NewClusterMetadataPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = persistence.NewClusterMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = persistence.NewClusterMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.usageTracker, f.logger)
 	}
 	result = persistence.NewClusterMetadataPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -199,12 +209,19 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		return nil, err
 	}
 
-	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit)
+	enableBlobCompression := f.config.EnableBlobCompression
+	if enableBlobCompression == nil {
+		enableBlobCompression = dynamicconfig.GetBoolPropertyFn(false)
+	}
+	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit, enableBlobCompression)
+	if f.recorder != nil {
+		result = recorder.NewExecutionPersistenceRecordingClient(result, f.recorder)
+	}
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = persistence.NewExecutionPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = persistence.NewExecutionPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.usageTracker, f.logger)
 	}
 	result = persistence.NewExecutionPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -220,7 +237,7 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (persistence.NamespaceRepli
 		result = persistence.NewQueuePersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = persistence.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = persistence.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.usageTracker, f.logger)
 	}
 	result = persistence.NewQueuePersistenceRetryableClient(result, namespaceQueueRetryPolicy, IsNamespaceQueueTransientError)
 	return persistence.NewNamespaceReplicationQueue(result, f.serializer, f.clusterName, f.metricsHandler, f.logger)
@@ -245,7 +262,7 @@ func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManage
 		result = persistence.NewNexusEndpointPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = persistence.NewNexusEndpointPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = persistence.NewNexusEndpointPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.usageTracker, f.logger)
 	}
 	result = persistence.NewNexusEndpointPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -257,6 +274,14 @@ func (f *factoryImpl) Close() {
 	if f.healthSignals != nil {
 		f.healthSignals.Stop()
 	}
+	if f.usageTracker != nil {
+		f.usageTracker.Stop()
+	}
+	if f.recorder != nil {
+		if err := f.recorder.Close(); err != nil {
+			f.logger.Warn("Unable to close persistence recorder.", tag.Error(err))
+		}
+	}
 }
 
 func IsPersistenceTransientError(err error) bool {
@@ -279,6 +304,15 @@ func IsNamespaceQueueTransientError(err error) bool {
 }
 
 func (f *factoryImpl) initDependencies() {
+	if f.config != nil && f.config.Recorder != nil {
+		r, err := recorder.NewRecorder(*f.config.Recorder, f.logger)
+		if err != nil {
+			f.logger.Error("Unable to create persistence recorder, persistence calls are not recorded.", tag.Error(err))
+		} else {
+			f.recorder = r
+		}
+	}
+
 	if f.metricsHandler == nil && f.healthSignals == nil {
 		return
 	}
@@ -289,5 +323,9 @@ func (f *factoryImpl) initDependencies() {
 	if f.healthSignals == nil {
 		f.healthSignals = persistence.NoopHealthSignalAggregator
 	}
+	if f.usageTracker == nil {
+		f.usageTracker = persistence.NoopUsageTracker
+	}
 	f.healthSignals.Start()
+	f.usageTracker.Start()
 }
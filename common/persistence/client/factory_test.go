@@ -72,6 +72,7 @@ func TestFactoryImpl_NewHistoryTaskQueueManager(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			)
 			historyTaskQueueManager, err := factory.NewHistoryTaskQueueManager()
 			if tc.err != nil {
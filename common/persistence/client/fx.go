@@ -27,16 +27,21 @@ package client
 import (
 	"time"
 
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/faultinjection"
+	"go.temporal.io/server/common/persistence/pebble"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/storemigration"
+	"go.temporal.io/server/common/persistence/tieredhistory"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resolver"
@@ -70,6 +75,7 @@ type (
 		MetricsHandler                     metrics.Handler
 		Logger                             log.Logger
 		HealthSignals                      persistence.HealthSignalAggregator
+		UsageTracker                       persistence.UsageTracker
 		DynamicRateLimitingParams          DynamicRateLimitingParams
 	}
 
@@ -90,6 +96,7 @@ var Module = fx.Options(
 
 	fx.Provide(ClusterNameProvider),
 	fx.Provide(HealthSignalAggregatorProvider),
+	fx.Provide(UsageTrackerProvider),
 	fx.Provide(persistence.NewDLQMetricsEmitter),
 	fx.Provide(EventBlobCacheProvider),
 )
@@ -152,6 +159,7 @@ func FactoryProvider(
 		params.MetricsHandler,
 		params.Logger,
 		params.HealthSignals,
+		params.UsageTracker,
 	)
 }
 
@@ -175,6 +183,27 @@ func HealthSignalAggregatorProvider(
 	return persistence.NoopHealthSignalAggregator
 }
 
+func UsageTrackerProvider(
+	persistenceMaxQPS PersistenceMaxQps,
+	persistenceNamespaceMaxQPS PersistenceNamespaceMaxQps,
+	metricsHandler metrics.Handler,
+) persistence.UsageTracker {
+	return persistence.NewUsageTrackerImpl(
+		func(namespace string) float64 {
+			// Mirrors the namespace rate limiter of FactoryProvider.
+			if persistenceMaxQPS == nil || persistenceMaxQPS() <= 0 {
+				return 0
+			}
+			if persistenceNamespaceMaxQPS != nil && persistenceNamespaceMaxQPS(namespace) > 0 {
+				return float64(persistenceNamespaceMaxQPS(namespace))
+			}
+			return float64(persistenceMaxQPS())
+		},
+		metricsHandler,
+		clock.NewRealTimeSource(),
+	)
+}
+
 func DataStoreFactoryProvider(
 	clusterName ClusterName,
 	r resolver.ServiceResolver,
@@ -184,21 +213,62 @@ func DataStoreFactoryProvider(
 	metricsHandler metrics.Handler,
 ) persistence.DataStoreFactory {
 
+	dataStoreFactory := newDataStoreFactory(cfg.DefaultStore, clusterName, r, cfg, abstractDataStoreFactory, logger, metricsHandler)
+	if cfg.Migration != nil {
+		dataStoreFactory = storemigration.NewDataStoreFactory(
+			dataStoreFactory,
+			newDataStoreFactory(cfg.Migration.TargetStore, clusterName, r, cfg, abstractDataStoreFactory, logger, metricsHandler),
+			cfg.Migration.TargetStore,
+			metricsHandler,
+			logger,
+		)
+	}
+	if cfg.TieredHistory != nil {
+		blobs, err := tieredhistory.NewBlobStore(cfg.TieredHistory.BlobStoreURI)
+		if err != nil {
+			logger.Fatal("unable to open tiered history blob store", tag.Error(err))
+		}
+		dataStoreFactory = tieredhistory.NewDataStoreFactory(dataStoreFactory, blobs, logger)
+	}
+	return dataStoreFactory
+}
+
+func newDataStoreFactory(
+	storeName string,
+	clusterName ClusterName,
+	r resolver.ServiceResolver,
+	cfg *config.Persistence,
+	abstractDataStoreFactory AbstractDataStoreFactory,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) persistence.DataStoreFactory {
+
 	var dataStoreFactory persistence.DataStoreFactory
-	defaultStoreCfg := cfg.DataStores[cfg.DefaultStore]
+	storeCfg := cfg.DataStores[storeName]
 	switch {
-	case defaultStoreCfg.Cassandra != nil:
-		dataStoreFactory = cassandra.NewFactory(*defaultStoreCfg.Cassandra, r, string(clusterName), logger, metricsHandler)
-	case defaultStoreCfg.SQL != nil:
-		dataStoreFactory = sql.NewFactory(*defaultStoreCfg.SQL, r, string(clusterName), logger, metricsHandler)
-	case defaultStoreCfg.CustomDataStoreConfig != nil:
-		dataStoreFactory = abstractDataStoreFactory.NewFactory(*defaultStoreCfg.CustomDataStoreConfig, r, string(clusterName), logger, metricsHandler)
+	case storeCfg.Cassandra != nil:
+		dataStoreFactory = cassandra.NewFactory(*storeCfg.Cassandra, r, string(clusterName), logger, metricsHandler)
+	case storeCfg.SQL != nil:
+		dataStoreFactory = sql.NewFactory(*storeCfg.SQL, r, string(clusterName), logger, metricsHandler)
+	case storeCfg.Pebble != nil:
+		var err error
+		dataStoreFactory, err = pebble.NewFactory(*storeCfg.Pebble, string(clusterName), logger)
+		if err != nil {
+			logger.Fatal("unable to open pebble database", tag.Error(err), tag.NewStringTag("store", storeName))
+		}
+	case storeCfg.CustomDataStoreConfig != nil:
+		dataStoreFactory = abstractDataStoreFactory.NewFactory(*storeCfg.CustomDataStoreConfig, r, string(clusterName), logger, metricsHandler)
 	default:
-		logger.Fatal("invalid config: one of cassandra or sql params must be specified for default data store")
+		logger.Fatal("invalid config: one of cassandra, sql or pebble params must be specified for data store", tag.NewStringTag("store", storeName))
 	}
 
-	if defaultStoreCfg.FaultInjection != nil {
-		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, dataStoreFactory)
+	if storeCfg.FaultInjection != nil {
+		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(
+			storeCfg.FaultInjection,
+			cfg.FaultInjectionTargets,
+			dataStoreFactory,
+			logger,
+		)
 	}
 
 	return dataStoreFactory
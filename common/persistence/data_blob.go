@@ -27,6 +27,7 @@ package persistence
 import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 // NewDataBlob returns a new DataBlob
@@ -36,7 +37,7 @@ func NewDataBlob(data []byte, encodingTypeStr string) *commonpb.DataBlob {
 		return nil
 	}
 
-	encodingType, err := enumspb.EncodingTypeFromString(encodingTypeStr)
+	encodingType, err := serialization.EncodingTypeFromString(encodingTypeStr)
 	if err != nil {
 		// encodingTypeStr not valid, an error will be returned on deserialization
 		encodingType = enumspb.ENCODING_TYPE_UNSPECIFIED
@@ -55,6 +55,7 @@ type (
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		enableBlobCompression dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -67,6 +68,7 @@ func NewExecutionManager(
 	eventBlobCache XDCCache,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	enableBlobCompression dynamicconfig.BoolPropertyFn,
 ) ExecutionManager {
 	return &executionManagerImpl{
 		serializer:            serializer,
@@ -75,6 +77,7 @@ func NewExecutionManager(
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		enableBlobCompression: enableBlobCompression,
 	}
 }
 
@@ -488,6 +491,7 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 		workflowNewEvents = append(workflowNewEvents, newEvents)
 		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
+		newEvents.Node.Events = m.compressHistoryNode(newEvents.Node.Events)
 	}
 	return xdcKVs, workflowNewEvents, &historyStatistics, nil
 }
@@ -552,6 +556,8 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	input *WorkflowMutation,
 ) (*InternalWorkflowMutation, error) {
 
+	encoding := m.blobEncoding()
+
 	tasks, err := serializeTasks(m.serializer, input.Tasks)
 	if err != nil {
 		return nil, err
@@ -593,17 +599,17 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		NextEventID:     input.NextEventID,
 	}
 
-	result.ExecutionInfoBlob, err = m.serializer.WorkflowExecutionInfoToBlob(input.ExecutionInfo, enumspb.ENCODING_TYPE_PROTO3)
+	result.ExecutionInfoBlob, err = m.serializer.WorkflowExecutionInfoToBlob(input.ExecutionInfo, encoding)
 	if err != nil {
 		return nil, err
 	}
-	result.ExecutionStateBlob, err = m.serializer.WorkflowExecutionStateToBlob(input.ExecutionState, enumspb.ENCODING_TYPE_PROTO3)
+	result.ExecutionStateBlob, err = m.serializer.WorkflowExecutionStateToBlob(input.ExecutionState, encoding)
 	if err != nil {
 		return nil, err
 	}
 
 	for key, info := range input.UpsertActivityInfos {
-		blob, err := m.serializer.ActivityInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.ActivityInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -611,7 +617,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertTimerInfos {
-		blob, err := m.serializer.TimerInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.TimerInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -619,7 +625,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertChildExecutionInfos {
-		blob, err := m.serializer.ChildExecutionInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.ChildExecutionInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -627,7 +633,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertRequestCancelInfos {
-		blob, err := m.serializer.RequestCancelInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.RequestCancelInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -635,7 +641,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertSignalInfos {
-		blob, err := m.serializer.SignalInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.SignalInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -643,7 +649,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	if len(input.NewBufferedEvents) > 0 {
-		result.NewBufferedEvents, err = m.serializer.SerializeEvents(input.NewBufferedEvents, enumspb.ENCODING_TYPE_PROTO3)
+		result.NewBufferedEvents, err = m.serializer.SerializeEvents(input.NewBufferedEvents, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -653,7 +659,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	if err != nil {
 		return nil, err
 	}
-	result.Checksum, err = m.serializer.ChecksumToBlob(input.Checksum, enumspb.ENCODING_TYPE_PROTO3)
+	result.Checksum, err = m.serializer.ChecksumToBlob(input.Checksum, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -665,6 +671,8 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	input *WorkflowSnapshot,
 ) (*InternalWorkflowSnapshot, error) {
 
+	encoding := m.blobEncoding()
+
 	tasks, err := serializeTasks(m.serializer, input.Tasks)
 	if err != nil {
 		return nil, err
@@ -692,11 +700,11 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		NextEventID:     input.NextEventID,
 	}
 
-	result.ExecutionInfoBlob, err = m.serializer.WorkflowExecutionInfoToBlob(input.ExecutionInfo, enumspb.ENCODING_TYPE_PROTO3)
+	result.ExecutionInfoBlob, err = m.serializer.WorkflowExecutionInfoToBlob(input.ExecutionInfo, encoding)
 	if err != nil {
 		return nil, err
 	}
-	result.ExecutionStateBlob, err = m.serializer.WorkflowExecutionStateToBlob(input.ExecutionState, enumspb.ENCODING_TYPE_PROTO3)
+	result.ExecutionStateBlob, err = m.serializer.WorkflowExecutionStateToBlob(input.ExecutionState, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -706,35 +714,35 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	}
 
 	for key, info := range input.ActivityInfos {
-		blob, err := m.serializer.ActivityInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.ActivityInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
 		result.ActivityInfos[key] = blob
 	}
 	for key, info := range input.TimerInfos {
-		blob, err := m.serializer.TimerInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.TimerInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
 		result.TimerInfos[key] = blob
 	}
 	for key, info := range input.ChildExecutionInfos {
-		blob, err := m.serializer.ChildExecutionInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.ChildExecutionInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
 		result.ChildExecutionInfos[key] = blob
 	}
 	for key, info := range input.RequestCancelInfos {
-		blob, err := m.serializer.RequestCancelInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.RequestCancelInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
 		result.RequestCancelInfos[key] = blob
 	}
 	for key, info := range input.SignalInfos {
-		blob, err := m.serializer.SignalInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := m.serializer.SignalInfoToBlob(info, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -744,7 +752,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		result.SignalRequestedIDs[key] = struct{}{}
 	}
 
-	result.Checksum, err = m.serializer.ChecksumToBlob(input.Checksum, enumspb.ENCODING_TYPE_PROTO3)
+	result.Checksum, err = m.serializer.ChecksumToBlob(input.Checksum, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -952,6 +960,23 @@ func (m *executionManagerImpl) IsReplicationDLQEmpty(
 	return m.persistence.IsReplicationDLQEmpty(ctx, request)
 }
 
+// blobEncoding returns the encoding of the mutable state blobs written to persistence.
+func (m *executionManagerImpl) blobEncoding() enumspb.EncodingType {
+	if m.enableBlobCompression() {
+		return serialization.EncodingTypeProto3Zstd
+	}
+	return enumspb.ENCODING_TYPE_PROTO3
+}
+
+// compressHistoryNode compresses the events of a history node right before they are written, so that size
+// limits, history size and the XDC cache all use the proto3 encoded events.
+func (m *executionManagerImpl) compressHistoryNode(events *commonpb.DataBlob) *commonpb.DataBlob {
+	if !m.enableBlobCompression() {
+		return events
+	}
+	return serialization.CompressBlob(events)
+}
+
 func (m *executionManagerImpl) Close() {
 	m.persistence.Close()
 }
@@ -57,7 +57,7 @@ func (c *faultInjectionClusterMetadataStore) DeleteClusterMetadata(
 	ctx context.Context,
 	request *persistence.InternalDeleteClusterMetadataRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteClusterMetadata"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteClusterMetadata", request), func() error {
 		return c.baseStore.DeleteClusterMetadata(ctx, request)
 	})
 }
@@ -66,7 +66,7 @@ func (c *faultInjectionClusterMetadataStore) GetClusterMembers(
 	ctx context.Context,
 	request *persistence.GetClusterMembersRequest,
 ) (*persistence.GetClusterMembersResponse, error) {
-	return inject1(c.generator.generate("GetClusterMembers"), func() (*persistence.GetClusterMembersResponse, error) {
+	return inject1(ctx, c.generator.generate("GetClusterMembers", request), func() (*persistence.GetClusterMembersResponse, error) {
 		return c.baseStore.GetClusterMembers(ctx, request)
 	})
 }
@@ -75,7 +75,7 @@ func (c *faultInjectionClusterMetadataStore) GetClusterMetadata(
 	ctx context.Context,
 	request *persistence.InternalGetClusterMetadataRequest,
 ) (*persistence.InternalGetClusterMetadataResponse, error) {
-	return inject1(c.generator.generate("GetClusterMetadata"), func() (*persistence.InternalGetClusterMetadataResponse, error) {
+	return inject1(ctx, c.generator.generate("GetClusterMetadata", request), func() (*persistence.InternalGetClusterMetadataResponse, error) {
 		return c.baseStore.GetClusterMetadata(ctx, request)
 	})
 }
@@ -88,7 +88,7 @@ func (c *faultInjectionClusterMetadataStore) ListClusterMetadata(
 	ctx context.Context,
 	request *persistence.InternalListClusterMetadataRequest,
 ) (*persistence.InternalListClusterMetadataResponse, error) {
-	return inject1(c.generator.generate("ListClusterMetadata"), func() (*persistence.InternalListClusterMetadataResponse, error) {
+	return inject1(ctx, c.generator.generate("ListClusterMetadata", request), func() (*persistence.InternalListClusterMetadataResponse, error) {
 		return c.baseStore.ListClusterMetadata(ctx, request)
 	})
 }
@@ -97,7 +97,7 @@ func (c *faultInjectionClusterMetadataStore) PruneClusterMembership(
 	ctx context.Context,
 	request *persistence.PruneClusterMembershipRequest,
 ) error {
-	return inject0(c.generator.generate("PruneClusterMembership"), func() error {
+	return inject0(ctx, c.generator.generate("PruneClusterMembership", request), func() error {
 		return c.baseStore.PruneClusterMembership(ctx, request)
 	})
 }
@@ -106,7 +106,7 @@ func (c *faultInjectionClusterMetadataStore) SaveClusterMetadata(
 	ctx context.Context,
 	request *persistence.InternalSaveClusterMetadataRequest,
 ) (bool, error) {
-	return inject1(c.generator.generate("SaveClusterMetadata"), func() (bool, error) {
+	return inject1(ctx, c.generator.generate("SaveClusterMetadata", request), func() (bool, error) {
 		return c.baseStore.SaveClusterMetadata(ctx, request)
 	})
 }
@@ -115,7 +115,7 @@ func (c *faultInjectionClusterMetadataStore) UpsertClusterMembership(
 	ctx context.Context,
 	request *persistence.UpsertClusterMembershipRequest,
 ) error {
-	return inject0(c.generator.generate("UpsertClusterMembership"), func() error {
+	return inject0(ctx, c.generator.generate("UpsertClusterMembership", request), func() error {
 		return c.baseStore.UpsertClusterMembership(ctx, request)
 	})
 }
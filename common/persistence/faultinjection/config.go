@@ -0,0 +1,39 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjection
+
+import (
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+var Targets = dynamicconfig.NewGlobalTypedSetting(
+	"system.persistenceFaultInjectionTargets",
+	config.FaultInjectionTargets{},
+	`Targets replaces the fault injection targets of the default persistence store at runtime. It has the same
+structure as the targets of the faultInjection config of the data store, e.g.
+{"dataStores": {"ExecutionStore": {"methods": {"UpdateWorkflowExecution": {"errors": {"ExecuteAndTimeout": 0.1},
+"latency": {"rate": 0.5, "min": "10ms", "max": "200ms"}, "shardIDs": [1, 2]}}}}}.
+It only has effect if the default store has a faultInjection config, which may have no targets. Removing it
+restores the targets of the faultInjection config.`,
+)
@@ -25,7 +25,13 @@
 package faultinjection
 
 import (
+	"fmt"
+	"sync/atomic"
+
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -33,6 +39,13 @@ type (
 	FaultInjectionDataStoreFactory struct {
 		baseFactory persistence.DataStoreFactory
 		fiConfig    *config.FaultInjection
+		logger      log.Logger
+
+		// generators holds the fault generators of the current targets. With dynamic targets, every store is wrapped
+		// and looks up its generator on each call, since targets can be added at runtime.
+		generators        atomic.Pointer[map[config.DataStoreName]*storeFaultGenerator]
+		dynamicTargets    bool
+		cancelSubscribers func()
 
 		taskStore          persistence.TaskStore
 		shardStore         persistence.ShardStore
@@ -43,19 +56,73 @@ type (
 		clusterMDStore     persistence.ClusterMetadataStore
 		nexusEndpointStore persistence.NexusEndpointStore
 	}
+
+	// dynamicFaultGenerator injects faults into a data store using the targets which are current at the time of
+	// each call.
+	dynamicFaultGenerator struct {
+		storeName  config.DataStoreName
+		generators *atomic.Pointer[map[config.DataStoreName]*storeFaultGenerator]
+	}
 )
 
+// NewFaultInjectionDatastoreFactory returns a data store factory which injects faults into the stores of baseFactory.
+// If dynamicTargets is not nil, its value replaces the targets of fiConfig whenever it has any data stores.
 func NewFaultInjectionDatastoreFactory(
 	fiConfig *config.FaultInjection,
+	dynamicTargets dynamicconfig.TypedSubscribable[config.FaultInjectionTargets],
 	baseFactory persistence.DataStoreFactory,
+	logger log.Logger,
 ) *FaultInjectionDataStoreFactory {
-	return &FaultInjectionDataStoreFactory{
-		baseFactory: baseFactory,
-		fiConfig:    fiConfig,
+	d := &FaultInjectionDataStoreFactory{
+		baseFactory:       baseFactory,
+		fiConfig:          fiConfig,
+		logger:            logger,
+		dynamicTargets:    dynamicTargets != nil,
+		cancelSubscribers: func() {},
+	}
+	d.generators.Store(&map[config.DataStoreName]*storeFaultGenerator{})
+	if dynamicTargets == nil {
+		generators, err := newStoreFaultGenerators(fiConfig.Targets)
+		if err != nil {
+			panic(fmt.Sprintf("invalid fault injection config: %v", err))
+		}
+		d.generators.Store(&generators)
+		return d
 	}
+	targets, cancel := dynamicTargets(d.updateTargets)
+	d.cancelSubscribers = cancel
+	d.updateTargets(targets)
+	return d
+}
+
+func (d *FaultInjectionDataStoreFactory) updateTargets(targets config.FaultInjectionTargets) {
+	if len(targets.DataStores) == 0 {
+		targets = d.fiConfig.Targets
+	}
+	generators, err := newStoreFaultGenerators(targets)
+	if err != nil {
+		d.logger.Error("Invalid fault injection targets, keeping the previous ones.", tag.Error(err))
+		return
+	}
+	d.generators.Store(&generators)
+}
+
+// faultGenerator returns the fault generator of a store, or nil if faults are never injected into the store.
+func (d *FaultInjectionDataStoreFactory) faultGenerator(storeName config.DataStoreName) faultGenerator {
+	if d.dynamicTargets {
+		return &dynamicFaultGenerator{
+			storeName:  storeName,
+			generators: &d.generators,
+		}
+	}
+	if generator, ok := (*d.generators.Load())[storeName]; ok {
+		return generator
+	}
+	return nil
 }
 
 func (d *FaultInjectionDataStoreFactory) Close() {
+	d.cancelSubscribers()
 	d.baseFactory.Close()
 }
 
@@ -65,10 +132,10 @@ func (d *FaultInjectionDataStoreFactory) NewTaskStore() (persistence.TaskStore,
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.TaskStoreName]; ok && len(storeConfig.Methods) > 0 {
+		if generator := d.faultGenerator(config.TaskStoreName); generator != nil {
 			d.taskStore = newFaultInjectionTaskStore(
 				baseStore,
-				newStoreFaultGenerator(&storeConfig),
+				generator,
 			)
 		} else {
 			d.taskStore = baseStore
@@ -83,10 +150,10 @@ func (d *FaultInjectionDataStoreFactory) NewShardStore() (persistence.ShardStore
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ShardStoreName]; ok && len(storeConfig.Methods) > 0 {
+		if generator := d.faultGenerator(config.ShardStoreName); generator != nil {
 			d.shardStore = newFaultInjectionShardStore(
 				baseStore,
-				newStoreFaultGenerator(&storeConfig),
+				generator,
 			)
 		} else {
 			d.shardStore = baseStore
@@ -100,10 +167,10 @@ func (d *FaultInjectionDataStoreFactory) NewMetadataStore() (persistence.Metadat
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.MetadataStoreName]; ok && len(storeConfig.Methods) > 0 {
+		if generator := d.faultGenerator(config.MetadataStoreName); generator != nil {
 			d.metadataStore = newFaultInjectionMetadataStore(
 				baseStore,
-				newStoreFaultGenerator(&storeConfig),
+				generator,
 			)
 		} else {
 			d.metadataStore = baseStore
@@ -118,10 +185,10 @@ func (d *FaultInjectionDataStoreFactory) NewExecutionStore() (persistence.Execut
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ExecutionStoreName]; ok && len(storeConfig.Methods) > 0 {
+		if generator := d.faultGenerator(config.ExecutionStoreName); generator != nil {
 			d.executionStore = newFaultInjectionExecutionStore(
 				baseStore,
-				newStoreFaultGenerator(&storeConfig),
+				generator,
 			)
 		} else {
 			d.executionStore = baseStore
@@ -136,10 +203,10 @@ func (d *FaultInjectionDataStoreFactory) NewQueue(queueType persistence.QueueTyp
 		if err != nil {
 			return baseQueue, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.QueueName]; ok && len(storeConfig.Methods) > 0 {
+		if generator := d.faultGenerator(config.QueueName); generator != nil {
 			d.queue = newFaultInjectionQueue(
 				baseQueue,
-				newStoreFaultGenerator(&storeConfig),
+				generator,
 			)
 		} else {
 			d.queue = baseQueue
@@ -154,10 +221,10 @@ func (d *FaultInjectionDataStoreFactory) NewQueueV2() (persistence.QueueV2, erro
 		if err != nil {
 			return baseQueue, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.QueueV2Name]; ok && len(storeConfig.Methods) > 0 {
+		if generator := d.faultGenerator(config.QueueV2Name); generator != nil {
 			d.queueV2 = newFaultInjectionQueueV2(
 				baseQueue,
-				newStoreFaultGenerator(&storeConfig),
+				generator,
 			)
 		} else {
 			d.queueV2 = baseQueue
@@ -172,10 +239,10 @@ func (d *FaultInjectionDataStoreFactory) NewClusterMetadataStore() (persistence.
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ClusterMDStoreName]; ok && len(storeConfig.Methods) > 0 {
+		if generator := d.faultGenerator(config.ClusterMDStoreName); generator != nil {
 			d.clusterMDStore = newFaultInjectionClusterMetadataStore(
 				baseStore,
-				newStoreFaultGenerator(&storeConfig),
+				generator,
 			)
 		} else {
 			d.clusterMDStore = baseStore
@@ -190,10 +257,10 @@ func (d *FaultInjectionDataStoreFactory) NewNexusEndpointStore() (persistence.Ne
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.NexusEndpointStoreName]; ok && len(storeConfig.Methods) > 0 {
+		if generator := d.faultGenerator(config.NexusEndpointStoreName); generator != nil {
 			d.nexusEndpointStore = newFaultInjectionNexusEndpointStore(
 				baseStore,
-				newStoreFaultGenerator(&storeConfig),
+				generator,
 			)
 		} else {
 			d.nexusEndpointStore = baseStore
@@ -201,3 +268,11 @@ func (d *FaultInjectionDataStoreFactory) NewNexusEndpointStore() (persistence.Ne
 	}
 	return d.nexusEndpointStore, nil
 }
+
+func (g *dynamicFaultGenerator) generate(methodName string, request any) *fault {
+	generator, ok := (*g.generators.Load())[g.storeName]
+	if !ok {
+		return nil
+	}
+	return generator.generate(methodName, request)
+}
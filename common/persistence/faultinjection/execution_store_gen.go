@@ -53,7 +53,7 @@ func (c *faultInjectionExecutionStore) AddHistoryTasks(
 	ctx context.Context,
 	request *persistence.InternalAddHistoryTasksRequest,
 ) error {
-	return inject0(c.generator.generate("AddHistoryTasks"), func() error {
+	return inject0(ctx, c.generator.generate("AddHistoryTasks", request), func() error {
 		return c.baseStore.AddHistoryTasks(ctx, request)
 	})
 }
@@ -62,7 +62,7 @@ func (c *faultInjectionExecutionStore) AppendHistoryNodes(
 	ctx context.Context,
 	request *persistence.InternalAppendHistoryNodesRequest,
 ) error {
-	return inject0(c.generator.generate("AppendHistoryNodes"), func() error {
+	return inject0(ctx, c.generator.generate("AppendHistoryNodes", request), func() error {
 		return c.baseStore.AppendHistoryNodes(ctx, request)
 	})
 }
@@ -75,7 +75,7 @@ func (c *faultInjectionExecutionStore) CompleteHistoryTask(
 	ctx context.Context,
 	request *persistence.CompleteHistoryTaskRequest,
 ) error {
-	return inject0(c.generator.generate("CompleteHistoryTask"), func() error {
+	return inject0(ctx, c.generator.generate("CompleteHistoryTask", request), func() error {
 		return c.baseStore.CompleteHistoryTask(ctx, request)
 	})
 }
@@ -84,7 +84,7 @@ func (c *faultInjectionExecutionStore) ConflictResolveWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
 ) error {
-	return inject0(c.generator.generate("ConflictResolveWorkflowExecution"), func() error {
+	return inject0(ctx, c.generator.generate("ConflictResolveWorkflowExecution", request), func() error {
 		return c.baseStore.ConflictResolveWorkflowExecution(ctx, request)
 	})
 }
@@ -93,7 +93,7 @@ func (c *faultInjectionExecutionStore) CreateWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalCreateWorkflowExecutionRequest,
 ) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
-	return inject1(c.generator.generate("CreateWorkflowExecution"), func() (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	return inject1(ctx, c.generator.generate("CreateWorkflowExecution", request), func() (*persistence.InternalCreateWorkflowExecutionResponse, error) {
 		return c.baseStore.CreateWorkflowExecution(ctx, request)
 	})
 }
@@ -102,7 +102,7 @@ func (c *faultInjectionExecutionStore) DeleteCurrentWorkflowExecution(
 	ctx context.Context,
 	request *persistence.DeleteCurrentWorkflowExecutionRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteCurrentWorkflowExecution"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteCurrentWorkflowExecution", request), func() error {
 		return c.baseStore.DeleteCurrentWorkflowExecution(ctx, request)
 	})
 }
@@ -111,7 +111,7 @@ func (c *faultInjectionExecutionStore) DeleteHistoryBranch(
 	ctx context.Context,
 	request *persistence.InternalDeleteHistoryBranchRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteHistoryBranch"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteHistoryBranch", request), func() error {
 		return c.baseStore.DeleteHistoryBranch(ctx, request)
 	})
 }
@@ -120,7 +120,7 @@ func (c *faultInjectionExecutionStore) DeleteHistoryNodes(
 	ctx context.Context,
 	request *persistence.InternalDeleteHistoryNodesRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteHistoryNodes"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteHistoryNodes", request), func() error {
 		return c.baseStore.DeleteHistoryNodes(ctx, request)
 	})
 }
@@ -129,7 +129,7 @@ func (c *faultInjectionExecutionStore) DeleteReplicationTaskFromDLQ(
 	ctx context.Context,
 	request *persistence.DeleteReplicationTaskFromDLQRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteReplicationTaskFromDLQ"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteReplicationTaskFromDLQ", request), func() error {
 		return c.baseStore.DeleteReplicationTaskFromDLQ(ctx, request)
 	})
 }
@@ -138,7 +138,7 @@ func (c *faultInjectionExecutionStore) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *persistence.DeleteWorkflowExecutionRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteWorkflowExecution"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteWorkflowExecution", request), func() error {
 		return c.baseStore.DeleteWorkflowExecution(ctx, request)
 	})
 }
@@ -147,7 +147,7 @@ func (c *faultInjectionExecutionStore) ForkHistoryBranch(
 	ctx context.Context,
 	request *persistence.InternalForkHistoryBranchRequest,
 ) error {
-	return inject0(c.generator.generate("ForkHistoryBranch"), func() error {
+	return inject0(ctx, c.generator.generate("ForkHistoryBranch", request), func() error {
 		return c.baseStore.ForkHistoryBranch(ctx, request)
 	})
 }
@@ -156,7 +156,7 @@ func (c *faultInjectionExecutionStore) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *persistence.GetAllHistoryTreeBranchesRequest,
 ) (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
-	return inject1(c.generator.generate("GetAllHistoryTreeBranches"), func() (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
+	return inject1(ctx, c.generator.generate("GetAllHistoryTreeBranches", request), func() (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
 		return c.baseStore.GetAllHistoryTreeBranches(ctx, request)
 	})
 }
@@ -165,7 +165,7 @@ func (c *faultInjectionExecutionStore) GetCurrentExecution(
 	ctx context.Context,
 	request *persistence.GetCurrentExecutionRequest,
 ) (*persistence.InternalGetCurrentExecutionResponse, error) {
-	return inject1(c.generator.generate("GetCurrentExecution"), func() (*persistence.InternalGetCurrentExecutionResponse, error) {
+	return inject1(ctx, c.generator.generate("GetCurrentExecution", request), func() (*persistence.InternalGetCurrentExecutionResponse, error) {
 		return c.baseStore.GetCurrentExecution(ctx, request)
 	})
 }
@@ -178,7 +178,7 @@ func (c *faultInjectionExecutionStore) GetHistoryTasks(
 	ctx context.Context,
 	request *persistence.GetHistoryTasksRequest,
 ) (*persistence.InternalGetHistoryTasksResponse, error) {
-	return inject1(c.generator.generate("GetHistoryTasks"), func() (*persistence.InternalGetHistoryTasksResponse, error) {
+	return inject1(ctx, c.generator.generate("GetHistoryTasks", request), func() (*persistence.InternalGetHistoryTasksResponse, error) {
 		return c.baseStore.GetHistoryTasks(ctx, request)
 	})
 }
@@ -187,7 +187,7 @@ func (c *faultInjectionExecutionStore) GetHistoryTreeContainingBranch(
 	ctx context.Context,
 	request *persistence.InternalGetHistoryTreeContainingBranchRequest,
 ) (*persistence.InternalGetHistoryTreeContainingBranchResponse, error) {
-	return inject1(c.generator.generate("GetHistoryTreeContainingBranch"), func() (*persistence.InternalGetHistoryTreeContainingBranchResponse, error) {
+	return inject1(ctx, c.generator.generate("GetHistoryTreeContainingBranch", request), func() (*persistence.InternalGetHistoryTreeContainingBranchResponse, error) {
 		return c.baseStore.GetHistoryTreeContainingBranch(ctx, request)
 	})
 }
@@ -200,7 +200,7 @@ func (c *faultInjectionExecutionStore) GetReplicationTasksFromDLQ(
 	ctx context.Context,
 	request *persistence.GetReplicationTasksFromDLQRequest,
 ) (*persistence.InternalGetHistoryTasksResponse, error) {
-	return inject1(c.generator.generate("GetReplicationTasksFromDLQ"), func() (*persistence.InternalGetHistoryTasksResponse, error) {
+	return inject1(ctx, c.generator.generate("GetReplicationTasksFromDLQ", request), func() (*persistence.InternalGetHistoryTasksResponse, error) {
 		return c.baseStore.GetReplicationTasksFromDLQ(ctx, request)
 	})
 }
@@ -209,7 +209,7 @@ func (c *faultInjectionExecutionStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *persistence.GetWorkflowExecutionRequest,
 ) (*persistence.InternalGetWorkflowExecutionResponse, error) {
-	return inject1(c.generator.generate("GetWorkflowExecution"), func() (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	return inject1(ctx, c.generator.generate("GetWorkflowExecution", request), func() (*persistence.InternalGetWorkflowExecutionResponse, error) {
 		return c.baseStore.GetWorkflowExecution(ctx, request)
 	})
 }
@@ -218,7 +218,7 @@ func (c *faultInjectionExecutionStore) IsReplicationDLQEmpty(
 	ctx context.Context,
 	request *persistence.GetReplicationTasksFromDLQRequest,
 ) (bool, error) {
-	return inject1(c.generator.generate("IsReplicationDLQEmpty"), func() (bool, error) {
+	return inject1(ctx, c.generator.generate("IsReplicationDLQEmpty", request), func() (bool, error) {
 		return c.baseStore.IsReplicationDLQEmpty(ctx, request)
 	})
 }
@@ -227,7 +227,7 @@ func (c *faultInjectionExecutionStore) ListConcreteExecutions(
 	ctx context.Context,
 	request *persistence.ListConcreteExecutionsRequest,
 ) (*persistence.InternalListConcreteExecutionsResponse, error) {
-	return inject1(c.generator.generate("ListConcreteExecutions"), func() (*persistence.InternalListConcreteExecutionsResponse, error) {
+	return inject1(ctx, c.generator.generate("ListConcreteExecutions", request), func() (*persistence.InternalListConcreteExecutionsResponse, error) {
 		return c.baseStore.ListConcreteExecutions(ctx, request)
 	})
 }
@@ -236,7 +236,7 @@ func (c *faultInjectionExecutionStore) PutReplicationTaskToDLQ(
 	ctx context.Context,
 	request *persistence.PutReplicationTaskToDLQRequest,
 ) error {
-	return inject0(c.generator.generate("PutReplicationTaskToDLQ"), func() error {
+	return inject0(ctx, c.generator.generate("PutReplicationTaskToDLQ", request), func() error {
 		return c.baseStore.PutReplicationTaskToDLQ(ctx, request)
 	})
 }
@@ -245,7 +245,7 @@ func (c *faultInjectionExecutionStore) RangeCompleteHistoryTasks(
 	ctx context.Context,
 	request *persistence.RangeCompleteHistoryTasksRequest,
 ) error {
-	return inject0(c.generator.generate("RangeCompleteHistoryTasks"), func() error {
+	return inject0(ctx, c.generator.generate("RangeCompleteHistoryTasks", request), func() error {
 		return c.baseStore.RangeCompleteHistoryTasks(ctx, request)
 	})
 }
@@ -254,7 +254,7 @@ func (c *faultInjectionExecutionStore) RangeDeleteReplicationTaskFromDLQ(
 	ctx context.Context,
 	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
 ) error {
-	return inject0(c.generator.generate("RangeDeleteReplicationTaskFromDLQ"), func() error {
+	return inject0(ctx, c.generator.generate("RangeDeleteReplicationTaskFromDLQ", request), func() error {
 		return c.baseStore.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 	})
 }
@@ -263,7 +263,7 @@ func (c *faultInjectionExecutionStore) ReadHistoryBranch(
 	ctx context.Context,
 	request *persistence.InternalReadHistoryBranchRequest,
 ) (*persistence.InternalReadHistoryBranchResponse, error) {
-	return inject1(c.generator.generate("ReadHistoryBranch"), func() (*persistence.InternalReadHistoryBranchResponse, error) {
+	return inject1(ctx, c.generator.generate("ReadHistoryBranch", request), func() (*persistence.InternalReadHistoryBranchResponse, error) {
 		return c.baseStore.ReadHistoryBranch(ctx, request)
 	})
 }
@@ -272,7 +272,7 @@ func (c *faultInjectionExecutionStore) SetWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalSetWorkflowExecutionRequest,
 ) error {
-	return inject0(c.generator.generate("SetWorkflowExecution"), func() error {
+	return inject0(ctx, c.generator.generate("SetWorkflowExecution", request), func() error {
 		return c.baseStore.SetWorkflowExecution(ctx, request)
 	})
 }
@@ -281,7 +281,7 @@ func (c *faultInjectionExecutionStore) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalUpdateWorkflowExecutionRequest,
 ) error {
-	return inject0(c.generator.generate("UpdateWorkflowExecution"), func() error {
+	return inject0(ctx, c.generator.generate("UpdateWorkflowExecution", request), func() error {
 		return c.baseStore.UpdateWorkflowExecution(ctx, request)
 	})
 }
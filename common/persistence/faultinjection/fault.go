@@ -27,12 +27,19 @@ package faultinjection
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/persistence"
 )
 
+const (
+	// executeAndPrefix is the prefix of error names which execute the operation before returning the error.
+	executeAndPrefix = "ExecuteAnd"
+)
+
 type (
 	fault struct {
 		err error
@@ -40,6 +47,9 @@ type (
 		execOp bool
 		// How often this fault should be injected. 0.0 means never, 1.0 means always.
 		rate float64
+		// latency is the delay before the operation is executed or the error is returned.
+		latency    time.Duration
+		methodName string
 	}
 )
 
@@ -50,76 +60,106 @@ func newFaultFromError(err error, rate float64) fault {
 	}
 }
 
-// newFault returns an error based on the provided name. If the name is not recognized, then this method will
-// panic.
-func newFault(errName string, errRate float64, methodName string) fault {
+// newFault returns an error based on the provided name. If the name is not recognized, then an error is returned.
+func newFault(errName string, errRate float64, methodName string) (fault, error) {
+	if name, ok := strings.CutPrefix(errName, executeAndPrefix); ok {
+		// Special errors which emulate case, when caller got an error (e.g. Timeout),
+		// but operation actually reached persistence and was executed successfully.
+		f, err := newFault(name, errRate, methodName)
+		if err != nil {
+			return fault{}, fmt.Errorf("unsupported error type: %v", errName)
+		}
+		f.execOp = true
+		return f, nil
+	}
+
 	header := fmt.Sprintf("fault injection error at %s with %.2f rate", methodName, errRate)
 	switch errName {
 	case "ShardOwnershipLost":
-		return newFaultFromError(&persistence.ShardOwnershipLostError{Msg: fmt.Sprintf("%s: persistence.ShardOwnershipLostError", header)}, errRate)
+		return newFaultFromError(&persistence.ShardOwnershipLostError{Msg: fmt.Sprintf("%s: persistence.ShardOwnershipLostError", header)}, errRate), nil
 	case "DeadlineExceeded":
 		// Real persistence store never returns context.DeadlineExceeded error. It returns persistence.TimeoutError instead.
 		// Therefor "DeadlineExceeded" shouldn't be used with fault injection. Use "Timeout" instead.
-		return newFaultFromError(fmt.Errorf("%s: %w", header, context.DeadlineExceeded), errRate)
+		return newFaultFromError(fmt.Errorf("%s: %w", header, context.DeadlineExceeded), errRate), nil
 	case "Timeout":
-		return newFaultFromError(&persistence.TimeoutError{Msg: fmt.Sprintf("%s: persistence.TimeoutError", header)}, errRate)
-	case "ExecuteAndTimeout":
-		// Special error which emulates case, when caller got a Timeout error,
-		// but operation actually reached persistence and was executed successfully.
-		f := newFaultFromError(&persistence.TimeoutError{Msg: fmt.Sprintf("%s: persistence.TimeoutError", header)}, errRate)
-		f.execOp = true
-		return f
+		return newFaultFromError(&persistence.TimeoutError{Msg: fmt.Sprintf("%s: persistence.TimeoutError", header)}, errRate), nil
 	case "ResourceExhausted":
 		return newFaultFromError(&serviceerror.ResourceExhausted{
 			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED,
 			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_SYSTEM,
 			Message: fmt.Sprintf("%s: serviceerror.ResourceExhausted", header),
-		}, errRate)
+		}, errRate), nil
 	case "Unavailable":
-		return newFaultFromError(serviceerror.NewUnavailable(fmt.Sprintf("%s: serviceerror.Unavailable", header)), errRate)
+		return newFaultFromError(serviceerror.NewUnavailable(fmt.Sprintf("%s: serviceerror.Unavailable", header)), errRate), nil
 	default:
-		panic(fmt.Sprintf("unsupported error type: %v", errName))
+		return fault{}, fmt.Errorf("unsupported error type: %v", errName)
+	}
+}
+
+// delay waits for the latency of the fault. It returns a persistence.TimeoutError if ctx expires first, like a
+// real persistence store would.
+func (f *fault) delay(ctx context.Context) error {
+	if f.latency <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(f.latency)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return &persistence.TimeoutError{Msg: fmt.Sprintf("fault injection latency of %v at %s: %v", f.latency, f.methodName, ctx.Err())}
 	}
 }
 
 // Not receiver on a *fault because of generics.
-func inject0(f *fault, op func() error) error {
+func inject0(ctx context.Context, f *fault, op func() error) error {
 	if f == nil {
 		return op()
 	}
-	if f.execOp {
+	if err := f.delay(ctx); err != nil {
+		return err
+	}
+	if f.err == nil || f.execOp {
 		err := op()
-		if err != nil {
+		if err != nil || f.err == nil {
 			return err
 		}
 	}
 	return f.err
 }
 
-func inject1[T1 any](f *fault, op func() (T1, error)) (T1, error) {
+func inject1[T1 any](ctx context.Context, f *fault, op func() (T1, error)) (T1, error) {
+	var nilT1 T1
 	if f == nil {
 		return op()
 	}
-	if f.execOp {
+	if err := f.delay(ctx); err != nil {
+		return nilT1, err
+	}
+	if f.err == nil || f.execOp {
 		r1, err := op()
-		if err != nil {
+		if err != nil || f.err == nil {
 			return r1, err
 		}
 	}
-	var nilT1 T1
 	return nilT1, f.err
 }
-func inject2[T1 any, T2 any](f *fault, op func() (T1, T2, error)) (T1, T2, error) {
+
+func inject2[T1 any, T2 any](ctx context.Context, f *fault, op func() (T1, T2, error)) (T1, T2, error) {
+	var nilT1 T1
+	var nilT2 T2
 	if f == nil {
 		return op()
 	}
-	if f.execOp {
+	if err := f.delay(ctx); err != nil {
+		return nilT1, nilT2, err
+	}
+	if f.err == nil || f.execOp {
 		r1, r2, err := op()
-		if err != nil {
+		if err != nil || f.err == nil {
 			return r1, r2, err
 		}
 	}
-	var nilT1 T1
-	var nilT2 T2
 	return nilT1, nilT2, f.err
 }
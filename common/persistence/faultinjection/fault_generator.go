@@ -26,6 +26,6 @@ package faultinjection
 
 type (
 	faultGenerator interface {
-		generate(methodName string) *fault
+		generate(methodName string, request any) *fault
 	}
 )
@@ -57,7 +57,7 @@ func (c *faultInjectionMetadataStore) CreateNamespace(
 	ctx context.Context,
 	request *persistence.InternalCreateNamespaceRequest,
 ) (*persistence.CreateNamespaceResponse, error) {
-	return inject1(c.generator.generate("CreateNamespace"), func() (*persistence.CreateNamespaceResponse, error) {
+	return inject1(ctx, c.generator.generate("CreateNamespace", request), func() (*persistence.CreateNamespaceResponse, error) {
 		return c.baseStore.CreateNamespace(ctx, request)
 	})
 }
@@ -66,7 +66,7 @@ func (c *faultInjectionMetadataStore) DeleteNamespace(
 	ctx context.Context,
 	request *persistence.DeleteNamespaceRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteNamespace"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteNamespace", request), func() error {
 		return c.baseStore.DeleteNamespace(ctx, request)
 	})
 }
@@ -75,7 +75,7 @@ func (c *faultInjectionMetadataStore) DeleteNamespaceByName(
 	ctx context.Context,
 	request *persistence.DeleteNamespaceByNameRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteNamespaceByName"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteNamespaceByName", request), func() error {
 		return c.baseStore.DeleteNamespaceByName(ctx, request)
 	})
 }
@@ -94,7 +94,7 @@ func (c *faultInjectionMetadataStore) GetNamespace(
 	ctx context.Context,
 	request *persistence.GetNamespaceRequest,
 ) (*persistence.InternalGetNamespaceResponse, error) {
-	return inject1(c.generator.generate("GetNamespace"), func() (*persistence.InternalGetNamespaceResponse, error) {
+	return inject1(ctx, c.generator.generate("GetNamespace", request), func() (*persistence.InternalGetNamespaceResponse, error) {
 		return c.baseStore.GetNamespace(ctx, request)
 	})
 }
@@ -103,7 +103,7 @@ func (c *faultInjectionMetadataStore) ListNamespaces(
 	ctx context.Context,
 	request *persistence.InternalListNamespacesRequest,
 ) (*persistence.InternalListNamespacesResponse, error) {
-	return inject1(c.generator.generate("ListNamespaces"), func() (*persistence.InternalListNamespacesResponse, error) {
+	return inject1(ctx, c.generator.generate("ListNamespaces", request), func() (*persistence.InternalListNamespacesResponse, error) {
 		return c.baseStore.ListNamespaces(ctx, request)
 	})
 }
@@ -112,7 +112,7 @@ func (c *faultInjectionMetadataStore) RenameNamespace(
 	ctx context.Context,
 	request *persistence.InternalRenameNamespaceRequest,
 ) error {
-	return inject0(c.generator.generate("RenameNamespace"), func() error {
+	return inject0(ctx, c.generator.generate("RenameNamespace", request), func() error {
 		return c.baseStore.RenameNamespace(ctx, request)
 	})
 }
@@ -121,7 +121,7 @@ func (c *faultInjectionMetadataStore) UpdateNamespace(
 	ctx context.Context,
 	request *persistence.InternalUpdateNamespaceRequest,
 ) error {
-	return inject0(c.generator.generate("UpdateNamespace"), func() error {
+	return inject0(ctx, c.generator.generate("UpdateNamespace", request), func() error {
 		return c.baseStore.UpdateNamespace(ctx, request)
 	})
 }
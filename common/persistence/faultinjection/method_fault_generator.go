@@ -25,9 +25,17 @@
 package faultinjection
 
 import (
+	"fmt"
 	"math/rand"
 	"sync"
 	"time"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	latencyDistributionUniform     = "uniform"
+	latencyDistributionExponential = "exponential"
 )
 
 type (
@@ -42,6 +50,9 @@ type (
 
 		rate           float64         // chance for one of the errors for this method to be returned
 		faultsMetadata []faultMetadata // faults with their thresholds that might be generated for this method
+
+		latency config.FaultInjectionLatencyConfig // latency that might be injected into calls of this method
+		target  requestTarget                      // requests this method's faults are limited to
 	}
 )
 
@@ -67,23 +78,83 @@ func newMethodFaultGenerator(faults []fault, seed int64) *methodFaultGenerator {
 	}
 }
 
-func (p *methodFaultGenerator) generate(_ string) *fault {
-	if p.rate <= 0 {
+func validateLatency(latency config.FaultInjectionLatencyConfig) error {
+	if latency.Rate < 0 || latency.Rate > 1 {
+		return fmt.Errorf("latency rate must be between 0 and 1: %v", latency.Rate)
+	}
+	switch latency.Distribution {
+	case "", latencyDistributionUniform:
+	case latencyDistributionExponential:
+		if latency.Mean <= 0 {
+			return fmt.Errorf("latency mean must be positive for %s distribution", latencyDistributionExponential)
+		}
+	default:
+		return fmt.Errorf("unsupported latency distribution: %v", latency.Distribution)
+	}
+	return nil
+}
+
+func (p *methodFaultGenerator) generate(methodName string, request any) *fault {
+	if p.rate <= 0 && p.latency.Rate <= 0 {
+		return nil
+	}
+	if !p.target.matches(request) {
 		return nil
 	}
 
-	p.rndMu.Lock()
-	roll := p.rnd.Float64()
-	p.rndMu.Unlock()
-
-	if roll < p.rate {
-		// Yes, this method call should be failed.
-		// Let's find out with what fault.
-		for i := range p.faultsMetadata {
-			if roll < p.faultsMetadata[i].threshold {
-				return &p.faultsMetadata[i].fault
+	var f *fault
+	if p.rate > 0 {
+		p.rndMu.Lock()
+		roll := p.rnd.Float64()
+		p.rndMu.Unlock()
+
+		if roll < p.rate {
+			// Yes, this method call should be failed.
+			// Let's find out with what fault.
+			for i := range p.faultsMetadata {
+				if roll < p.faultsMetadata[i].threshold {
+					f = &p.faultsMetadata[i].fault
+					break
+				}
 			}
 		}
 	}
-	return nil
+
+	if latency := p.sampleLatency(); latency > 0 {
+		// Faults are shared between calls, so the latency goes to a copy.
+		var delayed fault
+		if f != nil {
+			delayed = *f
+		}
+		delayed.latency = latency
+		delayed.methodName = methodName
+		f = &delayed
+	}
+	return f
+}
+
+func (p *methodFaultGenerator) sampleLatency() time.Duration {
+	if p.latency.Rate <= 0 {
+		return 0
+	}
+
+	p.rndMu.Lock()
+	defer p.rndMu.Unlock()
+	if p.rnd.Float64() >= p.latency.Rate {
+		return 0
+	}
+	minLatency, maxLatency := p.latency.Min, p.latency.Max
+	switch p.latency.Distribution {
+	case latencyDistributionExponential:
+		latency := minLatency + time.Duration(p.rnd.ExpFloat64()*float64(p.latency.Mean))
+		if maxLatency > minLatency && latency > maxLatency {
+			latency = maxLatency
+		}
+		return latency
+	default:
+		if maxLatency <= minLatency {
+			return minLatency
+		}
+		return minLatency + time.Duration(p.rnd.Int63n(int64(maxLatency-minLatency)+1))
+	}
 }
@@ -57,7 +57,7 @@ func (c *faultInjectionNexusEndpointStore) CreateOrUpdateNexusEndpoint(
 	ctx context.Context,
 	request *persistence.InternalCreateOrUpdateNexusEndpointRequest,
 ) error {
-	return inject0(c.generator.generate("CreateOrUpdateNexusEndpoint"), func() error {
+	return inject0(ctx, c.generator.generate("CreateOrUpdateNexusEndpoint", request), func() error {
 		return c.baseStore.CreateOrUpdateNexusEndpoint(ctx, request)
 	})
 }
@@ -66,7 +66,7 @@ func (c *faultInjectionNexusEndpointStore) DeleteNexusEndpoint(
 	ctx context.Context,
 	request *persistence.DeleteNexusEndpointRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteNexusEndpoint"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteNexusEndpoint", request), func() error {
 		return c.baseStore.DeleteNexusEndpoint(ctx, request)
 	})
 }
@@ -79,7 +79,7 @@ func (c *faultInjectionNexusEndpointStore) GetNexusEndpoint(
 	ctx context.Context,
 	request *persistence.GetNexusEndpointRequest,
 ) (*persistence.InternalNexusEndpoint, error) {
-	return inject1(c.generator.generate("GetNexusEndpoint"), func() (*persistence.InternalNexusEndpoint, error) {
+	return inject1(ctx, c.generator.generate("GetNexusEndpoint", request), func() (*persistence.InternalNexusEndpoint, error) {
 		return c.baseStore.GetNexusEndpoint(ctx, request)
 	})
 }
@@ -88,7 +88,7 @@ func (c *faultInjectionNexusEndpointStore) ListNexusEndpoints(
 	ctx context.Context,
 	request *persistence.ListNexusEndpointsRequest,
 ) (*persistence.InternalListNexusEndpointsResponse, error) {
-	return inject1(c.generator.generate("ListNexusEndpoints"), func() (*persistence.InternalListNexusEndpointsResponse, error) {
+	return inject1(ctx, c.generator.generate("ListNexusEndpoints", request), func() (*persistence.InternalListNexusEndpointsResponse, error) {
 		return c.baseStore.ListNexusEndpoints(ctx, request)
 	})
 }
@@ -58,7 +58,7 @@ func (c *faultInjectionQueue) DeleteMessageFromDLQ(
 	ctx context.Context,
 	p1 int64,
 ) error {
-	return inject0(c.generator.generate("DeleteMessageFromDLQ"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteMessageFromDLQ", nil), func() error {
 		return c.baseStore.DeleteMessageFromDLQ(ctx, p1)
 	})
 }
@@ -67,7 +67,7 @@ func (c *faultInjectionQueue) DeleteMessagesBefore(
 	ctx context.Context,
 	p1 int64,
 ) error {
-	return inject0(c.generator.generate("DeleteMessagesBefore"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteMessagesBefore", nil), func() error {
 		return c.baseStore.DeleteMessagesBefore(ctx, p1)
 	})
 }
@@ -76,7 +76,7 @@ func (c *faultInjectionQueue) EnqueueMessage(
 	ctx context.Context,
 	p1 *common.DataBlob,
 ) error {
-	return inject0(c.generator.generate("EnqueueMessage"), func() error {
+	return inject0(ctx, c.generator.generate("EnqueueMessage", nil), func() error {
 		return c.baseStore.EnqueueMessage(ctx, p1)
 	})
 }
@@ -85,7 +85,7 @@ func (c *faultInjectionQueue) EnqueueMessageToDLQ(
 	ctx context.Context,
 	p1 *common.DataBlob,
 ) (int64, error) {
-	return inject1(c.generator.generate("EnqueueMessageToDLQ"), func() (int64, error) {
+	return inject1(ctx, c.generator.generate("EnqueueMessageToDLQ", nil), func() (int64, error) {
 		return c.baseStore.EnqueueMessageToDLQ(ctx, p1)
 	})
 }
@@ -106,7 +106,7 @@ func (c *faultInjectionQueue) Init(
 	ctx context.Context,
 	p1 *common.DataBlob,
 ) error {
-	return inject0(c.generator.generate("Init"), func() error {
+	return inject0(ctx, c.generator.generate("Init", nil), func() error {
 		return c.baseStore.Init(ctx, p1)
 	})
 }
@@ -116,7 +116,7 @@ func (c *faultInjectionQueue) RangeDeleteMessagesFromDLQ(
 	p1 int64,
 	p2 int64,
 ) error {
-	return inject0(c.generator.generate("RangeDeleteMessagesFromDLQ"), func() error {
+	return inject0(ctx, c.generator.generate("RangeDeleteMessagesFromDLQ", nil), func() error {
 		return c.baseStore.RangeDeleteMessagesFromDLQ(ctx, p1, p2)
 	})
 }
@@ -126,7 +126,7 @@ func (c *faultInjectionQueue) ReadMessages(
 	p1 int64,
 	p2 int,
 ) ([]*persistence.QueueMessage, error) {
-	return inject1(c.generator.generate("ReadMessages"), func() ([]*persistence.QueueMessage, error) {
+	return inject1(ctx, c.generator.generate("ReadMessages", nil), func() ([]*persistence.QueueMessage, error) {
 		return c.baseStore.ReadMessages(ctx, p1, p2)
 	})
 }
@@ -138,7 +138,7 @@ func (c *faultInjectionQueue) ReadMessagesFromDLQ(
 	p3 int,
 	p4 []uint8,
 ) ([]*persistence.QueueMessage, []uint8, error) {
-	return inject2(c.generator.generate("ReadMessagesFromDLQ"), func() ([]*persistence.QueueMessage, []uint8, error) {
+	return inject2(ctx, c.generator.generate("ReadMessagesFromDLQ", nil), func() ([]*persistence.QueueMessage, []uint8, error) {
 		return c.baseStore.ReadMessagesFromDLQ(ctx, p1, p2, p3, p4)
 	})
 }
@@ -147,7 +147,7 @@ func (c *faultInjectionQueue) UpdateAckLevel(
 	ctx context.Context,
 	p1 *persistence.InternalQueueMetadata,
 ) error {
-	return inject0(c.generator.generate("UpdateAckLevel"), func() error {
+	return inject0(ctx, c.generator.generate("UpdateAckLevel", nil), func() error {
 		return c.baseStore.UpdateAckLevel(ctx, p1)
 	})
 }
@@ -156,7 +156,7 @@ func (c *faultInjectionQueue) UpdateDLQAckLevel(
 	ctx context.Context,
 	p1 *persistence.InternalQueueMetadata,
 ) error {
-	return inject0(c.generator.generate("UpdateDLQAckLevel"), func() error {
+	return inject0(ctx, c.generator.generate("UpdateDLQAckLevel", nil), func() error {
 		return c.baseStore.UpdateDLQAckLevel(ctx, p1)
 	})
 }
@@ -53,7 +53,7 @@ func (c *faultInjectionQueueV2) CreateQueue(
 	ctx context.Context,
 	request *persistence.InternalCreateQueueRequest,
 ) (*persistence.InternalCreateQueueResponse, error) {
-	return inject1(c.generator.generate("CreateQueue"), func() (*persistence.InternalCreateQueueResponse, error) {
+	return inject1(ctx, c.generator.generate("CreateQueue", request), func() (*persistence.InternalCreateQueueResponse, error) {
 		return c.baseStore.CreateQueue(ctx, request)
 	})
 }
@@ -62,7 +62,7 @@ func (c *faultInjectionQueueV2) EnqueueMessage(
 	ctx context.Context,
 	request *persistence.InternalEnqueueMessageRequest,
 ) (*persistence.InternalEnqueueMessageResponse, error) {
-	return inject1(c.generator.generate("EnqueueMessage"), func() (*persistence.InternalEnqueueMessageResponse, error) {
+	return inject1(ctx, c.generator.generate("EnqueueMessage", request), func() (*persistence.InternalEnqueueMessageResponse, error) {
 		return c.baseStore.EnqueueMessage(ctx, request)
 	})
 }
@@ -71,7 +71,7 @@ func (c *faultInjectionQueueV2) ListQueues(
 	ctx context.Context,
 	request *persistence.InternalListQueuesRequest,
 ) (*persistence.InternalListQueuesResponse, error) {
-	return inject1(c.generator.generate("ListQueues"), func() (*persistence.InternalListQueuesResponse, error) {
+	return inject1(ctx, c.generator.generate("ListQueues", request), func() (*persistence.InternalListQueuesResponse, error) {
 		return c.baseStore.ListQueues(ctx, request)
 	})
 }
@@ -80,7 +80,7 @@ func (c *faultInjectionQueueV2) RangeDeleteMessages(
 	ctx context.Context,
 	request *persistence.InternalRangeDeleteMessagesRequest,
 ) (*persistence.InternalRangeDeleteMessagesResponse, error) {
-	return inject1(c.generator.generate("RangeDeleteMessages"), func() (*persistence.InternalRangeDeleteMessagesResponse, error) {
+	return inject1(ctx, c.generator.generate("RangeDeleteMessages", request), func() (*persistence.InternalRangeDeleteMessagesResponse, error) {
 		return c.baseStore.RangeDeleteMessages(ctx, request)
 	})
 }
@@ -89,7 +89,7 @@ func (c *faultInjectionQueueV2) ReadMessages(
 	ctx context.Context,
 	request *persistence.InternalReadMessagesRequest,
 ) (*persistence.InternalReadMessagesResponse, error) {
-	return inject1(c.generator.generate("ReadMessages"), func() (*persistence.InternalReadMessagesResponse, error) {
+	return inject1(ctx, c.generator.generate("ReadMessages", request), func() (*persistence.InternalReadMessagesResponse, error) {
 		return c.baseStore.ReadMessages(ctx, request)
 	})
 }
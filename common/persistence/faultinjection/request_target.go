@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjection
+
+import (
+	"reflect"
+	"sync"
+)
+
+const (
+	// requestTargetMaxDepth is how deep into the structs of a request shard IDs and namespace IDs are looked up, e.g.
+	// InternalCreateWorkflowExecutionRequest has the namespace ID in its NewWorkflowSnapshot.
+	requestTargetMaxDepth = 2
+)
+
+type (
+	// requestTarget limits fault injection to requests for some shards and namespaces.
+	requestTarget struct {
+		shardIDs     map[int32]struct{}
+		namespaceIDs map[string]struct{}
+	}
+
+	// requestFieldPaths are the field index paths of the shard ID and namespace ID of a request type.
+	requestFieldPaths struct {
+		shardID     []int
+		namespaceID []int
+	}
+)
+
+var requestFieldPathsCache sync.Map // reflect.Type -> requestFieldPaths
+
+func newRequestTarget(shardIDs []int32, namespaceIDs []string) requestTarget {
+	var t requestTarget
+	if len(shardIDs) > 0 {
+		t.shardIDs = make(map[int32]struct{}, len(shardIDs))
+		for _, shardID := range shardIDs {
+			t.shardIDs[shardID] = struct{}{}
+		}
+	}
+	if len(namespaceIDs) > 0 {
+		t.namespaceIDs = make(map[string]struct{}, len(namespaceIDs))
+		for _, namespaceID := range namespaceIDs {
+			t.namespaceIDs[namespaceID] = struct{}{}
+		}
+	}
+	return t
+}
+
+func (t requestTarget) matches(request any) bool {
+	if t.shardIDs == nil && t.namespaceIDs == nil {
+		return true
+	}
+	v := reflect.ValueOf(request)
+	if !v.IsValid() {
+		return false
+	}
+	paths := getRequestFieldPaths(v.Type())
+	if t.shardIDs != nil {
+		field, ok := fieldByPath(v, paths.shardID)
+		if !ok {
+			return false
+		}
+		if _, ok := t.shardIDs[int32(field.Int())]; !ok {
+			return false
+		}
+	}
+	if t.namespaceIDs != nil {
+		field, ok := fieldByPath(v, paths.namespaceID)
+		if !ok {
+			return false
+		}
+		if _, ok := t.namespaceIDs[field.String()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func getRequestFieldPaths(requestType reflect.Type) requestFieldPaths {
+	if paths, ok := requestFieldPathsCache.Load(requestType); ok {
+		return paths.(requestFieldPaths)
+	}
+	paths := requestFieldPaths{
+		shardID:     findField(requestType, "ShardID", reflect.Int32, requestTargetMaxDepth),
+		namespaceID: findField(requestType, "NamespaceID", reflect.String, requestTargetMaxDepth),
+	}
+	requestFieldPathsCache.Store(requestType, paths)
+	return paths
+}
+
+// findField returns the index path of the shallowest field with the given name and kind, or nil if there is none.
+func findField(t reflect.Type, name string, kind reflect.Kind, depth int) []int {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	if field, ok := t.FieldByName(name); ok && field.Type.Kind() == kind {
+		return field.Index
+	}
+	if depth == 0 {
+		return nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		if path := findField(t.Field(i).Type, name, kind, depth-1); path != nil {
+			return append([]int{i}, path...)
+		}
+	}
+	return nil
+}
+
+// fieldByPath is like reflect.Value.FieldByIndex, but returns false instead of panicking on nil pointers.
+func fieldByPath(v reflect.Value, path []int) (reflect.Value, bool) {
+	if path == nil {
+		return reflect.Value{}, false
+	}
+	for _, i := range path {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
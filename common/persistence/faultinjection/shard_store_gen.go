@@ -53,7 +53,7 @@ func (c *faultInjectionShardStore) AssertShardOwnership(
 	ctx context.Context,
 	request *persistence.AssertShardOwnershipRequest,
 ) error {
-	return inject0(c.generator.generate("AssertShardOwnership"), func() error {
+	return inject0(ctx, c.generator.generate("AssertShardOwnership", request), func() error {
 		return c.baseStore.AssertShardOwnership(ctx, request)
 	})
 }
@@ -74,7 +74,7 @@ func (c *faultInjectionShardStore) GetOrCreateShard(
 	ctx context.Context,
 	request *persistence.InternalGetOrCreateShardRequest,
 ) (*persistence.InternalGetOrCreateShardResponse, error) {
-	return inject1(c.generator.generate("GetOrCreateShard"), func() (*persistence.InternalGetOrCreateShardResponse, error) {
+	return inject1(ctx, c.generator.generate("GetOrCreateShard", request), func() (*persistence.InternalGetOrCreateShardResponse, error) {
 		return c.baseStore.GetOrCreateShard(ctx, request)
 	})
 }
@@ -83,7 +83,7 @@ func (c *faultInjectionShardStore) UpdateShard(
 	ctx context.Context,
 	request *persistence.InternalUpdateShardRequest,
 ) error {
-	return inject0(c.generator.generate("UpdateShard"), func() error {
+	return inject0(ctx, c.generator.generate("UpdateShard", request), func() error {
 		return c.baseStore.UpdateShard(ctx, request)
 	})
 }
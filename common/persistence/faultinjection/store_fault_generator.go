@@ -25,6 +25,8 @@
 package faultinjection
 
 import (
+	"fmt"
+
 	"go.temporal.io/server/common/config"
 )
 
@@ -36,30 +38,56 @@ type (
 	}
 )
 
+// newStoreFaultGenerators returns data store error generators for the data stores with configured methods.
+func newStoreFaultGenerators(targets config.FaultInjectionTargets) (map[config.DataStoreName]*storeFaultGenerator, error) {
+	generators := make(map[config.DataStoreName]*storeFaultGenerator, len(targets.DataStores))
+	for storeName, storeConfig := range targets.DataStores {
+		if len(storeConfig.Methods) == 0 {
+			continue
+		}
+		generator, err := newStoreFaultGenerator(&storeConfig)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", storeName, err)
+		}
+		generators[storeName] = generator
+	}
+	return generators, nil
+}
+
 // newStoreFaultGenerator returns a new instance of a data store error generator that will inject errors
 // into the persistence layer based on the provided configuration.
-func newStoreFaultGenerator(cfg *config.FaultInjectionDataStoreConfig) *storeFaultGenerator {
+func newStoreFaultGenerator(cfg *config.FaultInjectionDataStoreConfig) (*storeFaultGenerator, error) {
 	methodFaultGenerators := make(map[string]faultGenerator, len(cfg.Methods))
 	for methodName, methodConfig := range cfg.Methods {
 		var faults []fault
 		for errName, errRate := range methodConfig.Errors {
-			faults = append(faults, newFault(errName, errRate, methodName))
+			f, err := newFault(errName, errRate, methodName)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", methodName, err)
+			}
+			faults = append(faults, f)
 		}
-		methodFaultGenerators[methodName] = newMethodFaultGenerator(faults, methodConfig.Seed)
+		if err := validateLatency(methodConfig.Latency); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodName, err)
+		}
+		generator := newMethodFaultGenerator(faults, methodConfig.Seed)
+		generator.latency = methodConfig.Latency
+		generator.target = newRequestTarget(methodConfig.ShardIDs, methodConfig.NamespaceIDs)
+		methodFaultGenerators[methodName] = generator
 	}
 	return &storeFaultGenerator{
 		methodFaultGenerators: methodFaultGenerators,
-	}
+	}, nil
 }
 
 // Generate returns an error from the configured error types and rates for this method.
 // If no errors are configured for the method, or if there are some errors configured for this method,
 // but no error is sampled, then this method returns nil.
 // When this method returns nil, this causes the persistence layer to use the real implementation.
-func (d *storeFaultGenerator) generate(methodName string) *fault {
+func (d *storeFaultGenerator) generate(methodName string, request any) *fault {
 	methodGenerator, ok := d.methodFaultGenerators[methodName]
 	if !ok {
 		return nil
 	}
-	return methodGenerator.generate(methodName)
+	return methodGenerator.generate(methodName, request)
 }
@@ -28,11 +28,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/mock"
 	"go.uber.org/mock/gomock"
@@ -49,7 +51,7 @@ func TestFaultInjection_DataStoreFactory_CreateErr(t *testing.T) {
 	errCreate := errors.New("error creating QueueV2")
 	dataStoreFactory.EXPECT().NewQueueV2().Return(nil, errCreate)
 
-	factory := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, dataStoreFactory)
+	factory := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, nil, dataStoreFactory, log.NewNoopLogger())
 
 	_, err := factory.NewQueueV2()
 	assert.ErrorIs(t, err, errCreate)
@@ -115,7 +117,7 @@ func TestFaultInjection_Inject(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			baseFactory := mock.NewMockDataStoreFactory(ctrl)
-			factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory)
+			factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory, log.NewNoopLogger())
 			baseQueue := mock.NewMockQueueV2(ctrl)
 			baseFactory.EXPECT().NewQueueV2().Return(baseQueue, nil)
 
@@ -168,7 +170,7 @@ func TestFaultInjection_StoreNotConfigured(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	baseFactory := mock.NewMockDataStoreFactory(ctrl)
-	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory)
+	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory, log.NewNoopLogger())
 	baseQueue := mock.NewMockQueueV2(ctrl)
 	baseFactory.EXPECT().NewQueueV2().Return(baseQueue, nil)
 
@@ -190,3 +192,167 @@ func TestFaultInjection_StoreNotConfigured(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp2)
 }
+
+func TestFaultInjection_Latency(t *testing.T) {
+	t.Parallel()
+
+	faultInjectionConfig := &config.FaultInjection{
+		Targets: config.FaultInjectionTargets{
+			DataStores: map[config.DataStoreName]config.FaultInjectionDataStoreConfig{
+				config.ShardStoreName: {
+					Methods: map[string]config.FaultInjectionMethodConfig{
+						"UpdateShard": {
+							Latency: config.FaultInjectionLatencyConfig{
+								Rate: 1,
+								Min:  50 * time.Millisecond,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	baseFactory := mock.NewMockDataStoreFactory(ctrl)
+	baseShardStore := mock.NewMockShardStore(ctrl)
+	baseFactory.EXPECT().NewShardStore().Return(baseShardStore, nil)
+	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory, log.NewNoopLogger())
+	s, err := factory.NewShardStore()
+	require.NoError(t, err)
+
+	baseShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	start := time.Now()
+	err = s.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// The base store isn't called if the context expires during the delay.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err = s.UpdateShard(ctx, &persistence.InternalUpdateShardRequest{})
+	var timeoutErr *persistence.TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+}
+
+func TestFaultInjection_ExecuteAnd(t *testing.T) {
+	t.Parallel()
+
+	faultInjectionConfig := &config.FaultInjection{
+		Targets: config.FaultInjectionTargets{
+			DataStores: map[config.DataStoreName]config.FaultInjectionDataStoreConfig{
+				config.ShardStoreName: {
+					Methods: map[string]config.FaultInjectionMethodConfig{
+						"UpdateShard": {
+							Errors: map[string]float64{"ExecuteAndUnavailable": 1},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	baseFactory := mock.NewMockDataStoreFactory(ctrl)
+	baseShardStore := mock.NewMockShardStore(ctrl)
+	baseFactory.EXPECT().NewShardStore().Return(baseShardStore, nil)
+	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory, log.NewNoopLogger())
+	s, err := factory.NewShardStore()
+	require.NoError(t, err)
+
+	baseShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	err = s.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{})
+	var unavailableErr *serviceerror.Unavailable
+	assert.ErrorAs(t, err, &unavailableErr)
+}
+
+func TestFaultInjection_Targets(t *testing.T) {
+	t.Parallel()
+
+	faultInjectionConfig := &config.FaultInjection{
+		Targets: config.FaultInjectionTargets{
+			DataStores: map[config.DataStoreName]config.FaultInjectionDataStoreConfig{
+				config.ExecutionStoreName: {
+					Methods: map[string]config.FaultInjectionMethodConfig{
+						"CreateWorkflowExecution": {
+							Errors:       map[string]float64{"Timeout": 1},
+							ShardIDs:     []int32{1},
+							NamespaceIDs: []string{"target-namespace-id"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	baseFactory := mock.NewMockDataStoreFactory(ctrl)
+	baseExecutionStore := mock.NewMockExecutionStore(ctrl)
+	baseFactory.EXPECT().NewExecutionStore().Return(baseExecutionStore, nil)
+	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory, log.NewNoopLogger())
+	e, err := factory.NewExecutionStore()
+	require.NoError(t, err)
+
+	newRequest := func(shardID int32, namespaceID string) *persistence.InternalCreateWorkflowExecutionRequest {
+		return &persistence.InternalCreateWorkflowExecutionRequest{
+			ShardID:             shardID,
+			NewWorkflowSnapshot: persistence.InternalWorkflowSnapshot{NamespaceID: namespaceID},
+		}
+	}
+
+	_, err = e.CreateWorkflowExecution(context.Background(), newRequest(1, "target-namespace-id"))
+	var timeoutErr *persistence.TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+
+	baseExecutionStore.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+	_, err = e.CreateWorkflowExecution(context.Background(), newRequest(2, "target-namespace-id"))
+	assert.NoError(t, err)
+	_, err = e.CreateWorkflowExecution(context.Background(), newRequest(1, "other-namespace-id"))
+	assert.NoError(t, err)
+	_, err = e.CreateWorkflowExecution(context.Background(), nil)
+	assert.NoError(t, err)
+}
+
+func TestFaultInjection_DynamicTargets(t *testing.T) {
+	t.Parallel()
+
+	var updateTargets func(config.FaultInjectionTargets)
+	dynamicTargets := func(callback func(config.FaultInjectionTargets)) (config.FaultInjectionTargets, func()) {
+		updateTargets = callback
+		return config.FaultInjectionTargets{}, func() {}
+	}
+	timeoutTargets := func(errName string) config.FaultInjectionTargets {
+		return config.FaultInjectionTargets{
+			DataStores: map[config.DataStoreName]config.FaultInjectionDataStoreConfig{
+				config.ShardStoreName: {
+					Methods: map[string]config.FaultInjectionMethodConfig{
+						"UpdateShard": {Errors: map[string]float64{errName: 1}},
+					},
+				},
+			},
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	baseFactory := mock.NewMockDataStoreFactory(ctrl)
+	baseShardStore := mock.NewMockShardStore(ctrl)
+	baseFactory.EXPECT().NewShardStore().Return(baseShardStore, nil)
+	factory := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, dynamicTargets, baseFactory, log.NewNoopLogger())
+	s, err := factory.NewShardStore()
+	require.NoError(t, err)
+
+	baseShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	assert.NoError(t, s.UpdateShard(context.Background(), nil))
+
+	updateTargets(timeoutTargets("Timeout"))
+	var timeoutErr *persistence.TimeoutError
+	assert.ErrorAs(t, s.UpdateShard(context.Background(), nil), &timeoutErr)
+
+	// Invalid targets are ignored.
+	updateTargets(timeoutTargets("UnknownError"))
+	assert.ErrorAs(t, s.UpdateShard(context.Background(), nil), &timeoutErr)
+
+	// Removing the dynamic targets restores the static ones.
+	updateTargets(config.FaultInjectionTargets{})
+	assert.NoError(t, s.UpdateShard(context.Background(), nil))
+}
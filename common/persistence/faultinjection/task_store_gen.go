@@ -57,7 +57,7 @@ func (c *faultInjectionTaskStore) CompleteTasksLessThan(
 	ctx context.Context,
 	request *persistence.CompleteTasksLessThanRequest,
 ) (int, error) {
-	return inject1(c.generator.generate("CompleteTasksLessThan"), func() (int, error) {
+	return inject1(ctx, c.generator.generate("CompleteTasksLessThan", request), func() (int, error) {
 		return c.baseStore.CompleteTasksLessThan(ctx, request)
 	})
 }
@@ -66,7 +66,7 @@ func (c *faultInjectionTaskStore) CountTaskQueuesByBuildId(
 	ctx context.Context,
 	request *persistence.CountTaskQueuesByBuildIdRequest,
 ) (int, error) {
-	return inject1(c.generator.generate("CountTaskQueuesByBuildId"), func() (int, error) {
+	return inject1(ctx, c.generator.generate("CountTaskQueuesByBuildId", request), func() (int, error) {
 		return c.baseStore.CountTaskQueuesByBuildId(ctx, request)
 	})
 }
@@ -75,7 +75,7 @@ func (c *faultInjectionTaskStore) CreateTaskQueue(
 	ctx context.Context,
 	request *persistence.InternalCreateTaskQueueRequest,
 ) error {
-	return inject0(c.generator.generate("CreateTaskQueue"), func() error {
+	return inject0(ctx, c.generator.generate("CreateTaskQueue", request), func() error {
 		return c.baseStore.CreateTaskQueue(ctx, request)
 	})
 }
@@ -84,7 +84,7 @@ func (c *faultInjectionTaskStore) CreateTasks(
 	ctx context.Context,
 	request *persistence.InternalCreateTasksRequest,
 ) (*persistence.CreateTasksResponse, error) {
-	return inject1(c.generator.generate("CreateTasks"), func() (*persistence.CreateTasksResponse, error) {
+	return inject1(ctx, c.generator.generate("CreateTasks", request), func() (*persistence.CreateTasksResponse, error) {
 		return c.baseStore.CreateTasks(ctx, request)
 	})
 }
@@ -93,7 +93,7 @@ func (c *faultInjectionTaskStore) DeleteTaskQueue(
 	ctx context.Context,
 	request *persistence.DeleteTaskQueueRequest,
 ) error {
-	return inject0(c.generator.generate("DeleteTaskQueue"), func() error {
+	return inject0(ctx, c.generator.generate("DeleteTaskQueue", request), func() error {
 		return c.baseStore.DeleteTaskQueue(ctx, request)
 	})
 }
@@ -106,7 +106,7 @@ func (c *faultInjectionTaskStore) GetTaskQueue(
 	ctx context.Context,
 	request *persistence.InternalGetTaskQueueRequest,
 ) (*persistence.InternalGetTaskQueueResponse, error) {
-	return inject1(c.generator.generate("GetTaskQueue"), func() (*persistence.InternalGetTaskQueueResponse, error) {
+	return inject1(ctx, c.generator.generate("GetTaskQueue", request), func() (*persistence.InternalGetTaskQueueResponse, error) {
 		return c.baseStore.GetTaskQueue(ctx, request)
 	})
 }
@@ -115,7 +115,7 @@ func (c *faultInjectionTaskStore) GetTaskQueueUserData(
 	ctx context.Context,
 	request *persistence.GetTaskQueueUserDataRequest,
 ) (*persistence.InternalGetTaskQueueUserDataResponse, error) {
-	return inject1(c.generator.generate("GetTaskQueueUserData"), func() (*persistence.InternalGetTaskQueueUserDataResponse, error) {
+	return inject1(ctx, c.generator.generate("GetTaskQueueUserData", request), func() (*persistence.InternalGetTaskQueueUserDataResponse, error) {
 		return c.baseStore.GetTaskQueueUserData(ctx, request)
 	})
 }
@@ -124,7 +124,7 @@ func (c *faultInjectionTaskStore) GetTaskQueuesByBuildId(
 	ctx context.Context,
 	request *persistence.GetTaskQueuesByBuildIdRequest,
 ) ([]string, error) {
-	return inject1(c.generator.generate("GetTaskQueuesByBuildId"), func() ([]string, error) {
+	return inject1(ctx, c.generator.generate("GetTaskQueuesByBuildId", request), func() ([]string, error) {
 		return c.baseStore.GetTaskQueuesByBuildId(ctx, request)
 	})
 }
@@ -133,7 +133,7 @@ func (c *faultInjectionTaskStore) GetTasks(
 	ctx context.Context,
 	request *persistence.GetTasksRequest,
 ) (*persistence.InternalGetTasksResponse, error) {
-	return inject1(c.generator.generate("GetTasks"), func() (*persistence.InternalGetTasksResponse, error) {
+	return inject1(ctx, c.generator.generate("GetTasks", request), func() (*persistence.InternalGetTasksResponse, error) {
 		return c.baseStore.GetTasks(ctx, request)
 	})
 }
@@ -142,7 +142,7 @@ func (c *faultInjectionTaskStore) ListTaskQueue(
 	ctx context.Context,
 	request *persistence.ListTaskQueueRequest,
 ) (*persistence.InternalListTaskQueueResponse, error) {
-	return inject1(c.generator.generate("ListTaskQueue"), func() (*persistence.InternalListTaskQueueResponse, error) {
+	return inject1(ctx, c.generator.generate("ListTaskQueue", request), func() (*persistence.InternalListTaskQueueResponse, error) {
 		return c.baseStore.ListTaskQueue(ctx, request)
 	})
 }
@@ -151,7 +151,7 @@ func (c *faultInjectionTaskStore) ListTaskQueueUserDataEntries(
 	ctx context.Context,
 	request *persistence.ListTaskQueueUserDataEntriesRequest,
 ) (*persistence.InternalListTaskQueueUserDataEntriesResponse, error) {
-	return inject1(c.generator.generate("ListTaskQueueUserDataEntries"), func() (*persistence.InternalListTaskQueueUserDataEntriesResponse, error) {
+	return inject1(ctx, c.generator.generate("ListTaskQueueUserDataEntries", request), func() (*persistence.InternalListTaskQueueUserDataEntriesResponse, error) {
 		return c.baseStore.ListTaskQueueUserDataEntries(ctx, request)
 	})
 }
@@ -160,7 +160,7 @@ func (c *faultInjectionTaskStore) UpdateTaskQueue(
 	ctx context.Context,
 	request *persistence.InternalUpdateTaskQueueRequest,
 ) (*persistence.UpdateTaskQueueResponse, error) {
-	return inject1(c.generator.generate("UpdateTaskQueue"), func() (*persistence.UpdateTaskQueueResponse, error) {
+	return inject1(ctx, c.generator.generate("UpdateTaskQueue", request), func() (*persistence.UpdateTaskQueueResponse, error) {
 		return c.baseStore.UpdateTaskQueue(ctx, request)
 	})
 }
@@ -169,7 +169,7 @@ func (c *faultInjectionTaskStore) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *persistence.InternalUpdateTaskQueueUserDataRequest,
 ) error {
-	return inject0(c.generator.generate("UpdateTaskQueueUserData"), func() error {
+	return inject0(ctx, c.generator.generate("UpdateTaskQueueUserData", request), func() error {
 		return c.baseStore.UpdateTaskQueueUserData(ctx, request)
 	})
 }
@@ -36,6 +36,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -508,10 +509,12 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 		return nil, err
 	}
 
+	size := len(req.Node.Events.Data)
+	req.Node.Events = m.compressHistoryNode(req.Node.Events)
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 
 	return &AppendHistoryNodesResponse{
-		Size: len(req.Node.Events.Data),
+		Size: size,
 	}, err
 }
 
@@ -526,6 +529,7 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 		return nil, err
 	}
 
+	req.Node.Events = m.compressHistoryNode(req.Node.Events)
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 	return &AppendHistoryNodesResponse{
 		Size: len(request.History.Data),
@@ -814,8 +818,11 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
+			dataBlobs[index], err = serialization.DecompressBlob(node.Events)
+			if err != nil {
+				return nil, nil, nil, nil, 0, err
+			}
+			dataSize += len(dataBlobs[index].Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 			nodeIDs = append(nodeIDs, node.NodeID)
 		}
@@ -904,8 +911,11 @@ func (m *executionManagerImpl) readRawHistoryBranchReverseAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
+			dataBlobs[index], err = serialization.DecompressBlob(node.Events)
+			if err != nil {
+				return nil, nil, nil, 0, err
+			}
+			dataSize += len(dataBlobs[index].Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 		}
 		lastNode := nodes[len(nodes)-1]
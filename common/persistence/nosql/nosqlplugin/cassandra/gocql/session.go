@@ -26,6 +26,8 @@ package gocql
 
 import (
 	"context"
+	"maps"
+	"reflect"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -52,6 +54,7 @@ type (
 	session struct {
 		status               int32
 		newClusterConfigFunc func() (*gocql.ClusterConfig, error)
+		scylla               bool
 		atomic.Value         // *gocql.Session
 		logger               log.Logger
 
@@ -63,6 +66,7 @@ type (
 
 func NewSession(
 	newClusterConfigFunc func() (*gocql.ClusterConfig, error),
+	scylla bool,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) (*session, error) {
@@ -75,6 +79,7 @@ func NewSession(
 	session := &session{
 		status:               common.DaemonStatusStarted,
 		newClusterConfigFunc: newClusterConfigFunc,
+		scylla:               scylla,
 		logger:               logger,
 		metricsHandler:       metricsHandler,
 
@@ -174,8 +179,50 @@ func (s *session) MapExecuteBatchCAS(
 ) (_ bool, _ Iter, retError error) {
 	defer func() { s.handleError(retError) }()
 
+	var dest map[string]interface{}
+	if s.scylla {
+		dest = maps.Clone(previous)
+	}
 	applied, iter, err := s.Value.Load().(*gocql.Session).MapExecuteBatchCAS(b.gocqlBatch, previous)
-	return applied, iter, err
+	if err != nil || applied || !s.scylla {
+		return applied, iter, err
+	}
+	skipNullRows(iter, previous, dest)
+	return applied, iter, nil
+}
+
+// skipNullRows replaces previous with the first row of iter that is not null. When a conditional batch is not
+// applied, ScyllaDB returns a row for every statement of the batch, with null columns for the statements whose row
+// does not exist, while Cassandra only returns the existing rows of conditional statements. Skipping the null rows
+// lets callers read the conflicting row from previous on both. dest holds the scan destinations of previous.
+func skipNullRows(
+	iter *gocql.Iter,
+	previous map[string]interface{},
+	dest map[string]interface{},
+) {
+	for isNullRow(previous) {
+		row := maps.Clone(dest)
+		if !iter.MapScan(row) {
+			return
+		}
+		delete(row, "[applied]")
+		clear(previous)
+		maps.Copy(previous, row)
+	}
+}
+
+// isNullRow returns true if all columns of row are null. gocql scans null columns into zero values, or nil for
+// pointer destinations.
+func isNullRow(row map[string]interface{}) bool {
+	if len(row) == 0 {
+		return false
+	}
+	for _, value := range row {
+		if v := reflect.ValueOf(value); v.IsValid() && !v.IsZero() {
+			return false
+		}
+	}
+	return true
 }
 
 func (s *session) AwaitSchemaAgreement(
@@ -81,3 +81,25 @@ func TestPanicCapture(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "panic:")
 }
+
+func TestIsNullRow(t *testing.T) {
+	var nilType *int
+	zero := 0
+	assert.True(t, isNullRow(map[string]interface{}{
+		"type":     nilType,
+		"range_id": int64(0),
+		"run_id":   gocql.UUID{},
+		"data":     []byte(nil),
+		"encoding": "",
+		"missing":  nil,
+	}))
+	assert.False(t, isNullRow(map[string]interface{}{
+		"type":     &zero,
+		"range_id": int64(0),
+	}))
+	assert.False(t, isNullRow(map[string]interface{}{
+		"type":     nilType,
+		"range_id": int64(5),
+	}))
+	assert.False(t, isNullRow(map[string]interface{}{}))
+}
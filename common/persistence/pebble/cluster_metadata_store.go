@@ -0,0 +1,271 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	clusterMetadataLockKey = "cluster_metadata"
+)
+
+type (
+	clusterMetadataStore struct {
+		store
+	}
+
+	clusterMetadataRow struct {
+		ClusterName     string `json:"clusterName"`
+		ClusterMetadata *blob  `json:"clusterMetadata"`
+		Version         int64  `json:"version"`
+	}
+
+	clusterMembershipRow struct {
+		Role          p.ServiceType `json:"role"`
+		HostID        []byte        `json:"hostId"`
+		RPCAddress    string        `json:"rpcAddress"`
+		RPCPort       uint16        `json:"rpcPort"`
+		SessionStart  time.Time     `json:"sessionStart"`
+		LastHeartbeat time.Time     `json:"lastHeartbeat"`
+		RecordExpiry  time.Time     `json:"recordExpiry"`
+	}
+)
+
+var _ p.ClusterMetadataStore = (*clusterMetadataStore)(nil)
+
+func clusterMetadataKey(clusterName string) key {
+	return newKey(tableClusterMetadata).String(clusterName)
+}
+
+func clusterMembershipKey(hostID []byte) key {
+	return newKey(tableClusterMembership).String(string(hostID))
+}
+
+// ListClusterMetadata lists cluster metadata ordered by cluster name. The page token is the key of the
+// last cluster of the previous page.
+func (s *clusterMetadataStore) ListClusterMetadata(
+	_ context.Context,
+	request *p.InternalListClusterMetadataRequest,
+) (*p.InternalListClusterMetadataResponse, error) {
+	prefix := newKey(tableClusterMetadata)
+	response := &p.InternalListClusterMetadataResponse{}
+	var lastKey []byte
+	err := scan(s.db, pageLowerBound(prefix, request.NextPageToken), prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var row clusterMetadataRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		response.ClusterMetadata = append(response.ClusterMetadata, &p.InternalGetClusterMetadataResponse{
+			ClusterMetadata: row.ClusterMetadata.dataBlob(),
+			Version:         row.Version,
+		})
+		lastKey = k
+		return len(response.ClusterMetadata) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.ClusterMetadata) == request.PageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
+
+func (s *clusterMetadataStore) GetClusterMetadata(
+	_ context.Context,
+	request *p.InternalGetClusterMetadataRequest,
+) (*p.InternalGetClusterMetadataResponse, error) {
+	var row clusterMetadataRow
+	found, err := get(s.db, clusterMetadataKey(request.ClusterName), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetClusterMetadata operation failed. Cluster metadata not found for cluster %v", request.ClusterName))
+	}
+	return &p.InternalGetClusterMetadataResponse{
+		ClusterMetadata: row.ClusterMetadata.dataBlob(),
+		Version:         row.Version,
+	}, nil
+}
+
+// SaveClusterMetadata writes the cluster metadata if its version is still Version, which is 0 for cluster
+// metadata which does not exist yet. The version of the written cluster metadata is Version+1.
+func (s *clusterMetadataStore) SaveClusterMetadata(
+	_ context.Context,
+	request *p.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	err := s.db.update(clusterMetadataLockKey, func(batch *pebble.Batch) error {
+		k := clusterMetadataKey(request.ClusterName)
+		var row clusterMetadataRow
+		if _, err := get(batch, k, &row); err != nil {
+			return err
+		}
+		if request.Version != row.Version {
+			return serviceerror.NewUnavailable(fmt.Sprintf("SaveClusterMetadata encountered version mismatch, expected %v but got %v.",
+				request.Version, row.Version))
+		}
+		return set(batch, k, &clusterMetadataRow{
+			ClusterName:     request.ClusterName,
+			ClusterMetadata: newBlob(request.ClusterMetadata),
+			Version:         request.Version + 1,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *clusterMetadataStore) DeleteClusterMetadata(
+	_ context.Context,
+	request *p.InternalDeleteClusterMetadataRequest,
+) error {
+	return s.db.update(clusterMetadataLockKey, func(batch *pebble.Batch) error {
+		return remove(batch, clusterMetadataKey(request.ClusterName))
+	})
+}
+
+// GetClusterMembers returns the unexpired members matching the request, ordered by host ID. The page token
+// is the host ID of the last member of the previous page.
+func (s *clusterMetadataStore) GetClusterMembers(
+	_ context.Context,
+	request *p.GetClusterMembersRequest,
+) (*p.GetClusterMembersResponse, error) {
+	if len(request.NextPageToken) != 0 && len(request.NextPageToken) != 16 {
+		return nil, serviceerror.NewInternal("page token is corrupted.")
+	}
+
+	now := time.Now().UTC()
+	prefix := newKey(tableClusterMembership)
+	lower, upper := prefix, prefix.PrefixEnd()
+	if request.HostIDEquals != nil {
+		lower = clusterMembershipKey(request.HostIDEquals)
+		upper = lower.Next()
+	} else if len(request.NextPageToken) != 0 {
+		lower = clusterMembershipKey(request.NextPageToken).Next()
+	}
+
+	response := &p.GetClusterMembersResponse{}
+	err := scan(s.db, lower, upper, false, func(_ []byte, value []byte) (bool, error) {
+		var row clusterMembershipRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		if !row.matches(request, now) {
+			return true, nil
+		}
+		response.ActiveMembers = append(response.ActiveMembers, &p.ClusterMember{
+			Role:          row.Role,
+			HostID:        row.HostID,
+			RPCAddress:    net.ParseIP(row.RPCAddress),
+			RPCPort:       row.RPCPort,
+			SessionStart:  row.SessionStart,
+			LastHeartbeat: row.LastHeartbeat,
+			RecordExpiry:  row.RecordExpiry,
+		})
+		return request.PageSize <= 0 || len(response.ActiveMembers) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if request.PageSize > 0 && len(response.ActiveMembers) == request.PageSize {
+		response.NextPageToken = response.ActiveMembers[len(response.ActiveMembers)-1].HostID
+	}
+	return response, nil
+}
+
+func (s *clusterMetadataStore) UpsertClusterMembership(
+	_ context.Context,
+	request *p.UpsertClusterMembershipRequest,
+) error {
+	now := time.Now().UTC()
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := set(batch, clusterMembershipKey(request.HostID), &clusterMembershipRow{
+		Role:          request.Role,
+		HostID:        request.HostID,
+		RPCAddress:    request.RPCAddress.String(),
+		RPCPort:       request.RPCPort,
+		SessionStart:  request.SessionStart,
+		LastHeartbeat: now,
+		RecordExpiry:  now.Add(request.RecordExpiry),
+	}); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+// PruneClusterMembership deletes expired members.
+func (s *clusterMetadataStore) PruneClusterMembership(
+	_ context.Context,
+	_ *p.PruneClusterMembershipRequest,
+) error {
+	now := time.Now().UTC()
+	prefix := newKey(tableClusterMembership)
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	err := scan(s.db, prefix, prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var row clusterMembershipRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		if row.RecordExpiry.Before(now) {
+			if err := remove(batch, k); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+func (r *clusterMembershipRow) matches(request *p.GetClusterMembersRequest, now time.Time) bool {
+	if !r.RecordExpiry.After(now) {
+		return false
+	}
+	if request.RPCAddressEquals != nil && r.RPCAddress != request.RPCAddressEquals.String() {
+		return false
+	}
+	if request.RoleEquals != p.All && r.Role != request.RoleEquals {
+		return false
+	}
+	if request.LastHeartbeatWithin > 0 && !r.LastHeartbeat.After(now.Add(-request.LastHeartbeatWithin)) {
+		return false
+	}
+	if !request.SessionStartedAfter.IsZero() && r.SessionStart.Before(request.SessionStartedAfter) {
+		return false
+	}
+	return true
+}
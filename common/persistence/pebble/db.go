@@ -0,0 +1,281 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/dgryski/go-farm"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// numLockStripes is the number of locks serializing read-write transactions. Transactions on
+	// unrelated rows, e.g. on different history shards, take different locks with high probability.
+	numLockStripes = 256
+)
+
+type (
+	// db is a pebble database. It is shared by all the data stores configured with the same path,
+	// since a pebble database can only be opened once.
+	db struct {
+		*pebble.DB
+		path         string
+		writeOptions *pebble.WriteOptions
+		locks        [numLockStripes]sync.Mutex
+		refCount     int
+	}
+
+	dbPool struct {
+		sync.Mutex
+		dbs map[string]*db
+	}
+
+	// blob is the stored form of a commonpb.DataBlob.
+	blob struct {
+		Data     []byte               `json:"data,omitempty"`
+		Encoding enumspb.EncodingType `json:"encoding,omitempty"`
+	}
+)
+
+var pool = &dbPool{dbs: make(map[string]*db)}
+
+// acquireDB opens the database at the configured path, or returns the already open one.
+func acquireDB(cfg config.Pebble) (*db, error) {
+	path, err := filepath.Abs(cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Lock()
+	defer pool.Unlock()
+	if d, ok := pool.dbs[path]; ok {
+		d.refCount++
+		return d, nil
+	}
+	pdb, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, err
+	}
+	d := &db{
+		DB:           pdb,
+		path:         path,
+		writeOptions: pebble.Sync,
+		refCount:     1,
+	}
+	if cfg.DisableSync {
+		d.writeOptions = pebble.NoSync
+	}
+	pool.dbs[path] = d
+	return d, nil
+}
+
+// release closes the database once it is released by all of its users.
+func (d *db) release() error {
+	pool.Lock()
+	defer pool.Unlock()
+	d.refCount--
+	if d.refCount > 0 {
+		return nil
+	}
+	delete(pool.dbs, d.path)
+	return d.DB.Close()
+}
+
+// update runs fn in a read-write transaction holding the lock of lockKey. Reads of the batch passed
+// to fn observe its writes, and all the writes are committed atomically if fn succeeds. Transactions
+// which check rows before writing them must lock the same key to be serialized.
+func (d *db) update(lockKey string, fn func(batch *pebble.Batch) error) error {
+	mu := &d.locks[farm.Fingerprint32([]byte(lockKey))%numLockStripes]
+	mu.Lock()
+	defer mu.Unlock()
+
+	batch := d.NewIndexedBatch()
+	defer func() { _ = batch.Close() }()
+	if err := fn(batch); err != nil {
+		return err
+	}
+	return d.commit(batch)
+}
+
+// commit commits the writes of batch without locking.
+func (d *db) commit(batch *pebble.Batch) error {
+	if err := batch.Commit(d.writeOptions); err != nil {
+		return newUnavailableError("commit", err)
+	}
+	return nil
+}
+
+func get(r pebble.Reader, k key, value any) (bool, error) {
+	data, closer, err := r.Get(k)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, newUnavailableError("get", err)
+	}
+	defer func() { _ = closer.Close() }()
+	if err := json.Unmarshal(data, value); err != nil {
+		return false, serviceerror.NewInternal(fmt.Sprintf("pebble failed to decode value. Error: %v", err))
+	}
+	return true, nil
+}
+
+func exists(r pebble.Reader, k key) (bool, error) {
+	_, closer, err := r.Get(k)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, newUnavailableError("get", err)
+	}
+	_ = closer.Close()
+	return true, nil
+}
+
+func set(batch *pebble.Batch, k key, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("pebble failed to encode value. Error: %v", err))
+	}
+	if err := batch.Set(k, data, nil); err != nil {
+		return newUnavailableError("set", err)
+	}
+	return nil
+}
+
+func remove(batch *pebble.Batch, k key) error {
+	if err := batch.Delete(k, nil); err != nil {
+		return newUnavailableError("delete", err)
+	}
+	return nil
+}
+
+func removeRange(batch *pebble.Batch, lower key, upper key) error {
+	if err := batch.DeleteRange(lower, upper, nil); err != nil {
+		return newUnavailableError("delete range", err)
+	}
+	return nil
+}
+
+// scan calls fn for the keys in [lower, upper) in ascending order, or in descending order if reverse is
+// set, until fn returns false. The key and value passed to fn are only valid until fn returns.
+func scan(
+	r pebble.Reader,
+	lower key,
+	upper key,
+	reverse bool,
+	fn func(k []byte, value []byte) (bool, error),
+) error {
+	iter, err := r.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: upper,
+	})
+	if err != nil {
+		return newUnavailableError("scan", err)
+	}
+	defer func() { _ = iter.Close() }()
+
+	valid := iter.First()
+	if reverse {
+		valid = iter.Last()
+	}
+	for valid {
+		more, err := fn(iter.Key(), iter.Value())
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+		if reverse {
+			valid = iter.Prev()
+		} else {
+			valid = iter.Next()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return newUnavailableError("scan", err)
+	}
+	return nil
+}
+
+func decode(data []byte, value any) error {
+	if err := json.Unmarshal(data, value); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("pebble failed to decode value. Error: %v", err))
+	}
+	return nil
+}
+
+// pageLowerBound returns the lower bound of the next page of an ascending scan, whose page token is
+// the last key of the previous page.
+func pageLowerBound(lower key, pageToken []byte) key {
+	if len(pageToken) == 0 {
+		return lower
+	}
+	next := key(pageToken).Next()
+	if string(next) < string(lower) {
+		return lower
+	}
+	return next
+}
+
+// pageUpperBound returns the upper bound of the next page of a descending scan, whose page token is
+// the last key of the previous page.
+func pageUpperBound(upper key, pageToken []byte) key {
+	if len(pageToken) == 0 || string(pageToken) > string(upper) {
+		return upper
+	}
+	return pageToken
+}
+
+func newBlob(dataBlob *commonpb.DataBlob) *blob {
+	if dataBlob == nil {
+		return nil
+	}
+	return &blob{
+		Data:     dataBlob.Data,
+		Encoding: dataBlob.EncodingType,
+	}
+}
+
+func (b *blob) dataBlob() *commonpb.DataBlob {
+	if b == nil {
+		return nil
+	}
+	return &commonpb.DataBlob{
+		EncodingType: b.Encoding,
+		Data:         b.Data,
+	}
+}
+
+func newUnavailableError(operation string, err error) error {
+	return serviceerror.NewUnavailable(fmt.Sprintf("pebble %v operation failed. Error: %v", operation, err))
+}
@@ -0,0 +1,715 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	executionStore struct {
+		store
+		p.HistoryBranchUtilImpl
+	}
+
+	currentExecutionRow struct {
+		RunID            string                          `json:"runId"`
+		CreateRequestID  string                          `json:"createRequestId"`
+		State            enumsspb.WorkflowExecutionState `json:"state"`
+		Status           enumspb.WorkflowExecutionStatus `json:"status"`
+		LastWriteVersion int64                           `json:"lastWriteVersion"`
+		StartTime        *time.Time                      `json:"startTime,omitempty"`
+	}
+
+	// executionRow holds the whole mutable state of a workflow execution.
+	executionRow struct {
+		ExecutionInfo       *blob               `json:"executionInfo"`
+		ExecutionState      *blob               `json:"executionState"`
+		NextEventID         int64               `json:"nextEventId"`
+		LastWriteVersion    int64               `json:"lastWriteVersion"`
+		DBRecordVersion     int64               `json:"dbRecordVersion"`
+		Checksum            *blob               `json:"checksum,omitempty"`
+		ActivityInfos       map[int64]*blob     `json:"activityInfos,omitempty"`
+		TimerInfos          map[string]*blob    `json:"timerInfos,omitempty"`
+		ChildExecutionInfos map[int64]*blob     `json:"childExecutionInfos,omitempty"`
+		RequestCancelInfos  map[int64]*blob     `json:"requestCancelInfos,omitempty"`
+		SignalInfos         map[int64]*blob     `json:"signalInfos,omitempty"`
+		SignalRequestedIDs  map[string]struct{} `json:"signalRequestedIds,omitempty"`
+		BufferedEvents      []*blob             `json:"bufferedEvents,omitempty"`
+	}
+)
+
+var _ p.ExecutionStore = (*executionStore)(nil)
+
+func currentExecutionKey(shardID int32, namespaceID string, workflowID string) key {
+	return newKey(tableCurrentExecution).Int32(shardID).String(namespaceID).String(workflowID)
+}
+
+func executionKey(shardID int32, namespaceID string, workflowID string, runID string) key {
+	return newKey(tableExecution).Int32(shardID).String(namespaceID).String(workflowID).String(runID)
+}
+
+func (s *executionStore) CreateWorkflowExecution(
+	_ context.Context,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (*p.InternalCreateWorkflowExecutionResponse, error) {
+	err := s.db.update(shardLockKey(request.ShardID), func(batch *pebble.Batch) error {
+		if err := checkShardRangeID(batch, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		if err := appendHistoryNodes(batch, request.NewWorkflowNewEvents); err != nil {
+			return err
+		}
+		return createWorkflowExecution(batch, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalCreateWorkflowExecutionResponse{}, nil
+}
+
+func createWorkflowExecution(
+	batch *pebble.Batch,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) error {
+	newWorkflow := request.NewWorkflowSnapshot
+	shardID := request.ShardID
+	workflowID := newWorkflow.WorkflowID
+
+	currentKey := currentExecutionKey(shardID, newWorkflow.NamespaceID, workflowID)
+	var currentRow currentExecutionRow
+	found, err := get(batch, currentKey, &currentRow)
+	if err != nil {
+		return err
+	}
+
+	switch request.Mode {
+	case p.CreateWorkflowModeBrandNew:
+		if found && currentRow.RunID != request.PreviousRunID {
+			return newCurrentWorkflowConditionFailedError(
+				&currentRow,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current run ID: %v, request run ID: %v",
+					workflowID,
+					currentRow.RunID,
+					request.PreviousRunID,
+				),
+			)
+		}
+
+	case p.CreateWorkflowModeUpdateCurrent:
+		if !found {
+			return newCurrentWorkflowConditionFailedError(nil, "")
+		}
+		if currentRow.RunID != request.PreviousRunID {
+			return newCurrentWorkflowConditionFailedError(
+				&currentRow,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current run ID: %v, request run ID: %v",
+					workflowID,
+					currentRow.RunID,
+					request.PreviousRunID,
+				),
+			)
+		}
+		if currentRow.LastWriteVersion != request.PreviousLastWriteVersion {
+			return newCurrentWorkflowConditionFailedError(
+				&currentRow,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current last write version: %v, request last write version: %v",
+					workflowID,
+					currentRow.LastWriteVersion,
+					request.PreviousLastWriteVersion,
+				),
+			)
+		}
+		if currentRow.State != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+			return newCurrentWorkflowConditionFailedError(
+				&currentRow,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current state: %v, request state: %v",
+					workflowID,
+					currentRow.State,
+					enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+				),
+			)
+		}
+
+	case p.CreateWorkflowModeBypassCurrent:
+		if found && currentRow.RunID == newWorkflow.ExecutionState.RunId {
+			return newRunIDMismatchError(&currentRow, newWorkflow.ExecutionState.RunId)
+		}
+
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("CreteWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if request.Mode != p.CreateWorkflowModeBypassCurrent {
+		if err := set(batch, currentKey, newCurrentExecutionRow(
+			newWorkflow.ExecutionState,
+			newWorkflow.LastWriteVersion,
+		)); err != nil {
+			return err
+		}
+	}
+	return applyWorkflowSnapshotAsNew(batch, shardID, &newWorkflow)
+}
+
+func (s *executionStore) UpdateWorkflowExecution(
+	_ context.Context,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	return s.db.update(shardLockKey(request.ShardID), func(batch *pebble.Batch) error {
+		if err := checkShardRangeID(batch, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		if err := appendHistoryNodes(batch, request.UpdateWorkflowNewEvents); err != nil {
+			return err
+		}
+		if err := appendHistoryNodes(batch, request.NewWorkflowNewEvents); err != nil {
+			return err
+		}
+		return updateWorkflowExecution(batch, request)
+	})
+}
+
+func updateWorkflowExecution(
+	batch *pebble.Batch,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	updateWorkflow := request.UpdateWorkflowMutation
+	newWorkflow := request.NewWorkflowSnapshot
+	shardID := request.ShardID
+	namespaceID := updateWorkflow.NamespaceID
+	workflowID := updateWorkflow.WorkflowID
+	runID := updateWorkflow.ExecutionState.RunId
+
+	switch request.Mode {
+	case p.UpdateWorkflowModeBypassCurrent:
+		if err := assertNotCurrentExecution(batch, shardID, namespaceID, workflowID, runID); err != nil {
+			return err
+		}
+
+	case p.UpdateWorkflowModeUpdateCurrent:
+		row := newCurrentExecutionRow(updateWorkflow.ExecutionState, updateWorkflow.LastWriteVersion)
+		if newWorkflow != nil {
+			if newWorkflow.NamespaceID != namespaceID {
+				return serviceerror.NewUnavailable("UpdateWorkflowExecution: cannot continue as new to another namespace")
+			}
+			row = newCurrentExecutionRow(newWorkflow.ExecutionState, newWorkflow.LastWriteVersion)
+		}
+		if err := assertRunIDAndUpdateCurrentExecution(batch, shardID, namespaceID, workflowID, row, runID); err != nil {
+			return err
+		}
+
+	default:
+		return serviceerror.NewUnavailable(fmt.Sprintf("UpdateWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if err := applyWorkflowMutation(batch, shardID, &updateWorkflow); err != nil {
+		return err
+	}
+	if newWorkflow != nil {
+		return applyWorkflowSnapshotAsNew(batch, shardID, newWorkflow)
+	}
+	return nil
+}
+
+func (s *executionStore) ConflictResolveWorkflowExecution(
+	_ context.Context,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	return s.db.update(shardLockKey(request.ShardID), func(batch *pebble.Batch) error {
+		if err := checkShardRangeID(batch, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		if err := appendHistoryNodes(batch, request.CurrentWorkflowEventsNewEvents); err != nil {
+			return err
+		}
+		if err := appendHistoryNodes(batch, request.ResetWorkflowEventsNewEvents); err != nil {
+			return err
+		}
+		if err := appendHistoryNodes(batch, request.NewWorkflowEventsNewEvents); err != nil {
+			return err
+		}
+		return conflictResolveWorkflowExecution(batch, request)
+	})
+}
+
+func conflictResolveWorkflowExecution(
+	batch *pebble.Batch,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	currentWorkflow := request.CurrentWorkflowMutation
+	resetWorkflow := request.ResetWorkflowSnapshot
+	newWorkflow := request.NewWorkflowSnapshot
+	shardID := request.ShardID
+	namespaceID := resetWorkflow.NamespaceID
+	workflowID := resetWorkflow.WorkflowID
+
+	switch request.Mode {
+	case p.ConflictResolveWorkflowModeBypassCurrent:
+		if err := assertNotCurrentExecution(batch, shardID, namespaceID, workflowID, resetWorkflow.ExecutionState.RunId); err != nil {
+			return err
+		}
+
+	case p.ConflictResolveWorkflowModeUpdateCurrent:
+		row := newCurrentExecutionRow(resetWorkflow.ExecutionState, resetWorkflow.LastWriteVersion)
+		if newWorkflow != nil {
+			row = newCurrentExecutionRow(newWorkflow.ExecutionState, newWorkflow.LastWriteVersion)
+		}
+		// reset workflow is current unless there is a current workflow mutation
+		previousRunID := resetWorkflow.ExecutionState.RunId
+		if currentWorkflow != nil {
+			previousRunID = currentWorkflow.ExecutionState.RunId
+		}
+		if err := assertRunIDAndUpdateCurrentExecution(batch, shardID, namespaceID, workflowID, row, previousRunID); err != nil {
+			return err
+		}
+
+	default:
+		return serviceerror.NewUnavailable(fmt.Sprintf("ConflictResolveWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if err := applyWorkflowSnapshotAsReset(batch, shardID, &resetWorkflow); err != nil {
+		return err
+	}
+	if currentWorkflow != nil {
+		if err := applyWorkflowMutation(batch, shardID, currentWorkflow); err != nil {
+			return err
+		}
+	}
+	if newWorkflow != nil {
+		return applyWorkflowSnapshotAsNew(batch, shardID, newWorkflow)
+	}
+	return nil
+}
+
+func (s *executionStore) SetWorkflowExecution(
+	_ context.Context,
+	request *p.InternalSetWorkflowExecutionRequest,
+) error {
+	return s.db.update(shardLockKey(request.ShardID), func(batch *pebble.Batch) error {
+		if err := checkShardRangeID(batch, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		return applyWorkflowSnapshotAsReset(batch, request.ShardID, &request.SetWorkflowSnapshot)
+	})
+}
+
+func (s *executionStore) GetWorkflowExecution(
+	_ context.Context,
+	request *p.GetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+	var row executionRow
+	found, err := get(s.db, executionKey(request.ShardID, request.NamespaceID, request.WorkflowID, request.RunID), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v", request.WorkflowID, request.RunID))
+	}
+	return &p.InternalGetWorkflowExecutionResponse{
+		State:           row.mutableState(),
+		DBRecordVersion: row.DBRecordVersion,
+	}, nil
+}
+
+func (s *executionStore) DeleteWorkflowExecution(
+	_ context.Context,
+	request *p.DeleteWorkflowExecutionRequest,
+) error {
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := remove(batch, executionKey(request.ShardID, request.NamespaceID, request.WorkflowID, request.RunID)); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+// DeleteCurrentWorkflowExecution deletes the current execution only if it is still the given run, since a
+// new run of the workflow may have started after the given run finished.
+func (s *executionStore) DeleteCurrentWorkflowExecution(
+	_ context.Context,
+	request *p.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return s.db.update(shardLockKey(request.ShardID), func(batch *pebble.Batch) error {
+		currentKey := currentExecutionKey(request.ShardID, request.NamespaceID, request.WorkflowID)
+		var currentRow currentExecutionRow
+		found, err := get(batch, currentKey, &currentRow)
+		if err != nil || !found || currentRow.RunID != request.RunID {
+			return err
+		}
+		return remove(batch, currentKey)
+	})
+}
+
+func (s *executionStore) GetCurrentExecution(
+	_ context.Context,
+	request *p.GetCurrentExecutionRequest,
+) (*p.InternalGetCurrentExecutionResponse, error) {
+	var row currentExecutionRow
+	found, err := get(s.db, currentExecutionKey(request.ShardID, request.NamespaceID, request.WorkflowID), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("current workflow execution not found. WorkflowId: %v", request.WorkflowID))
+	}
+	return &p.InternalGetCurrentExecutionResponse{
+		RunID: row.RunID,
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			CreateRequestId: row.CreateRequestID,
+			RunId:           row.RunID,
+			State:           row.State,
+			Status:          row.Status,
+		},
+	}, nil
+}
+
+func (s *executionStore) ListConcreteExecutions(
+	_ context.Context,
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+	prefix := newKey(tableExecution).Int32(request.ShardID)
+	response := &p.InternalListConcreteExecutionsResponse{}
+	var lastKey []byte
+	err := scan(s.db, pageLowerBound(prefix, request.PageToken), prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var row executionRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		response.States = append(response.States, row.mutableState())
+		lastKey = k
+		return len(response.States) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.States) == request.PageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
+
+func newCurrentExecutionRow(
+	executionState *persistencespb.WorkflowExecutionState,
+	lastWriteVersion int64,
+) *currentExecutionRow {
+	row := &currentExecutionRow{
+		RunID:            executionState.RunId,
+		CreateRequestID:  executionState.CreateRequestId,
+		State:            executionState.State,
+		Status:           executionState.Status,
+		LastWriteVersion: lastWriteVersion,
+	}
+	if executionState.StartTime != nil {
+		startTime := executionState.StartTime.AsTime()
+		row.StartTime = &startTime
+	}
+	return row
+}
+
+func newCurrentWorkflowConditionFailedError(currentRow *currentExecutionRow, message string) error {
+	if currentRow == nil {
+		return &p.CurrentWorkflowConditionFailedError{
+			Msg:              message,
+			RequestID:        "",
+			RunID:            "",
+			State:            enumsspb.WORKFLOW_EXECUTION_STATE_UNSPECIFIED,
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED,
+			LastWriteVersion: 0,
+			StartTime:        nil,
+		}
+	}
+	return &p.CurrentWorkflowConditionFailedError{
+		Msg:              message,
+		RequestID:        currentRow.CreateRequestID,
+		RunID:            currentRow.RunID,
+		State:            currentRow.State,
+		Status:           currentRow.Status,
+		LastWriteVersion: currentRow.LastWriteVersion,
+		StartTime:        currentRow.StartTime,
+	}
+}
+
+func newRunIDMismatchError(currentRow *currentExecutionRow, runID string) error {
+	return newCurrentWorkflowConditionFailedError(
+		currentRow,
+		fmt.Sprintf(
+			"assertRunIDMismatch failed. request run ID: %v, current run ID: %v",
+			runID,
+			currentRow.RunID,
+		),
+	)
+}
+
+// assertNotCurrentExecution returns an error if runID is the current run of the workflow.
+func assertNotCurrentExecution(
+	batch *pebble.Batch,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) error {
+	var currentRow currentExecutionRow
+	found, err := get(batch, currentExecutionKey(shardID, namespaceID, workflowID), &currentRow)
+	if err != nil {
+		return err
+	}
+	if found && currentRow.RunID == runID {
+		return newRunIDMismatchError(&currentRow, runID)
+	}
+	return nil
+}
+
+// assertRunIDAndUpdateCurrentExecution replaces the current execution with row if previousRunID is the
+// current run of the workflow.
+func assertRunIDAndUpdateCurrentExecution(
+	batch *pebble.Batch,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	row *currentExecutionRow,
+	previousRunID string,
+) error {
+	currentKey := currentExecutionKey(shardID, namespaceID, workflowID)
+	var currentRow currentExecutionRow
+	found, err := get(batch, currentKey, &currentRow)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return serviceerror.NewUnavailable("assertCurrentExecution failed. Unable to load current record.")
+	}
+	if currentRow.RunID != previousRunID {
+		return &p.CurrentWorkflowConditionFailedError{
+			Msg: fmt.Sprintf(
+				"assertRunIDAndUpdateCurrentExecution failed. current run ID: %v, request run ID: %v",
+				currentRow.RunID,
+				previousRunID,
+			),
+			RequestID:        currentRow.CreateRequestID,
+			RunID:            currentRow.RunID,
+			State:            currentRow.State,
+			Status:           currentRow.Status,
+			LastWriteVersion: currentRow.LastWriteVersion,
+		}
+	}
+	return set(batch, currentKey, row)
+}
+
+// lockAndCheckExecution returns the execution row if the condition of the write is met. The DB record
+// version is checked if it is set, and the next event ID otherwise.
+func lockAndCheckExecution(
+	batch *pebble.Batch,
+	k key,
+	condition int64,
+	dbRecordVersion int64,
+) (*executionRow, error) {
+	var row executionRow
+	found, err := get(batch, k, &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, &p.ConditionFailedError{
+			Msg: "lockAndCheckExecution failed. Unable to lock workflow execution which does not exist.",
+		}
+	}
+	if dbRecordVersion == 0 {
+		if row.NextEventID != condition {
+			return nil, &p.WorkflowConditionFailedError{
+				Msg:             fmt.Sprintf("lockAndCheckExecution failed. Next_event_id was %v when it should have been %v.", row.NextEventID, condition),
+				NextEventID:     row.NextEventID,
+				DBRecordVersion: row.DBRecordVersion,
+			}
+		}
+	} else if row.DBRecordVersion != dbRecordVersion-1 {
+		return nil, &p.WorkflowConditionFailedError{
+			Msg:             fmt.Sprintf("lockAndCheckExecution failed. DBRecordVersion expected: %v, actually %v.", dbRecordVersion-1, row.DBRecordVersion),
+			NextEventID:     row.NextEventID,
+			DBRecordVersion: row.DBRecordVersion,
+		}
+	}
+	return &row, nil
+}
+
+func applyWorkflowMutation(
+	batch *pebble.Batch,
+	shardID int32,
+	mutation *p.InternalWorkflowMutation,
+) error {
+	k := executionKey(shardID, mutation.NamespaceID, mutation.WorkflowID, mutation.ExecutionState.RunId)
+	row, err := lockAndCheckExecution(batch, k, mutation.Condition, mutation.DBRecordVersion)
+	if err != nil {
+		return err
+	}
+
+	row.ExecutionInfo = newBlob(mutation.ExecutionInfoBlob)
+	row.ExecutionState = newBlob(mutation.ExecutionStateBlob)
+	row.NextEventID = mutation.NextEventID
+	row.LastWriteVersion = mutation.LastWriteVersion
+	row.DBRecordVersion = mutation.DBRecordVersion
+	row.Checksum = newBlob(mutation.Checksum)
+
+	row.ActivityInfos = updateMap(row.ActivityInfos, mutation.UpsertActivityInfos, mutation.DeleteActivityInfos)
+	row.TimerInfos = updateMap(row.TimerInfos, mutation.UpsertTimerInfos, mutation.DeleteTimerInfos)
+	row.ChildExecutionInfos = updateMap(row.ChildExecutionInfos, mutation.UpsertChildExecutionInfos, mutation.DeleteChildExecutionInfos)
+	row.RequestCancelInfos = updateMap(row.RequestCancelInfos, mutation.UpsertRequestCancelInfos, mutation.DeleteRequestCancelInfos)
+	row.SignalInfos = updateMap(row.SignalInfos, mutation.UpsertSignalInfos, mutation.DeleteSignalInfos)
+	if row.SignalRequestedIDs == nil {
+		row.SignalRequestedIDs = make(map[string]struct{}, len(mutation.UpsertSignalRequestedIDs))
+	}
+	for signalRequestedID := range mutation.UpsertSignalRequestedIDs {
+		row.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+	for signalRequestedID := range mutation.DeleteSignalRequestedIDs {
+		delete(row.SignalRequestedIDs, signalRequestedID)
+	}
+
+	if mutation.ClearBufferedEvents {
+		row.BufferedEvents = nil
+	}
+	if mutation.NewBufferedEvents != nil {
+		row.BufferedEvents = append(row.BufferedEvents, newBlob(mutation.NewBufferedEvents))
+	}
+
+	if err := set(batch, k, row); err != nil {
+		return err
+	}
+	return addHistoryTasks(batch, shardID, mutation.Tasks)
+}
+
+func applyWorkflowSnapshotAsReset(
+	batch *pebble.Batch,
+	shardID int32,
+	snapshot *p.InternalWorkflowSnapshot,
+) error {
+	k := executionKey(shardID, snapshot.NamespaceID, snapshot.WorkflowID, snapshot.ExecutionState.RunId)
+	if _, err := lockAndCheckExecution(batch, k, snapshot.Condition, snapshot.DBRecordVersion); err != nil {
+		return err
+	}
+	if err := set(batch, k, newExecutionRow(snapshot)); err != nil {
+		return err
+	}
+	return addHistoryTasks(batch, shardID, snapshot.Tasks)
+}
+
+func applyWorkflowSnapshotAsNew(
+	batch *pebble.Batch,
+	shardID int32,
+	snapshot *p.InternalWorkflowSnapshot,
+) error {
+	k := executionKey(shardID, snapshot.NamespaceID, snapshot.WorkflowID, snapshot.ExecutionState.RunId)
+	found, err := exists(batch, k)
+	if err != nil {
+		return err
+	}
+	if found {
+		return &p.WorkflowConditionFailedError{
+			Msg:             fmt.Sprintf("Workflow execution already running. WorkflowId: %v", snapshot.WorkflowID),
+			NextEventID:     0,
+			DBRecordVersion: 0,
+		}
+	}
+	if err := set(batch, k, newExecutionRow(snapshot)); err != nil {
+		return err
+	}
+	return addHistoryTasks(batch, shardID, snapshot.Tasks)
+}
+
+func newExecutionRow(snapshot *p.InternalWorkflowSnapshot) *executionRow {
+	return &executionRow{
+		ExecutionInfo:       newBlob(snapshot.ExecutionInfoBlob),
+		ExecutionState:      newBlob(snapshot.ExecutionStateBlob),
+		NextEventID:         snapshot.NextEventID,
+		LastWriteVersion:    snapshot.LastWriteVersion,
+		DBRecordVersion:     snapshot.DBRecordVersion,
+		Checksum:            newBlob(snapshot.Checksum),
+		ActivityInfos:       updateMap(nil, snapshot.ActivityInfos, nil),
+		TimerInfos:          updateMap(nil, snapshot.TimerInfos, nil),
+		ChildExecutionInfos: updateMap(nil, snapshot.ChildExecutionInfos, nil),
+		RequestCancelInfos:  updateMap(nil, snapshot.RequestCancelInfos, nil),
+		SignalInfos:         updateMap(nil, snapshot.SignalInfos, nil),
+		SignalRequestedIDs:  snapshot.SignalRequestedIDs,
+	}
+}
+
+func (r *executionRow) mutableState() *p.InternalWorkflowMutableState {
+	state := &p.InternalWorkflowMutableState{
+		ActivityInfos:       dataBlobMap(r.ActivityInfos),
+		TimerInfos:          dataBlobMap(r.TimerInfos),
+		ChildExecutionInfos: dataBlobMap(r.ChildExecutionInfos),
+		RequestCancelInfos:  dataBlobMap(r.RequestCancelInfos),
+		SignalInfos:         dataBlobMap(r.SignalInfos),
+		SignalRequestedIDs:  make([]string, 0, len(r.SignalRequestedIDs)),
+		ExecutionInfo:       r.ExecutionInfo.dataBlob(),
+		ExecutionState:      r.ExecutionState.dataBlob(),
+		NextEventID:         r.NextEventID,
+		Checksum:            r.Checksum.dataBlob(),
+		DBRecordVersion:     r.DBRecordVersion,
+	}
+	for signalRequestedID := range r.SignalRequestedIDs {
+		state.SignalRequestedIDs = append(state.SignalRequestedIDs, signalRequestedID)
+	}
+	for _, event := range r.BufferedEvents {
+		state.BufferedEvents = append(state.BufferedEvents, event.dataBlob())
+	}
+	return state
+}
+
+func updateMap[K comparable](
+	m map[K]*blob,
+	upserts map[K]*commonpb.DataBlob,
+	deletes map[K]struct{},
+) map[K]*blob {
+	if m == nil {
+		m = make(map[K]*blob, len(upserts))
+	}
+	for k, v := range upserts {
+		m[k] = newBlob(v)
+	}
+	for k := range deletes {
+		delete(m, k)
+	}
+	return m
+}
+
+func dataBlobMap[K comparable](m map[K]*blob) map[K]*commonpb.DataBlob {
+	result := make(map[K]*commonpb.DataBlob, len(m))
+	for k, v := range m {
+		result[k] = v.dataBlob()
+	}
+	return result
+}
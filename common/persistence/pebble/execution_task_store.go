@@ -0,0 +1,267 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// historyTaskKey orders the tasks of immediate categories by task ID, and the tasks of scheduled
+// categories by fire time and task ID.
+func historyTaskKey(shardID int32, category tasks.Category, taskKey tasks.Key) key {
+	k := newKey(tableHistoryTask).Int32(shardID).Int32(int32(category.ID()))
+	if category.Type() == tasks.CategoryTypeScheduled {
+		k = k.Int64(taskKey.FireTime.Unix()).Int32(int32(taskKey.FireTime.Nanosecond()))
+	}
+	return k.Int64(taskKey.TaskID)
+}
+
+// historyTaskTimeKey is the prefix of the keys of the scheduled tasks firing at fireTime.
+func historyTaskTimeKey(shardID int32, category tasks.Category, fireTime time.Time) key {
+	return newKey(tableHistoryTask).Int32(shardID).Int32(int32(category.ID())).
+		Int64(fireTime.Unix()).Int32(int32(fireTime.Nanosecond()))
+}
+
+// historyTaskRange returns the key range of the tasks in [min, max). The range of scheduled tasks is
+// bounded by the fire time of max only, consistent with the other persistence implementations.
+func historyTaskRange(
+	shardID int32,
+	category tasks.Category,
+	inclusiveMinTaskKey tasks.Key,
+	exclusiveMaxTaskKey tasks.Key,
+) (key, key, error) {
+	switch category.Type() {
+	case tasks.CategoryTypeImmediate:
+		return historyTaskKey(shardID, category, inclusiveMinTaskKey),
+			historyTaskKey(shardID, category, exclusiveMaxTaskKey),
+			nil
+	case tasks.CategoryTypeScheduled:
+		return historyTaskKey(shardID, category, inclusiveMinTaskKey),
+			historyTaskTimeKey(shardID, category, exclusiveMaxTaskKey.FireTime),
+			nil
+	default:
+		return nil, nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", category))
+	}
+}
+
+func decodeHistoryTaskKey(category tasks.Category, k []byte) tasks.Key {
+	// skip the table prefix, shard ID and category ID
+	k = k[1+4+4:]
+	if category.Type() == tasks.CategoryTypeScheduled {
+		seconds := decodeInt64(k)
+		nanos := decodeInt32(k[8:])
+		return tasks.NewKey(time.Unix(seconds, int64(nanos)).UTC(), decodeInt64(k[12:]))
+	}
+	return tasks.NewImmediateKey(decodeInt64(k))
+}
+
+func replicationDLQTaskKey(shardID int32, sourceClusterName string, taskID int64) key {
+	return newKey(tableReplicationDLQTask).Int32(shardID).String(sourceClusterName).Int64(taskID)
+}
+
+func (s *executionStore) AddHistoryTasks(
+	_ context.Context,
+	request *p.InternalAddHistoryTasksRequest,
+) error {
+	return s.db.update(shardLockKey(request.ShardID), func(batch *pebble.Batch) error {
+		if err := checkShardRangeID(batch, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		return addHistoryTasks(batch, request.ShardID, request.Tasks)
+	})
+}
+
+func addHistoryTasks(
+	batch *pebble.Batch,
+	shardID int32,
+	historyTasks map[tasks.Category][]p.InternalHistoryTask,
+) error {
+	for category, tasksByCategory := range historyTasks {
+		for _, task := range tasksByCategory {
+			if err := set(batch, historyTaskKey(shardID, category, task.Key), newBlob(task.Blob)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *executionStore) GetHistoryTasks(
+	_ context.Context,
+	request *p.GetHistoryTasksRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	lower, upper, err := historyTaskRange(request.ShardID, request.TaskCategory, request.InclusiveMinTaskKey, request.ExclusiveMaxTaskKey)
+	if err != nil {
+		return nil, err
+	}
+	return getTasks(s.db, pageLowerBound(lower, request.NextPageToken), upper, request.BatchSize, func(k []byte) tasks.Key {
+		return decodeHistoryTaskKey(request.TaskCategory, k)
+	})
+}
+
+func (s *executionStore) CompleteHistoryTask(
+	_ context.Context,
+	request *p.CompleteHistoryTaskRequest,
+) error {
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := remove(batch, historyTaskKey(request.ShardID, request.TaskCategory, request.TaskKey)); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+func (s *executionStore) RangeCompleteHistoryTasks(
+	_ context.Context,
+	request *p.RangeCompleteHistoryTasksRequest,
+) error {
+	lower, upper, err := historyTaskRange(request.ShardID, request.TaskCategory, request.InclusiveMinTaskKey, request.ExclusiveMaxTaskKey)
+	if err != nil {
+		return err
+	}
+	if request.TaskCategory.Type() == tasks.CategoryTypeScheduled {
+		lower = historyTaskTimeKey(request.ShardID, request.TaskCategory, request.InclusiveMinTaskKey.FireTime)
+	}
+	return s.deleteRange(lower, upper)
+}
+
+// PutReplicationTaskToDLQ is idempotent, since tasks are immutable and may be put again when retried.
+func (s *executionStore) PutReplicationTaskToDLQ(
+	_ context.Context,
+	request *p.PutReplicationTaskToDLQRequest,
+) error {
+	dataBlob, err := serialization.ReplicationTaskInfoToBlob(request.TaskInfo)
+	if err != nil {
+		return err
+	}
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	k := replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.TaskInfo.GetTaskId())
+	if err := set(batch, k, newBlob(dataBlob)); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+func (s *executionStore) GetReplicationTasksFromDLQ(
+	_ context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.InternalGetReplicationTasksFromDLQResponse, error) {
+	lower := replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.InclusiveMinTaskKey.TaskID)
+	upper := replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.ExclusiveMaxTaskKey.TaskID)
+	prefixLen := len(replicationDLQTaskKey(request.ShardID, request.SourceClusterName, 0)) - 8
+	return getTasks(s.db, pageLowerBound(lower, request.NextPageToken), upper, request.BatchSize, func(k []byte) tasks.Key {
+		return tasks.NewImmediateKey(decodeInt64(k[prefixLen:]))
+	})
+}
+
+func (s *executionStore) DeleteReplicationTaskFromDLQ(
+	_ context.Context,
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := remove(batch, replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.TaskKey.TaskID)); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+func (s *executionStore) RangeDeleteReplicationTaskFromDLQ(
+	_ context.Context,
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.deleteRange(
+		replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.InclusiveMinTaskKey.TaskID),
+		replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.ExclusiveMaxTaskKey.TaskID),
+	)
+}
+
+func (s *executionStore) IsReplicationDLQEmpty(
+	_ context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	prefix := newKey(tableReplicationDLQTask).Int32(request.ShardID).String(request.SourceClusterName)
+	lower := replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.InclusiveMinTaskKey.TaskID)
+	empty := true
+	err := scan(s.db, lower, prefix.PrefixEnd(), false, func(_ []byte, _ []byte) (bool, error) {
+		empty = false
+		return false, nil
+	})
+	return empty, err
+}
+
+func (s *store) deleteRange(lower key, upper key) error {
+	if string(lower) >= string(upper) {
+		return nil
+	}
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := removeRange(batch, lower, upper); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+// getTasks reads a page of tasks whose values are blobs. The page token is the key of the last task
+// of the previous page, and is only set if the page is full.
+func getTasks(
+	r pebble.Reader,
+	lower key,
+	upper key,
+	pageSize int,
+	taskKey func(k []byte) tasks.Key,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	response := &p.InternalGetHistoryTasksResponse{}
+	if string(lower) >= string(upper) {
+		return response, nil
+	}
+	var lastKey []byte
+	err := scan(r, lower, upper, false, func(k []byte, value []byte) (bool, error) {
+		var taskBlob blob
+		if err := decode(value, &taskBlob); err != nil {
+			return false, err
+		}
+		response.Tasks = append(response.Tasks, p.InternalHistoryTask{
+			Key:  taskKey(k),
+			Blob: taskBlob.dataBlob(),
+		})
+		lastKey = k
+		return len(response.Tasks) < pageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Tasks) == pageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
@@ -0,0 +1,126 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"sync"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	// PersistenceName is the name of the pebble datastore
+	PersistenceName = "pebble"
+)
+
+type (
+	// Factory vends datastore implementations backed by an embedded pebble database
+	Factory struct {
+		cfg         config.Pebble
+		clusterName string
+		logger      log.Logger
+		db          *db
+		closeOnce   sync.Once
+	}
+
+	// store is embedded by all the data stores of a factory
+	store struct {
+		db     *db
+		logger log.Logger
+	}
+)
+
+// NewFactory returns an instance of a factory object which can be used to create
+// data stores backed by the pebble database at the configured path
+func NewFactory(
+	cfg config.Pebble,
+	clusterName string,
+	logger log.Logger,
+) (*Factory, error) {
+	d, err := acquireDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Factory{
+		cfg:         cfg,
+		clusterName: clusterName,
+		logger:      logger,
+		db:          d,
+	}, nil
+}
+
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	return &taskStore{store: f.newStore()}, nil
+}
+
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	return &shardStore{store: f.newStore(), clusterName: f.clusterName}, nil
+}
+
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	return &metadataStore{store: f.newStore()}, nil
+}
+
+func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
+	return &clusterMetadataStore{store: f.newStore()}, nil
+}
+
+func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
+	return &executionStore{store: f.newStore()}, nil
+}
+
+func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
+	return &queueStore{store: f.newStore(), queueType: queueType}, nil
+}
+
+func (f *Factory) NewQueueV2() (p.QueueV2, error) {
+	return &queueV2Store{store: f.newStore()}, nil
+}
+
+func (f *Factory) NewNexusEndpointStore() (p.NexusEndpointStore, error) {
+	return &nexusEndpointStore{store: f.newStore()}, nil
+}
+
+// Close releases the database. It is closed once all the factories using it are closed.
+func (f *Factory) Close() {
+	f.closeOnce.Do(func() {
+		if err := f.db.release(); err != nil {
+			f.logger.Error("Error closing pebble database", tag.Error(err))
+		}
+	})
+}
+
+func (f *Factory) newStore() store {
+	return store{db: f.db, logger: f.logger}
+}
+
+func (s *store) GetName() string {
+	return PersistenceName
+}
+
+// Close is a noop, the database is closed by the factory.
+func (s *store) Close() {
+}
@@ -0,0 +1,272 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	historyNodeRow struct {
+		TxnID     int64 `json:"txnId"`
+		PrevTxnID int64 `json:"prevTxnId"`
+		Events    *blob `json:"events,omitempty"`
+	}
+
+	historyTreeRow struct {
+		TreeID   string `json:"treeId"`
+		BranchID string `json:"branchId"`
+		TreeInfo *blob  `json:"treeInfo"`
+	}
+)
+
+func historyBranchKey(shardID int32, treeID string, branchID string) key {
+	return newKey(tableHistoryNode).Int32(shardID).String(treeID).String(branchID)
+}
+
+// historyNodeKey orders the nodes of a branch by node ID, and the nodes with the same node ID by
+// descending transaction ID, so that the node which wins comes first.
+func historyNodeKey(shardID int32, treeID string, branchID string, nodeID int64, txnID int64) key {
+	return historyBranchKey(shardID, treeID, branchID).Int64(nodeID).DescInt64(txnID)
+}
+
+func historyTreeKey(shardID int32, treeID string, branchID string) key {
+	return newKey(tableHistoryTree).Int32(shardID).String(treeID).String(branchID)
+}
+
+// AppendHistoryNodes adds or overrides a node of a history branch.
+func (s *executionStore) AppendHistoryNodes(
+	_ context.Context,
+	request *p.InternalAppendHistoryNodesRequest,
+) error {
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := appendHistoryNodes(batch, []*p.InternalAppendHistoryNodesRequest{request}); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+// appendHistoryNodes writes history nodes in the batch of a workflow execution write, so that events and
+// mutable state are committed atomically.
+func appendHistoryNodes(batch *pebble.Batch, requests []*p.InternalAppendHistoryNodesRequest) error {
+	for _, request := range requests {
+		branchInfo := request.BranchInfo
+		node := request.Node
+		k := historyNodeKey(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId(), node.NodeID, node.TransactionID)
+		if err := set(batch, k, &historyNodeRow{
+			TxnID:     node.TransactionID,
+			PrevTxnID: node.PrevTransactionID,
+			Events:    newBlob(node.Events),
+		}); err != nil {
+			return err
+		}
+		if !request.IsNewBranch {
+			continue
+		}
+		if err := set(batch, historyTreeKey(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId()), &historyTreeRow{
+			TreeID:   branchInfo.GetTreeId(),
+			BranchID: branchInfo.GetBranchId(),
+			TreeInfo: newBlob(request.TreeInfo),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *executionStore) DeleteHistoryNodes(
+	_ context.Context,
+	request *p.InternalDeleteHistoryNodesRequest,
+) error {
+	branchInfo := request.BranchInfo
+	if request.NodeID < p.GetBeginNodeID(branchInfo) {
+		return &p.InvalidPersistenceRequestError{
+			Msg: "cannot append to ancestors' nodes",
+		}
+	}
+
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	k := historyNodeKey(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId(), request.NodeID, request.TransactionID)
+	if err := remove(batch, k); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+// ReadHistoryBranch returns history nodes of a branch. The page token is the key of the last node of the
+// previous page.
+func (s *executionStore) ReadHistoryBranch(
+	_ context.Context,
+	request *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+	branch, err := s.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := historyBranchKey(request.ShardID, branch.TreeId, request.BranchID)
+	lower := prefix.Int64(request.MinNodeID)
+	upper := prefix.Int64(request.MaxNodeID)
+	if request.ReverseOrder {
+		upper = pageUpperBound(upper, request.NextPageToken)
+	} else {
+		lower = pageLowerBound(lower, request.NextPageToken)
+	}
+
+	response := &p.InternalReadHistoryBranchResponse{}
+	if string(lower) >= string(upper) {
+		return response, nil
+	}
+	var lastKey []byte
+	err = scan(s.db, lower, upper, request.ReverseOrder, func(k []byte, value []byte) (bool, error) {
+		var row historyNodeRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		node := p.InternalHistoryNode{
+			NodeID:            decodeInt64(k[len(prefix):]),
+			TransactionID:     row.TxnID,
+			PrevTransactionID: row.PrevTxnID,
+		}
+		if !request.MetadataOnly {
+			node.Events = row.Events.dataBlob()
+		}
+		response.Nodes = append(response.Nodes, node)
+		lastKey = k
+		return len(response.Nodes) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Nodes) == request.PageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing branch. The new branch shares the nodes of its
+// ancestors, so only its tree row is written.
+func (s *executionStore) ForkHistoryBranch(
+	_ context.Context,
+	request *p.InternalForkHistoryBranchRequest,
+) error {
+	treeID := request.ForkBranchInfo.GetTreeId()
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := set(batch, historyTreeKey(request.ShardID, treeID, request.NewBranchID), &historyTreeRow{
+		TreeID:   treeID,
+		BranchID: request.NewBranchID,
+		TreeInfo: newBlob(request.TreeInfo),
+	}); err != nil {
+		return err
+	}
+	return s.db.commit(batch)
+}
+
+// DeleteHistoryBranch removes a branch and the nodes of the given ranges of the branch and its ancestors.
+func (s *executionStore) DeleteHistoryBranch(
+	_ context.Context,
+	request *p.InternalDeleteHistoryBranchRequest,
+) error {
+	treeID := request.BranchInfo.GetTreeId()
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := remove(batch, historyTreeKey(request.ShardID, treeID, request.BranchInfo.GetBranchId())); err != nil {
+		return err
+	}
+	for _, branchRange := range request.BranchRanges {
+		prefix := historyBranchKey(request.ShardID, treeID, branchRange.BranchId)
+		if err := removeRange(batch, prefix.Int64(branchRange.BeginNodeId), prefix.PrefixEnd()); err != nil {
+			return err
+		}
+	}
+	return s.db.commit(batch)
+}
+
+// GetHistoryTreeContainingBranch returns all branch information of a tree.
+func (s *executionStore) GetHistoryTreeContainingBranch(
+	_ context.Context,
+	request *p.InternalGetHistoryTreeContainingBranchRequest,
+) (*p.InternalGetHistoryTreeContainingBranchResponse, error) {
+	branch, err := s.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := newKey(tableHistoryTree).Int32(request.ShardID).String(branch.TreeId)
+	response := &p.InternalGetHistoryTreeContainingBranchResponse{}
+	err = scan(s.db, prefix, prefix.PrefixEnd(), false, func(_ []byte, value []byte) (bool, error) {
+		var row historyTreeRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		response.TreeInfos = append(response.TreeInfos, row.TreeInfo.dataBlob())
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetAllHistoryTreeBranches returns the branches of all trees of all shards. The page token is the key of
+// the last branch of the previous page.
+func (s *executionStore) GetAllHistoryTreeBranches(
+	_ context.Context,
+	request *p.GetAllHistoryTreeBranchesRequest,
+) (*p.InternalGetAllHistoryTreeBranchesResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("PageSize must be greater than 0, but was %d", request.PageSize)
+	}
+
+	prefix := newKey(tableHistoryTree)
+	response := &p.InternalGetAllHistoryTreeBranchesResponse{}
+	var lastKey []byte
+	err := scan(s.db, pageLowerBound(prefix, request.NextPageToken), prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var row historyTreeRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		response.Branches = append(response.Branches, p.InternalHistoryBranchDetail{
+			TreeID:   row.TreeID,
+			BranchID: row.BranchID,
+			Data:     row.TreeInfo.Data,
+			Encoding: row.TreeInfo.Encoding.String(),
+		})
+		lastKey = k
+		return len(response.Branches) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Branches) == request.PageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
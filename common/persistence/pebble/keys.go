@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"encoding/binary"
+)
+
+// Tables are key prefixes of the database. Each table has its own key layout, see the functions below.
+const (
+	tableShard byte = iota + 1
+	tableCurrentExecution
+	tableExecution
+	tableHistoryTask
+	tableReplicationDLQTask
+	tableHistoryNode
+	tableHistoryTree
+	tableTaskQueue
+	tableTask
+	tableTaskQueueUserData
+	tableBuildIDToTaskQueue
+	tableNamespace
+	tableNamespaceName
+	tableNamespaceMetadata
+	tableClusterMetadata
+	tableClusterMembership
+	tableQueueMessage
+	tableQueueMetadata
+	tableQueueV2Metadata
+	tableQueueV2Message
+	tableNexusEndpointTableVersion
+	tableNexusEndpoint
+)
+
+// key builds an order preserving key. Components of a key sort the same way as their values, so that
+// ranges of keys can be scanned in order.
+type key []byte
+
+func newKey(table byte) key {
+	return key{table}
+}
+
+// Int64 appends a big endian integer with its sign bit flipped, so that negative values sort first.
+func (k key) Int64(v int64) key {
+	return binary.BigEndian.AppendUint64(k.clip(), uint64(v)^(1<<63))
+}
+
+// Int32 appends a big endian integer with its sign bit flipped, so that negative values sort first.
+func (k key) Int32(v int32) key {
+	return binary.BigEndian.AppendUint32(k.clip(), uint32(v)^(1<<31))
+}
+
+// DescInt64 appends an integer which sorts in descending order.
+func (k key) DescInt64(v int64) key {
+	return k.Int64(^v)
+}
+
+// String appends a string terminated by 0x00 0x01. 0x00 bytes of the string are escaped as 0x00 0xff, so that
+// a string sorts before all the strings it is a prefix of.
+func (k key) String(s string) key {
+	k = k.clip()
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 {
+			k = append(k, 0, 0xff)
+			continue
+		}
+		k = append(k, s[i])
+	}
+	return append(k, 0, 1)
+}
+
+// Next returns the smallest key greater than k.
+func (k key) Next() key {
+	next := make(key, len(k)+1)
+	copy(next, k)
+	return next
+}
+
+// PrefixEnd returns the smallest key greater than all the keys with prefix k.
+func (k key) PrefixEnd() key {
+	end := make(key, len(k))
+	copy(end, k)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	// k is all 0xff, there is no upper bound
+	return nil
+}
+
+// clip drops the spare capacity of k, so that appending to it never overwrites the keys built from the
+// same prefix.
+func (k key) clip() key {
+	return k[:len(k):len(k)]
+}
+
+func decodeInt64(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b) ^ (1 << 63))
+}
+
+func decodeInt32(b []byte) int32 {
+	return int32(binary.BigEndian.Uint32(b) ^ (1 << 31))
+}
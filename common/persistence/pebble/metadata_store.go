@@ -0,0 +1,307 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	// namespacesLockKey serializes namespace writes, which all bump the notification version.
+	namespacesLockKey = "namespaces"
+)
+
+type (
+	metadataStore struct {
+		store
+	}
+
+	namespaceRow struct {
+		ID                  string `json:"id"`
+		Name                string `json:"name"`
+		Namespace           *blob  `json:"namespace"`
+		IsGlobal            bool   `json:"isGlobal"`
+		NotificationVersion int64  `json:"notificationVersion"`
+	}
+
+	namespaceMetadataRow struct {
+		NotificationVersion int64 `json:"notificationVersion"`
+	}
+)
+
+var _ p.MetadataStore = (*metadataStore)(nil)
+
+func namespaceKey(id string) key {
+	return newKey(tableNamespace).String(id)
+}
+
+// namespaceNameKey indexes the IDs of namespaces by name.
+func namespaceNameKey(name string) key {
+	return newKey(tableNamespaceName).String(name)
+}
+
+func namespaceMetadataKey() key {
+	return newKey(tableNamespaceMetadata)
+}
+
+func (s *metadataStore) CreateNamespace(
+	_ context.Context,
+	request *p.InternalCreateNamespaceRequest,
+) (*p.CreateNamespaceResponse, error) {
+	err := s.db.update(namespacesLockKey, func(batch *pebble.Batch) error {
+		metadata, err := getNamespaceMetadata(batch)
+		if err != nil {
+			return err
+		}
+		idExists, err := exists(batch, namespaceKey(request.ID))
+		if err != nil {
+			return err
+		}
+		nameExists, err := exists(batch, namespaceNameKey(request.Name))
+		if err != nil {
+			return err
+		}
+		if idExists || nameExists {
+			return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+		}
+		if err := set(batch, namespaceKey(request.ID), &namespaceRow{
+			ID:                  request.ID,
+			Name:                request.Name,
+			Namespace:           newBlob(request.Namespace),
+			IsGlobal:            request.IsGlobal,
+			NotificationVersion: metadata.NotificationVersion,
+		}); err != nil {
+			return err
+		}
+		if err := set(batch, namespaceNameKey(request.Name), request.ID); err != nil {
+			return err
+		}
+		return updateNamespaceMetadata(batch, metadata)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.CreateNamespaceResponse{ID: request.ID}, nil
+}
+
+func (s *metadataStore) GetNamespace(
+	_ context.Context,
+	request *p.GetNamespaceRequest,
+) (*p.InternalGetNamespaceResponse, error) {
+	var id string
+	switch {
+	case request.Name != "" && request.ID != "":
+		return nil, serviceerror.NewInvalidArgument("GetNamespace operation failed.  Both ID and Name specified in request.")
+	case request.Name != "":
+		found, err := get(s.db, namespaceNameKey(request.Name), &id)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, serviceerror.NewNamespaceNotFound(request.Name)
+		}
+	case request.ID != "":
+		id = request.ID
+	default:
+		return nil, serviceerror.NewInvalidArgument("GetNamespace operation failed.  Both ID and Name are empty.")
+	}
+
+	var row namespaceRow
+	found, err := get(s.db, namespaceKey(id), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		identity := request.Name
+		if request.ID != "" {
+			identity = request.ID
+		}
+		return nil, serviceerror.NewNamespaceNotFound(identity)
+	}
+	return row.response(), nil
+}
+
+func (s *metadataStore) UpdateNamespace(
+	_ context.Context,
+	request *p.InternalUpdateNamespaceRequest,
+) error {
+	return s.updateNamespace(request)
+}
+
+func (s *metadataStore) RenameNamespace(
+	_ context.Context,
+	request *p.InternalRenameNamespaceRequest,
+) error {
+	return s.updateNamespace(request.InternalUpdateNamespaceRequest)
+}
+
+func (s *metadataStore) updateNamespace(request *p.InternalUpdateNamespaceRequest) error {
+	return s.db.update(namespacesLockKey, func(batch *pebble.Batch) error {
+		metadata, err := getNamespaceMetadata(batch)
+		if err != nil {
+			return err
+		}
+		if metadata.NotificationVersion != request.NotificationVersion {
+			return fmt.Errorf(
+				"conditional update error: expect: %v, actual: %v",
+				request.NotificationVersion,
+				metadata.NotificationVersion,
+			)
+		}
+
+		var row namespaceRow
+		found, err := get(batch, namespaceKey(request.Id), &row)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNamespaceNotFound(request.Id)
+		}
+		if row.Name != request.Name {
+			nameExists, err := exists(batch, namespaceNameKey(request.Name))
+			if err != nil {
+				return err
+			}
+			if nameExists {
+				return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+			}
+			if err := remove(batch, namespaceNameKey(row.Name)); err != nil {
+				return err
+			}
+			if err := set(batch, namespaceNameKey(request.Name), request.Id); err != nil {
+				return err
+			}
+		}
+		if err := set(batch, namespaceKey(request.Id), &namespaceRow{
+			ID:                  request.Id,
+			Name:                request.Name,
+			Namespace:           newBlob(request.Namespace),
+			IsGlobal:            request.IsGlobal,
+			NotificationVersion: request.NotificationVersion,
+		}); err != nil {
+			return err
+		}
+		return updateNamespaceMetadata(batch, metadata)
+	})
+}
+
+func (s *metadataStore) DeleteNamespace(
+	_ context.Context,
+	request *p.DeleteNamespaceRequest,
+) error {
+	return s.db.update(namespacesLockKey, func(batch *pebble.Batch) error {
+		return deleteNamespace(batch, request.ID)
+	})
+}
+
+func (s *metadataStore) DeleteNamespaceByName(
+	_ context.Context,
+	request *p.DeleteNamespaceByNameRequest,
+) error {
+	return s.db.update(namespacesLockKey, func(batch *pebble.Batch) error {
+		var id string
+		found, err := get(batch, namespaceNameKey(request.Name), &id)
+		if err != nil || !found {
+			return err
+		}
+		return deleteNamespace(batch, id)
+	})
+}
+
+func deleteNamespace(batch *pebble.Batch, id string) error {
+	var row namespaceRow
+	found, err := get(batch, namespaceKey(id), &row)
+	if err != nil || !found {
+		return err
+	}
+	if err := remove(batch, namespaceNameKey(row.Name)); err != nil {
+		return err
+	}
+	return remove(batch, namespaceKey(id))
+}
+
+// ListNamespaces lists namespaces ordered by ID. The page token is the key of the last namespace of the
+// previous page.
+func (s *metadataStore) ListNamespaces(
+	_ context.Context,
+	request *p.InternalListNamespacesRequest,
+) (*p.InternalListNamespacesResponse, error) {
+	prefix := newKey(tableNamespace)
+	response := &p.InternalListNamespacesResponse{}
+	var lastKey []byte
+	err := scan(s.db, pageLowerBound(prefix, request.NextPageToken), prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var row namespaceRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		response.Namespaces = append(response.Namespaces, row.response())
+		lastKey = k
+		return len(response.Namespaces) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Namespaces) == request.PageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
+
+func (s *metadataStore) GetMetadata(
+	_ context.Context,
+) (*p.GetMetadataResponse, error) {
+	metadata, err := getNamespaceMetadata(s.db)
+	if err != nil {
+		return nil, err
+	}
+	return &p.GetMetadataResponse{NotificationVersion: metadata.NotificationVersion}, nil
+}
+
+func (r *namespaceRow) response() *p.InternalGetNamespaceResponse {
+	return &p.InternalGetNamespaceResponse{
+		Namespace:           r.Namespace.dataBlob(),
+		IsGlobal:            r.IsGlobal,
+		NotificationVersion: r.NotificationVersion,
+	}
+}
+
+// getNamespaceMetadata returns the namespace metadata. The notification version starts at 1, like in the
+// schemas of the other persistence implementations.
+func getNamespaceMetadata(r pebble.Reader) (*namespaceMetadataRow, error) {
+	metadata := &namespaceMetadataRow{NotificationVersion: 1}
+	if _, err := get(r, namespaceMetadataKey(), metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+func updateNamespaceMetadata(batch *pebble.Batch, metadata *namespaceMetadataRow) error {
+	return set(batch, namespaceMetadataKey(), &namespaceMetadataRow{
+		NotificationVersion: metadata.NotificationVersion + 1,
+	})
+}
@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	// nexusEndpointsLockKey serializes endpoint writes, which all bump the table version.
+	nexusEndpointsLockKey = "nexus_endpoints"
+)
+
+type (
+	nexusEndpointStore struct {
+		store
+	}
+
+	nexusEndpointRow struct {
+		ID      string `json:"id"`
+		Version int64  `json:"version"`
+		Data    *blob  `json:"data"`
+	}
+)
+
+var _ p.NexusEndpointStore = (*nexusEndpointStore)(nil)
+
+func nexusEndpointKey(id string) key {
+	return newKey(tableNexusEndpoint).String(id)
+}
+
+func nexusEndpointTableVersionKey() key {
+	return newKey(tableNexusEndpointTableVersion)
+}
+
+func (s *nexusEndpointStore) CreateOrUpdateNexusEndpoint(
+	_ context.Context,
+	request *p.InternalCreateOrUpdateNexusEndpointRequest,
+) error {
+	return s.db.update(nexusEndpointsLockKey, func(batch *pebble.Batch) error {
+		if err := incrementNexusEndpointTableVersion(batch, request.LastKnownTableVersion); err != nil {
+			return err
+		}
+
+		k := nexusEndpointKey(request.Endpoint.ID)
+		var row nexusEndpointRow
+		found, err := get(batch, k, &row)
+		if err != nil {
+			return err
+		}
+		if request.Endpoint.Version == 0 && found || request.Endpoint.Version != 0 && (!found || row.Version != request.Endpoint.Version) {
+			return p.ErrNexusEndpointVersionConflict
+		}
+		return set(batch, k, &nexusEndpointRow{
+			ID:      request.Endpoint.ID,
+			Version: request.Endpoint.Version + 1,
+			Data:    newBlob(request.Endpoint.Data),
+		})
+	})
+}
+
+func (s *nexusEndpointStore) GetNexusEndpoint(
+	_ context.Context,
+	request *p.GetNexusEndpointRequest,
+) (*p.InternalNexusEndpoint, error) {
+	var row nexusEndpointRow
+	found, err := get(s.db, nexusEndpointKey(request.ID), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Nexus endpoint with ID `%v` not found", request.ID))
+	}
+	return row.endpoint(), nil
+}
+
+// ListNexusEndpoints lists endpoints ordered by ID. The page token is the key of the last endpoint of the
+// previous page.
+func (s *nexusEndpointStore) ListNexusEndpoints(
+	_ context.Context,
+	request *p.ListNexusEndpointsRequest,
+) (*p.InternalListNexusEndpointsResponse, error) {
+	// Read from a snapshot, so that the table version matches the listed endpoints.
+	snapshot := s.db.NewSnapshot()
+	defer func() { _ = snapshot.Close() }()
+
+	tableVersion, err := getNexusEndpointTableVersion(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	response := &p.InternalListNexusEndpointsResponse{TableVersion: tableVersion}
+	if request.LastKnownTableVersion != 0 && request.LastKnownTableVersion != tableVersion {
+		return response, p.ErrNexusTableVersionConflict
+	}
+
+	prefix := newKey(tableNexusEndpoint)
+	var lastKey []byte
+	err = scan(snapshot, pageLowerBound(prefix, request.NextPageToken), prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var row nexusEndpointRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		response.Endpoints = append(response.Endpoints, *row.endpoint())
+		lastKey = k
+		return len(response.Endpoints) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Endpoints) == request.PageSize {
+		response.NextPageToken = lastKey
+	}
+	return response, nil
+}
+
+func (s *nexusEndpointStore) DeleteNexusEndpoint(
+	_ context.Context,
+	request *p.DeleteNexusEndpointRequest,
+) error {
+	return s.db.update(nexusEndpointsLockKey, func(batch *pebble.Batch) error {
+		if err := incrementNexusEndpointTableVersion(batch, request.LastKnownTableVersion); err != nil {
+			return serviceerror.NewInternal(err.Error())
+		}
+
+		k := nexusEndpointKey(request.ID)
+		found, err := exists(batch, k)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNotFound(fmt.Sprintf("nexus endpoint not found for ID: %v", request.ID))
+		}
+		return remove(batch, k)
+	})
+}
+
+func (r *nexusEndpointRow) endpoint() *p.InternalNexusEndpoint {
+	return &p.InternalNexusEndpoint{
+		ID:      r.ID,
+		Version: r.Version,
+		Data:    r.Data.dataBlob(),
+	}
+}
+
+// getNexusEndpointTableVersion returns the version of the endpoints table, which is 0 before the first
+// endpoint is created.
+func getNexusEndpointTableVersion(r pebble.Reader) (int64, error) {
+	var tableVersion int64
+	if _, err := get(r, nexusEndpointTableVersionKey(), &tableVersion); err != nil {
+		return 0, err
+	}
+	return tableVersion, nil
+}
+
+func incrementNexusEndpointTableVersion(batch *pebble.Batch, lastKnownTableVersion int64) error {
+	tableVersion, err := getNexusEndpointTableVersion(batch)
+	if err != nil {
+		return err
+	}
+	if tableVersion != lastKnownTableVersion {
+		return p.ErrNexusTableVersionConflict
+	}
+	return set(batch, nexusEndpointTableVersionKey(), tableVersion+1)
+}
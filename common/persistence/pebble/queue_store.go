@@ -0,0 +1,291 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	queueStore struct {
+		store
+		queueType p.QueueType
+	}
+
+	queueMetadataRow struct {
+		Metadata *blob `json:"metadata"`
+		Version  int64 `json:"version"`
+	}
+)
+
+var _ p.Queue = (*queueStore)(nil)
+
+func queueMessagePrefix(queueType p.QueueType) key {
+	return newKey(tableQueueMessage).Int32(int32(queueType))
+}
+
+func queueMetadataKey(queueType p.QueueType) key {
+	return newKey(tableQueueMetadata).Int32(int32(queueType))
+}
+
+func queueLockKey(queueType p.QueueType) string {
+	return fmt.Sprintf("queue/%v", queueType)
+}
+
+func (q *queueStore) Init(
+	_ context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	if err := q.initializeMetadata(q.queueType, blob); err != nil {
+		return err
+	}
+	return q.initializeMetadata(q.dlqType(), blob)
+}
+
+func (q *queueStore) EnqueueMessage(
+	_ context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	_, err := q.enqueue(q.queueType, blob)
+	return err
+}
+
+func (q *queueStore) ReadMessages(
+	_ context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*p.QueueMessage, error) {
+	return q.readMessages(q.queueType, lastMessageID, p.MaxQueueMessageID, maxCount)
+}
+
+func (q *queueStore) DeleteMessagesBefore(
+	_ context.Context,
+	messageID int64,
+) error {
+	return q.rangeDeleteMessages(q.queueType, p.EmptyQueueMessageID, messageID-1)
+}
+
+func (q *queueStore) UpdateAckLevel(
+	_ context.Context,
+	metadata *p.InternalQueueMetadata,
+) error {
+	return q.updateMetadata(q.queueType, metadata)
+}
+
+func (q *queueStore) GetAckLevels(
+	_ context.Context,
+) (*p.InternalQueueMetadata, error) {
+	return q.getMetadata(q.queueType)
+}
+
+func (q *queueStore) EnqueueMessageToDLQ(
+	_ context.Context,
+	blob *commonpb.DataBlob,
+) (int64, error) {
+	return q.enqueue(q.dlqType(), blob)
+}
+
+// ReadMessagesFromDLQ reads the DLQ messages in (firstMessageID, lastMessageID]. The page token is the ID
+// of the last message of the previous page.
+func (q *queueStore) ReadMessagesFromDLQ(
+	_ context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*p.QueueMessage, []byte, error) {
+	if len(pageToken) != 0 {
+		if len(pageToken) != 8 {
+			return nil, nil, serviceerror.NewInternal(fmt.Sprintf("invalid next page token %v", pageToken))
+		}
+		firstMessageID = decodeInt64(pageToken)
+	}
+	messages, err := q.readMessages(q.dlqType(), firstMessageID, lastMessageID, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	var nextPageToken []byte
+	if len(messages) > 0 && len(messages) >= pageSize {
+		nextPageToken = key{}.Int64(messages[len(messages)-1].ID)
+	}
+	return messages, nextPageToken, nil
+}
+
+func (q *queueStore) DeleteMessageFromDLQ(
+	_ context.Context,
+	messageID int64,
+) error {
+	batch := q.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := remove(batch, queueMessagePrefix(q.dlqType()).Int64(messageID)); err != nil {
+		return err
+	}
+	return q.db.commit(batch)
+}
+
+func (q *queueStore) RangeDeleteMessagesFromDLQ(
+	_ context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	return q.rangeDeleteMessages(q.dlqType(), firstMessageID, lastMessageID)
+}
+
+func (q *queueStore) UpdateDLQAckLevel(
+	_ context.Context,
+	metadata *p.InternalQueueMetadata,
+) error {
+	return q.updateMetadata(q.dlqType(), metadata)
+}
+
+func (q *queueStore) GetDLQAckLevels(
+	_ context.Context,
+) (*p.InternalQueueMetadata, error) {
+	return q.getMetadata(q.dlqType())
+}
+
+func (q *queueStore) dlqType() p.QueueType {
+	return -q.queueType
+}
+
+// enqueue appends a message after the last message of the queue and returns its ID.
+func (q *queueStore) enqueue(queueType p.QueueType, blob *commonpb.DataBlob) (int64, error) {
+	var messageID int64
+	err := q.db.update(queueLockKey(queueType), func(batch *pebble.Batch) error {
+		prefix := queueMessagePrefix(queueType)
+		messageID = p.EmptyQueueMessageID + 1
+		err := scan(batch, prefix, prefix.PrefixEnd(), true, func(k []byte, _ []byte) (bool, error) {
+			messageID = decodeInt64(k[len(prefix):]) + 1
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+		return set(batch, prefix.Int64(messageID), newBlob(blob))
+	})
+	if err != nil {
+		return p.EmptyQueueMessageID, err
+	}
+	return messageID, nil
+}
+
+// readMessages reads the messages in (exclusiveMinMessageID, inclusiveMaxMessageID].
+func (q *queueStore) readMessages(
+	queueType p.QueueType,
+	exclusiveMinMessageID int64,
+	inclusiveMaxMessageID int64,
+	pageSize int,
+) ([]*p.QueueMessage, error) {
+	if exclusiveMinMessageID >= inclusiveMaxMessageID {
+		return nil, nil
+	}
+	prefix := queueMessagePrefix(queueType)
+	var messages []*p.QueueMessage
+	err := scan(q.db, prefix.Int64(exclusiveMinMessageID+1), messageUpperBound(prefix, inclusiveMaxMessageID), false, func(k []byte, value []byte) (bool, error) {
+		var message blob
+		if err := decode(value, &message); err != nil {
+			return false, err
+		}
+		messages = append(messages, &p.QueueMessage{
+			QueueType: queueType,
+			ID:        decodeInt64(k[len(prefix):]),
+			Data:      message.Data,
+			Encoding:  message.Encoding.String(),
+		})
+		return len(messages) < pageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// rangeDeleteMessages deletes the messages in (exclusiveMinMessageID, inclusiveMaxMessageID].
+func (q *queueStore) rangeDeleteMessages(
+	queueType p.QueueType,
+	exclusiveMinMessageID int64,
+	inclusiveMaxMessageID int64,
+) error {
+	if exclusiveMinMessageID >= inclusiveMaxMessageID {
+		return nil
+	}
+	prefix := queueMessagePrefix(queueType)
+	return q.deleteRange(prefix.Int64(exclusiveMinMessageID+1), messageUpperBound(prefix, inclusiveMaxMessageID))
+}
+
+func (q *queueStore) initializeMetadata(queueType p.QueueType, blob *commonpb.DataBlob) error {
+	return q.db.update(queueLockKey(queueType), func(batch *pebble.Batch) error {
+		k := queueMetadataKey(queueType)
+		found, err := exists(batch, k)
+		if err != nil || found {
+			return err
+		}
+		return set(batch, k, &queueMetadataRow{Metadata: newBlob(blob)})
+	})
+}
+
+func (q *queueStore) getMetadata(queueType p.QueueType) (*p.InternalQueueMetadata, error) {
+	var row queueMetadataRow
+	found, err := get(q.db, queueMetadataKey(queueType), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("queue metadata of queue type %v is not initialized", queueType))
+	}
+	return &p.InternalQueueMetadata{
+		Blob:    row.Metadata.dataBlob(),
+		Version: row.Version,
+	}, nil
+}
+
+func (q *queueStore) updateMetadata(queueType p.QueueType, metadata *p.InternalQueueMetadata) error {
+	return q.db.update(queueLockKey(queueType), func(batch *pebble.Batch) error {
+		k := queueMetadataKey(queueType)
+		var row queueMetadataRow
+		found, err := get(batch, k, &row)
+		if err != nil {
+			return err
+		}
+		if !found || row.Version != metadata.Version {
+			return &p.ConditionFailedError{Msg: "UpdateAckLevel operation encountered concurrent write."}
+		}
+		return set(batch, k, &queueMetadataRow{
+			Metadata: newBlob(metadata.Blob),
+			Version:  metadata.Version + 1,
+		})
+	})
+}
+
+func messageUpperBound(prefix key, inclusiveMaxMessageID int64) key {
+	if inclusiveMaxMessageID == p.MaxQueueMessageID {
+		return prefix.PrefixEnd()
+	}
+	return prefix.Int64(inclusiveMaxMessageID + 1)
+}
@@ -0,0 +1,339 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	defaultQueueV2Partition = 0
+)
+
+type (
+	queueV2Store struct {
+		store
+	}
+
+	queueV2MetadataRow struct {
+		QueueName string `json:"queueName"`
+		Metadata  []byte `json:"metadata"`
+	}
+)
+
+var _ p.QueueV2 = (*queueV2Store)(nil)
+
+func queueV2MetadataKey(queueType p.QueueV2Type, queueName string) key {
+	return newKey(tableQueueV2Metadata).Int32(int32(queueType)).String(queueName)
+}
+
+func queueV2MessagePrefix(queueType p.QueueV2Type, queueName string) key {
+	return newKey(tableQueueV2Message).Int32(int32(queueType)).String(queueName).Int32(defaultQueueV2Partition)
+}
+
+func queueV2LockKey(queueType p.QueueV2Type, queueName string) string {
+	return fmt.Sprintf("queue_v2/%v/%v", queueType, queueName)
+}
+
+func (q *queueV2Store) EnqueueMessage(
+	_ context.Context,
+	request *p.InternalEnqueueMessageRequest,
+) (*p.InternalEnqueueMessageResponse, error) {
+	var messageID int64
+	err := q.db.update(queueV2LockKey(request.QueueType, request.QueueName), func(batch *pebble.Batch) error {
+		if _, err := getQueueV2Metadata(batch, request.QueueType, request.QueueName); err != nil {
+			return err
+		}
+		var err error
+		messageID, err = nextQueueV2MessageID(batch, request.QueueType, request.QueueName)
+		if err != nil {
+			return err
+		}
+		return set(batch, queueV2MessagePrefix(request.QueueType, request.QueueName).Int64(messageID), newBlob(request.Blob))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalEnqueueMessageResponse{Metadata: p.MessageMetadata{ID: messageID}}, nil
+}
+
+func (q *queueV2Store) ReadMessages(
+	_ context.Context,
+	request *p.InternalReadMessagesRequest,
+) (*p.InternalReadMessagesResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, p.ErrNonPositiveReadQueueMessagesPageSize
+	}
+	qm, err := getQueueV2Metadata(q.db, request.QueueType, request.QueueName)
+	if err != nil {
+		return nil, err
+	}
+	minMessageID, err := p.GetMinMessageIDToReadForQueueV2(request.QueueType, request.QueueName, request.NextPageToken, qm)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := queueV2MessagePrefix(request.QueueType, request.QueueName)
+	var messages []p.QueueV2Message
+	err = scan(q.db, prefix.Int64(minMessageID), prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var message blob
+		if err := decode(value, &message); err != nil {
+			return false, err
+		}
+		if _, ok := enumspb.EncodingType_name[int32(message.Encoding)]; !ok {
+			return false, serialization.NewUnknownEncodingTypeError(message.Encoding.String())
+		}
+		messages = append(messages, p.QueueV2Message{
+			MetaData: p.MessageMetadata{ID: decodeInt64(k[len(prefix):])},
+			Data:     message.dataBlob(),
+		})
+		return len(messages) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalReadMessagesResponse{
+		Messages:      messages,
+		NextPageToken: p.GetNextPageTokenForReadMessages(messages),
+	}, nil
+}
+
+func (q *queueV2Store) CreateQueue(
+	_ context.Context,
+	request *p.InternalCreateQueueRequest,
+) (*p.InternalCreateQueueResponse, error) {
+	err := q.db.update(queueV2LockKey(request.QueueType, request.QueueName), func(batch *pebble.Batch) error {
+		k := queueV2MetadataKey(request.QueueType, request.QueueName)
+		found, err := exists(batch, k)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf(
+				"%w: queue type %v and name %v",
+				p.ErrQueueAlreadyExists,
+				request.QueueType,
+				request.QueueName,
+			)
+		}
+		return setQueueV2Metadata(batch, request.QueueType, request.QueueName, &persistencespb.Queue{
+			Partitions: map[int32]*persistencespb.QueuePartition{
+				defaultQueueV2Partition: {
+					MinMessageId: p.FirstQueueMessageID,
+				},
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalCreateQueueResponse{}, nil
+}
+
+// RangeDeleteMessages deletes messages up to InclusiveMaxMessageMetadata, but never the last message of the
+// queue, so that message IDs keep increasing.
+func (q *queueV2Store) RangeDeleteMessages(
+	_ context.Context,
+	request *p.InternalRangeDeleteMessagesRequest,
+) (*p.InternalRangeDeleteMessagesResponse, error) {
+	if request.InclusiveMaxMessageMetadata.ID < p.FirstQueueMessageID {
+		return nil, fmt.Errorf(
+			"%w: id is %d but must be >= %d",
+			p.ErrInvalidQueueRangeDeleteMaxMessageID,
+			request.InclusiveMaxMessageMetadata.ID,
+			p.FirstQueueMessageID,
+		)
+	}
+
+	response := &p.InternalRangeDeleteMessagesResponse{}
+	err := q.db.update(queueV2LockKey(request.QueueType, request.QueueName), func(batch *pebble.Batch) error {
+		qm, err := getQueueV2Metadata(batch, request.QueueType, request.QueueName)
+		if err != nil {
+			return err
+		}
+		partition, err := p.GetPartitionForQueueV2(request.QueueType, request.QueueName, qm)
+		if err != nil {
+			return err
+		}
+		maxMessageID, ok, err := maxQueueV2MessageID(batch, request.QueueType, request.QueueName)
+		if err != nil || !ok {
+			return err
+		}
+		deleteRange, ok := p.GetDeleteRange(p.DeleteRequest{
+			LastIDToDeleteInclusive: request.InclusiveMaxMessageMetadata.ID,
+			ExistingMessageRange: p.InclusiveMessageRange{
+				MinMessageID: partition.MinMessageId,
+				MaxMessageID: maxMessageID,
+			},
+		})
+		if !ok {
+			return nil
+		}
+		prefix := queueV2MessagePrefix(request.QueueType, request.QueueName)
+		if deleteRange.MinMessageID <= deleteRange.MaxMessageID {
+			if err := removeRange(batch, prefix.Int64(deleteRange.MinMessageID), prefix.Int64(deleteRange.MaxMessageID+1)); err != nil {
+				return err
+			}
+		}
+		partition.MinMessageId = deleteRange.NewMinMessageID
+		if err := setQueueV2Metadata(batch, request.QueueType, request.QueueName, qm); err != nil {
+			return err
+		}
+		response.MessagesDeleted = deleteRange.MessagesToDelete
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListQueues lists the queues of a type ordered by name. The page token is the offset of the next page.
+func (q *queueV2Store) ListQueues(
+	_ context.Context,
+	request *p.InternalListQueuesRequest,
+) (*p.InternalListQueuesResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, p.ErrNonPositiveListQueuesPageSize
+	}
+	offset, err := p.GetOffsetForListQueues(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 {
+		return nil, p.ErrNegativeListQueuesOffset
+	}
+
+	prefix := newKey(tableQueueV2Metadata).Int32(int32(request.QueueType))
+	var queues []p.QueueInfo
+	skipped := int64(0)
+	err = scan(q.db, prefix, prefix.PrefixEnd(), false, func(_ []byte, value []byte) (bool, error) {
+		if skipped < offset {
+			skipped++
+			return true, nil
+		}
+		var row queueV2MetadataRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		messageCount, err := q.getMessageCount(request.QueueType, &row)
+		if err != nil {
+			return false, err
+		}
+		queues = append(queues, p.QueueInfo{
+			QueueName:    row.QueueName,
+			MessageCount: messageCount,
+		})
+		return len(queues) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &p.InternalListQueuesResponse{Queues: queues}
+	if len(queues) > 0 {
+		response.NextPageToken = p.GetNextPageTokenForListQueues(offset + int64(len(queues)))
+	}
+	return response, nil
+}
+
+func (q *queueV2Store) getMessageCount(queueType p.QueueV2Type, row *queueV2MetadataRow) (int64, error) {
+	qm, err := decodeQueueV2Metadata(queueType, row)
+	if err != nil {
+		return 0, err
+	}
+	partition, err := p.GetPartitionForQueueV2(queueType, row.QueueName, qm)
+	if err != nil {
+		return 0, err
+	}
+	nextMessageID, err := nextQueueV2MessageID(q.db, queueType, row.QueueName)
+	if err != nil {
+		return 0, err
+	}
+	return nextMessageID - partition.MinMessageId, nil
+}
+
+func getQueueV2Metadata(r pebble.Reader, queueType p.QueueV2Type, queueName string) (*persistencespb.Queue, error) {
+	var row queueV2MetadataRow
+	found, err := get(r, queueV2MetadataKey(queueType, queueName), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, p.NewQueueNotFoundError(queueType, queueName)
+	}
+	return decodeQueueV2Metadata(queueType, &row)
+}
+
+func decodeQueueV2Metadata(queueType p.QueueV2Type, row *queueV2MetadataRow) (*persistencespb.Queue, error) {
+	qm := &persistencespb.Queue{}
+	if err := qm.Unmarshal(row.Metadata); err != nil {
+		return nil, serialization.NewDeserializationError(
+			enumspb.ENCODING_TYPE_PROTO3,
+			fmt.Errorf("unmarshal payload for queue with type %v and name %v failed: %w", queueType, row.QueueName, err),
+		)
+	}
+	return qm, nil
+}
+
+func setQueueV2Metadata(batch *pebble.Batch, queueType p.QueueV2Type, queueName string, qm *persistencespb.Queue) error {
+	data, err := qm.Marshal()
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("failed to encode metadata of queue with type %v and name %v: %v", queueType, queueName, err))
+	}
+	return set(batch, queueV2MetadataKey(queueType, queueName), &queueV2MetadataRow{
+		QueueName: queueName,
+		Metadata:  data,
+	})
+}
+
+func maxQueueV2MessageID(r pebble.Reader, queueType p.QueueV2Type, queueName string) (int64, bool, error) {
+	prefix := queueV2MessagePrefix(queueType, queueName)
+	var maxMessageID int64
+	found := false
+	err := scan(r, prefix, prefix.PrefixEnd(), true, func(k []byte, _ []byte) (bool, error) {
+		maxMessageID = decodeInt64(k[len(prefix):])
+		found = true
+		return false, nil
+	})
+	return maxMessageID, found, err
+}
+
+func nextQueueV2MessageID(r pebble.Reader, queueType p.QueueV2Type, queueName string) (int64, error) {
+	maxMessageID, ok, err := maxQueueV2MessageID(r, queueType, queueName)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return p.FirstQueueMessageID, nil
+	}
+	return maxMessageID + 1, nil
+}
@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cockroachdb/pebble"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	shardStore struct {
+		store
+		clusterName string
+	}
+
+	shardRow struct {
+		RangeID   int64 `json:"rangeId"`
+		ShardInfo *blob `json:"shardInfo"`
+	}
+)
+
+var _ p.ShardStore = (*shardStore)(nil)
+
+func shardKey(shardID int32) key {
+	return newKey(tableShard).Int32(shardID)
+}
+
+// shardLockKey is locked by all the transactions which check the range ID of a shard.
+func shardLockKey(shardID int32) string {
+	return "shard/" + strconv.FormatInt(int64(shardID), 10)
+}
+
+func (s *shardStore) GetClusterName() string {
+	return s.clusterName
+}
+
+func (s *shardStore) GetOrCreateShard(
+	_ context.Context,
+	request *p.InternalGetOrCreateShardRequest,
+) (*p.InternalGetOrCreateShardResponse, error) {
+	var row shardRow
+	found, err := get(s.db, shardKey(request.ShardID), &row)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return &p.InternalGetOrCreateShardResponse{ShardInfo: row.ShardInfo.dataBlob()}, nil
+	}
+	if request.CreateShardInfo == nil {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetOrCreateShard: ShardID %v not found", request.ShardID))
+	}
+
+	rangeID, shardInfo, err := request.CreateShardInfo()
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetOrCreateShard: failed to encode shard info for ShardID %v. Error: %v", request.ShardID, err))
+	}
+	err = s.db.update(shardLockKey(request.ShardID), func(batch *pebble.Batch) error {
+		// the shard may have been created concurrently
+		found, err := get(batch, shardKey(request.ShardID), &row)
+		if err != nil || found {
+			return err
+		}
+		row = shardRow{RangeID: rangeID, ShardInfo: newBlob(shardInfo)}
+		return set(batch, shardKey(request.ShardID), &row)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalGetOrCreateShardResponse{ShardInfo: row.ShardInfo.dataBlob()}, nil
+}
+
+func (s *shardStore) UpdateShard(
+	_ context.Context,
+	request *p.InternalUpdateShardRequest,
+) error {
+	return s.db.update(shardLockKey(request.ShardID), func(batch *pebble.Batch) error {
+		if err := checkShardRangeID(batch, request.ShardID, request.PreviousRangeID); err != nil {
+			return err
+		}
+		return set(batch, shardKey(request.ShardID), &shardRow{
+			RangeID:   request.RangeID,
+			ShardInfo: newBlob(request.ShardInfo),
+		})
+	})
+}
+
+func (s *shardStore) AssertShardOwnership(
+	_ context.Context,
+	request *p.AssertShardOwnershipRequest,
+) error {
+	var row shardRow
+	found, err := get(s.db, shardKey(request.ShardID), &row)
+	if err != nil {
+		return err
+	}
+	return assertShardRangeID(request.ShardID, request.RangeID, row.RangeID, found)
+}
+
+// checkShardRangeID returns ShardOwnershipLostError if the range ID of the shard is not rangeID. It must be
+// called in a transaction holding the shard lock.
+func checkShardRangeID(batch *pebble.Batch, shardID int32, rangeID int64) error {
+	var row shardRow
+	found, err := get(batch, shardKey(shardID), &row)
+	if err != nil {
+		return err
+	}
+	return assertShardRangeID(shardID, rangeID, row.RangeID, found)
+}
+
+func assertShardRangeID(shardID int32, expectedRangeID int64, rangeID int64, found bool) error {
+	if !found {
+		return serviceerror.NewUnavailable(fmt.Sprintf("Failed to lock shard with ID %v that does not exist.", shardID))
+	}
+	if rangeID != expectedRangeID {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     fmt.Sprintf("Failed to lock shard. Previous range ID: %v; new range ID: %v", expectedRangeID, rangeID),
+		}
+	}
+	return nil
+}
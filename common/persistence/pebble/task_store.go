@@ -0,0 +1,407 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	taskStore struct {
+		store
+	}
+
+	taskQueueRow struct {
+		RangeID       int64 `json:"rangeId"`
+		TaskQueueInfo *blob `json:"taskQueueInfo"`
+	}
+
+	taskQueueUserDataRow struct {
+		TaskQueue string `json:"taskQueue"`
+		Version   int64  `json:"version"`
+		UserData  *blob  `json:"userData"`
+	}
+)
+
+var _ p.TaskStore = (*taskStore)(nil)
+
+func taskQueueKey(namespaceID string, taskQueue string, taskType enumspb.TaskQueueType) key {
+	return newKey(tableTaskQueue).String(namespaceID).String(taskQueue).Int32(int32(taskType))
+}
+
+func taskQueueLockKey(namespaceID string, taskQueue string, taskType enumspb.TaskQueueType) string {
+	return fmt.Sprintf("task_queue/%v/%v/%v", namespaceID, taskQueue, taskType)
+}
+
+func taskPrefix(namespaceID string, taskQueue string, taskType enumspb.TaskQueueType) key {
+	return newKey(tableTask).String(namespaceID).String(taskQueue).Int32(int32(taskType))
+}
+
+func taskQueueUserDataKey(namespaceID string, taskQueue string) key {
+	return newKey(tableTaskQueueUserData).String(namespaceID).String(taskQueue)
+}
+
+func taskQueueUserDataLockKey(namespaceID string, taskQueue string) string {
+	return fmt.Sprintf("task_queue_user_data/%v/%v", namespaceID, taskQueue)
+}
+
+func buildIDToTaskQueueKey(namespaceID string, buildID string, taskQueue string) key {
+	return newKey(tableBuildIDToTaskQueue).String(namespaceID).String(buildID).String(taskQueue)
+}
+
+func (s *taskStore) CreateTaskQueue(
+	_ context.Context,
+	request *p.InternalCreateTaskQueueRequest,
+) error {
+	return s.db.update(taskQueueLockKey(request.NamespaceID, request.TaskQueue, request.TaskType), func(batch *pebble.Batch) error {
+		k := taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType)
+		found, err := exists(batch, k)
+		if err != nil {
+			return err
+		}
+		if found {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("CreateTaskQueue operation failed. Task queue %v of type %v already exists", request.TaskQueue, request.TaskType),
+			}
+		}
+		return set(batch, k, &taskQueueRow{
+			RangeID:       request.RangeID,
+			TaskQueueInfo: newBlob(request.TaskQueueInfo),
+		})
+	})
+}
+
+func (s *taskStore) GetTaskQueue(
+	_ context.Context,
+	request *p.InternalGetTaskQueueRequest,
+) (*p.InternalGetTaskQueueResponse, error) {
+	var row taskQueueRow
+	found, err := get(s.db, taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(
+			fmt.Sprintf("GetTaskQueue operation failed. TaskQueue: %v, TaskQueueType: %v", request.TaskQueue, request.TaskType),
+		)
+	}
+	return &p.InternalGetTaskQueueResponse{
+		RangeID:       row.RangeID,
+		TaskQueueInfo: row.TaskQueueInfo.dataBlob(),
+	}, nil
+}
+
+func (s *taskStore) UpdateTaskQueue(
+	_ context.Context,
+	request *p.InternalUpdateTaskQueueRequest,
+) (*p.UpdateTaskQueueResponse, error) {
+	err := s.db.update(taskQueueLockKey(request.NamespaceID, request.TaskQueue, request.TaskType), func(batch *pebble.Batch) error {
+		k := taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType)
+		if err := checkTaskQueueRangeID(batch, k, request.PrevRangeID); err != nil {
+			return err
+		}
+		return set(batch, k, &taskQueueRow{
+			RangeID:       request.RangeID,
+			TaskQueueInfo: newBlob(request.TaskQueueInfo),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.UpdateTaskQueueResponse{}, nil
+}
+
+// ListTaskQueue lists the task queues of all namespaces. The page token is the key of the last task
+// queue of the previous page.
+func (s *taskStore) ListTaskQueue(
+	_ context.Context,
+	request *p.ListTaskQueueRequest,
+) (*p.InternalListTaskQueueResponse, error) {
+	prefix := newKey(tableTaskQueue)
+	response := &p.InternalListTaskQueueResponse{}
+	var lastKey []byte
+	err := scan(s.db, pageLowerBound(prefix, request.PageToken), prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var row taskQueueRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		response.Items = append(response.Items, &p.InternalListTaskQueueItem{
+			TaskQueue: row.TaskQueueInfo.dataBlob(),
+			RangeID:   row.RangeID,
+		})
+		lastKey = k
+		return len(response.Items) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Items) == request.PageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
+
+func (s *taskStore) DeleteTaskQueue(
+	_ context.Context,
+	request *p.DeleteTaskQueueRequest,
+) error {
+	tq := request.TaskQueue
+	return s.db.update(taskQueueLockKey(tq.NamespaceID, tq.TaskQueueName, tq.TaskQueueType), func(batch *pebble.Batch) error {
+		k := taskQueueKey(tq.NamespaceID, tq.TaskQueueName, tq.TaskQueueType)
+		if err := checkTaskQueueRangeID(batch, k, request.RangeID); err != nil {
+			return err
+		}
+		return remove(batch, k)
+	})
+}
+
+func (s *taskStore) CreateTasks(
+	_ context.Context,
+	request *p.InternalCreateTasksRequest,
+) (*p.CreateTasksResponse, error) {
+	err := s.db.update(taskQueueLockKey(request.NamespaceID, request.TaskQueue, request.TaskType), func(batch *pebble.Batch) error {
+		if err := checkTaskQueueRangeID(batch, taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType), request.RangeID); err != nil {
+			return err
+		}
+		prefix := taskPrefix(request.NamespaceID, request.TaskQueue, request.TaskType)
+		for _, task := range request.Tasks {
+			if err := set(batch, prefix.Int64(task.TaskId), newBlob(task.Task)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.CreateTasksResponse{}, nil
+}
+
+// GetTasks returns the tasks in [InclusiveMinTaskID, ExclusiveMaxTaskID). The page token is the key of
+// the last task of the previous page.
+func (s *taskStore) GetTasks(
+	_ context.Context,
+	request *p.GetTasksRequest,
+) (*p.InternalGetTasksResponse, error) {
+	prefix := taskPrefix(request.NamespaceID, request.TaskQueue, request.TaskType)
+	lower := pageLowerBound(prefix.Int64(request.InclusiveMinTaskID), request.NextPageToken)
+	upper := prefix.Int64(request.ExclusiveMaxTaskID)
+
+	response := &p.InternalGetTasksResponse{}
+	if string(lower) >= string(upper) {
+		return response, nil
+	}
+	var lastKey []byte
+	err := scan(s.db, lower, upper, false, func(k []byte, value []byte) (bool, error) {
+		var task blob
+		if err := decode(value, &task); err != nil {
+			return false, err
+		}
+		response.Tasks = append(response.Tasks, task.dataBlob())
+		lastKey = k
+		return len(response.Tasks) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Tasks) == request.PageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
+
+// CompleteTasksLessThan deletes at most Limit tasks with IDs less than ExclusiveMaxTaskID, and returns
+// the number of tasks deleted.
+func (s *taskStore) CompleteTasksLessThan(
+	_ context.Context,
+	request *p.CompleteTasksLessThanRequest,
+) (int, error) {
+	if request.Limit <= 0 {
+		return 0, serviceerror.NewInternal("missing limit parameter")
+	}
+	prefix := taskPrefix(request.NamespaceID, request.TaskQueueName, request.TaskType)
+	upper := prefix.Int64(request.ExclusiveMaxTaskID)
+
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	deleted := 0
+	err := scan(s.db, prefix, upper, false, func(k []byte, _ []byte) (bool, error) {
+		if err := remove(batch, k); err != nil {
+			return false, err
+		}
+		deleted++
+		return deleted < request.Limit, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := s.db.commit(batch); err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+func (s *taskStore) GetTaskQueueUserData(
+	_ context.Context,
+	request *p.GetTaskQueueUserDataRequest,
+) (*p.InternalGetTaskQueueUserDataResponse, error) {
+	var row taskQueueUserDataRow
+	found, err := get(s.db, taskQueueUserDataKey(request.NamespaceID, request.TaskQueue), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("task queue user data not found for %v.%v", request.NamespaceID, request.TaskQueue))
+	}
+	return &p.InternalGetTaskQueueUserDataResponse{
+		Version:  row.Version,
+		UserData: row.UserData.dataBlob(),
+	}, nil
+}
+
+// UpdateTaskQueueUserData creates the user data if Version is 0, and otherwise replaces it if its version
+// is still Version. The version of the written user data is Version+1.
+func (s *taskStore) UpdateTaskQueueUserData(
+	_ context.Context,
+	request *p.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	return s.db.update(taskQueueUserDataLockKey(request.NamespaceID, request.TaskQueue), func(batch *pebble.Batch) error {
+		k := taskQueueUserDataKey(request.NamespaceID, request.TaskQueue)
+		var row taskQueueUserDataRow
+		found, err := get(batch, k, &row)
+		if err != nil {
+			return err
+		}
+		if request.Version == 0 && found {
+			return &p.ConditionFailedError{Msg: "Task queue user data already exists"}
+		}
+		if request.Version != 0 && (!found || row.Version != request.Version) {
+			return &p.ConditionFailedError{Msg: "Expected exactly one row to be updated"}
+		}
+		if err := set(batch, k, &taskQueueUserDataRow{
+			TaskQueue: request.TaskQueue,
+			Version:   request.Version + 1,
+			UserData:  newBlob(request.UserData),
+		}); err != nil {
+			return err
+		}
+
+		for _, buildID := range request.BuildIdsAdded {
+			if err := set(batch, buildIDToTaskQueueKey(request.NamespaceID, buildID, request.TaskQueue), request.TaskQueue); err != nil {
+				return err
+			}
+		}
+		for _, buildID := range request.BuildIdsRemoved {
+			if err := remove(batch, buildIDToTaskQueueKey(request.NamespaceID, buildID, request.TaskQueue)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *taskStore) ListTaskQueueUserDataEntries(
+	_ context.Context,
+	request *p.ListTaskQueueUserDataEntriesRequest,
+) (*p.InternalListTaskQueueUserDataEntriesResponse, error) {
+	prefix := newKey(tableTaskQueueUserData).String(request.NamespaceID)
+	response := &p.InternalListTaskQueueUserDataEntriesResponse{}
+	var lastKey []byte
+	err := scan(s.db, pageLowerBound(prefix, request.NextPageToken), prefix.PrefixEnd(), false, func(k []byte, value []byte) (bool, error) {
+		var row taskQueueUserDataRow
+		if err := decode(value, &row); err != nil {
+			return false, err
+		}
+		response.Entries = append(response.Entries, p.InternalTaskQueueUserDataEntry{
+			TaskQueue: row.TaskQueue,
+			Data:      row.UserData.dataBlob(),
+			Version:   row.Version,
+		})
+		lastKey = k
+		return len(response.Entries) < request.PageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Entries) == request.PageSize {
+		response.NextPageToken = append([]byte(nil), lastKey...)
+	}
+	return response, nil
+}
+
+func (s *taskStore) GetTaskQueuesByBuildId(
+	_ context.Context,
+	request *p.GetTaskQueuesByBuildIdRequest,
+) ([]string, error) {
+	var taskQueues []string
+	err := s.scanBuildID(request.NamespaceID, request.BuildID, func(taskQueue string) {
+		taskQueues = append(taskQueues, taskQueue)
+	})
+	return taskQueues, err
+}
+
+func (s *taskStore) CountTaskQueuesByBuildId(
+	_ context.Context,
+	request *p.CountTaskQueuesByBuildIdRequest,
+) (int, error) {
+	count := 0
+	err := s.scanBuildID(request.NamespaceID, request.BuildID, func(string) {
+		count++
+	})
+	return count, err
+}
+
+func (s *taskStore) scanBuildID(namespaceID string, buildID string, fn func(taskQueue string)) error {
+	prefix := newKey(tableBuildIDToTaskQueue).String(namespaceID).String(buildID)
+	return scan(s.db, prefix, prefix.PrefixEnd(), false, func(_ []byte, value []byte) (bool, error) {
+		var taskQueue string
+		if err := decode(value, &taskQueue); err != nil {
+			return false, err
+		}
+		fn(taskQueue)
+		return true, nil
+	})
+}
+
+func checkTaskQueueRangeID(batch *pebble.Batch, k key, rangeID int64) error {
+	var row taskQueueRow
+	found, err := get(batch, k, &row)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return &p.ConditionFailedError{Msg: "Task queue does not exists"}
+	}
+	if row.RangeID != rangeID {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Task queue range ID was %v when it was should have been %v", row.RangeID, rangeID),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pebble
+
+import (
+	"os"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives"
+)
+
+// TestCluster allows executing pebble operations in testing.
+type TestCluster struct {
+	cfg            config.Pebble
+	faultInjection *config.FaultInjection
+	logger         log.Logger
+}
+
+// NewTestCluster returns a new pebble test cluster. The database is created in a new temporary directory.
+func NewTestCluster(faultInjection *config.FaultInjection, logger log.Logger) *TestCluster {
+	return &TestCluster{
+		faultInjection: faultInjection,
+		logger:         logger,
+	}
+}
+
+// Config returns the persistence config for connecting to this test cluster
+func (s *TestCluster) Config() config.Persistence {
+	cfg := s.cfg
+	return config.Persistence{
+		DefaultStore: "test",
+		DataStores: map[string]config.DataStore{
+			"test": {Pebble: &cfg, FaultInjection: s.faultInjection},
+		},
+		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(primitives.DefaultTransactionSizeLimit),
+	}
+}
+
+// SetupTestDatabase from PersistenceTestCluster interface
+func (s *TestCluster) SetupTestDatabase() {
+	path, err := os.MkdirTemp("", "temporal-pebble-*")
+	if err != nil {
+		s.logger.Fatal("SetupTestDatabase", tag.Error(err))
+	}
+	s.cfg = config.Pebble{
+		Path: path,
+		// Tests don't need to survive host crashes.
+		DisableSync: true,
+	}
+}
+
+// TearDownTestDatabase from PersistenceTestCluster interface
+func (s *TestCluster) TearDownTestDatabase() {
+	if err := os.RemoveAll(s.cfg.Path); err != nil {
+		s.logger.Fatal("TearDownTestDatabase", tag.Error(err))
+	}
+}
@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/pebble"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
@@ -175,13 +176,25 @@ func NewTestBaseWithSQL(options *TestBaseOptions) *TestBase {
 	return NewTestBaseForCluster(testCluster, logger)
 }
 
-// NewTestBase returns a persistence test base backed by either cassandra or sql
+// NewTestBaseWithPebble returns a new persistence test base backed by an embedded pebble database
+func NewTestBaseWithPebble(options *TestBaseOptions) *TestBase {
+	logger := options.Logger
+	if logger == nil {
+		logger = log.NewTestLogger()
+	}
+	testCluster := pebble.NewTestCluster(options.FaultInjection, logger)
+	return NewTestBaseForCluster(testCluster, logger)
+}
+
+// NewTestBase returns a persistence test base backed by either cassandra, sql or pebble
 func NewTestBase(options *TestBaseOptions) *TestBase {
 	switch options.StoreType {
 	case config.StoreTypeSQL:
 		return NewTestBaseWithSQL(options)
 	case config.StoreTypeNoSQL:
 		return NewTestBaseWithCassandra(options)
+	case config.StoreTypePebble:
+		return NewTestBaseWithPebble(options)
 	default:
 		panic("invalid storeType " + options.StoreType)
 	}
@@ -230,6 +243,7 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		metrics.NoopMetricsHandler,
 		s.Logger,
 		s.PersistenceHealthSignals,
+		nil,
 	)
 
 	s.TaskMgr, err = factory.NewTaskManager()
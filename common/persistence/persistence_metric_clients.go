@@ -43,6 +43,7 @@ import (
 type (
 	metricEmitter struct {
 		metricsHandler metrics.Handler
+		usageTracker   UsageTracker
 		logger         log.Logger
 	}
 
@@ -98,10 +99,11 @@ var _ Queue = (*queuePersistenceClient)(nil)
 var _ NexusEndpointManager = (*nexusEndpointPersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
-func NewShardPersistenceMetricsClient(persistence ShardManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) ShardManager {
+func NewShardPersistenceMetricsClient(persistence ShardManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, usageTracker UsageTracker, logger log.Logger) ShardManager {
 	return &shardPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			usageTracker:   usageTracker,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -110,10 +112,11 @@ func NewShardPersistenceMetricsClient(persistence ShardManager, metricsHandler m
 }
 
 // NewExecutionPersistenceMetricsClient creates a client to manage executions
-func NewExecutionPersistenceMetricsClient(persistence ExecutionManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) ExecutionManager {
+func NewExecutionPersistenceMetricsClient(persistence ExecutionManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, usageTracker UsageTracker, logger log.Logger) ExecutionManager {
 	return &executionPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			usageTracker:   usageTracker,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -122,10 +125,11 @@ func NewExecutionPersistenceMetricsClient(persistence ExecutionManager, metricsH
 }
 
 // NewTaskPersistenceMetricsClient creates a client to manage tasks
-func NewTaskPersistenceMetricsClient(persistence TaskManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) TaskManager {
+func NewTaskPersistenceMetricsClient(persistence TaskManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, usageTracker UsageTracker, logger log.Logger) TaskManager {
 	return &taskPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			usageTracker:   usageTracker,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -134,10 +138,11 @@ func NewTaskPersistenceMetricsClient(persistence TaskManager, metricsHandler met
 }
 
 // NewMetadataPersistenceMetricsClient creates a MetadataManager client to manage metadata
-func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) MetadataManager {
+func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, usageTracker UsageTracker, logger log.Logger) MetadataManager {
 	return &metadataPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			usageTracker:   usageTracker,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -146,10 +151,11 @@ func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricsHan
 }
 
 // NewClusterMetadataPersistenceMetricsClient creates a ClusterMetadataManager client to manage cluster metadata
-func NewClusterMetadataPersistenceMetricsClient(persistence ClusterMetadataManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) ClusterMetadataManager {
+func NewClusterMetadataPersistenceMetricsClient(persistence ClusterMetadataManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, usageTracker UsageTracker, logger log.Logger) ClusterMetadataManager {
 	return &clusterMetadataPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			usageTracker:   usageTracker,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -158,10 +164,11 @@ func NewClusterMetadataPersistenceMetricsClient(persistence ClusterMetadataManag
 }
 
 // NewQueuePersistenceMetricsClient creates a client to manage queue
-func NewQueuePersistenceMetricsClient(persistence Queue, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) Queue {
+func NewQueuePersistenceMetricsClient(persistence Queue, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, usageTracker UsageTracker, logger log.Logger) Queue {
 	return &queuePersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			usageTracker:   usageTracker,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -170,10 +177,11 @@ func NewQueuePersistenceMetricsClient(persistence Queue, metricsHandler metrics.
 }
 
 // NewNexusEndpointPersistenceMetricsClient creates a NexusEndpointManager to manage nexus endpoints
-func NewNexusEndpointPersistenceMetricsClient(persistence NexusEndpointManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) NexusEndpointManager {
+func NewNexusEndpointPersistenceMetricsClient(persistence NexusEndpointManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, usageTracker UsageTracker, logger log.Logger) NexusEndpointManager {
 	return &nexusEndpointPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			usageTracker:   usageTracker,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -1289,6 +1297,7 @@ func (p *metricEmitter) recordRequestMetrics(operation string, caller string, la
 	metrics.PersistenceRequests.With(handler).Record(1)
 	metrics.PersistenceLatency.With(handler).Record(latency)
 	updateErrorMetric(handler, p.logger, operation, err)
+	p.usageTracker.Record(caller, operation, err)
 }
 
 func updateErrorMetric(handler metrics.Handler, logger log.Logger, operation string, err error) {
@@ -0,0 +1,394 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recorder
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	shardRecordingPersistenceClient struct {
+		persistence persistence.ShardManager
+		recorder    *Recorder
+	}
+
+	executionRecordingPersistenceClient struct {
+		persistence persistence.ExecutionManager
+		recorder    *Recorder
+	}
+)
+
+var _ persistence.ShardManager = (*shardRecordingPersistenceClient)(nil)
+var _ persistence.ExecutionManager = (*executionRecordingPersistenceClient)(nil)
+
+// NewShardPersistenceRecordingClient creates a client to manage shards which records the calls of recorded shards
+func NewShardPersistenceRecordingClient(
+	persistence persistence.ShardManager,
+	recorder *Recorder,
+) persistence.ShardManager {
+	return &shardRecordingPersistenceClient{
+		persistence: persistence,
+		recorder:    recorder,
+	}
+}
+
+// NewExecutionPersistenceRecordingClient creates a client to manage executions which records the calls of recorded
+// shards. Calls which are not made for a single shard, i.e. GetAllHistoryTreeBranches, are not recorded.
+func NewExecutionPersistenceRecordingClient(
+	persistence persistence.ExecutionManager,
+	recorder *Recorder,
+) persistence.ExecutionManager {
+	return &executionRecordingPersistenceClient{
+		persistence: persistence,
+		recorder:    recorder,
+	}
+}
+
+func (p *shardRecordingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardRecordingPersistenceClient) GetOrCreateShard(
+	ctx context.Context,
+	request *persistence.GetOrCreateShardRequest,
+) (*persistence.GetOrCreateShardResponse, error) {
+	call := p.recorder.start(request.ShardID, "GetOrCreateShard", request)
+	response, err := p.persistence.GetOrCreateShard(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *shardRecordingPersistenceClient) UpdateShard(
+	ctx context.Context,
+	request *persistence.UpdateShardRequest,
+) error {
+	call := p.recorder.start(request.ShardInfo.GetShardId(), "UpdateShard", request)
+	err := p.persistence.UpdateShard(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *shardRecordingPersistenceClient) AssertShardOwnership(
+	ctx context.Context,
+	request *persistence.AssertShardOwnershipRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "AssertShardOwnership", request)
+	err := p.persistence.AssertShardOwnership(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *shardRecordingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *executionRecordingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *executionRecordingPersistenceClient) GetHistoryBranchUtil() persistence.HistoryBranchUtil {
+	return p.persistence.GetHistoryBranchUtil()
+}
+
+func (p *executionRecordingPersistenceClient) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.CreateWorkflowExecutionRequest,
+) (*persistence.CreateWorkflowExecutionResponse, error) {
+	call := p.recorder.start(request.ShardID, "CreateWorkflowExecution", request)
+	response, err := p.persistence.CreateWorkflowExecution(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	call := p.recorder.start(request.ShardID, "UpdateWorkflowExecution", request)
+	response, err := p.persistence.UpdateWorkflowExecution(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.ConflictResolveWorkflowExecutionRequest,
+) (*persistence.ConflictResolveWorkflowExecutionResponse, error) {
+	call := p.recorder.start(request.ShardID, "ConflictResolveWorkflowExecution", request)
+	response, err := p.persistence.ConflictResolveWorkflowExecution(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteWorkflowExecutionRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "DeleteWorkflowExecution", request)
+	err := p.persistence.DeleteWorkflowExecution(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "DeleteCurrentWorkflowExecution", request)
+	err := p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) GetCurrentExecution(
+	ctx context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.GetCurrentExecutionResponse, error) {
+	call := p.recorder.start(request.ShardID, "GetCurrentExecution", request)
+	response, err := p.persistence.GetCurrentExecution(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.GetWorkflowExecutionResponse, error) {
+	call := p.recorder.start(request.ShardID, "GetWorkflowExecution", request)
+	response, err := p.persistence.GetWorkflowExecution(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.SetWorkflowExecutionRequest,
+) (*persistence.SetWorkflowExecutionResponse, error) {
+	call := p.recorder.start(request.ShardID, "SetWorkflowExecution", request)
+	response, err := p.persistence.SetWorkflowExecution(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.ListConcreteExecutionsResponse, error) {
+	call := p.recorder.start(request.ShardID, "ListConcreteExecutions", request)
+	response, err := p.persistence.ListConcreteExecutions(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) AddHistoryTasks(
+	ctx context.Context,
+	request *persistence.AddHistoryTasksRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "AddHistoryTasks", request)
+	err := p.persistence.AddHistoryTasks(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) GetHistoryTasks(
+	ctx context.Context,
+	request *persistence.GetHistoryTasksRequest,
+) (*persistence.GetHistoryTasksResponse, error) {
+	call := p.recorder.start(request.ShardID, "GetHistoryTasks", request)
+	response, err := p.persistence.GetHistoryTasks(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) CompleteHistoryTask(
+	ctx context.Context,
+	request *persistence.CompleteHistoryTaskRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "CompleteHistoryTask", request)
+	err := p.persistence.CompleteHistoryTask(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *persistence.RangeCompleteHistoryTasksRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "RangeCompleteHistoryTasks", request)
+	err := p.persistence.RangeCompleteHistoryTasks(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *persistence.PutReplicationTaskToDLQRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "PutReplicationTaskToDLQ", request)
+	err := p.persistence.PutReplicationTaskToDLQ(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (*persistence.GetHistoryTasksResponse, error) {
+	call := p.recorder.start(request.ShardID, "GetReplicationTasksFromDLQ", request)
+	response, err := p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.DeleteReplicationTaskFromDLQRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "DeleteReplicationTaskFromDLQ", request)
+	err := p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "RangeDeleteReplicationTaskFromDLQ", request)
+	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	call := p.recorder.start(request.ShardID, "IsReplicationDLQEmpty", request)
+	response, err := p.persistence.IsReplicationDLQEmpty(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.AppendHistoryNodesRequest,
+) (*persistence.AppendHistoryNodesResponse, error) {
+	call := p.recorder.start(request.ShardID, "AppendHistoryNodes", request)
+	response, err := p.persistence.AppendHistoryNodes(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) AppendRawHistoryNodes(
+	ctx context.Context,
+	request *persistence.AppendRawHistoryNodesRequest,
+) (*persistence.AppendHistoryNodesResponse, error) {
+	call := p.recorder.start(request.ShardID, "AppendRawHistoryNodes", request)
+	response, err := p.persistence.AppendRawHistoryNodes(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchResponse, error) {
+	call := p.recorder.start(request.ShardID, "ReadHistoryBranch", request)
+	response, err := p.persistence.ReadHistoryBranch(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	call := p.recorder.start(request.ShardID, "ReadHistoryBranchByBatch", request)
+	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) ReadHistoryBranchReverse(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchReverseRequest,
+) (*persistence.ReadHistoryBranchReverseResponse, error) {
+	call := p.recorder.start(request.ShardID, "ReadHistoryBranchReverse", request)
+	response, err := p.persistence.ReadHistoryBranchReverse(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) ReadRawHistoryBranch(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadRawHistoryBranchResponse, error) {
+	call := p.recorder.start(request.ShardID, "ReadRawHistoryBranch", request)
+	response, err := p.persistence.ReadRawHistoryBranch(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) ForkHistoryBranch(
+	ctx context.Context,
+	request *persistence.ForkHistoryBranchRequest,
+) (*persistence.ForkHistoryBranchResponse, error) {
+	call := p.recorder.start(request.ShardID, "ForkHistoryBranch", request)
+	response, err := p.persistence.ForkHistoryBranch(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) DeleteHistoryBranch(
+	ctx context.Context,
+	request *persistence.DeleteHistoryBranchRequest,
+) error {
+	call := p.recorder.start(request.ShardID, "DeleteHistoryBranch", request)
+	err := p.persistence.DeleteHistoryBranch(ctx, request)
+	call.finish(nil, err)
+	return err
+}
+
+func (p *executionRecordingPersistenceClient) TrimHistoryBranch(
+	ctx context.Context,
+	request *persistence.TrimHistoryBranchRequest,
+) (*persistence.TrimHistoryBranchResponse, error) {
+	call := p.recorder.start(request.ShardID, "TrimHistoryBranch", request)
+	response, err := p.persistence.TrimHistoryBranch(ctx, request)
+	call.finish(response, err)
+	return response, err
+}
+
+func (p *executionRecordingPersistenceClient) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *persistence.GetAllHistoryTreeBranchesRequest,
+) (*persistence.GetAllHistoryTreeBranchesResponse, error) {
+	return p.persistence.GetAllHistoryTreeBranches(ctx, request)
+}
+
+func (p *executionRecordingPersistenceClient) Close() {
+	p.persistence.Close()
+}
@@ -0,0 +1,502 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recorder
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// valueCodec encodes persistence requests and responses to JSON and back. Proto messages are encoded with
+	// protojson, history tasks with the task serializer of the persistence layer, maps with non-string keys as
+	// lists of key/value pairs and everything else following the reflected Go structure. Unexported fields and
+	// context.Context fields are not encoded.
+	valueCodec struct {
+		jsonpb         codec.JSONPBEncoder
+		taskSerializer *serialization.TaskSerializer
+	}
+
+	encodedCategory struct {
+		ID   int                `json:"id"`
+		Type tasks.CategoryType `json:"type"`
+		Name string             `json:"name"`
+	}
+
+	encodedTask struct {
+		Category encodedCategory `json:"category"`
+		Blob     json.RawMessage `json:"blob"`
+	}
+
+	encodedMapEntry struct {
+		Key   json.RawMessage `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+
+	// encodedError is a recorded error. Persistence errors are encoded with their fields, service errors with
+	// their gRPC status and other errors with their message only.
+	encodedError struct {
+		Type    string          `json:"type"`
+		Message string          `json:"message,omitempty"`
+		Value   json.RawMessage `json:"value,omitempty"`
+	}
+)
+
+const (
+	errorTypeServiceError = "ServiceError"
+	errorTypeOther        = "Error"
+)
+
+var (
+	null = json.RawMessage("null")
+
+	categoryType        = reflect.TypeOf(tasks.Category{})
+	taskType            = reflect.TypeOf((*tasks.Task)(nil)).Elem()
+	protoMessageType    = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	contextType         = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+	// persistenceErrorTypes are the persistence errors which are recorded with their fields.
+	persistenceErrorTypes = func() map[string]reflect.Type {
+		types := make(map[string]reflect.Type)
+		for _, err := range []error{
+			&persistence.ShardAlreadyExistError{},
+			&persistence.ShardOwnershipLostError{},
+			&persistence.ConditionFailedError{},
+			&persistence.CurrentWorkflowConditionFailedError{},
+			&persistence.WorkflowConditionFailedError{},
+			&persistence.InvalidPersistenceRequestError{},
+			&persistence.AppendHistoryTimeoutError{},
+			&persistence.TransactionSizeLimitError{},
+			&persistence.TimeoutError{},
+		} {
+			t := reflect.TypeOf(err).Elem()
+			types[t.Name()] = t
+		}
+		return types
+	}()
+)
+
+func newValueCodec() *valueCodec {
+	return &valueCodec{
+		jsonpb:         codec.NewJSONPBEncoder(),
+		taskSerializer: serialization.NewTaskSerializer(),
+	}
+}
+
+func (c *valueCodec) encode(v any) (json.RawMessage, error) {
+	if v == nil {
+		return null, nil
+	}
+	return c.encodeValue(reflect.ValueOf(v))
+}
+
+func (c *valueCodec) decode(data json.RawMessage, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	if err := c.decodeValue(data, v); err != nil {
+		return reflect.Value{}, err
+	}
+	return v, nil
+}
+
+func (c *valueCodec) encodeValue(v reflect.Value) (json.RawMessage, error) {
+	t := v.Type()
+	switch {
+	case t == categoryType:
+		return json.Marshal(encodeCategory(v.Interface().(tasks.Category)))
+	case t == taskType:
+		if v.IsNil() {
+			return null, nil
+		}
+		return c.encodeTask(v.Interface().(tasks.Task))
+	case t == contextType:
+		return null, nil
+	case t.Implements(protoMessageType):
+		if v.IsNil() {
+			return null, nil
+		}
+		return c.jsonpb.Encode(v.Interface().(proto.Message))
+	case t.Implements(jsonMarshalerType):
+		if t.Kind() == reflect.Pointer && v.IsNil() {
+			return null, nil
+		}
+		return json.Marshal(v.Interface())
+	}
+
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return json.Marshal(v.Interface())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return null, nil
+		}
+		return c.encodeValue(v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			return null, nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			return json.Marshal(base64.StdEncoding.EncodeToString(v.Bytes()))
+		}
+		return c.encodeList(v)
+	case reflect.Array:
+		return c.encodeList(v)
+	case reflect.Map:
+		if v.IsNil() {
+			return null, nil
+		}
+		return c.encodeMap(v)
+	case reflect.Struct:
+		return c.encodeStruct(v)
+	default:
+		return nil, fmt.Errorf("unable to encode value of type %v", t)
+	}
+}
+
+func (c *valueCodec) encodeTask(task tasks.Task) (json.RawMessage, error) {
+	blob, err := c.taskSerializer.SerializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	blobData, err := c.jsonpb.Encode(blob)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(encodedTask{
+		Category: encodeCategory(task.GetCategory()),
+		Blob:     blobData,
+	})
+}
+
+func (c *valueCodec) encodeList(v reflect.Value) (json.RawMessage, error) {
+	items := make([]json.RawMessage, v.Len())
+	for i := range items {
+		item, err := c.encodeValue(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+	return json.Marshal(items)
+}
+
+func (c *valueCodec) encodeMap(v reflect.Value) (json.RawMessage, error) {
+	entries := make([]encodedMapEntry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := c.encodeValue(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		value, err := c.encodeValue(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, encodedMapEntry{Key: key, Value: value})
+	}
+	// Sort the entries so that equal maps are encoded the same way, which replay relies on to compare requests.
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].Key, entries[j].Key) < 0
+	})
+
+	if v.Type().Key().Kind() != reflect.String {
+		return json.Marshal(entries)
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, entry := range entries {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(entry.Key)
+		buf.WriteByte(':')
+		buf.Write(entry.Value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (c *valueCodec) encodeStruct(v reflect.Value) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value, err := c.encodeValue(v.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("%v.%v: %w", v.Type(), field.Name, err)
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		name, _ := json.Marshal(field.Name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (c *valueCodec) decodeValue(data json.RawMessage, v reflect.Value) error {
+	t := v.Type()
+	if isNull(data) {
+		v.SetZero()
+		return nil
+	}
+	switch {
+	case t == categoryType:
+		var category encodedCategory
+		if err := json.Unmarshal(data, &category); err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(decodeCategory(category)))
+		return nil
+	case t == taskType:
+		task, err := c.decodeTask(data)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(task))
+		return nil
+	case t == contextType:
+		return nil
+	case t.Implements(protoMessageType) && t.Kind() == reflect.Pointer:
+		message := reflect.New(t.Elem())
+		if err := c.jsonpb.Decode(data, message.Interface().(proto.Message)); err != nil {
+			return err
+		}
+		v.Set(message)
+		return nil
+	case reflect.PointerTo(t).Implements(jsonUnmarshalerType):
+		return json.Unmarshal(data, v.Addr().Interface())
+	}
+
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return json.Unmarshal(data, v.Addr().Interface())
+	case reflect.Pointer:
+		elem := reflect.New(t.Elem())
+		if err := c.decodeValue(data, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			var encoded string
+			if err := json.Unmarshal(data, &encoded); err != nil {
+				return err
+			}
+			decoded, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(decoded)
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		v.Set(reflect.MakeSlice(t, len(items), len(items)))
+		for i, item := range items {
+			if err := c.decodeValue(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Array:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		if len(items) != v.Len() {
+			return fmt.Errorf("unable to decode %d items into %v", len(items), t)
+		}
+		for i, item := range items {
+			if err := c.decodeValue(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		return c.decodeMap(data, v)
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		for name, fieldData := range fields {
+			field, ok := t.FieldByName(name)
+			if !ok || !field.IsExported() || len(field.Index) != 1 {
+				return fmt.Errorf("unknown field %v.%v", t, name)
+			}
+			if err := c.decodeValue(fieldData, v.Field(field.Index[0])); err != nil {
+				return fmt.Errorf("%v.%v: %w", t, name, err)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unable to decode value of type %v", t)
+	}
+}
+
+func (c *valueCodec) decodeTask(data json.RawMessage) (tasks.Task, error) {
+	var task encodedTask
+	if err := json.Unmarshal(data, &task); err != nil {
+		return nil, err
+	}
+	blob := &commonpb.DataBlob{}
+	if err := c.jsonpb.Decode(task.Blob, blob); err != nil {
+		return nil, err
+	}
+	return c.taskSerializer.DeserializeTask(decodeCategory(task.Category), blob)
+}
+
+func (c *valueCodec) decodeMap(data json.RawMessage, v reflect.Value) error {
+	t := v.Type()
+	var entries []encodedMapEntry
+	if t.Key().Kind() == reflect.String {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		for key, value := range fields {
+			encodedKey, err := json.Marshal(key)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, encodedMapEntry{Key: encodedKey, Value: value})
+		}
+	} else if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	v.Set(reflect.MakeMapWithSize(t, len(entries)))
+	for _, entry := range entries {
+		key, err := c.decode(entry.Key, t.Key())
+		if err != nil {
+			return err
+		}
+		value, err := c.decode(entry.Value, t.Elem())
+		if err != nil {
+			return err
+		}
+		v.SetMapIndex(key, value)
+	}
+	return nil
+}
+
+func (c *valueCodec) encodeError(err error) (*encodedError, error) {
+	if err == nil {
+		return nil, nil
+	}
+	t := reflect.TypeOf(err)
+	if t.Kind() == reflect.Pointer {
+		if errorType, ok := persistenceErrorTypes[t.Elem().Name()]; ok && errorType == t.Elem() {
+			value, encodeErr := c.encodeValue(reflect.ValueOf(err))
+			if encodeErr != nil {
+				return nil, encodeErr
+			}
+			return &encodedError{Type: errorType.Name(), Message: err.Error(), Value: value}, nil
+		}
+	}
+	var svcErr serviceerror.ServiceError
+	if errors.As(err, &svcErr) {
+		value, encodeErr := c.jsonpb.Encode(serviceerror.ToStatus(err).Proto())
+		if encodeErr != nil {
+			return nil, encodeErr
+		}
+		return &encodedError{Type: errorTypeServiceError, Message: err.Error(), Value: value}, nil
+	}
+	return &encodedError{Type: errorTypeOther, Message: err.Error()}, nil
+}
+
+func (c *valueCodec) decodeError(encoded *encodedError) (error, error) {
+	if encoded == nil {
+		return nil, nil
+	}
+	switch encoded.Type {
+	case errorTypeServiceError:
+		st := &spb.Status{}
+		if err := c.jsonpb.Decode(encoded.Value, st); err != nil {
+			return nil, err
+		}
+		return serviceerror.FromStatus(status.FromProto(st)), nil
+	case errorTypeOther:
+		return errors.New(encoded.Message), nil
+	}
+	errorType, ok := persistenceErrorTypes[encoded.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown error type %q", encoded.Type)
+	}
+	value, err := c.decode(encoded.Value, reflect.PointerTo(errorType))
+	if err != nil {
+		return nil, err
+	}
+	return value.Interface().(error), nil
+}
+
+func encodeCategory(category tasks.Category) encodedCategory {
+	return encodedCategory{
+		ID:   category.ID(),
+		Type: category.Type(),
+		Name: category.Name(),
+	}
+}
+
+func decodeCategory(category encodedCategory) tasks.Category {
+	return tasks.NewCategory(category.ID, category.Type, category.Name)
+}
+
+func isNull(data json.RawMessage) bool {
+	return len(data) == 0 || bytes.Equal(bytes.TrimSpace(data), null)
+}
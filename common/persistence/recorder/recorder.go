@@ -0,0 +1,314 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	defaultMaxFileSize = 64 * 1024 * 1024
+	defaultMaxFiles    = 4
+)
+
+type (
+	// Record is one recorded persistence call.
+	Record struct {
+		// Time is the time the call was made.
+		Time    time.Time `json:"time"`
+		ShardID int32     `json:"shardId"`
+		// Method is the name of the ShardManager or ExecutionManager method, e.g. "UpdateWorkflowExecution".
+		Method   string          `json:"method"`
+		Request  json.RawMessage `json:"request"`
+		Response json.RawMessage `json:"response,omitempty"`
+		Error    *encodedError   `json:"error,omitempty"`
+	}
+
+	// Recorder writes the persistence calls of history shards to one rotating file per shard.
+	Recorder struct {
+		dir         string
+		shardIDs    map[int32]struct{}
+		maxFileSize int64
+		maxFiles    int
+		codec       *valueCodec
+		logger      log.Logger
+
+		mu    sync.Mutex
+		files map[int32]*rotatingFile
+	}
+
+	// call is a persistence call which is being recorded. A nil call is not recorded.
+	call struct {
+		recorder *Recorder
+		record   Record
+	}
+
+	rotatingFile struct {
+		dir         string
+		shardID     int32
+		maxFileSize int64
+		maxFiles    int
+
+		mu   sync.Mutex
+		file *os.File
+		size int64
+	}
+)
+
+// NewRecorder creates a Recorder writing to the directory of the given config.
+func NewRecorder(cfg config.PersistenceRecorder, logger log.Logger) (*Recorder, error) {
+	if err := os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("unable to create recorder dir: %w", err)
+	}
+	r := &Recorder{
+		dir:         cfg.Dir,
+		maxFileSize: cfg.MaxFileSize,
+		maxFiles:    cfg.MaxFiles,
+		codec:       newValueCodec(),
+		logger:      logger,
+		files:       make(map[int32]*rotatingFile),
+	}
+	if r.maxFileSize <= 0 {
+		r.maxFileSize = defaultMaxFileSize
+	}
+	if r.maxFiles <= 0 {
+		r.maxFiles = defaultMaxFiles
+	}
+	if len(cfg.ShardIDs) > 0 {
+		r.shardIDs = make(map[int32]struct{}, len(cfg.ShardIDs))
+		for _, shardID := range cfg.ShardIDs {
+			r.shardIDs[shardID] = struct{}{}
+		}
+	}
+	return r, nil
+}
+
+// Close closes the files of the recorder. Calls finished after Close are recorded to newly opened files.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs []error
+	for _, file := range r.files {
+		errs = append(errs, file.close())
+	}
+	r.files = make(map[int32]*rotatingFile)
+	return errors.Join(errs...)
+}
+
+// start records the request of a call. The call must be finished with the response of the persistence
+// manager. It returns nil if the shard is not recorded.
+func (r *Recorder) start(shardID int32, method string, request any) *call {
+	if r.shardIDs != nil {
+		if _, ok := r.shardIDs[shardID]; !ok {
+			return nil
+		}
+	}
+	// Requests are encoded before the call, since persistence managers may modify them.
+	data, err := r.codec.encode(request)
+	if err != nil {
+		r.logger.Warn("Unable to record persistence request.",
+			tag.ShardID(shardID), tag.NewStringTag("method", method), tag.Error(err))
+		return nil
+	}
+	return &call{
+		recorder: r,
+		record: Record{
+			Time:    time.Now().UTC(),
+			ShardID: shardID,
+			Method:  method,
+			Request: data,
+		},
+	}
+}
+
+func (c *call) finish(response any, err error) {
+	if c == nil {
+		return
+	}
+	r := c.recorder
+	data, encodeErr := r.codec.encode(response)
+	if encodeErr == nil {
+		c.record.Response = data
+		c.record.Error, encodeErr = r.codec.encodeError(err)
+	}
+	if encodeErr != nil {
+		r.logger.Warn("Unable to record persistence response.",
+			tag.ShardID(c.record.ShardID), tag.NewStringTag("method", c.record.Method), tag.Error(encodeErr))
+		return
+	}
+	line, encodeErr := json.Marshal(c.record)
+	if encodeErr != nil {
+		r.logger.Warn("Unable to record persistence call.",
+			tag.ShardID(c.record.ShardID), tag.NewStringTag("method", c.record.Method), tag.Error(encodeErr))
+		return
+	}
+	if writeErr := r.file(c.record.ShardID).write(append(line, '\n')); writeErr != nil {
+		r.logger.Warn("Unable to write persistence recording.", tag.ShardID(c.record.ShardID), tag.Error(writeErr))
+	}
+}
+
+func (r *Recorder) file(shardID int32) *rotatingFile {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file, ok := r.files[shardID]
+	if !ok {
+		file = &rotatingFile{
+			dir:         r.dir,
+			shardID:     shardID,
+			maxFileSize: r.maxFileSize,
+			maxFiles:    r.maxFiles,
+		}
+		r.files[shardID] = file
+	}
+	return file
+}
+
+func (f *rotatingFile) write(line []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file != nil && f.size > 0 && f.size+int64(len(line)) > f.maxFileSize {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+	if f.file == nil {
+		file, err := os.OpenFile(recordingPath(f.dir, f.shardID, 0), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		info, err := file.Stat()
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+		f.file = file
+		f.size = info.Size()
+	}
+	n, err := f.file.Write(line)
+	f.size += int64(n)
+	return err
+}
+
+// rotate renames the current file to <name>.1.jsonl, shifting older files up to maxFiles and removing the
+// oldest one.
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+	f.size = 0
+
+	if err := os.Remove(recordingPath(f.dir, f.shardID, f.maxFiles)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := f.maxFiles - 1; i >= 0; i-- {
+		err := os.Rename(recordingPath(f.dir, f.shardID, i), recordingPath(f.dir, f.shardID, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *rotatingFile) close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// ReadRecording reads the recorded calls of a shard from dir, oldest first, including the rotated files.
+func ReadRecording(dir string, shardID int32) ([]Record, error) {
+	var paths []string
+	for i := 0; ; i++ {
+		path := recordingPath(dir, shardID, i)
+		if _, err := os.Stat(path); err != nil {
+			if os.IsNotExist(err) && i > 0 {
+				break
+			}
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	var records []Record
+	for i := len(paths) - 1; i >= 0; i-- {
+		fileRecords, err := readRecordingFile(paths[i])
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %w", paths[i], err)
+		}
+		records = append(records, fileRecords...)
+	}
+	return records, nil
+}
+
+func readRecordingFile(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	var records []Record
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && err == nil {
+			var record Record
+			if err := json.Unmarshal(line, &record); err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+		if err == io.EOF {
+			// An incomplete last line is a call which was being written when the process stopped.
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func recordingPath(dir string, shardID int32, index int) string {
+	if index == 0 {
+		return filepath.Join(dir, fmt.Sprintf("shard-%d.jsonl", shardID))
+	}
+	return filepath.Join(dir, fmt.Sprintf("shard-%d.%d.jsonl", shardID, index))
+}
@@ -0,0 +1,279 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recorder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testShardID int32 = 7
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+	r, err := NewRecorder(config.PersistenceRecorder{Dir: dir}, log.NewTestLogger())
+	require.NoError(t, err)
+
+	shardInfo := &persistencespb.ShardInfo{
+		ShardId:    testShardID,
+		RangeId:    12,
+		Owner:      "host",
+		UpdateTime: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}
+	workflowKey := definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id")
+	createRequest := &persistence.CreateWorkflowExecutionRequest{
+		ShardID: testShardID,
+		RangeID: 12,
+		Mode:    persistence.CreateWorkflowModeBrandNew,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: workflowKey.NamespaceID,
+				WorkflowId:  workflowKey.WorkflowID,
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				RunId: workflowKey.RunID,
+				State: enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+			},
+			NextEventID: 3,
+			Tasks: map[tasks.Category][]tasks.Task{
+				tasks.CategoryTransfer: {
+					&tasks.ActivityTask{
+						WorkflowKey:         workflowKey,
+						VisibilityTimestamp: time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC),
+						TaskID:              100,
+						TaskQueue:           "task-queue",
+						ScheduledEventID:    2,
+					},
+				},
+			},
+			DBRecordVersion: 1,
+		},
+	}
+	createResponse := &persistence.CreateWorkflowExecutionResponse{
+		NewMutableStateStats: persistence.MutableStateStatistics{TotalSize: 42},
+	}
+	getRequest := &persistence.GetWorkflowExecutionRequest{
+		ShardID:     testShardID,
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       "missing-run-id",
+	}
+	updateShardRequest := &persistence.UpdateShardRequest{
+		ShardInfo:       shardInfo,
+		PreviousRangeID: 11,
+	}
+
+	shardManager := persistence.NewMockShardManager(ctrl)
+	shardManager.EXPECT().GetOrCreateShard(gomock.Any(), gomock.Any()).Return(&persistence.GetOrCreateShardResponse{ShardInfo: shardInfo}, nil)
+	shardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(&persistence.ShardOwnershipLostError{ShardID: testShardID, Msg: "lost"})
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	executionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(createResponse, nil)
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+	executionManager.EXPECT().IsReplicationDLQEmpty(gomock.Any(), gomock.Any()).Return(true, nil)
+	executionManager.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	recordingShardManager := NewShardPersistenceRecordingClient(shardManager, r)
+	recordingExecutionManager := NewExecutionPersistenceRecordingClient(executionManager, r)
+	_, err = recordingShardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{ShardID: testShardID, LifecycleContext: ctx})
+	require.NoError(t, err)
+	_, err = recordingExecutionManager.CreateWorkflowExecution(ctx, createRequest)
+	require.NoError(t, err)
+	_, err = recordingExecutionManager.GetWorkflowExecution(ctx, getRequest)
+	require.Error(t, err)
+	err = recordingShardManager.UpdateShard(ctx, updateShardRequest)
+	require.Error(t, err)
+	_, err = recordingExecutionManager.IsReplicationDLQEmpty(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+		GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{ShardID: testShardID, TaskCategory: tasks.CategoryReplication},
+		SourceClusterName:      "other",
+	})
+	require.NoError(t, err)
+	err = recordingExecutionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{ShardID: testShardID})
+	require.Error(t, err)
+	require.NoError(t, r.Close())
+
+	records, err := ReadRecording(dir, testShardID)
+	require.NoError(t, err)
+	require.Len(t, records, 6)
+	require.Equal(t, "GetOrCreateShard", records[0].Method)
+
+	replayer := NewReplayer(records, true)
+	replayedShardInfo, err := replayer.ShardInfo(testShardID)
+	require.NoError(t, err)
+	require.True(t, shardInfo.Equal(replayedShardInfo))
+
+	// Calls must be made in the recorded order.
+	err = replayer.UpdateShard(ctx, updateShardRequest)
+	require.ErrorIs(t, err, ErrOutOfOrder)
+
+	replayedCreateResponse, err := replayer.CreateWorkflowExecution(ctx, createRequest)
+	require.NoError(t, err)
+	require.Equal(t, createResponse, replayedCreateResponse)
+
+	_, err = replayer.GetWorkflowExecution(ctx, getRequest)
+	var notFound *serviceerror.NotFound
+	require.ErrorAs(t, err, &notFound)
+	require.Equal(t, "not found", notFound.Error())
+
+	err = replayer.UpdateShard(ctx, updateShardRequest)
+	var ownershipLost *persistence.ShardOwnershipLostError
+	require.ErrorAs(t, err, &ownershipLost)
+	require.Equal(t, &persistence.ShardOwnershipLostError{ShardID: testShardID, Msg: "lost"}, ownershipLost)
+
+	_, err = replayer.IsReplicationDLQEmpty(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+		GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{ShardID: testShardID, TaskCategory: tasks.CategoryReplication},
+		SourceClusterName:      "another",
+	})
+	require.ErrorIs(t, err, ErrRequestMismatch)
+	empty, err := replayer.IsReplicationDLQEmpty(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+		GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{ShardID: testShardID, TaskCategory: tasks.CategoryReplication},
+		SourceClusterName:      "other",
+	})
+	require.NoError(t, err)
+	require.True(t, empty)
+
+	err = replayer.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{ShardID: testShardID})
+	require.EqualError(t, err, "boom")
+	require.Zero(t, replayer.Remaining())
+
+	_, err = replayer.GetWorkflowExecution(ctx, getRequest)
+	require.ErrorIs(t, err, ErrNoRecordedCall)
+
+	// A non-strict replayer replays calls of different methods independently of their recorded order.
+	replayer = NewReplayer(records, false)
+	err = replayer.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{ShardID: testShardID})
+	require.EqualError(t, err, "boom")
+	err = replayer.UpdateShard(ctx, updateShardRequest)
+	require.ErrorAs(t, err, &ownershipLost)
+	_, err = replayer.GetWorkflowExecution(ctx, getRequest)
+	require.ErrorAs(t, err, &notFound)
+	require.Equal(t, 3, replayer.Remaining())
+}
+
+func TestCodec_Tasks(t *testing.T) {
+	c := newValueCodec()
+	workflowKey := definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id")
+	snapshot := persistence.WorkflowSnapshot{
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryTimer: {
+				&tasks.UserTimerTask{
+					WorkflowKey:         workflowKey,
+					VisibilityTimestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+					TaskID:              1,
+					EventID:             5,
+				},
+			},
+			tasks.CategoryVisibility: {
+				&tasks.StartExecutionVisibilityTask{
+					WorkflowKey:         workflowKey,
+					VisibilityTimestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+					TaskID:              2,
+				},
+			},
+		},
+		NextEventID: 6,
+	}
+
+	data, err := c.encode(snapshot)
+	require.NoError(t, err)
+	decoded, err := c.decode(data, reflect.TypeOf(snapshot))
+	require.NoError(t, err)
+	require.Equal(t, snapshot, decoded.Interface())
+
+	encodedAgain, err := c.encode(decoded.Interface())
+	require.NoError(t, err)
+	require.True(t, equalJSON(data, encodedAgain))
+}
+
+func TestRecorder_Rotation(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+	r, err := NewRecorder(config.PersistenceRecorder{
+		Dir:         dir,
+		ShardIDs:    []int32{testShardID},
+		MaxFileSize: 512,
+		MaxFiles:    2,
+	}, log.NewTestLogger())
+	require.NoError(t, err)
+
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	executionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	recordingExecutionManager := NewExecutionPersistenceRecordingClient(executionManager, r)
+	const calls = 50
+	for i := 0; i < calls; i++ {
+		for _, shardID := range []int32{testShardID, testShardID + 1} {
+			require.NoError(t, recordingExecutionManager.AddHistoryTasks(ctx, &persistence.AddHistoryTasksRequest{
+				ShardID:    shardID,
+				RangeID:    int64(i),
+				WorkflowID: "workflow-id",
+			}))
+		}
+	}
+	require.NoError(t, r.Close())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.ElementsMatch(t, []string{"shard-7.jsonl", "shard-7.1.jsonl", "shard-7.2.jsonl"}, names)
+	for _, name := range names {
+		info, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err)
+		require.LessOrEqual(t, info.Size(), int64(512))
+	}
+
+	// The oldest calls were rotated out, the remaining ones are read in order.
+	records, err := ReadRecording(dir, testShardID)
+	require.NoError(t, err)
+	require.NotEmpty(t, records)
+	require.Less(t, len(records), calls)
+	replayer := NewReplayer(records, false)
+	for i := range records {
+		require.Equal(t, testShardID, records[i].ShardID)
+		require.Contains(t, string(records[i].Request), fmt.Sprintf(`"RangeID":%d,`, calls-len(records)+i))
+		require.NoError(t, replayer.AddHistoryTasks(ctx, &persistence.AddHistoryTasksRequest{ShardID: testShardID}))
+	}
+	require.Zero(t, replayer.Remaining())
+}
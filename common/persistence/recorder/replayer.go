@@ -0,0 +1,403 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recorder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Replayer is a ShardManager and ExecutionManager which replays recorded persistence calls from one ordered
+	// log per shard. A strict Replayer answers each call with the oldest unreplayed recorded call of the shard,
+	// which must be of the same method and have an equal request. A non-strict Replayer answers each call with
+	// the oldest unreplayed recorded call of the same shard and method, so calls of different methods may be
+	// replayed in a different order than they were recorded.
+	Replayer struct {
+		codec  *valueCodec
+		strict bool
+
+		mu    sync.Mutex
+		calls map[int32][]Record
+	}
+)
+
+var (
+	// ErrNoRecordedCall is returned by the Replayer for calls which have no recorded call left.
+	ErrNoRecordedCall = errors.New("no recorded call left")
+	// ErrRequestMismatch is returned by a strict Replayer for calls whose request differs from the recorded one.
+	ErrRequestMismatch = errors.New("request doesn't match the recorded request")
+	// ErrOutOfOrder is returned by a strict Replayer for calls which were not the next recorded call of the shard.
+	ErrOutOfOrder = errors.New("call doesn't match the next recorded call")
+)
+
+var _ persistence.ShardManager = (*Replayer)(nil)
+var _ persistence.ExecutionManager = (*Replayer)(nil)
+
+// NewReplayer creates a Replayer for the given records, e.g. the result of ReadRecording. The records of a shard
+// must be in the order they were recorded. If strict is true, calls must be made in the recorded order and the
+// request of every call must be equal to the recorded one.
+func NewReplayer(records []Record, strict bool) *Replayer {
+	r := &Replayer{
+		codec:  newValueCodec(),
+		strict: strict,
+		calls:  make(map[int32][]Record),
+	}
+	for _, record := range records {
+		r.calls[record.ShardID] = append(r.calls[record.ShardID], record)
+	}
+	return r
+}
+
+// Remaining returns the number of recorded calls which were not replayed.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	remaining := 0
+	for _, records := range r.calls {
+		remaining += len(records)
+	}
+	return remaining
+}
+
+// next removes and returns the recorded call which answers a call of the shard and method. A nil request
+// matches any recorded request.
+func (r *Replayer) next(shardID int32, method string, request any) (Record, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	records := r.calls[shardID]
+	index := -1
+	if r.strict {
+		if len(records) > 0 {
+			if records[0].Method != method {
+				return Record{}, fmt.Errorf("%w: shard %d: recorded %s, got %s",
+					ErrOutOfOrder, shardID, records[0].Method, method)
+			}
+			index = 0
+		}
+	} else {
+		for i := range records {
+			if records[i].Method == method {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 {
+		return Record{}, fmt.Errorf("%w: shard %d, %s", ErrNoRecordedCall, shardID, method)
+	}
+	record := records[index]
+	if r.strict && request != nil {
+		data, err := r.codec.encode(request)
+		if err != nil {
+			return Record{}, err
+		}
+		if !equalJSON(data, record.Request) {
+			return Record{}, fmt.Errorf("%w: shard %d, %s: recorded %s, got %s",
+				ErrRequestMismatch, shardID, method, record.Request, data)
+		}
+	}
+	if index == 0 {
+		r.calls[shardID] = records[1:]
+	} else {
+		r.calls[shardID] = append(records[:index:index], records[index+1:]...)
+	}
+	return record, nil
+}
+
+// ShardInfo replays the next recorded GetOrCreateShard call of the shard, regardless of its request, and
+// returns the recorded shard info. It is used to acquire the shard before replaying the calls which follow.
+func (r *Replayer) ShardInfo(shardID int32) (*persistencespb.ShardInfo, error) {
+	response, err := replayResponse[*persistence.GetOrCreateShardResponse](r, shardID, "GetOrCreateShard", nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.ShardInfo, nil
+}
+
+func replayResponse[T any](r *Replayer, shardID int32, method string, request any) (T, error) {
+	var response T
+	record, err := r.next(shardID, method, request)
+	if err != nil {
+		return response, err
+	}
+	value, err := r.codec.decode(record.Response, reflect.TypeOf(&response).Elem())
+	if err != nil {
+		return response, fmt.Errorf("unable to decode recorded %s response: %w", method, err)
+	}
+	recordedErr, err := r.codec.decodeError(record.Error)
+	if err != nil {
+		return response, fmt.Errorf("unable to decode recorded %s error: %w", method, err)
+	}
+	return value.Interface().(T), recordedErr
+}
+
+func replayError(r *Replayer, shardID int32, method string, request any) error {
+	_, err := replayResponse[*struct{}](r, shardID, method, request)
+	return err
+}
+
+// equalJSON compares JSON ignoring insignificant whitespace, which protojson adds randomly.
+func equalJSON(a, b json.RawMessage) bool {
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, a) != nil || json.Compact(&compactB, b) != nil {
+		return false
+	}
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
+
+func (r *Replayer) GetName() string {
+	return "replayer"
+}
+
+func (r *Replayer) GetHistoryBranchUtil() persistence.HistoryBranchUtil {
+	return &persistence.HistoryBranchUtilImpl{}
+}
+
+func (r *Replayer) Close() {
+}
+
+func (r *Replayer) GetOrCreateShard(
+	_ context.Context,
+	request *persistence.GetOrCreateShardRequest,
+) (*persistence.GetOrCreateShardResponse, error) {
+	return replayResponse[*persistence.GetOrCreateShardResponse](r, request.ShardID, "GetOrCreateShard", request)
+}
+
+func (r *Replayer) UpdateShard(
+	_ context.Context,
+	request *persistence.UpdateShardRequest,
+) error {
+	return replayError(r, request.ShardInfo.GetShardId(), "UpdateShard", request)
+}
+
+func (r *Replayer) AssertShardOwnership(
+	_ context.Context,
+	request *persistence.AssertShardOwnershipRequest,
+) error {
+	return replayError(r, request.ShardID, "AssertShardOwnership", request)
+}
+
+func (r *Replayer) CreateWorkflowExecution(
+	_ context.Context,
+	request *persistence.CreateWorkflowExecutionRequest,
+) (*persistence.CreateWorkflowExecutionResponse, error) {
+	return replayResponse[*persistence.CreateWorkflowExecutionResponse](r, request.ShardID, "CreateWorkflowExecution", request)
+}
+
+func (r *Replayer) UpdateWorkflowExecution(
+	_ context.Context,
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	return replayResponse[*persistence.UpdateWorkflowExecutionResponse](r, request.ShardID, "UpdateWorkflowExecution", request)
+}
+
+func (r *Replayer) ConflictResolveWorkflowExecution(
+	_ context.Context,
+	request *persistence.ConflictResolveWorkflowExecutionRequest,
+) (*persistence.ConflictResolveWorkflowExecutionResponse, error) {
+	return replayResponse[*persistence.ConflictResolveWorkflowExecutionResponse](r, request.ShardID, "ConflictResolveWorkflowExecution", request)
+}
+
+func (r *Replayer) DeleteWorkflowExecution(
+	_ context.Context,
+	request *persistence.DeleteWorkflowExecutionRequest,
+) error {
+	return replayError(r, request.ShardID, "DeleteWorkflowExecution", request)
+}
+
+func (r *Replayer) DeleteCurrentWorkflowExecution(
+	_ context.Context,
+	request *persistence.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return replayError(r, request.ShardID, "DeleteCurrentWorkflowExecution", request)
+}
+
+func (r *Replayer) GetCurrentExecution(
+	_ context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.GetCurrentExecutionResponse, error) {
+	return replayResponse[*persistence.GetCurrentExecutionResponse](r, request.ShardID, "GetCurrentExecution", request)
+}
+
+func (r *Replayer) GetWorkflowExecution(
+	_ context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.GetWorkflowExecutionResponse, error) {
+	return replayResponse[*persistence.GetWorkflowExecutionResponse](r, request.ShardID, "GetWorkflowExecution", request)
+}
+
+func (r *Replayer) SetWorkflowExecution(
+	_ context.Context,
+	request *persistence.SetWorkflowExecutionRequest,
+) (*persistence.SetWorkflowExecutionResponse, error) {
+	return replayResponse[*persistence.SetWorkflowExecutionResponse](r, request.ShardID, "SetWorkflowExecution", request)
+}
+
+func (r *Replayer) ListConcreteExecutions(
+	_ context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.ListConcreteExecutionsResponse, error) {
+	return replayResponse[*persistence.ListConcreteExecutionsResponse](r, request.ShardID, "ListConcreteExecutions", request)
+}
+
+func (r *Replayer) AddHistoryTasks(
+	_ context.Context,
+	request *persistence.AddHistoryTasksRequest,
+) error {
+	return replayError(r, request.ShardID, "AddHistoryTasks", request)
+}
+
+func (r *Replayer) GetHistoryTasks(
+	_ context.Context,
+	request *persistence.GetHistoryTasksRequest,
+) (*persistence.GetHistoryTasksResponse, error) {
+	return replayResponse[*persistence.GetHistoryTasksResponse](r, request.ShardID, "GetHistoryTasks", request)
+}
+
+func (r *Replayer) CompleteHistoryTask(
+	_ context.Context,
+	request *persistence.CompleteHistoryTaskRequest,
+) error {
+	return replayError(r, request.ShardID, "CompleteHistoryTask", request)
+}
+
+func (r *Replayer) RangeCompleteHistoryTasks(
+	_ context.Context,
+	request *persistence.RangeCompleteHistoryTasksRequest,
+) error {
+	return replayError(r, request.ShardID, "RangeCompleteHistoryTasks", request)
+}
+
+func (r *Replayer) PutReplicationTaskToDLQ(
+	_ context.Context,
+	request *persistence.PutReplicationTaskToDLQRequest,
+) error {
+	return replayError(r, request.ShardID, "PutReplicationTaskToDLQ", request)
+}
+
+func (r *Replayer) GetReplicationTasksFromDLQ(
+	_ context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (*persistence.GetHistoryTasksResponse, error) {
+	return replayResponse[*persistence.GetHistoryTasksResponse](r, request.ShardID, "GetReplicationTasksFromDLQ", request)
+}
+
+func (r *Replayer) DeleteReplicationTaskFromDLQ(
+	_ context.Context,
+	request *persistence.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return replayError(r, request.ShardID, "DeleteReplicationTaskFromDLQ", request)
+}
+
+func (r *Replayer) RangeDeleteReplicationTaskFromDLQ(
+	_ context.Context,
+	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return replayError(r, request.ShardID, "RangeDeleteReplicationTaskFromDLQ", request)
+}
+
+func (r *Replayer) IsReplicationDLQEmpty(
+	_ context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	return replayResponse[bool](r, request.ShardID, "IsReplicationDLQEmpty", request)
+}
+
+func (r *Replayer) AppendHistoryNodes(
+	_ context.Context,
+	request *persistence.AppendHistoryNodesRequest,
+) (*persistence.AppendHistoryNodesResponse, error) {
+	return replayResponse[*persistence.AppendHistoryNodesResponse](r, request.ShardID, "AppendHistoryNodes", request)
+}
+
+func (r *Replayer) AppendRawHistoryNodes(
+	_ context.Context,
+	request *persistence.AppendRawHistoryNodesRequest,
+) (*persistence.AppendHistoryNodesResponse, error) {
+	return replayResponse[*persistence.AppendHistoryNodesResponse](r, request.ShardID, "AppendRawHistoryNodes", request)
+}
+
+func (r *Replayer) ReadHistoryBranch(
+	_ context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchResponse, error) {
+	return replayResponse[*persistence.ReadHistoryBranchResponse](r, request.ShardID, "ReadHistoryBranch", request)
+}
+
+func (r *Replayer) ReadHistoryBranchByBatch(
+	_ context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	return replayResponse[*persistence.ReadHistoryBranchByBatchResponse](r, request.ShardID, "ReadHistoryBranchByBatch", request)
+}
+
+func (r *Replayer) ReadHistoryBranchReverse(
+	_ context.Context,
+	request *persistence.ReadHistoryBranchReverseRequest,
+) (*persistence.ReadHistoryBranchReverseResponse, error) {
+	return replayResponse[*persistence.ReadHistoryBranchReverseResponse](r, request.ShardID, "ReadHistoryBranchReverse", request)
+}
+
+func (r *Replayer) ReadRawHistoryBranch(
+	_ context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadRawHistoryBranchResponse, error) {
+	return replayResponse[*persistence.ReadRawHistoryBranchResponse](r, request.ShardID, "ReadRawHistoryBranch", request)
+}
+
+func (r *Replayer) ForkHistoryBranch(
+	_ context.Context,
+	request *persistence.ForkHistoryBranchRequest,
+) (*persistence.ForkHistoryBranchResponse, error) {
+	return replayResponse[*persistence.ForkHistoryBranchResponse](r, request.ShardID, "ForkHistoryBranch", request)
+}
+
+func (r *Replayer) DeleteHistoryBranch(
+	_ context.Context,
+	request *persistence.DeleteHistoryBranchRequest,
+) error {
+	return replayError(r, request.ShardID, "DeleteHistoryBranch", request)
+}
+
+func (r *Replayer) TrimHistoryBranch(
+	_ context.Context,
+	request *persistence.TrimHistoryBranchRequest,
+) (*persistence.TrimHistoryBranchResponse, error) {
+	return replayResponse[*persistence.TrimHistoryBranchResponse](r, request.ShardID, "TrimHistoryBranch", request)
+}
+
+func (r *Replayer) GetAllHistoryTreeBranches(
+	_ context.Context,
+	_ *persistence.GetAllHistoryTreeBranchesRequest,
+) (*persistence.GetAllHistoryTreeBranchesResponse, error) {
+	return nil, fmt.Errorf("%w: GetAllHistoryTreeBranches is not recorded", ErrNoRecordedCall)
+}
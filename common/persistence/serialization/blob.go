@@ -162,11 +162,11 @@ func decode(
 		return nil
 	}
 
-	enc, _ := enumspb.EncodingTypeFromString(encoding)
+	enc, _ := EncodingTypeFromString(encoding)
 	switch enc {
 	case enumspb.ENCODING_TYPE_JSON:
 		return codec.NewJSONPBEncoder().Decode(blob, result)
-	case enumspb.ENCODING_TYPE_PROTO3:
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd:
 		return proto3Decode(blob, encoding, result)
 	default:
 		return NewUnknownEncodingTypeError(encoding, enumspb.ENCODING_TYPE_JSON, enumspb.ENCODING_TYPE_PROTO3)
@@ -187,18 +187,19 @@ func proto3Encode(m proto.Message) (*commonpb.DataBlob, error) {
 }
 
 func proto3Decode(blob []byte, encoding string, result proto.Message) error {
-	e, _ := enumspb.EncodingTypeFromString(encoding)
-	if e != enumspb.ENCODING_TYPE_PROTO3 {
-		return NewUnknownEncodingTypeError(encoding, enumspb.ENCODING_TYPE_PROTO3)
+	e, err := EncodingTypeFromString(encoding)
+	if err != nil {
+		return NewUnknownEncodingTypeError(encoding, enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd)
 	}
 	return Proto3Decode(blob, e, result)
 }
 
 func Proto3Decode(blob []byte, e enumspb.EncodingType, result proto.Message) error {
-	if e != enumspb.ENCODING_TYPE_PROTO3 {
-		return NewUnknownEncodingTypeError(e.String(), enumspb.ENCODING_TYPE_PROTO3)
+	data, err := proto3Data(blob, e)
+	if err != nil {
+		return err
 	}
-	err := proto.Unmarshal(blob, result)
+	err = proto.Unmarshal(data, result)
 	if err == nil {
 		err = utf8validator.Validate(result, utf8validator.SourcePersistence)
 	}
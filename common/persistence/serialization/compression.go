@@ -0,0 +1,121 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"bytes"
+
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+const (
+	// EncodingTypeProto3Zstd is the encoding of blobs which are proto3 encoded and then compressed with zstd.
+	// It is a server only encoding outside of the range of the public API encoding types, so its string form
+	// is its number. Serializing with this encoding falls back to ENCODING_TYPE_PROTO3 when compression
+	// doesn't make the blob smaller.
+	EncodingTypeProto3Zstd enumspb.EncodingType = 1000
+)
+
+var (
+	zstdMagicNumber = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// EncodingTypeFromString parses the string form of an encoding type, including the server only ones.
+func EncodingTypeFromString(s string) (enumspb.EncodingType, error) {
+	if s == EncodingTypeProto3Zstd.String() {
+		return EncodingTypeProto3Zstd, nil
+	}
+	return enumspb.EncodingTypeFromString(s)
+}
+
+// CompressBlob compresses a proto3 encoded blob. The blob is returned as is if it has another encoding or
+// compression doesn't make it smaller.
+func CompressBlob(blob *commonpb.DataBlob) *commonpb.DataBlob {
+	if blob == nil || blob.EncodingType != enumspb.ENCODING_TYPE_PROTO3 {
+		return blob
+	}
+	return proto3Blob(blob.Data, EncodingTypeProto3Zstd)
+}
+
+// DecompressBlob returns the proto3 encoded form of a compressed blob. Blobs with other encodings are
+// returned as is. Blobs leaving persistence, e.g. raw history sent to other clusters, must be decompressed
+// first since only the server knows about compressed encodings.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || blob.EncodingType != EncodingTypeProto3Zstd {
+		return blob, nil
+	}
+	data, err := proto3Data(blob.Data, blob.EncodingType)
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.DataBlob{
+		Data:         data,
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+	}, nil
+}
+
+// proto3Blob returns a blob of proto3 encoded data, compressed if the encoding asks for it.
+func proto3Blob(data []byte, encoding enumspb.EncodingType) *commonpb.DataBlob {
+	if encoding == EncodingTypeProto3Zstd {
+		compressed := zstdEncoder.EncodeAll(data, nil)
+		if len(compressed) < len(data) {
+			return &commonpb.DataBlob{
+				Data:         compressed,
+				EncodingType: EncodingTypeProto3Zstd,
+			}
+		}
+	}
+	return &commonpb.DataBlob{
+		Data:         data,
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+	}
+}
+
+// proto3Data returns the proto3 encoded data of a blob with either a proto3 or a compressed proto3 encoding.
+// Compressed data is recognized by the zstd frame magic number rather than by the encoding alone, because
+// Cassandra stores a single encoding for all entries of a mutable state map, so entries written with
+// compression enabled and disabled can end up with the same encoding.
+func proto3Data(data []byte, encoding enumspb.EncodingType) ([]byte, error) {
+	switch encoding {
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd:
+		if !bytes.HasPrefix(data, zstdMagicNumber) {
+			return data, nil
+		}
+		decompressed, err := zstdDecoder.DecodeAll(data, nil)
+		if err != nil {
+			if encoding == enumspb.ENCODING_TYPE_PROTO3 {
+				// Proto3 data which happens to start with the magic number.
+				return data, nil
+			}
+			return nil, NewDeserializationError(EncodingTypeProto3Zstd, err)
+		}
+		return decompressed, nil
+	default:
+		return nil, NewUnknownEncodingTypeError(encoding.String(), enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd)
+	}
+}
@@ -163,15 +163,12 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 		return nil, nil
 	}
 
-	events := &historypb.History{}
-	var err error
-	switch data.EncodingType {
-	case enumspb.ENCODING_TYPE_PROTO3:
-		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = events.Unmarshal(data.Data)
-	default:
-		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
+	raw, err := proto3Data(data.Data, data.EncodingType)
+	if err != nil {
+		return nil, err
 	}
+	events := &historypb.History{}
+	err = events.Unmarshal(raw)
 	if err == nil {
 		err = utf8validator.Validate(events, utf8validator.SourcePersistence)
 	}
@@ -196,15 +193,12 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 		return nil, nil
 	}
 
-	event := &historypb.HistoryEvent{}
-	var err error
-	switch data.EncodingType {
-	case enumspb.ENCODING_TYPE_PROTO3:
-		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = event.Unmarshal(data.Data)
-	default:
-		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
+	raw, err := proto3Data(data.Data, data.EncodingType)
+	if err != nil {
+		return nil, err
 	}
+	event := &historypb.HistoryEvent{}
+	err = event.Unmarshal(raw)
 	if err == nil {
 		err = utf8validator.Validate(event, utf8validator.SourcePersistence)
 	}
@@ -230,16 +224,12 @@ func (t *serializerImpl) DeserializeClusterMetadata(data *commonpb.DataBlob) (*p
 		return nil, nil
 	}
 
-	cm := &persistencespb.ClusterMetadata{}
-	var err error
-	switch data.EncodingType {
-	case enumspb.ENCODING_TYPE_PROTO3:
-		// Thrift == Proto for this object so that we can maintain test behavior until thrift is gone
-		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = cm.Unmarshal(data.Data)
-	default:
-		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
+	raw, err := proto3Data(data.Data, data.EncodingType)
+	if err != nil {
+		return nil, err
 	}
+	cm := &persistencespb.ClusterMetadata{}
+	err = cm.Unmarshal(raw)
 	if err == nil {
 		err = utf8validator.Validate(cm, utf8validator.SourcePersistence)
 	}
@@ -259,7 +249,7 @@ func (t *serializerImpl) serialize(p marshaler, encodingType enumspb.EncodingTyp
 	var err error
 
 	switch encodingType {
-	case enumspb.ENCODING_TYPE_PROTO3:
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
 		if msg, ok := p.(proto.Message); ok {
 			if err := utf8validator.Validate(msg, utf8validator.SourcePersistence); err != nil {
@@ -268,7 +258,7 @@ func (t *serializerImpl) serialize(p marshaler, encodingType enumspb.EncodingTyp
 		}
 		data, err = p.Marshal()
 	default:
-		return nil, NewUnknownEncodingTypeError(encodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
+		return nil, NewUnknownEncodingTypeError(encodingType.String(), enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd)
 	}
 
 	if err != nil {
@@ -280,10 +270,7 @@ func (t *serializerImpl) serialize(p marshaler, encodingType enumspb.EncodingTyp
 		return nil, nil
 	}
 
-	return &commonpb.DataBlob{
-		Data:         data,
-		EncodingType: encodingType,
-	}, nil
+	return proto3Blob(data, encodingType), nil
 }
 
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
@@ -583,7 +570,7 @@ func decodeBlob(data *commonpb.DataBlob, result proto.Message) error {
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_JSON:
 		return codec.NewJSONPBEncoder().Decode(data.Data, result)
-	case enumspb.ENCODING_TYPE_PROTO3:
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd:
 		return ProtoDecodeBlob(data, result)
 	default:
 		return NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_JSON, enumspb.ENCODING_TYPE_PROTO3)
@@ -608,16 +595,16 @@ func encodeBlob(o proto.Message, encoding enumspb.EncodingType) (*commonpb.DataB
 			Data:         blob,
 			EncodingType: enumspb.ENCODING_TYPE_JSON,
 		}, nil
-	case enumspb.ENCODING_TYPE_PROTO3:
-		return ProtoEncodeBlob(o, enumspb.ENCODING_TYPE_PROTO3)
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd:
+		return ProtoEncodeBlob(o, encoding)
 	default:
 		return nil, NewUnknownEncodingTypeError(encoding.String(), enumspb.ENCODING_TYPE_JSON, enumspb.ENCODING_TYPE_PROTO3)
 	}
 }
 
 func ProtoEncodeBlob(m proto.Message, encoding enumspb.EncodingType) (*commonpb.DataBlob, error) {
-	if encoding != enumspb.ENCODING_TYPE_PROTO3 {
-		return nil, NewUnknownEncodingTypeError(encoding.String(), enumspb.ENCODING_TYPE_PROTO3)
+	if encoding != enumspb.ENCODING_TYPE_PROTO3 && encoding != EncodingTypeProto3Zstd {
+		return nil, NewUnknownEncodingTypeError(encoding.String(), enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd)
 	}
 
 	if m == nil || (reflect.ValueOf(m).Kind() == reflect.Ptr && reflect.ValueOf(m).IsNil()) {
@@ -635,5 +622,5 @@ func ProtoEncodeBlob(m proto.Message, encoding enumspb.EncodingType) (*commonpb.
 	if err != nil {
 		return nil, NewSerializationError(enumspb.ENCODING_TYPE_PROTO3, err)
 	}
-	return &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: data}, nil
+	return proto3Blob(data, encoding), nil
 }
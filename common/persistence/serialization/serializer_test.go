@@ -26,12 +26,14 @@ package serialization
 
 import (
 	"math/rand"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -195,3 +197,89 @@ func (s *temporalSerializerSuite) TestSerializeShardInfo_Random() {
 
 	s.ProtoEqual(&shardInfo, deserializedShardInfo)
 }
+
+func (s *temporalSerializerSuite) TestSerializeEvents_Compressed() {
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+				ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+					Result: payloads.EncodeString(strings.Repeat("result", 1000)),
+				},
+			},
+		},
+	}
+
+	uncompressed, err := s.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	compressed, err := s.serializer.SerializeEvents(events, EncodingTypeProto3Zstd)
+	s.NoError(err)
+	s.Equal(EncodingTypeProto3Zstd, compressed.EncodingType)
+	s.Less(len(compressed.Data), len(uncompressed.Data))
+
+	deserialized, err := s.serializer.DeserializeEvents(compressed)
+	s.NoError(err)
+	s.ProtoElementsMatch(events, deserialized)
+
+	decompressed, err := DecompressBlob(compressed)
+	s.NoError(err)
+	s.ProtoEqual(uncompressed, decompressed)
+	s.ProtoEqual(compressed, CompressBlob(uncompressed))
+}
+
+func (s *temporalSerializerSuite) TestSerializeEvents_CompressionFallsBackToProto3() {
+	events := []*historypb.HistoryEvent{{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED}}
+
+	blob, err := s.serializer.SerializeEvents(events, EncodingTypeProto3Zstd)
+	s.NoError(err)
+	s.Equal(enumspb.ENCODING_TYPE_PROTO3, blob.EncodingType)
+
+	deserialized, err := s.serializer.DeserializeEvents(blob)
+	s.NoError(err)
+	s.ProtoElementsMatch(events, deserialized)
+}
+
+func (s *temporalSerializerSuite) TestWorkflowExecutionInfo_MixedEncodings() {
+	info := &persistencespb.WorkflowExecutionInfo{
+		WorkflowId: strings.Repeat("workflow-id", 100),
+		TaskQueue:  strings.Repeat("task-queue", 100),
+	}
+
+	uncompressed, err := s.serializer.WorkflowExecutionInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	compressed, err := s.serializer.WorkflowExecutionInfoToBlob(info, EncodingTypeProto3Zstd)
+	s.NoError(err)
+	s.Equal(EncodingTypeProto3Zstd, compressed.EncodingType)
+
+	for _, blob := range []*commonpb.DataBlob{
+		uncompressed,
+		compressed,
+		// Cassandra stores a single encoding for all entries of a mutable state map.
+		{Data: compressed.Data, EncodingType: enumspb.ENCODING_TYPE_PROTO3},
+		{Data: uncompressed.Data, EncodingType: EncodingTypeProto3Zstd},
+	} {
+		deserialized, err := s.serializer.WorkflowExecutionInfoFromBlob(blob)
+		s.NoError(err)
+		s.ProtoEqual(info, deserialized)
+
+		deserialized, err = WorkflowExecutionInfoFromBlob(blob.Data, blob.EncodingType.String())
+		s.NoError(err)
+		s.ProtoEqual(info, deserialized)
+	}
+}
+
+func (s *temporalSerializerSuite) TestEncodingTypeFromString() {
+	for _, encoding := range []enumspb.EncodingType{
+		enumspb.ENCODING_TYPE_PROTO3,
+		enumspb.ENCODING_TYPE_JSON,
+		EncodingTypeProto3Zstd,
+	} {
+		parsed, err := EncodingTypeFromString(encoding.String())
+		s.NoError(err)
+		s.Equal(encoding, parsed)
+	}
+
+	_, err := EncodingTypeFromString("1001")
+	s.Error(err)
+}
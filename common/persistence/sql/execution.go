@@ -40,10 +40,18 @@ import (
 	"go.temporal.io/server/common/primitives"
 )
 
-type sqlExecutionStore struct {
-	SqlStore
-	p.HistoryBranchUtilImpl
-}
+type (
+	sqlExecutionStore struct {
+		SqlStore
+		p.HistoryBranchUtilImpl
+	}
+
+	listConcreteExecutionsPageToken struct {
+		NamespaceID primitives.UUID
+		WorkflowID  string
+		RunID       primitives.UUID
+	}
+)
 
 var _ p.ExecutionStore = (*sqlExecutionStore)(nil)
 
@@ -246,20 +254,37 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed. Error: %v", err))
 	}
 
-	state := &p.InternalWorkflowMutableState{
-		ExecutionInfo:  p.NewDataBlob(executionsRow.Data, executionsRow.DataEncoding),
-		ExecutionState: p.NewDataBlob(executionsRow.State, executionsRow.StateEncoding),
-		NextEventID:    executionsRow.NextEventID,
+	state, err := m.getMutableState(ctx, executionsRow)
+	if err != nil {
+		return nil, err
+	}
 
+	return &p.InternalGetWorkflowExecutionResponse{
+		State:           state,
 		DBRecordVersion: executionsRow.DBRecordVersion,
+	}, nil
+}
+
+// getMutableState reads the mutable state of an executions row.
+func (m *sqlExecutionStore) getMutableState(
+	ctx context.Context,
+	row *sqlplugin.ExecutionsRow,
+) (*p.InternalWorkflowMutableState, error) {
+	state := &p.InternalWorkflowMutableState{
+		ExecutionInfo:  p.NewDataBlob(row.Data, row.DataEncoding),
+		ExecutionState: p.NewDataBlob(row.State, row.StateEncoding),
+		NextEventID:    row.NextEventID,
+
+		DBRecordVersion: row.DBRecordVersion,
 	}
 
+	var err error
 	state.ActivityInfos, err = getActivityInfoMap(ctx,
 		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
+		row.ShardID,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
 	)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get activity info. Error: %v", err))
@@ -267,10 +292,10 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.TimerInfos, err = getTimerInfoMap(ctx,
 		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
+		row.ShardID,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
 	)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get timer info. Error: %v", err))
@@ -278,10 +303,10 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.ChildExecutionInfos, err = getChildExecutionInfoMap(ctx,
 		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
+		row.ShardID,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
 	)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get child executionsRow info. Error: %v", err))
@@ -289,10 +314,10 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.RequestCancelInfos, err = getRequestCancelInfoMap(ctx,
 		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
+		row.ShardID,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
 	)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get request cancel info. Error: %v", err))
@@ -300,10 +325,10 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.SignalInfos, err = getSignalInfoMap(ctx,
 		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
+		row.ShardID,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
 	)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get signal info. Error: %v", err))
@@ -311,10 +336,10 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.BufferedEvents, err = getBufferedEvents(ctx,
 		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
+		row.ShardID,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
 	)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get buffered events. Error: %v", err))
@@ -322,19 +347,16 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.SignalRequestedIDs, err = getSignalsRequested(ctx,
 		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
+		row.ShardID,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
 	)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get signals requested. Error: %v", err))
 	}
 
-	return &p.InternalGetWorkflowExecutionResponse{
-		State:           state,
-		DBRecordVersion: executionsRow.DBRecordVersion,
-	}, nil
+	return state, nil
 }
 
 func (m *sqlExecutionStore) UpdateWorkflowExecution(
@@ -722,10 +744,50 @@ func (m *sqlExecutionStore) setWorkflowExecutionTx(
 }
 
 func (m *sqlExecutionStore) ListConcreteExecutions(
-	_ context.Context,
-	_ *p.ListConcreteExecutionsRequest,
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID: request.ShardID,
+		// Empty but not nil, so that the first page compares greater than every row.
+		MinNamespaceID: primitives.UUID{},
+		MinRunID:       primitives.UUID{},
+		PageSize:       request.PageSize,
+	}
+	if len(request.PageToken) != 0 {
+		token, err := deserializePageTokenJson[listConcreteExecutionsPageToken](request.PageToken)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("ListConcreteExecutions: invalid page token: %v", err))
+		}
+		filter.MinNamespaceID = token.NamespaceID
+		filter.MinWorkflowID = token.WorkflowID
+		filter.MinRunID = token.RunID
+	}
+
+	rows, err := m.Db.RangeSelectFromExecutions(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("ListConcreteExecutions: failed. Error: %v", err))
+	}
+	response := &p.InternalListConcreteExecutionsResponse{}
+	for i := range rows {
+		state, err := m.getMutableState(ctx, &rows[i])
+		if err != nil {
+			return nil, err
+		}
+		response.States = append(response.States, state)
+	}
+	if len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		response.NextPageToken, err = serializePageTokenJson(&listConcreteExecutionsPageToken{
+			NamespaceID: lastRow.NamespaceID,
+			WorkflowID:  lastRow.WorkflowID,
+			RunID:       lastRow.RunID,
+		})
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("ListConcreteExecutions: unable to serialize page token: %v", err))
+		}
+	}
+	return response, nil
 }
 
 func getStartTimeFromState(state *persistence.WorkflowExecutionState) *time.Time {
@@ -416,6 +416,8 @@ func (m *sqlExecutionStore) GetAllHistoryTreeBranches(
 	if pageSize <= 0 {
 		return nil, fmt.Errorf("PageSize must be greater than 0, but was %d", pageSize)
 	}
+	// Branches are listed by the history scavenger, which tolerates stale data.
+	ctx = p.WithReplicaReads(ctx)
 
 	page := sqlplugin.HistoryTreeBranchPage{
 		Limit: pageSize,
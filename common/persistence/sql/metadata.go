@@ -246,6 +246,8 @@ func (m *sqlMetadataManagerV2) ListNamespaces(
 	ctx context.Context,
 	request *persistence.InternalListNamespacesRequest,
 ) (*persistence.InternalListNamespacesResponse, error) {
+	// Namespace listings are refreshed periodically and tolerate stale data.
+	ctx = persistence.WithReplicaReads(ctx)
 	var pageToken *primitives.UUID
 	if request.NextPageToken != nil {
 		token := primitives.UUID(request.NextPageToken)
@@ -171,17 +171,22 @@ func (h *DatabaseHandle) Conn() Conn {
 }
 
 func (h *DatabaseHandle) ConvertError(err error) error {
-	if h.needsRefresh(err) ||
+	if h.isConnectionError(err) {
+		h.reconnect(true)
+		return serviceerror.NewUnavailable(fmt.Sprintf("database connection lost: %s", err.Error()))
+	}
+	return err
+}
+
+// isConnectionError returns true if err means that the connection pool needs to be refreshed.
+func (h *DatabaseHandle) isConnectionError(err error) bool {
+	return err != nil && (h.needsRefresh(err) ||
 		errors.Is(err, driver.ErrBadConn) ||
 		errors.Is(err, io.ErrUnexpectedEOF) ||
 		errors.Is(err, io.EOF) ||
 		errors.Is(err, syscall.ECONNRESET) ||
 		errors.Is(err, syscall.ECONNABORTED) ||
-		errors.Is(err, syscall.ECONNREFUSED) {
-		h.reconnect(true)
-		return serviceerror.NewUnavailable(fmt.Sprintf("database connection lost: %s", err.Error()))
-	}
-	return err
+		errors.Is(err, syscall.ECONNREFUSED))
 }
 
 func (invalidConn) Rebind(query string) string {
@@ -59,6 +59,17 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// ExecutionsRangeFilter contains the column names within executions table that
+	// can be used to page through the executions of a shard. Rows are returned in
+	// (namespace_id, workflow_id, run_id) order, after the given exclusive lower bound.
+	ExecutionsRangeFilter struct {
+		ShardID        int32
+		MinNamespaceID primitives.UUID
+		MinWorkflowID  string
+		MinRunID       primitives.UUID
+		PageSize       int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int32
@@ -92,6 +103,7 @@ type (
 		InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(ctx context.Context, filter ExecutionsFilter) (*ExecutionsRow, error)
+		RangeSelectFromExecutions(ctx context.Context, filter ExecutionsRangeFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(ctx context.Context, filter ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
 		WriteLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
@@ -103,6 +103,8 @@ type (
 		UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
 		ListTables(database string) ([]string, error)
+		ListColumns(database string) ([]ColumnRow, error)
+		ListIndexes(database string) ([]IndexRow, error)
 		DropTable(table string) error
 		DropAllTables(database string) error
 		CreateDatabase(database string) error
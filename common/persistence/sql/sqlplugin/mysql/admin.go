@@ -27,6 +27,8 @@ package mysql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -64,6 +66,17 @@ const (
 
 	listTablesQuery = "SHOW TABLES FROM %v"
 
+	listColumnsQuery = `SELECT TABLE_NAME AS table_name, COLUMN_NAME AS column_name, ` +
+		`CONCAT(COLUMN_TYPE, IF(IS_NULLABLE = 'NO', ' NOT NULL', '')) AS column_type ` +
+		`FROM information_schema.columns WHERE TABLE_SCHEMA = ? ORDER BY TABLE_NAME, ORDINAL_POSITION`
+
+	listIndexesQuery = `SELECT TABLE_NAME AS table_name, INDEX_NAME AS index_name, ` +
+		`CONCAT(IF(NON_UNIQUE = 0, 'UNIQUE ', ''), '(', ` +
+		`GROUP_CONCAT(CONCAT(COALESCE(COLUMN_NAME, ''), IF(COLLATION = 'D', ' DESC', '')) ORDER BY SEQ_IN_INDEX SEPARATOR ', '), ` +
+		`')') AS definition ` +
+		`FROM information_schema.statistics WHERE TABLE_SCHEMA = ? ` +
+		`GROUP BY TABLE_NAME, INDEX_NAME, NON_UNIQUE ORDER BY TABLE_NAME, INDEX_NAME`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -118,6 +131,28 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, mdb.handle.ConvertError(err)
 }
 
+// ListColumns returns the columns of all tables in this database
+func (mdb *db) ListColumns(database string) ([]sqlplugin.ColumnRow, error) {
+	var columns []sqlplugin.ColumnRow
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Select(&columns, listColumnsQuery, database)
+	return columns, mdb.handle.ConvertError(err)
+}
+
+// ListIndexes returns the indexes of all tables in this database
+func (mdb *db) ListIndexes(database string) ([]sqlplugin.IndexRow, error) {
+	var indexes []sqlplugin.IndexRow
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Select(&indexes, listIndexesQuery, database)
+	return indexes, mdb.handle.ConvertError(err)
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
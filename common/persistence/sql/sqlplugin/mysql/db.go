@@ -56,6 +56,7 @@ type db struct {
 	dbName string
 
 	handle    *sqlplugin.DatabaseHandle
+	replicas  *sqlplugin.ReadReplicas
 	tx        *sqlx.Tx
 	converter DataConverter
 }
@@ -83,13 +84,15 @@ func newDB(
 	dbKind sqlplugin.DbKind,
 	dbName string,
 	handle *sqlplugin.DatabaseHandle,
+	replicas *sqlplugin.ReadReplicas,
 	tx *sqlx.Tx,
 ) *db {
 	mdb := &db{
-		dbKind: dbKind,
-		dbName: dbName,
-		handle: handle,
-		tx:     tx,
+		dbKind:   dbKind,
+		dbName:   dbName,
+		handle:   handle,
+		replicas: replicas,
+		tx:       tx,
 	}
 	mdb.converter = &converter{}
 	return mdb
@@ -112,7 +115,7 @@ func (mdb *db) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
 	if err != nil {
 		return nil, mdb.handle.ConvertError(err)
 	}
-	return newDB(mdb.dbKind, mdb.dbName, mdb.handle, xtx), nil
+	return newDB(mdb.dbKind, mdb.dbName, mdb.handle, mdb.replicas, xtx), nil
 }
 
 // Commit commits a previously started transaction
@@ -127,6 +130,7 @@ func (mdb *db) Rollback() error {
 
 // Close closes the connection to the mysql db
 func (mdb *db) Close() error {
+	mdb.replicas.Close()
 	mdb.handle.Close()
 	return nil
 }
@@ -166,11 +170,25 @@ func (mdb *db) ExecContext(ctx context.Context, stmt string, args ...any) (sql.R
 }
 
 func (mdb *db) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	if mdb.tx == nil {
+		if ok, err := mdb.replicas.Read(ctx, dest, func(conn sqlplugin.Conn) error {
+			return conn.GetContext(ctx, dest, query, args...)
+		}); ok {
+			return err
+		}
+	}
 	err := mdb.conn().GetContext(ctx, dest, query, args...)
 	return mdb.handle.ConvertError(err)
 }
 
 func (mdb *db) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	if mdb.tx == nil {
+		if ok, err := mdb.replicas.Read(ctx, dest, func(conn sqlplugin.Conn) error {
+			return conn.SelectContext(ctx, dest, query, args...)
+		}); ok {
+			return err
+		}
+	}
 	err := mdb.conn().SelectContext(ctx, dest, query, args...)
 	return mdb.handle.ConvertError(err)
 }
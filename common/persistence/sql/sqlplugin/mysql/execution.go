@@ -44,6 +44,10 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
+	rangeSelectExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND (namespace_id, workflow_id, run_id) > (?, ?, ?)
+ ORDER BY namespace_id, workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -228,6 +232,23 @@ func (mdb *db) SelectFromExecutions(
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads a page of rows from executions table
+func (mdb *db) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := mdb.SelectContext(ctx,
+		&rows, rangeSelectExecutionsQuery,
+		filter.ShardID,
+		filter.MinNamespaceID,
+		filter.MinWorkflowID,
+		filter.MinRunID,
+		filter.PageSize,
+	)
+	return rows, err
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *db) DeleteFromExecutions(
 	ctx context.Context,
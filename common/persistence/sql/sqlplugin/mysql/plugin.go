@@ -25,6 +25,12 @@
 package mysql
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
@@ -64,7 +70,17 @@ func (p *plugin) CreateDB(
 		return p.createDBConnection(dbKind, cfg, r)
 	}
 	handle := sqlplugin.NewDatabaseHandle(connect, isConnNeedsRefreshError, logger, metricsHandler, clock.NewRealTimeSource())
-	db := newDB(dbKind, cfg.DatabaseName, handle, nil)
+	connectReplica := func(addr string) (*sqlx.DB, error) {
+		replicaCfg := *cfg
+		replicaCfg.ConnectAddr = addr
+		replicaCfg.ReadReplicas = nil
+		if cfg.Connect != nil {
+			return cfg.Connect(&replicaCfg)
+		}
+		return p.createDBConnection(dbKind, &replicaCfg, r)
+	}
+	replicas := sqlplugin.NewReadReplicas(cfg.ReadReplicas, connectReplica, isConnNeedsRefreshError, replicationLag, logger, metricsHandler, clock.NewRealTimeSource())
+	db := newDB(dbKind, cfg.DatabaseName, handle, replicas, nil)
 	return db, nil
 }
 
@@ -83,7 +99,7 @@ func (p *plugin) CreateAdminDB(
 		return p.createDBConnection(dbKind, cfg, r)
 	}
 	handle := sqlplugin.NewDatabaseHandle(connect, isConnNeedsRefreshError, logger, metricsHandler, clock.NewRealTimeSource())
-	db := newDB(dbKind, cfg.DatabaseName, handle, nil)
+	db := newDB(dbKind, cfg.DatabaseName, handle, nil, nil)
 	return db, nil
 }
 
@@ -102,3 +118,41 @@ func (p *plugin) createDBConnection(
 	}
 	return mysqlSession.DB, nil
 }
+
+// replicationLag returns the replication lag of a MySQL replica. A server without replication status, e.g. a
+// group replication member, has no lag.
+func replicationLag(ctx context.Context, db *sqlx.DB) (time.Duration, error) {
+	rows, err := db.QueryxContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+	status := make(map[string]any)
+	if err := rows.MapScan(status); err != nil {
+		return 0, err
+	}
+	for _, column := range []string{"Seconds_Behind_Source", "Seconds_Behind_Master"} {
+		value, ok := status[column]
+		if !ok {
+			continue
+		}
+		var seconds int64
+		switch value := value.(type) {
+		case nil:
+			return 0, errors.New("replication is not running")
+		case int64:
+			seconds = value
+		case []byte:
+			if seconds, err = strconv.ParseInt(string(value), 10, 64); err != nil {
+				return 0, fmt.Errorf("unable to parse %s: %w", column, err)
+			}
+		default:
+			return 0, fmt.Errorf("unexpected %s type %T", column, value)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, errors.New("replication lag is not reported")
+}
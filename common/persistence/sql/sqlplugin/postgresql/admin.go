@@ -27,6 +27,8 @@ package postgresql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -68,6 +70,15 @@ const (
 
 	listTablesQuery = "select table_name from information_schema.tables where table_schema='public'"
 
+	listColumnsQuery = `SELECT c.relname AS table_name, a.attname AS column_name, ` +
+		`format_type(a.atttypid, a.atttypmod) || CASE WHEN a.attnotnull THEN ' NOT NULL' ELSE '' END AS column_type ` +
+		`FROM pg_attribute a JOIN pg_class c ON c.oid = a.attrelid JOIN pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE n.nspname = 'public' AND c.relkind IN ('r', 'p') AND a.attnum > 0 AND NOT a.attisdropped ` +
+		`ORDER BY c.relname, a.attnum`
+
+	listIndexesQuery = `SELECT tablename AS table_name, indexname AS index_name, indexdef AS definition ` +
+		`FROM pg_indexes WHERE schemaname = 'public' ORDER BY tablename, indexname`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -119,6 +130,20 @@ func (pdb *db) ListTables(database string) ([]string, error) {
 	return tables, pdb.handle.ConvertError(err)
 }
 
+// ListColumns returns the columns of all tables in this database
+func (pdb *db) ListColumns(database string) ([]sqlplugin.ColumnRow, error) {
+	var columns []sqlplugin.ColumnRow
+	err := pdb.Select(&columns, listColumnsQuery)
+	return columns, pdb.handle.ConvertError(err)
+}
+
+// ListIndexes returns the indexes of all tables in this database
+func (pdb *db) ListIndexes(database string) ([]sqlplugin.IndexRow, error) {
+	var indexes []sqlplugin.IndexRow
+	err := pdb.Select(&indexes, listIndexesQuery)
+	return indexes, pdb.handle.ConvertError(err)
+}
+
 // DropTable drops a given table from the database
 func (pdb *db) DropTable(name string) error {
 	return pdb.Exec(fmt.Sprintf(dropTableQuery, name))
@@ -57,8 +57,9 @@ type db struct {
 	resolver  resolver.ServiceResolver
 	converter DataConverter
 
-	handle *sqlplugin.DatabaseHandle
-	tx     *sqlx.Tx
+	handle   *sqlplugin.DatabaseHandle
+	replicas *sqlplugin.ReadReplicas
+	tx       *sqlx.Tx
 }
 
 var _ sqlplugin.DB = (*db)(nil)
@@ -70,6 +71,7 @@ func newDB(
 	dbName string,
 	dbDriver driver.Driver,
 	handle *sqlplugin.DatabaseHandle,
+	replicas *sqlplugin.ReadReplicas,
 	tx *sqlx.Tx,
 ) *db {
 	mdb := &db{
@@ -77,6 +79,7 @@ func newDB(
 		dbName:   dbName,
 		dbDriver: dbDriver,
 		handle:   handle,
+		replicas: replicas,
 		tx:       tx,
 	}
 	mdb.converter = &converter{}
@@ -101,11 +104,12 @@ func (pdb *db) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
 	if err != nil {
 		return nil, pdb.handle.ConvertError(err)
 	}
-	return newDB(pdb.dbKind, pdb.dbName, pdb.dbDriver, pdb.handle, tx), nil
+	return newDB(pdb.dbKind, pdb.dbName, pdb.dbDriver, pdb.handle, pdb.replicas, tx), nil
 }
 
 // Close closes the connection to the mysql db
 func (pdb *db) Close() error {
+	pdb.replicas.Close()
 	pdb.handle.Close()
 	return nil
 }
@@ -155,6 +159,13 @@ func (pdb *db) ExecContext(ctx context.Context, stmt string, args ...any) (sql.R
 }
 
 func (pdb *db) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	if pdb.tx == nil {
+		if ok, err := pdb.replicas.Read(ctx, dest, func(conn sqlplugin.Conn) error {
+			return conn.GetContext(ctx, dest, query, args...)
+		}); ok {
+			return err
+		}
+	}
 	err := pdb.conn().GetContext(ctx, dest, query, args...)
 	return pdb.handle.ConvertError(err)
 }
@@ -169,6 +180,13 @@ func (pdb *db) Select(dest any, query string, args ...any) error {
 }
 
 func (pdb *db) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	if pdb.tx == nil {
+		if ok, err := pdb.replicas.Read(ctx, dest, func(conn sqlplugin.Conn) error {
+			return conn.SelectContext(ctx, dest, query, args...)
+		}); ok {
+			return err
+		}
+	}
 	err := pdb.conn().SelectContext(ctx, dest, query, args...)
 	return pdb.handle.ConvertError(err)
 }
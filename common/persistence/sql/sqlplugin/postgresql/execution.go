@@ -44,6 +44,10 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
+	rangeSelectExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND (namespace_id, workflow_id, run_id) > ($2, $3, $4)
+ ORDER BY namespace_id, workflow_id, run_id LIMIT $5`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
@@ -228,6 +232,23 @@ func (pdb *db) SelectFromExecutions(
 	return &row, nil
 }
 
+// RangeSelectFromExecutions reads a page of rows from executions table
+func (pdb *db) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := pdb.SelectContext(ctx,
+		&rows, rangeSelectExecutionsQuery,
+		filter.ShardID,
+		filter.MinNamespaceID,
+		filter.MinWorkflowID,
+		filter.MinRunID,
+		filter.PageSize,
+	)
+	return rows, err
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (pdb *db) DeleteFromExecutions(
 	ctx context.Context,
@@ -25,8 +25,10 @@
 package postgresql
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/api/serviceerror"
@@ -82,7 +84,17 @@ func (d *plugin) CreateDB(
 	}
 	needsRefresh := d.d.IsConnNeedsRefreshError
 	handle := sqlplugin.NewDatabaseHandle(connect, needsRefresh, logger, metricsHandler, clock.NewRealTimeSource())
-	db := newDB(dbKind, cfg.DatabaseName, d.d, handle, nil)
+	connectReplica := func(addr string) (*sqlx.DB, error) {
+		replicaCfg := *cfg
+		replicaCfg.ConnectAddr = addr
+		replicaCfg.ReadReplicas = nil
+		if cfg.Connect != nil {
+			return cfg.Connect(&replicaCfg)
+		}
+		return d.createDBConnection(&replicaCfg, r)
+	}
+	replicas := sqlplugin.NewReadReplicas(cfg.ReadReplicas, connectReplica, needsRefresh, replicationLag, logger, metricsHandler, clock.NewRealTimeSource())
+	db := newDB(dbKind, cfg.DatabaseName, d.d, handle, replicas, nil)
 	return db, nil
 }
 
@@ -102,7 +114,7 @@ func (d *plugin) CreateAdminDB(
 	}
 	needsRefresh := d.d.IsConnNeedsRefreshError
 	handle := sqlplugin.NewDatabaseHandle(connect, needsRefresh, logger, metricsHandler, clock.NewRealTimeSource())
-	db := newDB(dbKind, cfg.DatabaseName, d.d, handle, nil)
+	db := newDB(dbKind, cfg.DatabaseName, d.d, handle, nil, nil)
 	return db, nil
 }
 
@@ -143,3 +155,19 @@ func (d *plugin) createDBConnection(
 		fmt.Sprintf("unable to connect to DB, tried default DB names: %v, errors: %v", strings.Join(defaultDatabaseNames, ","), errors),
 	)
 }
+
+// replicationLagQuery returns the time since the last replayed transaction of a standby, or 0 if the standby
+// replayed everything it received or the server is not a standby.
+const replicationLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// replicationLag returns the replication lag of a PostgreSQL standby.
+func replicationLag(ctx context.Context, db *sqlx.DB) (time.Duration, error) {
+	var seconds float64
+	if err := db.GetContext(ctx, &seconds, replicationLagQuery); err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
@@ -0,0 +1,226 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	defaultReplicaHealthCheckInterval = 10 * time.Second
+)
+
+type (
+	// ReadReplicas routes reads whose context allows replica reads (see persistence.WithReplicaReads) to the
+	// read replicas of a database. Replicas are health checked periodically and reads fall back to the primary
+	// if no replica is healthy or the connection to the replica fails.
+	ReadReplicas struct {
+		replicas            []*readReplica
+		healthCheckInterval time.Duration
+		maxReplicationLag   time.Duration
+		replicationLag      ReplicationLagFn
+		logger              log.Logger
+
+		next     atomic.Uint32
+		stopOnce sync.Once
+		stopCh   chan struct{}
+		doneCh   chan struct{}
+	}
+
+	// ReplicationLagFn returns the replication lag of a replica.
+	ReplicationLagFn func(ctx context.Context, db *sqlx.DB) (time.Duration, error)
+
+	readReplica struct {
+		addr    string
+		handle  *DatabaseHandle
+		healthy atomic.Bool
+	}
+)
+
+// NewReadReplicas creates the replicas of the given config and starts their health checks. Replicas are
+// unhealthy until their first health check passes. It returns nil if cfg is nil.
+func NewReadReplicas(
+	cfg *config.SQLReadReplicas,
+	connect func(addr string) (*sqlx.DB, error),
+	needsRefresh func(error) bool,
+	replicationLag ReplicationLagFn,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
+) *ReadReplicas {
+	if cfg == nil || len(cfg.ConnectAddrs) == 0 {
+		return nil
+	}
+	r := &ReadReplicas{
+		healthCheckInterval: cfg.HealthCheckInterval,
+		maxReplicationLag:   cfg.MaxReplicationLag,
+		replicationLag:      replicationLag,
+		logger:              logger,
+		stopCh:              make(chan struct{}),
+		doneCh:              make(chan struct{}),
+	}
+	if r.healthCheckInterval <= 0 {
+		r.healthCheckInterval = defaultReplicaHealthCheckInterval
+	}
+	for _, addr := range cfg.ConnectAddrs {
+		replicaLogger := log.With(logger, tag.NewStringTag("replica", addr))
+		r.replicas = append(r.replicas, &readReplica{
+			addr: addr,
+			handle: NewDatabaseHandle(
+				func() (*sqlx.DB, error) { return connect(addr) },
+				needsRefresh,
+				replicaLogger,
+				metricsHandler,
+				timeSource,
+			),
+		})
+	}
+	go r.healthCheckLoop()
+	return r
+}
+
+// Read runs read against a healthy replica if the context allows replica reads. It returns false if the read
+// was not served by a replica, either because no replica is usable or because the connection to the replica
+// failed, in which case dest is reset and the caller must read from the primary.
+func (r *ReadReplicas) Read(
+	ctx context.Context,
+	dest any,
+	read func(conn Conn) error,
+) (bool, error) {
+	if r == nil || !persistence.ReplicaReadsAllowed(ctx) {
+		return false, nil
+	}
+	replica := r.pick()
+	if replica == nil {
+		return false, nil
+	}
+	err := read(replica.handle.Conn())
+	if err == DatabaseUnavailableError || replica.handle.isConnectionError(err) {
+		_ = replica.handle.ConvertError(err)
+		r.setHealthy(replica, false, err)
+		resetDest(dest)
+		return false, nil
+	}
+	return true, err
+}
+
+// Close stops the health checks and closes the replica connections.
+func (r *ReadReplicas) Close() {
+	if r == nil {
+		return
+	}
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+		<-r.doneCh
+		for _, replica := range r.replicas {
+			replica.handle.Close()
+		}
+	})
+}
+
+// pick returns the next healthy replica in round robin order, or nil if no replica is healthy.
+func (r *ReadReplicas) pick() *readReplica {
+	start := r.next.Add(1)
+	for i := range r.replicas {
+		replica := r.replicas[(int(start)+i)%len(r.replicas)]
+		if replica.healthy.Load() {
+			return replica
+		}
+	}
+	return nil
+}
+
+func (r *ReadReplicas) healthCheckLoop() {
+	defer close(r.doneCh)
+	ticker := time.NewTicker(r.healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		r.checkHealth()
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *ReadReplicas) checkHealth() {
+	for _, replica := range r.replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), r.healthCheckInterval)
+		err := r.checkReplica(ctx, replica)
+		cancel()
+		r.setHealthy(replica, err == nil, err)
+	}
+}
+
+func (r *ReadReplicas) checkReplica(ctx context.Context, replica *readReplica) error {
+	db, err := replica.handle.DB()
+	if err != nil {
+		return err
+	}
+	if err := db.PingContext(ctx); err != nil {
+		return replica.handle.ConvertError(err)
+	}
+	if r.maxReplicationLag <= 0 || r.replicationLag == nil {
+		return nil
+	}
+	lag, err := r.replicationLag(ctx, db)
+	if err != nil {
+		return replica.handle.ConvertError(err)
+	}
+	if lag > r.maxReplicationLag {
+		return fmt.Errorf("replication lag %v exceeds %v", lag, r.maxReplicationLag)
+	}
+	return nil
+}
+
+func (r *ReadReplicas) setHealthy(replica *readReplica, healthy bool, err error) {
+	if replica.healthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		r.logger.Info("sql read replica is healthy", tag.NewStringTag("replica", replica.addr))
+	} else {
+		r.logger.Warn("sql read replica is unhealthy, reading from primary", tag.NewStringTag("replica", replica.addr), tag.Error(err))
+	}
+}
+
+// resetDest zeroes the value dest points to, so that rows scanned by a failed read are not kept.
+func resetDest(dest any) {
+	if v := reflect.ValueOf(dest); v.Kind() == reflect.Pointer && !v.IsNil() {
+		v.Elem().SetZero()
+	}
+}
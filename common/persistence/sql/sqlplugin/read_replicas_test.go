@@ -0,0 +1,175 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	_ "modernc.org/sqlite"
+)
+
+func newTestReplicas(
+	t *testing.T,
+	addrs []string,
+	maxReplicationLag time.Duration,
+	replicationLag ReplicationLagFn,
+) *ReadReplicas {
+	connect := func(addr string) (*sqlx.DB, error) {
+		db, err := sqlx.Connect("sqlite", "file:"+addr+"?mode=memory&cache=shared")
+		if err != nil {
+			return nil, err
+		}
+		db.SetMaxOpenConns(1)
+		if _, err := db.Exec("CREATE TABLE IF NOT EXISTS source (name TEXT)"); err != nil {
+			return nil, err
+		}
+		if _, err := db.Exec("DELETE FROM source; INSERT INTO source VALUES (?)", addr); err != nil {
+			return nil, err
+		}
+		return db, nil
+	}
+	replicas := NewReadReplicas(
+		&config.SQLReadReplicas{
+			ConnectAddrs:        addrs,
+			HealthCheckInterval: time.Hour,
+			MaxReplicationLag:   maxReplicationLag,
+		},
+		connect,
+		func(error) bool { return false },
+		replicationLag,
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+		clock.NewRealTimeSource(),
+	)
+	t.Cleanup(replicas.Close)
+	return replicas
+}
+
+func waitHealthy(t *testing.T, replicas *ReadReplicas, healthy bool) {
+	require.Eventually(t, func() bool {
+		for _, replica := range replicas.replicas {
+			if replica.healthy.Load() != healthy {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func readSource(ctx context.Context, replicas *ReadReplicas) (string, bool, error) {
+	var name string
+	ok, err := replicas.Read(ctx, &name, func(conn Conn) error {
+		return conn.GetContext(ctx, &name, "SELECT name FROM source")
+	})
+	return name, ok, err
+}
+
+func TestReadReplicas_Nil(t *testing.T) {
+	require.Nil(t, NewReadReplicas(nil, nil, nil, nil, log.NewNoopLogger(), metrics.NoopMetricsHandler, clock.NewRealTimeSource()))
+
+	var replicas *ReadReplicas
+	_, ok, err := readSource(persistence.WithReplicaReads(context.Background()), replicas)
+	require.NoError(t, err)
+	require.False(t, ok)
+	replicas.Close()
+}
+
+func TestReadReplicas_Read(t *testing.T) {
+	replicas := newTestReplicas(t, []string{"replica_read_a", "replica_read_b"}, 0, nil)
+	waitHealthy(t, replicas, true)
+
+	_, ok, err := readSource(context.Background(), replicas)
+	require.NoError(t, err)
+	require.False(t, ok, "reads without replica reads allowed must go to the primary")
+
+	_, ok, err = readSource(persistence.WithoutReplicaReads(persistence.WithReplicaReads(context.Background())), replicas)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ctx := persistence.WithReplicaReads(context.Background())
+	served := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		name, ok, err := readSource(ctx, replicas)
+		require.NoError(t, err)
+		require.True(t, ok)
+		served[name]++
+	}
+	require.Equal(t, map[string]int{"replica_read_a": 2, "replica_read_b": 2}, served)
+}
+
+func TestReadReplicas_ConnectionError(t *testing.T) {
+	replicas := newTestReplicas(t, []string{"replica_conn_error"}, 0, nil)
+	waitHealthy(t, replicas, true)
+
+	ctx := persistence.WithReplicaReads(context.Background())
+	name := "stale"
+	ok, err := replicas.Read(ctx, &name, func(conn Conn) error {
+		return driver.ErrBadConn
+	})
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, name, "dest must be reset before falling back to the primary")
+	require.False(t, replicas.replicas[0].healthy.Load())
+
+	_, ok, err = readSource(ctx, replicas)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestReadReplicas_QueryError(t *testing.T) {
+	replicas := newTestReplicas(t, []string{"replica_query_error"}, 0, nil)
+	waitHealthy(t, replicas, true)
+
+	ctx := persistence.WithReplicaReads(context.Background())
+	queryErr := errors.New("query error")
+	ok, err := replicas.Read(ctx, nil, func(conn Conn) error {
+		return queryErr
+	})
+	require.ErrorIs(t, err, queryErr)
+	require.True(t, ok)
+	require.True(t, replicas.replicas[0].healthy.Load())
+}
+
+func TestReadReplicas_ReplicationLag(t *testing.T) {
+	lagging := func(context.Context, *sqlx.DB) (time.Duration, error) {
+		return time.Minute, nil
+	}
+	replicas := newTestReplicas(t, []string{"replica_lagging"}, time.Second, lagging)
+	replicas.checkHealth()
+	waitHealthy(t, replicas, false)
+
+	_, ok, err := readSource(persistence.WithReplicaReads(context.Background()), replicas)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -29,6 +29,8 @@ package sqlite
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -58,6 +60,13 @@ const (
 
 	listTablesQuery = "SELECT name FROM sqlite_master WHERE type='table'"
 
+	listColumnsQuery = `SELECT m.name AS table_name, p.name AS column_name, ` +
+		`p.type || CASE WHEN p."notnull" THEN ' NOT NULL' ELSE '' END AS column_type ` +
+		`FROM sqlite_master m JOIN pragma_table_info(m.name) p WHERE m.type = 'table' ORDER BY m.name, p.cid`
+
+	listIndexesQuery = `SELECT tbl_name AS table_name, name AS index_name, COALESCE(sql, '') AS definition ` +
+		`FROM sqlite_master WHERE type = 'index' ORDER BY tbl_name, name`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -100,6 +109,20 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns the columns of all tables in this database
+func (mdb *db) ListColumns(database string) ([]sqlplugin.ColumnRow, error) {
+	var columns []sqlplugin.ColumnRow
+	err := mdb.db.Select(&columns, listColumnsQuery)
+	return columns, err
+}
+
+// ListIndexes returns the indexes of all tables in this database
+func (mdb *db) ListIndexes(database string) ([]sqlplugin.IndexRow, error) {
+	var indexes []sqlplugin.IndexRow
+	err := mdb.db.Select(&indexes, listIndexesQuery)
+	return indexes, err
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
@@ -144,6 +144,41 @@ func (s *historyExecutionSuite) TestInsertSelect() {
 	s.Equal(&execution, row)
 }
 
+func (s *historyExecutionSuite) TestInsertRangeSelect() {
+	shardID := rand.Int31()
+	pageSize := 2
+	numExecutions := 5
+
+	var executions []sqlplugin.ExecutionsRow
+	for i := 0; i < numExecutions; i++ {
+		execution := s.newRandomExecutionRow(shardID, primitives.NewUUID(), shuffle.String(testHistoryExecutionWorkflowID), primitives.NewUUID(), rand.Int63(), rand.Int63())
+		_, err := s.store.InsertIntoExecutions(newExecutionContext(), &execution)
+		s.NoError(err)
+		executions = append(executions, execution)
+	}
+
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID:        shardID,
+		MinNamespaceID: primitives.UUID{},
+		MinRunID:       primitives.UUID{},
+		PageSize:       pageSize,
+	}
+	var rows []sqlplugin.ExecutionsRow
+	for {
+		page, err := s.store.RangeSelectFromExecutions(newExecutionContext(), filter)
+		s.NoError(err)
+		rows = append(rows, page...)
+		if len(page) < pageSize {
+			break
+		}
+		lastRow := page[len(page)-1]
+		filter.MinNamespaceID = lastRow.NamespaceID
+		filter.MinWorkflowID = lastRow.WorkflowID
+		filter.MinRunID = lastRow.RunID
+	}
+	s.ElementsMatch(executions, rows)
+}
+
 func (s *historyExecutionSuite) TestInsertUpdate_Success() {
 	shardID := rand.Int31()
 	namespaceID := primitives.NewUUID()
@@ -209,6 +209,10 @@ func parseCountGroupByGroupValue(fieldName string, value any) (any, error) {
 			)
 		}
 	default:
+		// MySQL driver returns string columns as byte slices.
+		if bytesValue, ok := value.([]byte); ok {
+			return string(bytesValue), nil
+		}
 		return value, nil
 	}
 }
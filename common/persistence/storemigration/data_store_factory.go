@@ -0,0 +1,253 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package storemigration migrates the default data store of a cluster to another data store while the cluster
+// is running. DataStoreFactory shadows every write to the other store, and Migrator backfills the target store,
+// verifies it and cuts history shards over to it one by one.
+package storemigration
+
+import (
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// DataStoreFactory is a data store factory which wraps the factories of the source (default) store and the
+	// target store of a migration. Reads only go to the primary store of a call and writes go to the primary
+	// store first and are then shadowed to the other store. The source store is the primary store of every
+	// store, except for the shard and execution stores of shards which are cut over to the target store.
+	DataStoreFactory struct {
+		source      persistence.DataStoreFactory
+		target      persistence.DataStoreFactory
+		targetStore string
+		shadower    *shadower
+		logger      log.Logger
+
+		router             *shardRouter
+		taskStore          persistence.TaskStore
+		shardStore         persistence.ShardStore
+		metadataStore      persistence.MetadataStore
+		executionStore     persistence.ExecutionStore
+		queue              persistence.Queue
+		queueV2            persistence.QueueV2
+		clusterMDStore     persistence.ClusterMetadataStore
+		nexusEndpointStore persistence.NexusEndpointStore
+	}
+)
+
+var _ persistence.DataStoreFactory = (*DataStoreFactory)(nil)
+
+// NewDataStoreFactory returns a data store factory which migrates the stores of source to the stores of target.
+// targetStore is the name of the target data store in the persistence config.
+func NewDataStoreFactory(
+	source persistence.DataStoreFactory,
+	target persistence.DataStoreFactory,
+	targetStore string,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *DataStoreFactory {
+	return &DataStoreFactory{
+		source:      source,
+		target:      target,
+		targetStore: targetStore,
+		shadower:    newShadower(metricsHandler, logger),
+		logger:      logger,
+	}
+}
+
+// FromDataStoreFactory returns the migration data store factory of factory, unwrapping the factories which wrap
+// it. It returns false if persistence is not configured with a data store migration.
+func FromDataStoreFactory(factory persistence.DataStoreFactory) (*DataStoreFactory, bool) {
+	for {
+		switch f := factory.(type) {
+		case *DataStoreFactory:
+			return f, true
+		case interface {
+			Unwrap() persistence.DataStoreFactory
+		}:
+			factory = f.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+}
+
+// TargetStore returns the name of the data store being migrated to.
+func (f *DataStoreFactory) TargetStore() string {
+	return f.targetStore
+}
+
+func (f *DataStoreFactory) Close() {
+	f.source.Close()
+	f.target.Close()
+}
+
+func (f *DataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
+	if f.taskStore == nil {
+		source, err := f.source.NewTaskStore()
+		if err != nil {
+			return nil, err
+		}
+		target, err := f.target.NewTaskStore()
+		if err != nil {
+			return nil, err
+		}
+		f.taskStore = newTaskStore(source, target, f.shadower)
+	}
+	return f.taskStore, nil
+}
+
+func (f *DataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	if f.shardStore == nil {
+		router, err := f.shardRouter()
+		if err != nil {
+			return nil, err
+		}
+		target, err := f.target.NewShardStore()
+		if err != nil {
+			return nil, err
+		}
+		f.shardStore = newShardStore(router.sourceShardStore, target, router, f.shadower)
+	}
+	return f.shardStore, nil
+}
+
+func (f *DataStoreFactory) NewMetadataStore() (persistence.MetadataStore, error) {
+	if f.metadataStore == nil {
+		source, err := f.source.NewMetadataStore()
+		if err != nil {
+			return nil, err
+		}
+		target, err := f.target.NewMetadataStore()
+		if err != nil {
+			return nil, err
+		}
+		f.metadataStore = newMetadataStore(source, target, f.shadower)
+	}
+	return f.metadataStore, nil
+}
+
+func (f *DataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	if f.executionStore == nil {
+		router, err := f.shardRouter()
+		if err != nil {
+			return nil, err
+		}
+		source, err := f.source.NewExecutionStore()
+		if err != nil {
+			return nil, err
+		}
+		target, err := f.target.NewExecutionStore()
+		if err != nil {
+			return nil, err
+		}
+		f.executionStore = newExecutionStore(source, target, router, f.shadower)
+	}
+	return f.executionStore, nil
+}
+
+func (f *DataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
+	if f.queue == nil {
+		source, err := f.source.NewQueue(queueType)
+		if err != nil {
+			return nil, err
+		}
+		target, err := f.target.NewQueue(queueType)
+		if err != nil {
+			return nil, err
+		}
+		f.queue = newQueue(source, target, f.shadower)
+	}
+	return f.queue, nil
+}
+
+func (f *DataStoreFactory) NewQueueV2() (persistence.QueueV2, error) {
+	if f.queueV2 == nil {
+		source, err := f.source.NewQueueV2()
+		if err != nil {
+			return nil, err
+		}
+		target, err := f.target.NewQueueV2()
+		if err != nil {
+			return nil, err
+		}
+		f.queueV2 = newQueueV2(source, target, f.shadower)
+	}
+	return f.queueV2, nil
+}
+
+func (f *DataStoreFactory) NewClusterMetadataStore() (persistence.ClusterMetadataStore, error) {
+	if f.clusterMDStore == nil {
+		source, err := f.source.NewClusterMetadataStore()
+		if err != nil {
+			return nil, err
+		}
+		target, err := f.target.NewClusterMetadataStore()
+		if err != nil {
+			return nil, err
+		}
+		f.clusterMDStore = newClusterMetadataStore(source, target, f.shadower)
+	}
+	return f.clusterMDStore, nil
+}
+
+func (f *DataStoreFactory) NewNexusEndpointStore() (persistence.NexusEndpointStore, error) {
+	if f.nexusEndpointStore == nil {
+		source, err := f.source.NewNexusEndpointStore()
+		if err != nil {
+			return nil, err
+		}
+		target, err := f.target.NewNexusEndpointStore()
+		if err != nil {
+			return nil, err
+		}
+		f.nexusEndpointStore = newNexusEndpointStore(source, target, f.shadower)
+	}
+	return f.nexusEndpointStore, nil
+}
+
+// NewMigrator returns a migrator which copies data from the source store to the target store of the factory.
+// The migrator uses stores of its own, so its writes are not shadowed.
+func (f *DataStoreFactory) NewMigrator(taskCategoryRegistry tasks.TaskCategoryRegistry) (*Migrator, error) {
+	source, err := newStores(f.source)
+	if err != nil {
+		return nil, err
+	}
+	target, err := newStores(f.target)
+	if err != nil {
+		return nil, err
+	}
+	return newMigrator(source, target, f.targetStore, taskCategoryRegistry, f.logger), nil
+}
+
+func (f *DataStoreFactory) shardRouter() (*shardRouter, error) {
+	if f.router == nil {
+		sourceShardStore, err := f.source.NewShardStore()
+		if err != nil {
+			return nil, err
+		}
+		f.router = newShardRouter(sourceShardStore, f.targetStore)
+	}
+	return f.router, nil
+}
@@ -0,0 +1,403 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// executionStore routes each call to the primary store of its shard.
+	executionStore struct {
+		source   persistence.ExecutionStore
+		target   persistence.ExecutionStore
+		router   *shardRouter
+		shadower *shadower
+	}
+)
+
+func newExecutionStore(
+	source persistence.ExecutionStore,
+	target persistence.ExecutionStore,
+	router *shardRouter,
+	shadower *shadower,
+) *executionStore {
+	return &executionStore{
+		source:   source,
+		target:   target,
+		router:   router,
+		shadower: shadower,
+	}
+}
+
+func (s *executionStore) Close() {
+	s.source.Close()
+	s.target.Close()
+}
+
+func (s *executionStore) GetName() string {
+	return s.source.GetName()
+}
+
+func (s *executionStore) GetHistoryBranchUtil() persistence.HistoryBranchUtil {
+	return s.source.GetHistoryBranchUtil()
+}
+
+func (s *executionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalCreateWorkflowExecutionRequest,
+) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return nil, err
+	}
+	return shadow1(s.shadower, "CreateWorkflowExecution", func() (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+		return primary.CreateWorkflowExecution(ctx, request)
+	}, func() error {
+		_, err := secondary.CreateWorkflowExecution(ctx, request)
+		return err
+	})
+}
+
+func (s *executionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "UpdateWorkflowExecution", func() error {
+		return primary.UpdateWorkflowExecution(ctx, request)
+	}, func() error {
+		return secondary.UpdateWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *executionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "ConflictResolveWorkflowExecution", func() error {
+		return primary.ConflictResolveWorkflowExecution(ctx, request)
+	}, func() error {
+		return secondary.ConflictResolveWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *executionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteWorkflowExecutionRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "DeleteWorkflowExecution", func() error {
+		return primary.DeleteWorkflowExecution(ctx, request)
+	}, func() error {
+		return secondary.DeleteWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *executionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "DeleteCurrentWorkflowExecution", func() error {
+		return primary.DeleteCurrentWorkflowExecution(ctx, request)
+	}, func() error {
+		return secondary.DeleteCurrentWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *executionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.InternalGetCurrentExecutionResponse, error) {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return nil, err
+	}
+	return primary.GetCurrentExecution(ctx, request)
+}
+
+func (s *executionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return nil, err
+	}
+	return primary.GetWorkflowExecution(ctx, request)
+}
+
+func (s *executionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalSetWorkflowExecutionRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "SetWorkflowExecution", func() error {
+		return primary.SetWorkflowExecution(ctx, request)
+	}, func() error {
+		return secondary.SetWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *executionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.InternalListConcreteExecutionsResponse, error) {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return nil, err
+	}
+	return primary.ListConcreteExecutions(ctx, request)
+}
+
+func (s *executionStore) AddHistoryTasks(
+	ctx context.Context,
+	request *persistence.InternalAddHistoryTasksRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "AddHistoryTasks", func() error {
+		return primary.AddHistoryTasks(ctx, request)
+	}, func() error {
+		return secondary.AddHistoryTasks(ctx, request)
+	})
+}
+
+func (s *executionStore) GetHistoryTasks(
+	ctx context.Context,
+	request *persistence.GetHistoryTasksRequest,
+) (*persistence.InternalGetHistoryTasksResponse, error) {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return nil, err
+	}
+	return primary.GetHistoryTasks(ctx, request)
+}
+
+func (s *executionStore) CompleteHistoryTask(
+	ctx context.Context,
+	request *persistence.CompleteHistoryTaskRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "CompleteHistoryTask", func() error {
+		return primary.CompleteHistoryTask(ctx, request)
+	}, func() error {
+		return secondary.CompleteHistoryTask(ctx, request)
+	})
+}
+
+func (s *executionStore) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *persistence.RangeCompleteHistoryTasksRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "RangeCompleteHistoryTasks", func() error {
+		return primary.RangeCompleteHistoryTasks(ctx, request)
+	}, func() error {
+		return secondary.RangeCompleteHistoryTasks(ctx, request)
+	})
+}
+
+func (s *executionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *persistence.PutReplicationTaskToDLQRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "PutReplicationTaskToDLQ", func() error {
+		return primary.PutReplicationTaskToDLQ(ctx, request)
+	}, func() error {
+		return secondary.PutReplicationTaskToDLQ(ctx, request)
+	})
+}
+
+func (s *executionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (*persistence.InternalGetReplicationTasksFromDLQResponse, error) {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return nil, err
+	}
+	return primary.GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (s *executionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.DeleteReplicationTaskFromDLQRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "DeleteReplicationTaskFromDLQ", func() error {
+		return primary.DeleteReplicationTaskFromDLQ(ctx, request)
+	}, func() error {
+		return secondary.DeleteReplicationTaskFromDLQ(ctx, request)
+	})
+}
+
+func (s *executionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "RangeDeleteReplicationTaskFromDLQ", func() error {
+		return primary.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	}, func() error {
+		return secondary.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	})
+}
+
+func (s *executionStore) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return false, err
+	}
+	return primary.IsReplicationDLQEmpty(ctx, request)
+}
+
+func (s *executionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "AppendHistoryNodes", func() error {
+		return primary.AppendHistoryNodes(ctx, request)
+	}, func() error {
+		return secondary.AppendHistoryNodes(ctx, request)
+	})
+}
+
+func (s *executionStore) DeleteHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryNodesRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "DeleteHistoryNodes", func() error {
+		return primary.DeleteHistoryNodes(ctx, request)
+	}, func() error {
+		return secondary.DeleteHistoryNodes(ctx, request)
+	})
+}
+
+func (s *executionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return nil, err
+	}
+	return primary.ReadHistoryBranch(ctx, request)
+}
+
+func (s *executionStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalForkHistoryBranchRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "ForkHistoryBranch", func() error {
+		return primary.ForkHistoryBranch(ctx, request)
+	}, func() error {
+		return secondary.ForkHistoryBranch(ctx, request)
+	})
+}
+
+func (s *executionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryBranchRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "DeleteHistoryBranch", func() error {
+		return primary.DeleteHistoryBranch(ctx, request)
+	}, func() error {
+		return secondary.DeleteHistoryBranch(ctx, request)
+	})
+}
+
+func (s *executionStore) GetHistoryTreeContainingBranch(
+	ctx context.Context,
+	request *persistence.InternalGetHistoryTreeContainingBranchRequest,
+) (*persistence.InternalGetHistoryTreeContainingBranchResponse, error) {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return nil, err
+	}
+	return primary.GetHistoryTreeContainingBranch(ctx, request)
+}
+
+// GetAllHistoryTreeBranches reads from the source store, which has the branches of every shard.
+func (s *executionStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *persistence.GetAllHistoryTreeBranchesRequest,
+) (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
+	return s.source.GetAllHistoryTreeBranches(ctx, request)
+}
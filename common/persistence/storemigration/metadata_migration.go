@@ -0,0 +1,503 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration
+
+import (
+	"context"
+	"math"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	metadataPageSize = 100
+)
+
+// VerifyMetadata compares cluster metadata, namespaces, Nexus endpoints, task queues with their backlog and
+// task queue user data with the target store, and copies the ones which are missing or different in the target
+// store if repair is true. It returns the number of mismatches.
+func (m *Migrator) VerifyMetadata(ctx context.Context, repair bool) (int, error) {
+	mismatches := 0
+	for _, verify := range []func(context.Context, bool) (int, error){
+		m.verifyClusterMetadata,
+		m.verifyNamespaces,
+		m.verifyNexusEndpoints,
+		m.verifyTaskQueues,
+		m.verifyTaskQueueUserData,
+	} {
+		n, err := verify(ctx, repair)
+		mismatches += n
+		if err != nil {
+			return mismatches, err
+		}
+	}
+	return mismatches, nil
+}
+
+func (m *Migrator) verifyClusterMetadata(ctx context.Context, repair bool) (int, error) {
+	mismatches := 0
+	var pageToken []byte
+	for {
+		resp, err := m.source.clusterMetadata.ListClusterMetadata(ctx, &persistence.InternalListClusterMetadataRequest{
+			PageSize:      metadataPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return mismatches, err
+		}
+		for _, source := range resp.ClusterMetadata {
+			clusterMetadata, err := m.serializer.DeserializeClusterMetadata(source.ClusterMetadata)
+			if err != nil {
+				return mismatches, err
+			}
+			target, err := m.target.clusterMetadata.GetClusterMetadata(ctx, &persistence.InternalGetClusterMetadataRequest{
+				ClusterName: clusterMetadata.GetClusterName(),
+			})
+			switch err.(type) {
+			case nil:
+				if blobEqual(source.ClusterMetadata, target.ClusterMetadata) {
+					continue
+				}
+			case *serviceerror.NotFound:
+			default:
+				return mismatches, err
+			}
+			mismatches++
+			if !repair {
+				continue
+			}
+			if err := saveClusterMetadata(ctx, m.target.clusterMetadata, &persistence.InternalSaveClusterMetadataRequest{
+				ClusterName:     clusterMetadata.GetClusterName(),
+				ClusterMetadata: source.ClusterMetadata,
+			}); err != nil {
+				return mismatches, err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return mismatches, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (m *Migrator) verifyNamespaces(ctx context.Context, repair bool) (int, error) {
+	mismatches := 0
+	err := m.listNamespaces(ctx, func(source *persistence.InternalGetNamespaceResponse, detail *persistencespb.NamespaceDetail) error {
+		var targetName string
+		target, err := m.target.metadata.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: detail.GetInfo().GetId()})
+		switch err.(type) {
+		case nil:
+			if target.IsGlobal == source.IsGlobal && blobEqual(target.Namespace, source.Namespace) {
+				return nil
+			}
+			targetDetail, err := m.serializer.NamespaceDetailFromBlob(target.Namespace)
+			if err != nil {
+				return err
+			}
+			targetName = targetDetail.GetInfo().GetName()
+		case *serviceerror.NamespaceNotFound:
+		default:
+			return err
+		}
+		mismatches++
+		if !repair {
+			return nil
+		}
+
+		request := &persistence.InternalUpdateNamespaceRequest{
+			Id:        detail.GetInfo().GetId(),
+			Name:      detail.GetInfo().GetName(),
+			Namespace: source.Namespace,
+			IsGlobal:  source.IsGlobal,
+		}
+		switch targetName {
+		case "":
+			_, err = m.target.metadata.CreateNamespace(ctx, &persistence.InternalCreateNamespaceRequest{
+				ID:        request.Id,
+				Name:      request.Name,
+				Namespace: request.Namespace,
+				IsGlobal:  request.IsGlobal,
+			})
+			return err
+		case request.Name:
+			return updateNamespace(ctx, m.target.metadata, request)
+		default:
+			metadata, err := m.target.metadata.GetMetadata(ctx)
+			if err != nil {
+				return err
+			}
+			request.NotificationVersion = metadata.NotificationVersion
+			return m.target.metadata.RenameNamespace(ctx, &persistence.InternalRenameNamespaceRequest{
+				InternalUpdateNamespaceRequest: request,
+				PreviousName:                   targetName,
+			})
+		}
+	})
+	return mismatches, err
+}
+
+func (m *Migrator) verifyNexusEndpoints(ctx context.Context, repair bool) (int, error) {
+	mismatches := 0
+	var pageToken []byte
+	for {
+		resp, err := m.source.nexusEndpoint.ListNexusEndpoints(ctx, &persistence.ListNexusEndpointsRequest{
+			NextPageToken: pageToken,
+			PageSize:      metadataPageSize,
+		})
+		if err != nil {
+			return mismatches, err
+		}
+		for _, source := range resp.Endpoints {
+			target, err := m.target.nexusEndpoint.GetNexusEndpoint(ctx, &persistence.GetNexusEndpointRequest{ID: source.ID})
+			switch err.(type) {
+			case nil:
+				if blobEqual(source.Data, target.Data) {
+					continue
+				}
+			case *serviceerror.NotFound:
+			default:
+				return mismatches, err
+			}
+			mismatches++
+			if !repair {
+				continue
+			}
+			if err := saveNexusEndpoint(ctx, m.target.nexusEndpoint, source.ID, source.Data); err != nil {
+				return mismatches, err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return mismatches, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (m *Migrator) verifyTaskQueues(ctx context.Context, repair bool) (int, error) {
+	mismatches := 0
+	var pageToken []byte
+	for {
+		resp, err := m.source.task.ListTaskQueue(ctx, &persistence.ListTaskQueueRequest{
+			PageSize:  metadataPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return mismatches, err
+		}
+		for _, item := range resp.Items {
+			n, err := m.verifyTaskQueue(ctx, item, repair)
+			mismatches += n
+			if err != nil {
+				return mismatches, err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return mismatches, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// verifyTaskQueue verifies a task queue and the tasks of its backlog. It returns the number of mismatches.
+func (m *Migrator) verifyTaskQueue(
+	ctx context.Context,
+	item *persistence.InternalListTaskQueueItem,
+	repair bool,
+) (int, error) {
+	info, err := m.serializer.TaskQueueInfoFromBlob(item.TaskQueue)
+	if err != nil {
+		return 0, err
+	}
+	mismatches := 0
+	target, err := m.target.task.GetTaskQueue(ctx, &persistence.InternalGetTaskQueueRequest{
+		NamespaceID: info.GetNamespaceId(),
+		TaskQueue:   info.GetName(),
+		TaskType:    info.GetTaskType(),
+	})
+	switch err.(type) {
+	case nil:
+		if target.RangeID != item.RangeID || !blobEqual(target.TaskQueueInfo, item.TaskQueue) {
+			mismatches++
+			if repair {
+				_, err = m.target.task.UpdateTaskQueue(ctx, &persistence.InternalUpdateTaskQueueRequest{
+					NamespaceID:   info.GetNamespaceId(),
+					TaskQueue:     info.GetName(),
+					TaskType:      info.GetTaskType(),
+					RangeID:       item.RangeID,
+					TaskQueueInfo: item.TaskQueue,
+					TaskQueueKind: info.GetKind(),
+					ExpiryTime:    info.GetExpiryTime(),
+					PrevRangeID:   target.RangeID,
+				})
+			}
+		}
+	case *serviceerror.NotFound:
+		mismatches++
+		if repair {
+			err = m.target.task.CreateTaskQueue(ctx, &persistence.InternalCreateTaskQueueRequest{
+				NamespaceID:   info.GetNamespaceId(),
+				TaskQueue:     info.GetName(),
+				TaskType:      info.GetTaskType(),
+				RangeID:       item.RangeID,
+				TaskQueueInfo: item.TaskQueue,
+				TaskQueueKind: info.GetKind(),
+				ExpiryTime:    info.GetExpiryTime(),
+			})
+		} else {
+			err = nil
+		}
+	}
+	if err != nil {
+		return mismatches, err
+	}
+
+	var pageToken []byte
+	for {
+		resp, err := m.source.task.GetTasks(ctx, &persistence.GetTasksRequest{
+			NamespaceID:        info.GetNamespaceId(),
+			TaskQueue:          info.GetName(),
+			TaskType:           info.GetTaskType(),
+			InclusiveMinTaskID: info.GetAckLevel() + 1,
+			ExclusiveMaxTaskID: math.MaxInt64,
+			PageSize:           metadataPageSize,
+			NextPageToken:      pageToken,
+		})
+		if err != nil {
+			return mismatches, err
+		}
+		missing, err := m.missingTasks(ctx, info, resp.Tasks)
+		if err != nil {
+			return mismatches, err
+		}
+		mismatches += len(missing)
+		if repair && len(missing) > 0 {
+			if _, err := m.target.task.CreateTasks(ctx, &persistence.InternalCreateTasksRequest{
+				NamespaceID:   info.GetNamespaceId(),
+				TaskQueue:     info.GetName(),
+				TaskType:      info.GetTaskType(),
+				RangeID:       item.RangeID,
+				TaskQueueInfo: item.TaskQueue,
+				Tasks:         missing,
+			}); err != nil {
+				return mismatches, err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return mismatches, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// missingTasks returns the tasks of a page of source tasks of a task queue which are missing in the target
+// store.
+func (m *Migrator) missingTasks(
+	ctx context.Context,
+	info *persistencespb.TaskQueueInfo,
+	sourceTasks []*commonpb.DataBlob,
+) ([]*persistence.InternalCreateTask, error) {
+	if len(sourceTasks) == 0 {
+		return nil, nil
+	}
+	tasks := make([]*persistencespb.AllocatedTaskInfo, 0, len(sourceTasks))
+	for _, blob := range sourceTasks {
+		task, err := m.serializer.TaskInfoFromBlob(blob)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	targetTaskIDs := make(map[int64]struct{}, len(tasks))
+	var pageToken []byte
+	for {
+		resp, err := m.target.task.GetTasks(ctx, &persistence.GetTasksRequest{
+			NamespaceID:        info.GetNamespaceId(),
+			TaskQueue:          info.GetName(),
+			TaskType:           info.GetTaskType(),
+			InclusiveMinTaskID: tasks[0].GetTaskId(),
+			ExclusiveMaxTaskID: tasks[len(tasks)-1].GetTaskId() + 1,
+			PageSize:           metadataPageSize,
+			NextPageToken:      pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range resp.Tasks {
+			task, err := m.serializer.TaskInfoFromBlob(blob)
+			if err != nil {
+				return nil, err
+			}
+			targetTaskIDs[task.GetTaskId()] = struct{}{}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	var missing []*persistence.InternalCreateTask
+	for i, task := range tasks {
+		if _, ok := targetTaskIDs[task.GetTaskId()]; ok {
+			continue
+		}
+		missing = append(missing, &persistence.InternalCreateTask{
+			TaskId:     task.GetTaskId(),
+			ExpiryTime: task.GetData().GetExpiryTime(),
+			Task:       sourceTasks[i],
+		})
+	}
+	return missing, nil
+}
+
+func (m *Migrator) verifyTaskQueueUserData(ctx context.Context, repair bool) (int, error) {
+	mismatches := 0
+	err := m.listNamespaces(ctx, func(_ *persistence.InternalGetNamespaceResponse, detail *persistencespb.NamespaceDetail) error {
+		namespaceID := detail.GetInfo().GetId()
+		var pageToken []byte
+		for {
+			resp, err := m.source.task.ListTaskQueueUserDataEntries(ctx, &persistence.ListTaskQueueUserDataEntriesRequest{
+				NamespaceID:   namespaceID,
+				PageSize:      metadataPageSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+			for _, entry := range resp.Entries {
+				var targetData *commonpb.DataBlob
+				target, err := m.target.task.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+					NamespaceID: namespaceID,
+					TaskQueue:   entry.TaskQueue,
+				})
+				switch err.(type) {
+				case nil:
+					if blobEqual(entry.Data, target.UserData) {
+						continue
+					}
+					targetData = target.UserData
+				case *serviceerror.NotFound:
+				default:
+					return err
+				}
+				mismatches++
+				if !repair {
+					continue
+				}
+				added, removed, err := m.buildIDDeltas(targetData, entry.Data)
+				if err != nil {
+					return err
+				}
+				if err := saveTaskQueueUserData(ctx, m.target.task, &persistence.InternalUpdateTaskQueueUserDataRequest{
+					NamespaceID:     namespaceID,
+					TaskQueue:       entry.TaskQueue,
+					UserData:        entry.Data,
+					BuildIdsAdded:   added,
+					BuildIdsRemoved: removed,
+				}); err != nil {
+					return err
+				}
+			}
+			if len(resp.NextPageToken) == 0 {
+				return nil
+			}
+			pageToken = resp.NextPageToken
+		}
+	})
+	return mismatches, err
+}
+
+// buildIDDeltas returns the active build IDs which are added and removed by replacing the user data prev, which
+// can be nil, with the user data curr. Stores keep an index of the task queues of each build ID.
+func (m *Migrator) buildIDDeltas(prev *commonpb.DataBlob, curr *commonpb.DataBlob) ([]string, []string, error) {
+	prevBuildIDs := make(map[string]struct{})
+	if prev != nil {
+		userData, err := m.serializer.TaskQueueUserDataFromBlob(prev)
+		if err != nil {
+			return nil, nil, err
+		}
+		prevBuildIDs = activeBuildIDs(userData)
+	}
+	userData, err := m.serializer.TaskQueueUserDataFromBlob(curr)
+	if err != nil {
+		return nil, nil, err
+	}
+	currBuildIDs := activeBuildIDs(userData)
+
+	var added, removed []string
+	for buildID := range currBuildIDs {
+		if _, ok := prevBuildIDs[buildID]; !ok {
+			added = append(added, buildID)
+		}
+	}
+	for buildID := range prevBuildIDs {
+		if _, ok := currBuildIDs[buildID]; !ok {
+			removed = append(removed, buildID)
+		}
+	}
+	return added, removed, nil
+}
+
+func activeBuildIDs(userData *persistencespb.TaskQueueUserData) map[string]struct{} {
+	buildIDs := make(map[string]struct{})
+	for _, set := range userData.GetVersioningData().GetVersionSets() {
+		for _, buildID := range set.GetBuildIds() {
+			if buildID.GetState() == persistencespb.STATE_ACTIVE {
+				buildIDs[buildID.GetId()] = struct{}{}
+			}
+		}
+	}
+	return buildIDs
+}
+
+func (m *Migrator) listNamespaces(
+	ctx context.Context,
+	fn func(*persistence.InternalGetNamespaceResponse, *persistencespb.NamespaceDetail) error,
+) error {
+	var pageToken []byte
+	for {
+		resp, err := m.source.metadata.ListNamespaces(ctx, &persistence.InternalListNamespacesRequest{
+			PageSize:      metadataPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, ns := range resp.Namespaces {
+			detail, err := m.serializer.NamespaceDetailFromBlob(ns.Namespace)
+			if err != nil {
+				return err
+			}
+			if err := fn(ns, detail); err != nil {
+				return err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
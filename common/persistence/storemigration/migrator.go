@@ -0,0 +1,277 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// Migrator copies data from the source store to the target store of a migration, verifies that both stores
+	// have the same data and cuts history shards over to the target store.
+	//
+	// Copies are idempotent and every verification repairs the data it finds missing or different in the target
+	// store, so all operations can be retried. Shards must be cut over one at a time: BeginCutover fences the
+	// shard owner off, the shard is verified once more while no one writes to it, and then CompleteCutover makes
+	// the target store its primary store.
+	//
+	// Namespace replication queues and history task DLQs are shadowed but not copied, so the target store only
+	// has their messages which were written after the migration started.
+	Migrator struct {
+		source               stores
+		target               stores
+		targetStore          string
+		serializer           serialization.Serializer
+		taskCategoryRegistry tasks.TaskCategoryRegistry
+		logger               log.Logger
+	}
+
+	stores struct {
+		shard           persistence.ShardStore
+		execution       persistence.ExecutionStore
+		task            persistence.TaskStore
+		metadata        persistence.MetadataStore
+		clusterMetadata persistence.ClusterMetadataStore
+		nexusEndpoint   persistence.NexusEndpointStore
+	}
+)
+
+func newStores(factory persistence.DataStoreFactory) (stores, error) {
+	var s stores
+	var err error
+	if s.shard, err = factory.NewShardStore(); err != nil {
+		return s, err
+	}
+	if s.execution, err = factory.NewExecutionStore(); err != nil {
+		return s, err
+	}
+	if s.task, err = factory.NewTaskStore(); err != nil {
+		return s, err
+	}
+	if s.metadata, err = factory.NewMetadataStore(); err != nil {
+		return s, err
+	}
+	if s.clusterMetadata, err = factory.NewClusterMetadataStore(); err != nil {
+		return s, err
+	}
+	if s.nexusEndpoint, err = factory.NewNexusEndpointStore(); err != nil {
+		return s, err
+	}
+	return s, nil
+}
+
+func newMigrator(
+	source stores,
+	target stores,
+	targetStore string,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	logger log.Logger,
+) *Migrator {
+	return &Migrator{
+		source:               source,
+		target:               target,
+		targetStore:          targetStore,
+		serializer:           serialization.NewSerializer(),
+		taskCategoryRegistry: taskCategoryRegistry,
+		logger:               logger,
+	}
+}
+
+// TargetStore returns the name of the data store being migrated to.
+func (m *Migrator) TargetStore() string {
+	return m.targetStore
+}
+
+// ShardState returns the migration state of a shard.
+func (m *Migrator) ShardState(ctx context.Context, shardID int32) (enumsspb.DataStoreMigrationState, error) {
+	shardInfo, err := m.getShard(ctx, m.source.shard, shardID)
+	if err != nil {
+		return enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, err
+	}
+	return migrationState(shardInfo, m.targetStore), nil
+}
+
+// CopyShard copies the shard row, which has the range ID and the queue states of the shard, to the target store.
+// It must be called before any data of the shard is copied, since the target store rejects writes to shards with
+// a different range ID.
+func (m *Migrator) CopyShard(ctx context.Context, shardID int32) error {
+	shardInfo, err := m.getShard(ctx, m.source.shard, shardID)
+	if err != nil {
+		return err
+	}
+	return m.putShard(ctx, m.target.shard, shardInfo)
+}
+
+// BeginCutover marks the shard as being cut over in both stores and increases its range ID, so the shard owner
+// loses the shard and it can't be acquired again until the cutover is completed or aborted.
+func (m *Migrator) BeginCutover(ctx context.Context, shardID int32) error {
+	shardInfo, err := m.getShard(ctx, m.source.shard, shardID)
+	if err != nil {
+		return err
+	}
+	switch state := migrationState(shardInfo, m.targetStore); state {
+	case enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER:
+		return m.putShard(ctx, m.target.shard, shardInfo)
+	case enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER:
+		return fmt.Errorf("shard %v is already cut over to data store %v", shardID, m.targetStore)
+	}
+	return m.setMigrationState(ctx, shardInfo, enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER, m.source.shard, m.target.shard)
+}
+
+// CompleteCutover makes the target store the primary store of a shard which is being cut over.
+func (m *Migrator) CompleteCutover(ctx context.Context, shardID int32) error {
+	shardInfo, err := m.getShard(ctx, m.source.shard, shardID)
+	if err != nil {
+		return err
+	}
+	switch state := migrationState(shardInfo, m.targetStore); state {
+	case enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER:
+		return nil
+	case enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER:
+	default:
+		return fmt.Errorf("shard %v is not being cut over to data store %v", shardID, m.targetStore)
+	}
+	// the source shard row decides the primary store of the shard, so it is updated last
+	return m.setMigrationState(ctx, shardInfo, enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER, m.target.shard, m.source.shard)
+}
+
+// AbortCutover makes the source store the primary store of a shard which is being cut over again.
+func (m *Migrator) AbortCutover(ctx context.Context, shardID int32) error {
+	shardInfo, err := m.getShard(ctx, m.source.shard, shardID)
+	if err != nil {
+		return err
+	}
+	if state := migrationState(shardInfo, m.targetStore); state != enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER {
+		return fmt.Errorf("shard %v is not being cut over to data store %v", shardID, m.targetStore)
+	}
+	return m.setMigrationState(ctx, shardInfo, enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, m.target.shard, m.source.shard)
+}
+
+// setMigrationState updates the migration state of a shard and increases its range ID in both stores, first
+// updating the store given first.
+func (m *Migrator) setMigrationState(
+	ctx context.Context,
+	shardInfo *persistencespb.ShardInfo,
+	state enumsspb.DataStoreMigrationState,
+	first persistence.ShardStore,
+	second persistence.ShardStore,
+) error {
+	shardInfo.RangeId++
+	if state == enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED {
+		shardInfo.DataStoreMigration = nil
+	} else {
+		shardInfo.DataStoreMigration = &persistencespb.DataStoreMigrationInfo{
+			TargetStore: m.targetStore,
+			State:       state,
+		}
+	}
+	for _, store := range []persistence.ShardStore{first, second} {
+		if err := m.putShard(ctx, store, shardInfo); err != nil {
+			return err
+		}
+	}
+	m.logger.Info("Updated data store migration state of shard.",
+		tag.ShardID(shardInfo.GetShardId()),
+		tag.ShardRangeID(shardInfo.GetRangeId()),
+		tag.NewStringTag("target-store", m.targetStore),
+		tag.NewStringTag("migration-state", state.String()),
+	)
+	return nil
+}
+
+func (m *Migrator) getShard(
+	ctx context.Context,
+	store persistence.ShardStore,
+	shardID int32,
+) (*persistencespb.ShardInfo, error) {
+	resp, err := store.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{
+		ShardID: shardID,
+		CreateShardInfo: func() (int64, *commonpb.DataBlob, error) {
+			return 0, nil, fmt.Errorf("shard %v is not created", shardID)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.serializer.ShardInfoFromBlob(resp.ShardInfo)
+}
+
+// putShard writes a shard row regardless of its range ID in the store.
+func (m *Migrator) putShard(
+	ctx context.Context,
+	store persistence.ShardStore,
+	shardInfo *persistencespb.ShardInfo,
+) error {
+	blob, err := m.serializer.ShardInfoToBlob(shardInfo, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return err
+	}
+	created := false
+	resp, err := store.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{
+		ShardID: shardInfo.GetShardId(),
+		CreateShardInfo: func() (int64, *commonpb.DataBlob, error) {
+			created = true
+			return shardInfo.GetRangeId(), blob, nil
+		},
+	})
+	if err != nil || created {
+		return err
+	}
+	current, err := m.serializer.ShardInfoFromBlob(resp.ShardInfo)
+	if err != nil {
+		return err
+	}
+	return store.UpdateShard(ctx, &persistence.InternalUpdateShardRequest{
+		ShardID:         shardInfo.GetShardId(),
+		RangeID:         shardInfo.GetRangeId(),
+		Owner:           shardInfo.GetOwner(),
+		ShardInfo:       blob,
+		PreviousRangeID: current.GetRangeId(),
+	})
+}
+
+// targetRangeID returns the range ID of a shard in the target store, which conditions every write of executions
+// and tasks of the shard to the target store.
+func (m *Migrator) targetRangeID(ctx context.Context, shardID int32) (int64, error) {
+	shardInfo, err := m.getShard(ctx, m.target.shard, shardID)
+	if err != nil {
+		return 0, err
+	}
+	return shardInfo.GetRangeId(), nil
+}
+
+func blobEqual(a *commonpb.DataBlob, b *commonpb.DataBlob) bool {
+	return a.GetEncodingType() == b.GetEncodingType() && bytes.Equal(a.GetData(), b.GetData())
+}
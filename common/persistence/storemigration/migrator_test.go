@@ -0,0 +1,304 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/sql"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/storemigration"
+	"go.temporal.io/server/common/persistence/tests"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	testClusterName = "migration-test-cluster"
+	testTargetStore = "target"
+	testShardID     = int32(1)
+)
+
+type (
+	migratorSuite struct {
+		suite.Suite
+
+		ctx      context.Context
+		cancel   context.CancelFunc
+		factory  *storemigration.DataStoreFactory
+		migrator *storemigration.Migrator
+		rangeID  int64
+
+		// managers of the migration factory, whose writes are shadowed to the target store
+		shardManager     persistence.ShardManager
+		executionManager persistence.ExecutionManager
+		// managers of the source and target stores only
+		sourceExecutions persistence.ExecutionManager
+		targetExecutions persistence.ExecutionManager
+		sourceMetadata   persistence.MetadataManager
+		targetMetadata   persistence.MetadataManager
+	}
+
+	testExecution struct {
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+)
+
+func TestMigratorSuite(t *testing.T) {
+	suite.Run(t, new(migratorSuite))
+}
+
+func (s *migratorSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+	logger := log.NewNoopLogger()
+	source := newSQLiteFactory(logger)
+	target := newSQLiteFactory(logger)
+	s.factory = storemigration.NewDataStoreFactory(source, target, testTargetStore, metrics.NoopMetricsHandler, logger)
+
+	var err error
+	s.migrator, err = s.factory.NewMigrator(tasks.NewDefaultTaskCategoryRegistry())
+	s.Require().NoError(err)
+
+	shardStore, err := s.factory.NewShardStore()
+	s.Require().NoError(err)
+	s.shardManager = persistence.NewShardManager(shardStore, serialization.NewSerializer())
+	s.executionManager = s.newExecutionManager(s.factory, logger)
+	s.sourceExecutions = s.newExecutionManager(source, logger)
+	s.targetExecutions = s.newExecutionManager(target, logger)
+	s.sourceMetadata = s.newMetadataManager(source, logger)
+	s.targetMetadata = s.newMetadataManager(target, logger)
+
+	resp, err := s.shardManager.GetOrCreateShard(s.ctx, &persistence.GetOrCreateShardRequest{
+		ShardID:          testShardID,
+		InitialShardInfo: &persistencespb.ShardInfo{ShardId: testShardID, RangeId: 1},
+	})
+	s.Require().NoError(err)
+	s.rangeID = resp.ShardInfo.GetRangeId()
+}
+
+func (s *migratorSuite) TearDownTest() {
+	s.cancel()
+	s.factory.Close()
+}
+
+func (s *migratorSuite) TestVerifyExecutions() {
+	// created before the shard is copied, so the write can't be shadowed to the target store
+	backfilled := s.createWorkflow()
+	s.NoError(s.migrator.CopyShard(s.ctx, testShardID))
+	shadowed := s.createWorkflow()
+
+	page, err := s.migrator.VerifyExecutions(s.ctx, testShardID, 10, nil, false)
+	s.NoError(err)
+	s.Equal(2, page.Executions)
+	s.Equal(1, page.Mismatches)
+	_, err = s.getWorkflow(s.targetExecutions, backfilled)
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	page, err = s.migrator.VerifyExecutions(s.ctx, testShardID, 10, nil, true)
+	s.NoError(err)
+	s.Equal(1, page.Mismatches)
+	page, err = s.migrator.VerifyExecutions(s.ctx, testShardID, 10, nil, false)
+	s.NoError(err)
+	s.Zero(page.Mismatches)
+
+	for _, execution := range []testExecution{backfilled, shadowed} {
+		source, err := s.getWorkflow(s.sourceExecutions, execution)
+		s.NoError(err)
+		target, err := s.getWorkflow(s.targetExecutions, execution)
+		s.NoError(err)
+		s.Equal(source.DBRecordVersion, target.DBRecordVersion)
+		s.Equal(source.State.GetNextEventId(), target.State.GetNextEventId())
+		s.Equal(source.State.GetExecutionState().GetRunId(), target.State.GetExecutionState().GetRunId())
+	}
+
+	missing, err := s.migrator.VerifyHistoryTasks(s.ctx, testShardID, false)
+	s.NoError(err)
+	s.Zero(missing)
+}
+
+func (s *migratorSuite) TestVerifyMetadata() {
+	mismatches, err := s.migrator.VerifyMetadata(s.ctx, false)
+	s.NoError(err)
+	s.Zero(mismatches)
+
+	namespaceID := uuid.NewString()
+	_, err = s.sourceMetadata.CreateNamespace(s.ctx, &persistence.CreateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:    namespaceID,
+				Name:  "test-namespace",
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{ActiveClusterName: testClusterName},
+		},
+	})
+	s.NoError(err)
+
+	mismatches, err = s.migrator.VerifyMetadata(s.ctx, false)
+	s.NoError(err)
+	s.Equal(1, mismatches)
+	mismatches, err = s.migrator.VerifyMetadata(s.ctx, true)
+	s.NoError(err)
+	s.Equal(1, mismatches)
+	mismatches, err = s.migrator.VerifyMetadata(s.ctx, false)
+	s.NoError(err)
+	s.Zero(mismatches)
+
+	resp, err := s.targetMetadata.GetNamespace(s.ctx, &persistence.GetNamespaceRequest{ID: namespaceID})
+	s.NoError(err)
+	s.Equal("test-namespace", resp.Namespace.GetInfo().GetName())
+}
+
+func (s *migratorSuite) TestCutover() {
+	s.NoError(s.migrator.CopyShard(s.ctx, testShardID))
+	state, err := s.migrator.ShardState(s.ctx, testShardID)
+	s.NoError(err)
+	s.Equal(enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, state)
+
+	s.NoError(s.migrator.BeginCutover(s.ctx, testShardID))
+	s.NoError(s.migrator.BeginCutover(s.ctx, testShardID))
+	_, err = s.shardManager.GetOrCreateShard(s.ctx, &persistence.GetOrCreateShardRequest{ShardID: testShardID})
+	s.IsType(&serviceerror.Unavailable{}, err)
+
+	s.NoError(s.migrator.AbortCutover(s.ctx, testShardID))
+	resp, err := s.shardManager.GetOrCreateShard(s.ctx, &persistence.GetOrCreateShardRequest{ShardID: testShardID})
+	s.NoError(err)
+	s.Nil(resp.ShardInfo.GetDataStoreMigration())
+
+	s.NoError(s.migrator.BeginCutover(s.ctx, testShardID))
+	s.NoError(s.migrator.CompleteCutover(s.ctx, testShardID))
+	s.NoError(s.migrator.CompleteCutover(s.ctx, testShardID))
+	s.Error(s.migrator.BeginCutover(s.ctx, testShardID))
+	state, err = s.migrator.ShardState(s.ctx, testShardID)
+	s.NoError(err)
+	s.Equal(enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER, state)
+
+	resp, err = s.shardManager.GetOrCreateShard(s.ctx, &persistence.GetOrCreateShardRequest{ShardID: testShardID})
+	s.NoError(err)
+	s.Equal(enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER, resp.ShardInfo.GetDataStoreMigration().GetState())
+	s.rangeID = resp.ShardInfo.GetRangeId()
+
+	// the target store is the primary store of the shard once it is cut over, and writes to the source
+	// store fail since its shard row is not updated anymore
+	execution := s.createWorkflow()
+	_, err = s.getWorkflow(s.targetExecutions, execution)
+	s.NoError(err)
+}
+
+func (s *migratorSuite) createWorkflow() testExecution {
+	execution := testExecution{
+		namespaceID: uuid.NewString(),
+		workflowID:  uuid.NewString(),
+		runID:       uuid.NewString(),
+	}
+	snapshot, events := tests.RandomSnapshot(
+		execution.namespaceID,
+		execution.workflowID,
+		execution.runID,
+		common.FirstEventID,
+		common.EmptyVersion,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		1,
+		tests.RandomBranchToken(execution.namespaceID, execution.workflowID, execution.runID, &persistence.HistoryBranchUtilImpl{}),
+	)
+	_, err := s.executionManager.CreateWorkflowExecution(s.ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID:             testShardID,
+		RangeID:             s.rangeID,
+		Mode:                persistence.CreateWorkflowModeBrandNew,
+		NewWorkflowSnapshot: *snapshot,
+		NewWorkflowEvents:   events,
+	})
+	s.Require().NoError(err)
+	return execution
+}
+
+func (s *migratorSuite) getWorkflow(
+	executionManager persistence.ExecutionManager,
+	execution testExecution,
+) (*persistence.GetWorkflowExecutionResponse, error) {
+	return executionManager.GetWorkflowExecution(s.ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     testShardID,
+		NamespaceID: execution.namespaceID,
+		WorkflowID:  execution.workflowID,
+		RunID:       execution.runID,
+	})
+}
+
+func (s *migratorSuite) newExecutionManager(
+	factory persistence.DataStoreFactory,
+	logger log.Logger,
+) persistence.ExecutionManager {
+	executionStore, err := factory.NewExecutionStore()
+	s.Require().NoError(err)
+	return persistence.NewExecutionManager(
+		executionStore,
+		serialization.NewSerializer(),
+		nil,
+		logger,
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		dynamicconfig.GetBoolPropertyFn(false),
+	)
+}
+
+func (s *migratorSuite) newMetadataManager(
+	factory persistence.DataStoreFactory,
+	logger log.Logger,
+) persistence.MetadataManager {
+	metadataStore, err := factory.NewMetadataStore()
+	s.Require().NoError(err)
+	return persistence.NewMetadataManagerImpl(metadataStore, serialization.NewSerializer(), logger, testClusterName)
+}
+
+func newSQLiteFactory(logger log.Logger) persistence.DataStoreFactory {
+	return sql.NewFactory(
+		config.SQL{
+			PluginName:         "sqlite",
+			DatabaseName:       uuid.NewString(),
+			ConnectAttributes:  map[string]string{"mode": "memory", "cache": "private"},
+			TaskScanPartitions: 1,
+		},
+		resolver.NewNoopResolver(),
+		testClusterName,
+		logger,
+		metrics.NoopMetricsHandler,
+	)
+}
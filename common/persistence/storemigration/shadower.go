@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration
+
+import (
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	shadowWriteFailureLogRPS = 1
+)
+
+type (
+	// shadower records writes which could not be shadowed to the secondary store. Shadow writes are best effort:
+	// the migrator repairs the target store when it verifies a shard, and shadow writes to the source store of
+	// shards which are cut over only keep it usable for rolling the migration back.
+	shadower struct {
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+)
+
+func newShadower(
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *shadower {
+	return &shadower{
+		metricsHandler: metricsHandler,
+		logger:         log.NewThrottledLogger(logger, func() float64 { return shadowWriteFailureLogRPS }),
+	}
+}
+
+// shadowed records the result of a shadow write.
+func (s *shadower) shadowed(operation string, err error) {
+	if err == nil {
+		return
+	}
+	metrics.PersistenceMigrationShadowWriteFailures.With(s.metricsHandler).Record(1, metrics.OperationTag(operation))
+	s.logger.Warn("Unable to shadow write to the secondary data store.", tag.Operation(operation), tag.Error(err))
+}
+
+func shadow0(s *shadower, operation string, primary func() error, secondary func() error) error {
+	if err := primary(); err != nil {
+		return err
+	}
+	s.shadowed(operation, secondary())
+	return nil
+}
+
+func shadow1[T any](s *shadower, operation string, primary func() (T, error), secondary func() error) (T, error) {
+	result, err := primary()
+	if err != nil {
+		return result, err
+	}
+	s.shadowed(operation, secondary())
+	return result, nil
+}
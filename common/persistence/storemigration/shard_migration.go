@@ -0,0 +1,741 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration
+
+import (
+	"context"
+	"math"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	historyNodePageSize  = 100
+	historyTaskBatchSize = 100
+)
+
+type (
+	// ExecutionsPage is the result of verifying a page of workflow executions of a shard.
+	ExecutionsPage struct {
+		// Executions is the number of executions in the page.
+		Executions int
+		// Mismatches is the number of executions which were missing or different in the target store.
+		Mismatches int
+		// NextPageToken is empty after the last page of the shard.
+		NextPageToken []byte
+	}
+
+	execution struct {
+		shardID        int32
+		namespaceID    string
+		workflowID     string
+		runID          string
+		state          *persistence.InternalWorkflowMutableState
+		info           *persistencespb.WorkflowExecutionInfo
+		executionState *persistencespb.WorkflowExecutionState
+		// isCurrent is true if the execution is the current run of its workflow
+		isCurrent bool
+	}
+
+	// historySegment is the range of history nodes [beginNodeID, endNodeID) of a branch which belongs to a
+	// history branch, either as one of its ancestors or as the branch itself.
+	historySegment struct {
+		branchID    string
+		beginNodeID int64
+		endNodeID   int64
+	}
+
+	historyNodeKey struct {
+		nodeID        int64
+		transactionID int64
+	}
+)
+
+// VerifyExecutions compares a page of workflow executions of a shard with the target store, including their
+// current execution records and history. Executions which are missing or different in the target store are
+// copied again if repair is true, so the first verification of a shard is its backfill.
+func (m *Migrator) VerifyExecutions(
+	ctx context.Context,
+	shardID int32,
+	pageSize int,
+	pageToken []byte,
+	repair bool,
+) (ExecutionsPage, error) {
+	resp, err := m.source.execution.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardID,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return ExecutionsPage{}, err
+	}
+	page := ExecutionsPage{
+		Executions:    len(resp.States),
+		NextPageToken: resp.NextPageToken,
+	}
+	var rangeID int64
+	for _, listed := range resp.States {
+		source, err := m.decodeExecution(shardID, listed)
+		if err != nil {
+			return page, err
+		}
+		// the listed execution may be stale if the shard is in use, so it is read again
+		source, err = m.getExecution(ctx, source)
+		if err != nil {
+			return page, err
+		}
+		if source == nil {
+			// deleted since it was listed
+			continue
+		}
+		equal, err := m.verifyExecution(ctx, source)
+		if err != nil {
+			return page, err
+		}
+		if equal {
+			continue
+		}
+		page.Mismatches++
+		if !repair {
+			continue
+		}
+		if rangeID == 0 {
+			if rangeID, err = m.targetRangeID(ctx, shardID); err != nil {
+				return page, err
+			}
+		}
+		if err := m.copyExecution(ctx, rangeID, source); err != nil {
+			return page, err
+		}
+	}
+	return page, nil
+}
+
+func (m *Migrator) decodeExecution(
+	shardID int32,
+	state *persistence.InternalWorkflowMutableState,
+) (*execution, error) {
+	info, err := m.serializer.WorkflowExecutionInfoFromBlob(state.ExecutionInfo)
+	if err != nil {
+		return nil, err
+	}
+	executionState, err := m.serializer.WorkflowExecutionStateFromBlob(state.ExecutionState)
+	if err != nil {
+		return nil, err
+	}
+	return &execution{
+		shardID:        shardID,
+		namespaceID:    info.GetNamespaceId(),
+		workflowID:     info.GetWorkflowId(),
+		runID:          executionState.GetRunId(),
+		state:          state,
+		info:           info,
+		executionState: executionState,
+	}, nil
+}
+
+// getExecution reads an execution and its current execution record from the source store. It returns nil if
+// the execution doesn't exist.
+func (m *Migrator) getExecution(ctx context.Context, e *execution) (*execution, error) {
+	resp, err := m.source.execution.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     e.shardID,
+		NamespaceID: e.namespaceID,
+		WorkflowID:  e.workflowID,
+		RunID:       e.runID,
+	})
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	source, err := m.decodeExecution(e.shardID, resp.State)
+	if err != nil {
+		return nil, err
+	}
+	current, err := getCurrentExecution(ctx, m.source.execution, source)
+	if err != nil {
+		return nil, err
+	}
+	source.isCurrent = current != nil && current.RunID == source.runID
+	return source, nil
+}
+
+func (m *Migrator) verifyExecution(ctx context.Context, source *execution) (bool, error) {
+	resp, err := m.target.execution.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     source.shardID,
+		NamespaceID: source.namespaceID,
+		WorkflowID:  source.workflowID,
+		RunID:       source.runID,
+	})
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	target, err := m.decodeExecution(source.shardID, resp.State)
+	if err != nil {
+		return false, err
+	}
+	if !mutableStateEqual(source, target) {
+		return false, nil
+	}
+
+	current, err := getCurrentExecution(ctx, m.target.execution, source)
+	if err != nil {
+		return false, err
+	}
+	isCurrent := current != nil && current.RunID == source.runID
+	if isCurrent != source.isCurrent {
+		return false, nil
+	}
+	if isCurrent && (current.ExecutionState.GetState() != source.executionState.GetState() ||
+		current.ExecutionState.GetStatus() != source.executionState.GetStatus()) {
+		return false, nil
+	}
+
+	return m.syncHistory(ctx, source, false)
+}
+
+// copyExecution replaces an execution in the target store with the execution of the source store. Its history
+// is copied first, so the execution never refers to history which is missing in the target store.
+func (m *Migrator) copyExecution(ctx context.Context, rangeID int64, source *execution) error {
+	if _, err := m.syncHistory(ctx, source, true); err != nil {
+		return err
+	}
+
+	if err := m.target.execution.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     source.shardID,
+		NamespaceID: source.namespaceID,
+		WorkflowID:  source.workflowID,
+		RunID:       source.runID,
+	}); err != nil {
+		return err
+	}
+	current, err := getCurrentExecution(ctx, m.target.execution, source)
+	if err != nil {
+		return err
+	}
+	if current != nil && (source.isCurrent || current.RunID == source.runID) {
+		if err := m.target.execution.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+			ShardID:     source.shardID,
+			NamespaceID: source.namespaceID,
+			WorkflowID:  source.workflowID,
+			RunID:       current.RunID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Snapshots can't have buffered events, so they are appended by one update each, which also brings the
+	// record version to the one of the source store.
+	state := source.state
+	dbRecordVersion := state.DBRecordVersion
+	if dbRecordVersion > 0 {
+		dbRecordVersion = max(dbRecordVersion-int64(len(state.BufferedEvents)), 0)
+	}
+	startVersion, lastWriteVersion := executionVersions(source.info)
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIDs))
+	for _, id := range state.SignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	createMode := persistence.CreateWorkflowModeBypassCurrent
+	updateMode := persistence.UpdateWorkflowModeBypassCurrent
+	if source.isCurrent {
+		createMode = persistence.CreateWorkflowModeBrandNew
+		updateMode = persistence.UpdateWorkflowModeUpdateCurrent
+	}
+	if _, err := m.target.execution.CreateWorkflowExecution(ctx, &persistence.InternalCreateWorkflowExecutionRequest{
+		ShardID: source.shardID,
+		RangeID: rangeID,
+		Mode:    createMode,
+		NewWorkflowSnapshot: persistence.InternalWorkflowSnapshot{
+			NamespaceID:         source.namespaceID,
+			WorkflowID:          source.workflowID,
+			RunID:               source.runID,
+			ExecutionInfo:       source.info,
+			ExecutionInfoBlob:   state.ExecutionInfo,
+			ExecutionState:      source.executionState,
+			ExecutionStateBlob:  state.ExecutionState,
+			StartVersion:        startVersion,
+			LastWriteVersion:    lastWriteVersion,
+			NextEventID:         state.NextEventID,
+			DBRecordVersion:     dbRecordVersion,
+			ActivityInfos:       state.ActivityInfos,
+			TimerInfos:          state.TimerInfos,
+			ChildExecutionInfos: state.ChildExecutionInfos,
+			RequestCancelInfos:  state.RequestCancelInfos,
+			SignalInfos:         state.SignalInfos,
+			SignalRequestedIDs:  signalRequestedIDs,
+			Checksum:            state.Checksum,
+		},
+	}); err != nil {
+		return err
+	}
+	for _, bufferedEvents := range state.BufferedEvents {
+		if dbRecordVersion > 0 {
+			dbRecordVersion++
+		}
+		if err := m.target.execution.UpdateWorkflowExecution(ctx, &persistence.InternalUpdateWorkflowExecutionRequest{
+			ShardID: source.shardID,
+			RangeID: rangeID,
+			Mode:    updateMode,
+			UpdateWorkflowMutation: persistence.InternalWorkflowMutation{
+				NamespaceID:        source.namespaceID,
+				WorkflowID:         source.workflowID,
+				RunID:              source.runID,
+				ExecutionInfo:      source.info,
+				ExecutionInfoBlob:  state.ExecutionInfo,
+				ExecutionState:     source.executionState,
+				ExecutionStateBlob: state.ExecutionState,
+				NextEventID:        state.NextEventID,
+				StartVersion:       startVersion,
+				LastWriteVersion:   lastWriteVersion,
+				DBRecordVersion:    dbRecordVersion,
+				NewBufferedEvents:  bufferedEvents,
+				Condition:          state.NextEventID,
+				Checksum:           state.Checksum,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncHistory compares the history of every version history of an execution with the target store, and copies
+// the history tree and the nodes which are missing or different in the target store if repair is true.
+func (m *Migrator) syncHistory(ctx context.Context, source *execution, repair bool) (bool, error) {
+	equal := true
+	synced := make(map[historySegment]struct{})
+	for _, versionHistory := range source.info.GetVersionHistories().GetHistories() {
+		branchToken := versionHistory.GetBranchToken()
+		if len(branchToken) == 0 {
+			continue
+		}
+		branch, err := m.source.execution.GetHistoryBranchUtil().ParseHistoryBranchInfo(branchToken)
+		if err != nil {
+			return false, err
+		}
+		treeEqual, err := m.syncHistoryTree(ctx, source.shardID, branchToken, repair)
+		if err != nil {
+			return false, err
+		}
+		equal = equal && treeEqual
+		for _, segment := range historySegments(branch) {
+			if _, ok := synced[segment]; ok {
+				continue
+			}
+			synced[segment] = struct{}{}
+			segmentEqual, err := m.syncHistoryNodes(ctx, source.shardID, branchToken, branch.GetTreeId(), segment, repair)
+			if err != nil {
+				return false, err
+			}
+			equal = equal && segmentEqual
+		}
+	}
+	return equal, nil
+}
+
+func (m *Migrator) syncHistoryTree(
+	ctx context.Context,
+	shardID int32,
+	branchToken []byte,
+	repair bool,
+) (bool, error) {
+	request := &persistence.InternalGetHistoryTreeContainingBranchRequest{
+		BranchToken: branchToken,
+		ShardID:     shardID,
+	}
+	sourceTree, err := m.source.execution.GetHistoryTreeContainingBranch(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	targetTree, err := m.target.execution.GetHistoryTreeContainingBranch(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	targetBranches := make(map[string]*commonpb.DataBlob, len(targetTree.TreeInfos))
+	for _, blob := range targetTree.TreeInfos {
+		treeInfo, err := m.serializer.HistoryTreeInfoFromBlob(blob)
+		if err != nil {
+			return false, err
+		}
+		targetBranches[treeInfo.GetBranchInfo().GetBranchId()] = blob
+	}
+
+	equal := true
+	for _, blob := range sourceTree.TreeInfos {
+		treeInfo, err := m.serializer.HistoryTreeInfoFromBlob(blob)
+		if err != nil {
+			return false, err
+		}
+		if blobEqual(blob, targetBranches[treeInfo.GetBranchInfo().GetBranchId()]) {
+			continue
+		}
+		equal = false
+		if !repair {
+			continue
+		}
+		// forking only writes the tree row of the new branch, which replaces the existing row if there is one
+		if err := m.target.execution.ForkHistoryBranch(ctx, &persistence.InternalForkHistoryBranchRequest{
+			ForkBranchInfo: &persistencespb.HistoryBranch{TreeId: treeInfo.GetBranchInfo().GetTreeId()},
+			TreeInfo:       blob,
+			NewBranchID:    treeInfo.GetBranchInfo().GetBranchId(),
+			Info:           treeInfo.GetInfo(),
+			ShardID:        shardID,
+		}); err != nil {
+			return false, err
+		}
+	}
+	return equal, nil
+}
+
+func (m *Migrator) syncHistoryNodes(
+	ctx context.Context,
+	shardID int32,
+	branchToken []byte,
+	treeID string,
+	segment historySegment,
+	repair bool,
+) (bool, error) {
+	branchInfo := &persistencespb.HistoryBranch{
+		TreeId:   treeID,
+		BranchId: segment.branchID,
+	}
+	equal := true
+	var pageToken []byte
+	for {
+		sourceNodes, nextPageToken, err := readHistoryNodes(ctx, m.source.execution, shardID, branchToken, segment.branchID, segment.beginNodeID, segment.endNodeID, pageToken)
+		if err != nil {
+			return false, err
+		}
+		if len(sourceNodes) > 0 {
+			targetNodes, err := readAllHistoryNodes(
+				ctx,
+				m.target.execution,
+				shardID,
+				branchToken,
+				segment.branchID,
+				sourceNodes[0].NodeID,
+				sourceNodes[len(sourceNodes)-1].NodeID+1,
+			)
+			if err != nil {
+				return false, err
+			}
+			for _, node := range sourceNodes {
+				targetNode, ok := targetNodes[historyNodeKey{nodeID: node.NodeID, transactionID: node.TransactionID}]
+				if ok && targetNode.PrevTransactionID == node.PrevTransactionID && blobEqual(targetNode.Events, node.Events) {
+					continue
+				}
+				equal = false
+				if !repair {
+					continue
+				}
+				if ok {
+					if err := m.target.execution.DeleteHistoryNodes(ctx, &persistence.InternalDeleteHistoryNodesRequest{
+						BranchToken:   branchToken,
+						ShardID:       shardID,
+						BranchInfo:    branchInfo,
+						NodeID:        node.NodeID,
+						TransactionID: node.TransactionID,
+					}); err != nil {
+						return false, err
+					}
+				}
+				if err := m.target.execution.AppendHistoryNodes(ctx, &persistence.InternalAppendHistoryNodesRequest{
+					BranchToken: branchToken,
+					BranchInfo:  branchInfo,
+					Node:        node,
+					ShardID:     shardID,
+				}); err != nil {
+					return false, err
+				}
+			}
+		}
+		if len(nextPageToken) == 0 {
+			return equal, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// VerifyHistoryTasks checks that every history task of a shard in the source store is in the target store, and
+// copies the missing ones if repair is true. It returns the number of missing tasks.
+func (m *Migrator) VerifyHistoryTasks(ctx context.Context, shardID int32, repair bool) (int, error) {
+	missing := 0
+	var rangeID int64
+	for _, category := range m.taskCategoryRegistry.GetCategories() {
+		minKey, maxKey := tasks.MinimumKey, tasks.MaximumKey
+		if category.Type() == tasks.CategoryTypeImmediate {
+			minKey, maxKey = tasks.NewImmediateKey(0), tasks.NewImmediateKey(math.MaxInt64)
+		}
+		var pageToken []byte
+		for {
+			resp, err := m.source.execution.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+				ShardID:             shardID,
+				TaskCategory:        category,
+				InclusiveMinTaskKey: minKey,
+				ExclusiveMaxTaskKey: maxKey,
+				BatchSize:           historyTaskBatchSize,
+				NextPageToken:       pageToken,
+			})
+			if err != nil {
+				return missing, err
+			}
+			if len(resp.Tasks) > 0 {
+				missingTasks, err := m.missingHistoryTasks(ctx, shardID, category, resp.Tasks)
+				if err != nil {
+					return missing, err
+				}
+				missing += len(missingTasks)
+				if repair && len(missingTasks) > 0 {
+					if rangeID == 0 {
+						if rangeID, err = m.targetRangeID(ctx, shardID); err != nil {
+							return missing, err
+						}
+					}
+					if err := m.target.execution.AddHistoryTasks(ctx, &persistence.InternalAddHistoryTasksRequest{
+						ShardID: shardID,
+						RangeID: rangeID,
+						Tasks:   map[tasks.Category][]persistence.InternalHistoryTask{category: missingTasks},
+					}); err != nil {
+						return missing, err
+					}
+				}
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+	return missing, nil
+}
+
+// missingHistoryTasks returns the tasks of a page of source tasks which are missing or different in the target
+// store.
+func (m *Migrator) missingHistoryTasks(
+	ctx context.Context,
+	shardID int32,
+	category tasks.Category,
+	sourceTasks []persistence.InternalHistoryTask,
+) ([]persistence.InternalHistoryTask, error) {
+	targetTasks := make(map[tasks.Key]*commonpb.DataBlob, len(sourceTasks))
+	var pageToken []byte
+	for {
+		resp, err := m.target.execution.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: sourceTasks[0].Key,
+			ExclusiveMaxTaskKey: sourceTasks[len(sourceTasks)-1].Key.Next(),
+			BatchSize:           historyTaskBatchSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range resp.Tasks {
+			targetTasks[task.Key] = task.Blob
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	var missing []persistence.InternalHistoryTask
+	for _, task := range sourceTasks {
+		if blob, ok := targetTasks[task.Key]; !ok || !blobEqual(blob, task.Blob) {
+			missing = append(missing, task)
+		}
+	}
+	return missing, nil
+}
+
+func getCurrentExecution(
+	ctx context.Context,
+	store persistence.ExecutionStore,
+	e *execution,
+) (*persistence.InternalGetCurrentExecutionResponse, error) {
+	resp, err := store.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     e.shardID,
+		NamespaceID: e.namespaceID,
+		WorkflowID:  e.workflowID,
+	})
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+		return nil, nil
+	}
+	return resp, err
+}
+
+func readHistoryNodes(
+	ctx context.Context,
+	store persistence.ExecutionStore,
+	shardID int32,
+	branchToken []byte,
+	branchID string,
+	minNodeID int64,
+	maxNodeID int64,
+	pageToken []byte,
+) ([]persistence.InternalHistoryNode, []byte, error) {
+	resp, err := store.ReadHistoryBranch(ctx, &persistence.InternalReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		BranchID:      branchID,
+		MinNodeID:     minNodeID,
+		MaxNodeID:     maxNodeID,
+		PageSize:      historyNodePageSize,
+		NextPageToken: pageToken,
+		ShardID:       shardID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Nodes, resp.NextPageToken, nil
+}
+
+func readAllHistoryNodes(
+	ctx context.Context,
+	store persistence.ExecutionStore,
+	shardID int32,
+	branchToken []byte,
+	branchID string,
+	minNodeID int64,
+	maxNodeID int64,
+) (map[historyNodeKey]persistence.InternalHistoryNode, error) {
+	nodes := make(map[historyNodeKey]persistence.InternalHistoryNode)
+	var pageToken []byte
+	for {
+		page, nextPageToken, err := readHistoryNodes(ctx, store, shardID, branchToken, branchID, minNodeID, maxNodeID, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range page {
+			nodes[historyNodeKey{nodeID: node.NodeID, transactionID: node.TransactionID}] = node
+		}
+		if len(nextPageToken) == 0 {
+			return nodes, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// historySegments returns the segments of the ancestors of a branch followed by the segment of the branch.
+func historySegments(branch *persistencespb.HistoryBranch) []historySegment {
+	segments := make([]historySegment, 0, len(branch.GetAncestors())+1)
+	beginNodeID := common.FirstEventID
+	for _, ancestor := range branch.GetAncestors() {
+		segments = append(segments, historySegment{
+			branchID:    ancestor.GetBranchId(),
+			beginNodeID: ancestor.GetBeginNodeId(),
+			endNodeID:   ancestor.GetEndNodeId(),
+		})
+		beginNodeID = ancestor.GetEndNodeId()
+	}
+	return append(segments, historySegment{
+		branchID:    branch.GetBranchId(),
+		beginNodeID: beginNodeID,
+		endNodeID:   common.EndEventID,
+	})
+}
+
+// executionVersions returns the start and last write versions of an execution, which the stores keep in the
+// current execution record.
+func executionVersions(info *persistencespb.WorkflowExecutionInfo) (int64, int64) {
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(info.GetVersionHistories())
+	if err != nil {
+		return common.EmptyVersion, common.EmptyVersion
+	}
+	firstItem, err := versionhistory.GetFirstVersionHistoryItem(versionHistory)
+	if err != nil {
+		return common.EmptyVersion, common.EmptyVersion
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	if err != nil {
+		return common.EmptyVersion, common.EmptyVersion
+	}
+	return firstItem.GetVersion(), lastItem.GetVersion()
+}
+
+func mutableStateEqual(source *execution, target *execution) bool {
+	a, b := source.state, target.state
+	return blobEqual(a.ExecutionInfo, b.ExecutionInfo) &&
+		proto.Equal(source.executionState, target.executionState) &&
+		a.NextEventID == b.NextEventID &&
+		blobMapEqual(a.ActivityInfos, b.ActivityInfos) &&
+		blobMapEqual(a.TimerInfos, b.TimerInfos) &&
+		blobMapEqual(a.ChildExecutionInfos, b.ChildExecutionInfos) &&
+		blobMapEqual(a.RequestCancelInfos, b.RequestCancelInfos) &&
+		blobMapEqual(a.SignalInfos, b.SignalInfos) &&
+		stringSetEqual(a.SignalRequestedIDs, b.SignalRequestedIDs) &&
+		blobSliceEqual(a.BufferedEvents, b.BufferedEvents) &&
+		blobEqual(a.Checksum, b.Checksum)
+}
+
+func blobMapEqual[K comparable](a map[K]*commonpb.DataBlob, b map[K]*commonpb.DataBlob) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, blob := range a {
+		if other, ok := b[key]; !ok || !blobEqual(blob, other) {
+			return false
+		}
+	}
+	return true
+}
+
+func blobSliceEqual(a []*commonpb.DataBlob, b []*commonpb.DataBlob) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !blobEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func stringSetEqual(a []string, b []string) bool {
+	set := make(map[string]struct{}, len(a))
+	for _, s := range a {
+		set[s] = struct{}{}
+	}
+	if len(set) != len(b) {
+		return false
+	}
+	for _, s := range b {
+		if _, ok := set[s]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// shardRouteTTL is how long the store of a shard is cached before it is read again from the source shard
+	// row. Shard owners refresh it whenever they acquire the shard, so the TTL only matters for processes which
+	// access executions of shards they don't own.
+	shardRouteTTL = time.Minute
+)
+
+type (
+	// shardRouter tracks which history shards are cut over to the target store. The migration state of a shard
+	// is stored in its shard info, and the source shard row is the source of truth for it.
+	shardRouter struct {
+		sourceShardStore persistence.ShardStore
+		targetStore      string
+		serializer       serialization.Serializer
+
+		sync.RWMutex
+		routes map[int32]shardRoute
+	}
+
+	shardRoute struct {
+		cutOver bool
+		expiry  time.Time
+	}
+)
+
+func newShardRouter(
+	sourceShardStore persistence.ShardStore,
+	targetStore string,
+) *shardRouter {
+	return &shardRouter{
+		sourceShardStore: sourceShardStore,
+		targetStore:      targetStore,
+		serializer:       serialization.NewSerializer(),
+		routes:           make(map[int32]shardRoute),
+	}
+}
+
+// isCutOver returns true if the target store is the primary store of the shard.
+func (r *shardRouter) isCutOver(ctx context.Context, shardID int32) (bool, error) {
+	r.RLock()
+	route, ok := r.routes[shardID]
+	r.RUnlock()
+	if ok && time.Now().Before(route.expiry) {
+		return route.cutOver, nil
+	}
+
+	notFound := false
+	resp, err := r.sourceShardStore.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{
+		ShardID: shardID,
+		CreateShardInfo: func() (int64, *commonpb.DataBlob, error) {
+			notFound = true
+			return 0, nil, serviceerror.NewNotFound(fmt.Sprintf("shard %v is not created", shardID))
+		},
+	})
+	if notFound {
+		r.update(shardID, false)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	state, err := r.migrationState(resp.ShardInfo)
+	if err != nil {
+		return false, err
+	}
+	cutOver := state == enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER
+	r.update(shardID, cutOver)
+	return cutOver, nil
+}
+
+func (r *shardRouter) update(shardID int32, cutOver bool) {
+	r.Lock()
+	defer r.Unlock()
+	r.routes[shardID] = shardRoute{
+		cutOver: cutOver,
+		expiry:  time.Now().Add(shardRouteTTL),
+	}
+}
+
+// migrationState returns the migration state of a shard to the target store.
+func (r *shardRouter) migrationState(shardInfoBlob *commonpb.DataBlob) (enumsspb.DataStoreMigrationState, error) {
+	shardInfo, err := r.serializer.ShardInfoFromBlob(shardInfoBlob)
+	if err != nil {
+		return enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, err
+	}
+	return migrationState(shardInfo, r.targetStore), nil
+}
+
+func migrationState(shardInfo *persistencespb.ShardInfo, targetStore string) enumsspb.DataStoreMigrationState {
+	migration := shardInfo.GetDataStoreMigration()
+	if migration.GetTargetStore() != targetStore {
+		// the shard was migrated to another store before
+		return enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED
+	}
+	return migration.GetState()
+}
+
+// route returns the primary and secondary store of a shard.
+func route[T any](ctx context.Context, r *shardRouter, shardID int32, source T, target T) (T, T, error) {
+	cutOver, err := r.isCutOver(ctx, shardID)
+	if err != nil {
+		return source, target, err
+	}
+	if cutOver {
+		return target, source, nil
+	}
+	return source, target, nil
+}
@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	shardStore struct {
+		source   persistence.ShardStore
+		target   persistence.ShardStore
+		router   *shardRouter
+		shadower *shadower
+	}
+)
+
+func newShardStore(
+	source persistence.ShardStore,
+	target persistence.ShardStore,
+	router *shardRouter,
+	shadower *shadower,
+) *shardStore {
+	return &shardStore{
+		source:   source,
+		target:   target,
+		router:   router,
+		shadower: shadower,
+	}
+}
+
+func (s *shardStore) Close() {
+	s.source.Close()
+	s.target.Close()
+}
+
+func (s *shardStore) GetName() string {
+	return s.source.GetName()
+}
+
+func (s *shardStore) GetClusterName() string {
+	return s.source.GetClusterName()
+}
+
+// GetOrCreateShard reads the shard from the store it is cut over to. Shards which are being cut over can't be
+// acquired until the cutover is done.
+func (s *shardStore) GetOrCreateShard(
+	ctx context.Context,
+	request *persistence.InternalGetOrCreateShardRequest,
+) (*persistence.InternalGetOrCreateShardResponse, error) {
+	resp, err := s.source.GetOrCreateShard(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.router.migrationState(resp.ShardInfo)
+	if err != nil {
+		return nil, err
+	}
+	switch state {
+	case enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER:
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(
+			"shard %v is being cut over to data store %v", request.ShardID, s.router.targetStore,
+		))
+	case enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER:
+		s.router.update(request.ShardID, true)
+		return s.target.GetOrCreateShard(ctx, request)
+	default:
+		s.router.update(request.ShardID, false)
+		return resp, nil
+	}
+}
+
+func (s *shardStore) UpdateShard(
+	ctx context.Context,
+	request *persistence.InternalUpdateShardRequest,
+) error {
+	primary, secondary, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return shadow0(s.shadower, "UpdateShard", func() error {
+		return primary.UpdateShard(ctx, request)
+	}, func() error {
+		return secondary.UpdateShard(ctx, request)
+	})
+}
+
+func (s *shardStore) AssertShardOwnership(
+	ctx context.Context,
+	request *persistence.AssertShardOwnershipRequest,
+) error {
+	primary, _, err := route(ctx, s.router, request.ShardID, s.source, s.target)
+	if err != nil {
+		return err
+	}
+	return primary.AssertShardOwnership(ctx, request)
+}
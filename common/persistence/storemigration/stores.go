@@ -0,0 +1,625 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storemigration
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/persistence"
+)
+
+// The stores below are not sharded, so the source store is always their primary store. They embed the source
+// store for reads and shadow writes to the target store. Writes which are conditioned on a version use the
+// current version of the target store when they are shadowed, since versions of the two stores diverge.
+
+type (
+	taskStore struct {
+		persistence.TaskStore
+		target   persistence.TaskStore
+		shadower *shadower
+	}
+
+	metadataStore struct {
+		persistence.MetadataStore
+		target   persistence.MetadataStore
+		shadower *shadower
+	}
+
+	clusterMetadataStore struct {
+		persistence.ClusterMetadataStore
+		target   persistence.ClusterMetadataStore
+		shadower *shadower
+	}
+
+	nexusEndpointStore struct {
+		persistence.NexusEndpointStore
+		target   persistence.NexusEndpointStore
+		shadower *shadower
+	}
+
+	queue struct {
+		persistence.Queue
+		target   persistence.Queue
+		shadower *shadower
+	}
+
+	queueV2 struct {
+		persistence.QueueV2
+		target   persistence.QueueV2
+		shadower *shadower
+	}
+)
+
+func newTaskStore(
+	source persistence.TaskStore,
+	target persistence.TaskStore,
+	shadower *shadower,
+) *taskStore {
+	return &taskStore{
+		TaskStore: source,
+		target:    target,
+		shadower:  shadower,
+	}
+}
+
+func (s *taskStore) Close() {
+	s.TaskStore.Close()
+	s.target.Close()
+}
+
+func (s *taskStore) CreateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalCreateTaskQueueRequest,
+) error {
+	return shadow0(s.shadower, "CreateTaskQueue", func() error {
+		return s.TaskStore.CreateTaskQueue(ctx, request)
+	}, func() error {
+		return s.target.CreateTaskQueue(ctx, request)
+	})
+}
+
+func (s *taskStore) UpdateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueRequest,
+) (*persistence.UpdateTaskQueueResponse, error) {
+	return shadow1(s.shadower, "UpdateTaskQueue", func() (*persistence.UpdateTaskQueueResponse, error) {
+		return s.TaskStore.UpdateTaskQueue(ctx, request)
+	}, func() error {
+		_, err := s.target.UpdateTaskQueue(ctx, request)
+		return err
+	})
+}
+
+func (s *taskStore) DeleteTaskQueue(
+	ctx context.Context,
+	request *persistence.DeleteTaskQueueRequest,
+) error {
+	return shadow0(s.shadower, "DeleteTaskQueue", func() error {
+		return s.TaskStore.DeleteTaskQueue(ctx, request)
+	}, func() error {
+		return s.target.DeleteTaskQueue(ctx, request)
+	})
+}
+
+func (s *taskStore) CreateTasks(
+	ctx context.Context,
+	request *persistence.InternalCreateTasksRequest,
+) (*persistence.CreateTasksResponse, error) {
+	return shadow1(s.shadower, "CreateTasks", func() (*persistence.CreateTasksResponse, error) {
+		return s.TaskStore.CreateTasks(ctx, request)
+	}, func() error {
+		_, err := s.target.CreateTasks(ctx, request)
+		return err
+	})
+}
+
+func (s *taskStore) CompleteTasksLessThan(
+	ctx context.Context,
+	request *persistence.CompleteTasksLessThanRequest,
+) (int, error) {
+	return shadow1(s.shadower, "CompleteTasksLessThan", func() (int, error) {
+		return s.TaskStore.CompleteTasksLessThan(ctx, request)
+	}, func() error {
+		_, err := s.target.CompleteTasksLessThan(ctx, request)
+		return err
+	})
+}
+
+func (s *taskStore) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	return shadow0(s.shadower, "UpdateTaskQueueUserData", func() error {
+		return s.TaskStore.UpdateTaskQueueUserData(ctx, request)
+	}, func() error {
+		return saveTaskQueueUserData(ctx, s.target, request)
+	})
+}
+
+func newMetadataStore(
+	source persistence.MetadataStore,
+	target persistence.MetadataStore,
+	shadower *shadower,
+) *metadataStore {
+	return &metadataStore{
+		MetadataStore: source,
+		target:        target,
+		shadower:      shadower,
+	}
+}
+
+func (s *metadataStore) Close() {
+	s.MetadataStore.Close()
+	s.target.Close()
+}
+
+func (s *metadataStore) CreateNamespace(
+	ctx context.Context,
+	request *persistence.InternalCreateNamespaceRequest,
+) (*persistence.CreateNamespaceResponse, error) {
+	return shadow1(s.shadower, "CreateNamespace", func() (*persistence.CreateNamespaceResponse, error) {
+		return s.MetadataStore.CreateNamespace(ctx, request)
+	}, func() error {
+		_, err := s.target.CreateNamespace(ctx, request)
+		return err
+	})
+}
+
+func (s *metadataStore) UpdateNamespace(
+	ctx context.Context,
+	request *persistence.InternalUpdateNamespaceRequest,
+) error {
+	return shadow0(s.shadower, "UpdateNamespace", func() error {
+		return s.MetadataStore.UpdateNamespace(ctx, request)
+	}, func() error {
+		return updateNamespace(ctx, s.target, request)
+	})
+}
+
+func (s *metadataStore) RenameNamespace(
+	ctx context.Context,
+	request *persistence.InternalRenameNamespaceRequest,
+) error {
+	return shadow0(s.shadower, "RenameNamespace", func() error {
+		return s.MetadataStore.RenameNamespace(ctx, request)
+	}, func() error {
+		metadata, err := s.target.GetMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		updateRequest := *request.InternalUpdateNamespaceRequest
+		updateRequest.NotificationVersion = metadata.NotificationVersion
+		return s.target.RenameNamespace(ctx, &persistence.InternalRenameNamespaceRequest{
+			InternalUpdateNamespaceRequest: &updateRequest,
+			PreviousName:                   request.PreviousName,
+		})
+	})
+}
+
+func (s *metadataStore) DeleteNamespace(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceRequest,
+) error {
+	return shadow0(s.shadower, "DeleteNamespace", func() error {
+		return s.MetadataStore.DeleteNamespace(ctx, request)
+	}, func() error {
+		return s.target.DeleteNamespace(ctx, request)
+	})
+}
+
+func (s *metadataStore) DeleteNamespaceByName(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceByNameRequest,
+) error {
+	return shadow0(s.shadower, "DeleteNamespaceByName", func() error {
+		return s.MetadataStore.DeleteNamespaceByName(ctx, request)
+	}, func() error {
+		return s.target.DeleteNamespaceByName(ctx, request)
+	})
+}
+
+func newClusterMetadataStore(
+	source persistence.ClusterMetadataStore,
+	target persistence.ClusterMetadataStore,
+	shadower *shadower,
+) *clusterMetadataStore {
+	return &clusterMetadataStore{
+		ClusterMetadataStore: source,
+		target:               target,
+		shadower:             shadower,
+	}
+}
+
+func (s *clusterMetadataStore) Close() {
+	s.ClusterMetadataStore.Close()
+	s.target.Close()
+}
+
+func (s *clusterMetadataStore) SaveClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	return shadow1(s.shadower, "SaveClusterMetadata", func() (bool, error) {
+		return s.ClusterMetadataStore.SaveClusterMetadata(ctx, request)
+	}, func() error {
+		return saveClusterMetadata(ctx, s.target, request)
+	})
+}
+
+func (s *clusterMetadataStore) DeleteClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalDeleteClusterMetadataRequest,
+) error {
+	return shadow0(s.shadower, "DeleteClusterMetadata", func() error {
+		return s.ClusterMetadataStore.DeleteClusterMetadata(ctx, request)
+	}, func() error {
+		return s.target.DeleteClusterMetadata(ctx, request)
+	})
+}
+
+func (s *clusterMetadataStore) UpsertClusterMembership(
+	ctx context.Context,
+	request *persistence.UpsertClusterMembershipRequest,
+) error {
+	return shadow0(s.shadower, "UpsertClusterMembership", func() error {
+		return s.ClusterMetadataStore.UpsertClusterMembership(ctx, request)
+	}, func() error {
+		return s.target.UpsertClusterMembership(ctx, request)
+	})
+}
+
+func (s *clusterMetadataStore) PruneClusterMembership(
+	ctx context.Context,
+	request *persistence.PruneClusterMembershipRequest,
+) error {
+	return shadow0(s.shadower, "PruneClusterMembership", func() error {
+		return s.ClusterMetadataStore.PruneClusterMembership(ctx, request)
+	}, func() error {
+		return s.target.PruneClusterMembership(ctx, request)
+	})
+}
+
+func newNexusEndpointStore(
+	source persistence.NexusEndpointStore,
+	target persistence.NexusEndpointStore,
+	shadower *shadower,
+) *nexusEndpointStore {
+	return &nexusEndpointStore{
+		NexusEndpointStore: source,
+		target:             target,
+		shadower:           shadower,
+	}
+}
+
+func (s *nexusEndpointStore) Close() {
+	s.NexusEndpointStore.Close()
+	s.target.Close()
+}
+
+func (s *nexusEndpointStore) CreateOrUpdateNexusEndpoint(
+	ctx context.Context,
+	request *persistence.InternalCreateOrUpdateNexusEndpointRequest,
+) error {
+	return shadow0(s.shadower, "CreateOrUpdateNexusEndpoint", func() error {
+		return s.NexusEndpointStore.CreateOrUpdateNexusEndpoint(ctx, request)
+	}, func() error {
+		return saveNexusEndpoint(ctx, s.target, request.Endpoint.ID, request.Endpoint.Data)
+	})
+}
+
+func (s *nexusEndpointStore) DeleteNexusEndpoint(
+	ctx context.Context,
+	request *persistence.DeleteNexusEndpointRequest,
+) error {
+	return shadow0(s.shadower, "DeleteNexusEndpoint", func() error {
+		return s.NexusEndpointStore.DeleteNexusEndpoint(ctx, request)
+	}, func() error {
+		tableVersion, err := nexusEndpointsTableVersion(ctx, s.target)
+		if err != nil {
+			return err
+		}
+		return s.target.DeleteNexusEndpoint(ctx, &persistence.DeleteNexusEndpointRequest{
+			LastKnownTableVersion: tableVersion,
+			ID:                    request.ID,
+		})
+	})
+}
+
+func newQueue(
+	source persistence.Queue,
+	target persistence.Queue,
+	shadower *shadower,
+) *queue {
+	return &queue{
+		Queue:    source,
+		target:   target,
+		shadower: shadower,
+	}
+}
+
+func (q *queue) Close() {
+	q.Queue.Close()
+	q.target.Close()
+}
+
+func (q *queue) Init(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	return shadow0(q.shadower, "Init", func() error {
+		return q.Queue.Init(ctx, blob)
+	}, func() error {
+		return q.target.Init(ctx, blob)
+	})
+}
+
+func (q *queue) EnqueueMessage(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	return shadow0(q.shadower, "EnqueueMessage", func() error {
+		return q.Queue.EnqueueMessage(ctx, blob)
+	}, func() error {
+		return q.target.EnqueueMessage(ctx, blob)
+	})
+}
+
+func (q *queue) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return shadow0(q.shadower, "DeleteMessagesBefore", func() error {
+		return q.Queue.DeleteMessagesBefore(ctx, messageID)
+	}, func() error {
+		return q.target.DeleteMessagesBefore(ctx, messageID)
+	})
+}
+
+func (q *queue) UpdateAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	return shadow0(q.shadower, "UpdateAckLevel", func() error {
+		return q.Queue.UpdateAckLevel(ctx, metadata)
+	}, func() error {
+		current, err := q.target.GetAckLevels(ctx)
+		if err != nil {
+			return err
+		}
+		return q.target.UpdateAckLevel(ctx, &persistence.InternalQueueMetadata{
+			Blob:    metadata.Blob,
+			Version: current.Version,
+		})
+	})
+}
+
+func (q *queue) EnqueueMessageToDLQ(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) (int64, error) {
+	return shadow1(q.shadower, "EnqueueMessageToDLQ", func() (int64, error) {
+		return q.Queue.EnqueueMessageToDLQ(ctx, blob)
+	}, func() error {
+		_, err := q.target.EnqueueMessageToDLQ(ctx, blob)
+		return err
+	})
+}
+
+func (q *queue) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return shadow0(q.shadower, "DeleteMessageFromDLQ", func() error {
+		return q.Queue.DeleteMessageFromDLQ(ctx, messageID)
+	}, func() error {
+		return q.target.DeleteMessageFromDLQ(ctx, messageID)
+	})
+}
+
+func (q *queue) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	return shadow0(q.shadower, "RangeDeleteMessagesFromDLQ", func() error {
+		return q.Queue.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+	}, func() error {
+		return q.target.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+	})
+}
+
+func (q *queue) UpdateDLQAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	return shadow0(q.shadower, "UpdateDLQAckLevel", func() error {
+		return q.Queue.UpdateDLQAckLevel(ctx, metadata)
+	}, func() error {
+		current, err := q.target.GetDLQAckLevels(ctx)
+		if err != nil {
+			return err
+		}
+		return q.target.UpdateDLQAckLevel(ctx, &persistence.InternalQueueMetadata{
+			Blob:    metadata.Blob,
+			Version: current.Version,
+		})
+	})
+}
+
+func newQueueV2(
+	source persistence.QueueV2,
+	target persistence.QueueV2,
+	shadower *shadower,
+) *queueV2 {
+	return &queueV2{
+		QueueV2:  source,
+		target:   target,
+		shadower: shadower,
+	}
+}
+
+func (q *queueV2) EnqueueMessage(
+	ctx context.Context,
+	request *persistence.InternalEnqueueMessageRequest,
+) (*persistence.InternalEnqueueMessageResponse, error) {
+	return shadow1(q.shadower, "EnqueueMessage", func() (*persistence.InternalEnqueueMessageResponse, error) {
+		return q.QueueV2.EnqueueMessage(ctx, request)
+	}, func() error {
+		_, err := q.target.EnqueueMessage(ctx, request)
+		return err
+	})
+}
+
+func (q *queueV2) CreateQueue(
+	ctx context.Context,
+	request *persistence.InternalCreateQueueRequest,
+) (*persistence.InternalCreateQueueResponse, error) {
+	return shadow1(q.shadower, "CreateQueue", func() (*persistence.InternalCreateQueueResponse, error) {
+		return q.QueueV2.CreateQueue(ctx, request)
+	}, func() error {
+		_, err := q.target.CreateQueue(ctx, request)
+		return err
+	})
+}
+
+func (q *queueV2) RangeDeleteMessages(
+	ctx context.Context,
+	request *persistence.InternalRangeDeleteMessagesRequest,
+) (*persistence.InternalRangeDeleteMessagesResponse, error) {
+	return shadow1(q.shadower, "RangeDeleteMessages", func() (*persistence.InternalRangeDeleteMessagesResponse, error) {
+		return q.QueueV2.RangeDeleteMessages(ctx, request)
+	}, func() error {
+		_, err := q.target.RangeDeleteMessages(ctx, request)
+		return err
+	})
+}
+
+// saveTaskQueueUserData writes task queue user data to a store, regardless of its version in the store.
+func saveTaskQueueUserData(
+	ctx context.Context,
+	store persistence.TaskStore,
+	request *persistence.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	var version int64
+	current, err := store.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+		NamespaceID: request.NamespaceID,
+		TaskQueue:   request.TaskQueue,
+	})
+	switch err.(type) {
+	case nil:
+		version = current.Version
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+	updateRequest := *request
+	updateRequest.Version = version
+	return store.UpdateTaskQueueUserData(ctx, &updateRequest)
+}
+
+// updateNamespace updates a namespace in a store, regardless of the notification version of the store.
+func updateNamespace(
+	ctx context.Context,
+	store persistence.MetadataStore,
+	request *persistence.InternalUpdateNamespaceRequest,
+) error {
+	metadata, err := store.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	updateRequest := *request
+	updateRequest.NotificationVersion = metadata.NotificationVersion
+	return store.UpdateNamespace(ctx, &updateRequest)
+}
+
+// saveClusterMetadata writes cluster metadata to a store, regardless of its version in the store.
+func saveClusterMetadata(
+	ctx context.Context,
+	store persistence.ClusterMetadataStore,
+	request *persistence.InternalSaveClusterMetadataRequest,
+) error {
+	var version int64
+	current, err := store.GetClusterMetadata(ctx, &persistence.InternalGetClusterMetadataRequest{
+		ClusterName: request.ClusterName,
+	})
+	switch err.(type) {
+	case nil:
+		version = current.Version
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+	applied, err := store.SaveClusterMetadata(ctx, &persistence.InternalSaveClusterMetadataRequest{
+		ClusterName:     request.ClusterName,
+		ClusterMetadata: request.ClusterMetadata,
+		Version:         version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return &persistence.ConditionFailedError{Msg: "cluster metadata was updated concurrently"}
+	}
+	return nil
+}
+
+// saveNexusEndpoint writes a Nexus endpoint to a store, regardless of its version and the table version of the
+// store.
+func saveNexusEndpoint(
+	ctx context.Context,
+	store persistence.NexusEndpointStore,
+	id string,
+	data *commonpb.DataBlob,
+) error {
+	tableVersion, err := nexusEndpointsTableVersion(ctx, store)
+	if err != nil {
+		return err
+	}
+	var version int64
+	current, err := store.GetNexusEndpoint(ctx, &persistence.GetNexusEndpointRequest{ID: id})
+	switch err.(type) {
+	case nil:
+		version = current.Version
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+	return store.CreateOrUpdateNexusEndpoint(ctx, &persistence.InternalCreateOrUpdateNexusEndpointRequest{
+		LastKnownTableVersion: tableVersion,
+		Endpoint: persistence.InternalNexusEndpoint{
+			ID:      id,
+			Version: version,
+			Data:    data,
+		},
+	})
+}
+
+func nexusEndpointsTableVersion(ctx context.Context, store persistence.NexusEndpointStore) (int64, error) {
+	resp, err := store.ListNexusEndpoints(ctx, &persistence.ListNexusEndpointsRequest{PageSize: 1})
+	if err != nil {
+		return 0, err
+	}
+	return resp.TableVersion, nil
+}
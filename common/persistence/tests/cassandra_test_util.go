@@ -104,6 +104,7 @@ func SetUpCassandraDatabase(t *testing.T, cfg *config.Cassandra, logger log.Logg
 		func() (*gocql.ClusterConfig, error) {
 			return commongocql.NewCassandraCluster(adminCfg, resolver.NewNoopResolver())
 		},
+		adminCfg.Scylla,
 		logger,
 		metrics.NoopMetricsHandler,
 	)
@@ -132,6 +133,7 @@ func ApplySchemaUpdate(t *testing.T, cfg *config.Cassandra, schemaFile string, l
 		func() (*gocql.ClusterConfig, error) {
 			return commongocql.NewCassandraCluster(*cfg, resolver.NewNoopResolver())
 		},
+		cfg.Scylla,
 		logger,
 		metrics.NoopMetricsHandler,
 	)
@@ -167,6 +169,7 @@ func TearDownCassandraKeyspace(t *testing.T, cfg *config.Cassandra) {
 		func() (*gocql.ClusterConfig, error) {
 			return commongocql.NewCassandraCluster(adminCfg, resolver.NewNoopResolver())
 		},
+		adminCfg.Scylla,
 		log.NewNoopLogger(),
 		metrics.NoopMetricsHandler,
 	)
@@ -250,5 +253,6 @@ func NewCassandraConfig() *config.Cassandra {
 		Port:           environment.GetCassandraPort(),
 		Keyspace:       testCassandraDatabaseNamePrefix + shuffle.String(testCassandraDatabaseNameSuffix),
 		ConnectTimeout: 30 * time.Second,
+		Scylla:         environment.GetCassandraScylla(),
 	}
 }
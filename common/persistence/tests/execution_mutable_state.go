@@ -94,6 +94,7 @@ func NewExecutionMutableStateSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetBoolPropertyFn(false),
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
@@ -1874,6 +1875,41 @@ func (s *ExecutionMutableStateSuite) TestDelete_NotExists() {
 	s.AssertMissingFromDB(s.NamespaceID, s.WorkflowID, s.RunID)
 }
 
+func (s *ExecutionMutableStateSuite) TestListConcreteExecutions() {
+	var expectedRunIDs []string
+	for i := 0; i < 5; i++ {
+		s.WorkflowID = uuid.New().String()
+		s.RunID = uuid.New().String()
+		s.CreateWorkflow(
+			rand.Int63(),
+			enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			rand.Int63(),
+		)
+		expectedRunIDs = append(expectedRunIDs, s.RunID)
+	}
+
+	var runIDs []string
+	var pageToken []byte
+	for {
+		resp, err := s.ExecutionManager.ListConcreteExecutions(s.Ctx, &p.ListConcreteExecutionsRequest{
+			ShardID:   s.ShardID,
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		s.NoError(err)
+		s.LessOrEqual(len(resp.States), 2)
+		for _, state := range resp.States {
+			runIDs = append(runIDs, state.ExecutionState.RunId)
+		}
+		pageToken = resp.PageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.ElementsMatch(expectedRunIDs, runIDs)
+}
+
 func (s *ExecutionMutableStateSuite) CreateWorkflow(
 	lastWriteVersion int64,
 	state enumsspb.WorkflowExecutionState,
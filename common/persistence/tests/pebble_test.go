@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/pebble"
+	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	testPebbleClusterName = "temporal_pebble_cluster"
+)
+
+// newPebbleFactory returns a factory of a new pebble database, which is closed when the test finishes.
+func newPebbleFactory(t *testing.T, logger log.Logger) *pebble.Factory {
+	factory, err := pebble.NewFactory(
+		config.Pebble{Path: t.TempDir(), DisableSync: true},
+		testPebbleClusterName,
+		logger,
+	)
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+	t.Cleanup(factory.Close)
+	return factory
+}
+
+func TestPebbleShardStoreSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newPebbleFactory(t, logger)
+	shardStore, err := factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+
+	s := NewShardSuite(
+		t,
+		shardStore,
+		serialization.NewSerializer(),
+		logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestPebbleExecutionMutableStateStoreSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newPebbleFactory(t, logger)
+	shardStore, err := factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+	executionStore, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+
+	s := NewExecutionMutableStateSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		&persistence.HistoryBranchUtilImpl{},
+		logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestPebbleExecutionMutableStateTaskStoreSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newPebbleFactory(t, logger)
+	shardStore, err := factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+	executionStore, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+
+	s := NewExecutionMutableStateTaskSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestPebbleHistoryStoreSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newPebbleFactory(t, logger)
+	store, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+
+	s := NewHistoryEventsSuite(t, store, logger)
+	suite.Run(t, s)
+}
+
+func TestPebbleTaskQueueSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newPebbleFactory(t, logger)
+	taskQueueStore, err := factory.NewTaskStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+
+	s := NewTaskQueueSuite(t, taskQueueStore, logger)
+	suite.Run(t, s)
+}
+
+func TestPebbleTaskQueueTaskSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newPebbleFactory(t, logger)
+	taskQueueStore, err := factory.NewTaskStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+
+	s := NewTaskQueueTaskSuite(t, taskQueueStore, logger)
+	suite.Run(t, s)
+}
+
+func TestPebbleQueueV2(t *testing.T) {
+	factory := newPebbleFactory(t, log.NewNoopLogger())
+	queue, err := factory.NewQueueV2()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+	RunQueueV2TestSuite(t, queue)
+}
+
+func TestPebbleNexusEndpointPersistence(t *testing.T) {
+	factory := newPebbleFactory(t, log.NewNoopLogger())
+	store, err := factory.NewNexusEndpointStore()
+	if err != nil {
+		t.Fatalf("unable to create pebble DB: %v", err)
+	}
+	tableVersion := atomic.Int64{}
+	RunNexusEndpointTestSuite(t, store, &tableVersion)
+}
+
+func TestPebbleHistoryV2PersistenceSuite(t *testing.T) {
+	s := new(persistencetests.HistoryV2PersistenceSuite)
+	s.TestBase = persistencetests.NewTestBaseWithPebble(&persistencetests.TestBaseOptions{})
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestPebbleMetadataPersistenceSuiteV2(t *testing.T) {
+	s := new(persistencetests.MetadataPersistenceSuiteV2)
+	s.TestBase = persistencetests.NewTestBaseWithPebble(&persistencetests.TestBaseOptions{})
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestPebbleClusterMetadataPersistence(t *testing.T) {
+	s := new(persistencetests.ClusterMetadataManagerSuite)
+	s.TestBase = persistencetests.NewTestBaseWithPebble(&persistencetests.TestBaseOptions{})
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestPebbleQueuePersistence(t *testing.T) {
+	s := new(persistencetests.QueuePersistenceSuite)
+	s.TestBase = persistencetests.NewTestBaseWithPebble(&persistencetests.TestBaseOptions{})
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
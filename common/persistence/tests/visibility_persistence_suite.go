@@ -1078,6 +1078,81 @@ func (s *VisibilityPersistenceSuite) TestCountGroupByWorkflowExecutions() {
 	s.Equal(int64(5), resp.Count)
 }
 
+func (s *VisibilityPersistenceSuite) TestCountWorkflowExecutionsHistogram() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	day := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	var startRequests []*manager.RecordWorkflowExecutionStartedRequest
+	for _, startTime := range []time.Time{
+		day.Add(10*time.Hour + 15*time.Minute),
+		day.Add(10*time.Hour + 45*time.Minute),
+		day.Add(12*time.Hour + 5*time.Minute),
+		day.Add(12*time.Hour + 10*time.Minute),
+	} {
+		startRequests = append(
+			startRequests,
+			s.createOpenWorkflowRecord(
+				testNamespaceUUID,
+				"visibility-workflow-test",
+				"visibility-workflow",
+				startTime,
+				"test-queue",
+			),
+		)
+	}
+	s.createClosedWorkflowRecord(startRequests[0], day.Add(13*time.Hour+30*time.Minute))
+
+	resp, err := s.VisibilityMgr.CountWorkflowExecutionsHistogram(
+		s.ctx,
+		&manager.CountWorkflowExecutionsHistogramRequest{
+			NamespaceID: testNamespaceUUID,
+			TimeField:   searchattribute.StartTime,
+			Interval:    manager.HistogramIntervalHour,
+		},
+	)
+	s.NoError(err)
+	s.Equal(
+		[]*manager.HistogramBucket{
+			{StartTime: day.Add(10 * time.Hour), Count: 2},
+			{StartTime: day.Add(12 * time.Hour), Count: 2},
+		},
+		resp.Buckets,
+	)
+
+	resp, err = s.VisibilityMgr.CountWorkflowExecutionsHistogram(
+		s.ctx,
+		&manager.CountWorkflowExecutionsHistogramRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "ExecutionStatus = 'Running'",
+			TimeField:   searchattribute.StartTime,
+			Interval:    manager.HistogramIntervalWeek,
+		},
+	)
+	s.NoError(err)
+	s.Equal(
+		[]*manager.HistogramBucket{
+			{StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Count: 3},
+		},
+		resp.Buckets,
+	)
+
+	resp, err = s.VisibilityMgr.CountWorkflowExecutionsHistogram(
+		s.ctx,
+		&manager.CountWorkflowExecutionsHistogramRequest{
+			NamespaceID: testNamespaceUUID,
+			TimeField:   searchattribute.CloseTime,
+			Interval:    manager.HistogramIntervalDay,
+		},
+	)
+	s.NoError(err)
+	s.Equal(
+		[]*manager.HistogramBucket{
+			{StartTime: day, Count: 1},
+		},
+		resp.Buckets,
+	)
+}
+
 func (s *VisibilityPersistenceSuite) listWithPagination(namespaceID namespace.ID, pageSize int) []*workflowpb.WorkflowExecutionInfo {
 	var executions []*workflowpb.WorkflowExecutionInfo
 	resp, err := s.VisibilityMgr.ListWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequestV2{
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tieredhistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// URISchemeFile is the URI scheme of blob stores on a file system, e.g. file:///var/temporal/history.
+	URISchemeFile = "file"
+)
+
+type (
+	// BlobStore stores the offloaded history of branches. Keys are slash separated paths.
+	BlobStore interface {
+		// Put creates or replaces the blob of key. A reader never observes a partially written blob.
+		Put(ctx context.Context, key string, data []byte) error
+		// Get returns the blob of key, or ErrBlobNotFound if it doesn't exist.
+		Get(ctx context.Context, key string) ([]byte, error)
+		// Delete removes the blob of key. It is not an error if the blob doesn't exist.
+		Delete(ctx context.Context, key string) error
+	}
+
+	fileBlobStore struct {
+		dir string
+	}
+)
+
+// ErrBlobNotFound is returned by BlobStore.Get when the blob doesn't exist.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// NewBlobStore returns the blob store of uri.
+func NewBlobStore(uri string) (BlobStore, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blob store URI %q: %w", uri, err)
+	}
+	switch u.Scheme {
+	case URISchemeFile:
+		if u.Path == "" {
+			return nil, fmt.Errorf("blob store URI %q has no path", uri)
+		}
+		return NewFileBlobStore(u.Path)
+	default:
+		return nil, fmt.Errorf("unsupported blob store URI scheme %q", u.Scheme)
+	}
+}
+
+// NewFileBlobStore returns a blob store which keeps each blob in a file under dir.
+func NewFileBlobStore(dir string) (BlobStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileBlobStore{dir: dir}, nil
+}
+
+func (s *fileBlobStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// write to a temporary file first, so that the blob is replaced atomically
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *fileBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	return data, err
+}
+
+func (s *fileBlobStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileBlobStore) path(key string) (string, error) {
+	if key == "" || strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source $GOFILE -destination data_store_factory_mock.go
+
+// Package tieredhistory moves the old history of long-running workflows from the database to a blob store.
+// Offloaded nodes are read back transparently by ReadHistoryBranch of the execution store, so history readers
+// don't know which tier a node is in.
+package tieredhistory
+
+import (
+	"context"
+	"sync"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Offloader moves old history of branches to the blob store.
+	Offloader interface {
+		// OffloadHistoryBranch moves the nodes of a branch which are older than request.OlderThan to the blob
+		// store. It is safe to call concurrently with reads of the branch, but not with other offloads of it.
+		OffloadHistoryBranch(ctx context.Context, request *OffloadHistoryBranchRequest) (*OffloadHistoryBranchResponse, error)
+	}
+
+	// DataStoreFactory wraps a data store factory so that the history of its execution store can be offloaded to
+	// a blob store. Every other store is returned as is.
+	DataStoreFactory struct {
+		persistence.DataStoreFactory
+		blobs  BlobStore
+		logger log.Logger
+
+		lock           sync.Mutex
+		executionStore *executionStore
+	}
+)
+
+var _ persistence.DataStoreFactory = (*DataStoreFactory)(nil)
+var _ Offloader = (*DataStoreFactory)(nil)
+
+// NewDataStoreFactory returns a data store factory which offloads the history of factory to blobs.
+func NewDataStoreFactory(
+	factory persistence.DataStoreFactory,
+	blobs BlobStore,
+	logger log.Logger,
+) *DataStoreFactory {
+	return &DataStoreFactory{
+		DataStoreFactory: factory,
+		blobs:            blobs,
+		logger:           logger,
+	}
+}
+
+// OffloaderFromDataStoreFactory returns the offloader of factory, or nil if its history is not offloaded.
+func OffloaderFromDataStoreFactory(factory persistence.DataStoreFactory) Offloader {
+	if f, ok := factory.(*DataStoreFactory); ok {
+		return f
+	}
+	return nil
+}
+
+// Unwrap returns the wrapped data store factory.
+func (f *DataStoreFactory) Unwrap() persistence.DataStoreFactory {
+	return f.DataStoreFactory
+}
+
+func (f *DataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	return f.newExecutionStore()
+}
+
+func (f *DataStoreFactory) OffloadHistoryBranch(
+	ctx context.Context,
+	request *OffloadHistoryBranchRequest,
+) (*OffloadHistoryBranchResponse, error) {
+	store, err := f.newExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	return store.offloadHistoryBranch(ctx, request)
+}
+
+func (f *DataStoreFactory) newExecutionStore() (*executionStore, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.executionStore == nil {
+		store, err := f.DataStoreFactory.NewExecutionStore()
+		if err != nil {
+			return nil, err
+		}
+		f.executionStore = newExecutionStore(store, f.blobs, f.logger)
+	}
+	return f.executionStore, nil
+}
@@ -0,0 +1,624 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tieredhistory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// maxSegmentNodes is the number of nodes after which an offloaded segment is closed.
+	maxSegmentNodes = 1000
+	// offloadReadPageSize is the page size of database reads when offloading a branch.
+	offloadReadPageSize = 100
+)
+
+type (
+	// OffloadHistoryBranchRequest is the request to offload the history of a branch.
+	OffloadHistoryBranchRequest struct {
+		ShardID     int32
+		BranchToken []byte
+		// OlderThan is the time before which the events of a node must have been created for it to be offloaded.
+		OlderThan time.Time
+	}
+
+	// OffloadHistoryBranchResponse is the response to OffloadHistoryBranchRequest.
+	OffloadHistoryBranchResponse struct {
+		// OffloadedNodes is the number of nodes moved from the database to the blob store.
+		OffloadedNodes int
+	}
+
+	// executionStore serves history reads from the blob store for the nodes which have been offloaded, and from
+	// the database for the rest. Every other call goes to the database.
+	executionStore struct {
+		persistence.ExecutionStore
+		blobs      BlobStore
+		serializer serialization.Serializer
+		logger     log.Logger
+	}
+
+	// pageToken is the page token of reads that involve offloaded nodes. The tokens of reads served by the
+	// database alone are passed through.
+	pageToken struct {
+		// Offloaded is set while offloaded nodes are read. LastNodeID and LastTransactionID identify the last
+		// node returned, and are zero if none has been returned yet.
+		Offloaded         bool  `json:"offloaded,omitempty"`
+		LastNodeID        int64 `json:"lastNodeID,omitempty"`
+		LastTransactionID int64 `json:"lastTransactionID,omitempty"`
+		// StoreMinNodeID is the MinNodeID of the database reads which follow the offloaded nodes of forward reads.
+		StoreMinNodeID int64 `json:"storeMinNodeID,omitempty"`
+		// LowestNodeID is the lowest node returned by the database in reverse reads. Offloaded nodes are read
+		// below it.
+		LowestNodeID int64 `json:"lowestNodeID,omitempty"`
+		// StoreToken is the page token of the database.
+		StoreToken []byte `json:"storeToken,omitempty"`
+	}
+)
+
+var pageTokenPrefix = []byte("tieredhistory:")
+
+func newExecutionStore(
+	store persistence.ExecutionStore,
+	blobs BlobStore,
+	logger log.Logger,
+) *executionStore {
+	return &executionStore{
+		ExecutionStore: store,
+		blobs:          blobs,
+		serializer:     serialization.NewSerializer(),
+		logger:         logger,
+	}
+}
+
+func (s *executionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	token, err := decodePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	if request.ReverseOrder {
+		return s.readReverse(ctx, request, token)
+	}
+
+	switch {
+	case token != nil && token.Offloaded:
+		return s.readOffloadedForward(ctx, request, token, nil)
+	case token != nil:
+		return s.readStoreForward(ctx, request, token.StoreMinNodeID, token.StoreToken)
+	case len(request.NextPageToken) > 0:
+		return s.ExecutionStore.ReadHistoryBranch(ctx, request)
+	}
+
+	resp, err := s.ExecutionStore.ReadHistoryBranch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	// the database has the first node of the range, so nothing of the range is offloaded
+	if len(resp.Nodes) > 0 && resp.Nodes[0].NodeID <= request.MinNodeID {
+		return resp, nil
+	}
+	m, err := s.loadManifest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if m.watermark() <= request.MinNodeID {
+		return resp, nil
+	}
+	return s.readOffloadedForward(ctx, request, &pageToken{Offloaded: true}, m)
+}
+
+func (s *executionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryBranchRequest,
+) error {
+	// offloaded nodes are deleted first, since they can't be found anymore once the branch is deleted from the
+	// database
+	for _, br := range request.BranchRanges {
+		if err := s.deleteOffloaded(ctx, request.BranchInfo.GetTreeId(), br.BranchId, br.BeginNodeId); err != nil {
+			return err
+		}
+	}
+	return s.ExecutionStore.DeleteHistoryBranch(ctx, request)
+}
+
+// offloadHistoryBranch moves the nodes of a branch which are older than request.OlderThan to the blob store,
+// oldest first. The nodes of the branch itself are offloaded, its ancestors are offloaded with their own
+// branches. The last node of a branch always stays in the database.
+func (s *executionStore) offloadHistoryBranch(
+	ctx context.Context,
+	request *OffloadHistoryBranchRequest,
+) (*OffloadHistoryBranchResponse, error) {
+	branch, err := s.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+	m, err := loadManifest(ctx, s.blobs, branch.TreeId, branch.BranchId)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		m = &manifest{}
+	} else if err := s.deleteOffloadedNodesFromStore(ctx, request, branch, m.watermark()); err != nil {
+		// the nodes may be left behind by an earlier offload that failed after the manifest was written
+		return nil, err
+	}
+
+	resp := &OffloadHistoryBranchResponse{}
+	for {
+		offloaded, err := s.offloadSegment(ctx, request, branch, m)
+		if err != nil {
+			return nil, err
+		}
+		if offloaded == 0 {
+			return resp, nil
+		}
+		resp.OffloadedNodes += offloaded
+	}
+}
+
+// offloadSegment moves the next segment of old nodes of a branch to the blob store and returns the number of
+// nodes moved.
+func (s *executionStore) offloadSegment(
+	ctx context.Context,
+	request *OffloadHistoryBranchRequest,
+	branch *persistencespb.HistoryBranch,
+	m *manifest,
+) (int, error) {
+	firstNodeID := max(persistence.GetBeginNodeID(branch), m.watermark())
+	var nodes []persistence.InternalHistoryNode
+	var nextNodeID int64
+	var storeToken []byte
+	for nextNodeID == 0 {
+		resp, err := s.ExecutionStore.ReadHistoryBranch(ctx, &persistence.InternalReadHistoryBranchRequest{
+			BranchToken:   request.BranchToken,
+			BranchID:      branch.BranchId,
+			MinNodeID:     firstNodeID,
+			MaxNodeID:     common.EndEventID,
+			PageSize:      offloadReadPageSize,
+			NextPageToken: storeToken,
+			ShardID:       request.ShardID,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, node := range resp.Nodes {
+			if len(nodes) >= maxSegmentNodes && node.NodeID != nodes[len(nodes)-1].NodeID {
+				nextNodeID = node.NodeID
+				break
+			}
+			old, err := s.isOlderThan(node, request.OlderThan)
+			if err != nil {
+				return 0, err
+			}
+			if !old {
+				nextNodeID = node.NodeID
+				break
+			}
+			nodes = append(nodes, node)
+		}
+		storeToken = resp.NextPageToken
+		if len(storeToken) == 0 {
+			break
+		}
+	}
+	if nextNodeID == 0 {
+		// every node is old enough, keep the last one in the database
+		if len(nodes) == 0 {
+			return 0, nil
+		}
+		nextNodeID = nodes[len(nodes)-1].NodeID
+	}
+	nodes = slices.DeleteFunc(nodes, func(node persistence.InternalHistoryNode) bool {
+		return node.NodeID >= nextNodeID
+	})
+	if len(nodes) == 0 {
+		return 0, nil
+	}
+
+	seg := segment{
+		FirstNodeID: firstNodeID,
+		NextNodeID:  nextNodeID,
+		Key:         segmentKey(branch.TreeId, branch.BranchId, firstNodeID),
+	}
+	if err := storeSegment(ctx, s.blobs, seg, nodes); err != nil {
+		return 0, err
+	}
+	m.Segments = append(m.Segments, seg)
+	if err := storeManifest(ctx, s.blobs, branch.TreeId, branch.BranchId, m); err != nil {
+		m.Segments = m.Segments[:len(m.Segments)-1]
+		return 0, err
+	}
+	// nodes are deleted in ascending order, so the nodes left in the database are always a suffix of the branch
+	for _, node := range nodes {
+		if err := s.deleteNodeFromStore(ctx, request, branch, node); err != nil {
+			return 0, err
+		}
+	}
+	s.logger.Debug("Offloaded history segment.",
+		tag.NewStringTag("tree-id", branch.TreeId),
+		tag.NewStringTag("branch-id", branch.BranchId),
+		tag.NewInt64("first-node-id", seg.FirstNodeID),
+		tag.NewInt64("next-node-id", seg.NextNodeID),
+	)
+	return len(nodes), nil
+}
+
+// deleteOffloadedNodesFromStore deletes the nodes of a branch below the watermark from the database.
+func (s *executionStore) deleteOffloadedNodesFromStore(
+	ctx context.Context,
+	request *OffloadHistoryBranchRequest,
+	branch *persistencespb.HistoryBranch,
+	watermark int64,
+) error {
+	var storeToken []byte
+	for {
+		resp, err := s.ExecutionStore.ReadHistoryBranch(ctx, &persistence.InternalReadHistoryBranchRequest{
+			BranchToken:   request.BranchToken,
+			BranchID:      branch.BranchId,
+			MinNodeID:     persistence.GetBeginNodeID(branch),
+			MaxNodeID:     watermark,
+			PageSize:      offloadReadPageSize,
+			NextPageToken: storeToken,
+			ShardID:       request.ShardID,
+			MetadataOnly:  true,
+		})
+		if err != nil {
+			return err
+		}
+		for _, node := range resp.Nodes {
+			if err := s.deleteNodeFromStore(ctx, request, branch, node); err != nil {
+				return err
+			}
+		}
+		storeToken = resp.NextPageToken
+		if len(storeToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *executionStore) deleteNodeFromStore(
+	ctx context.Context,
+	request *OffloadHistoryBranchRequest,
+	branch *persistencespb.HistoryBranch,
+	node persistence.InternalHistoryNode,
+) error {
+	return s.ExecutionStore.DeleteHistoryNodes(ctx, &persistence.InternalDeleteHistoryNodesRequest{
+		BranchToken:   request.BranchToken,
+		ShardID:       request.ShardID,
+		BranchInfo:    branch,
+		NodeID:        node.NodeID,
+		TransactionID: node.TransactionID,
+	})
+}
+
+// deleteOffloaded deletes the offloaded segments of a branch which start at or after beginNodeID.
+func (s *executionStore) deleteOffloaded(ctx context.Context, treeID string, branchID string, beginNodeID int64) error {
+	m, err := loadManifest(ctx, s.blobs, treeID, branchID)
+	if err != nil || m == nil {
+		return err
+	}
+	index := slices.IndexFunc(m.Segments, func(seg segment) bool {
+		return seg.FirstNodeID >= beginNodeID
+	})
+	if index < 0 {
+		return nil
+	}
+	deleted := slices.Clone(m.Segments[index:])
+	m.Segments = m.Segments[:index]
+	// the manifest is updated first, so that it never lists a deleted segment
+	if err := storeManifest(ctx, s.blobs, treeID, branchID, m); err != nil {
+		return err
+	}
+	for _, seg := range deleted {
+		if err := s.blobs.Delete(ctx, seg.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *executionStore) readStoreForward(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+	minNodeID int64,
+	storeToken []byte,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	storeRequest := *request
+	storeRequest.MinNodeID = minNodeID
+	storeRequest.NextPageToken = storeToken
+	resp, err := s.ExecutionStore.ReadHistoryBranch(ctx, &storeRequest)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.NextPageToken) > 0 {
+		resp.NextPageToken, err = encodePageToken(&pageToken{StoreMinNodeID: minNodeID, StoreToken: resp.NextPageToken})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// readOffloadedForward reads the offloaded nodes of a forward read, followed by the nodes in the database from
+// the watermark on.
+func (s *executionStore) readOffloadedForward(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+	token *pageToken,
+	m *manifest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	if m == nil {
+		var err error
+		if m, err = s.loadManifest(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+	maxNodeID := min(request.MaxNodeID, m.watermark())
+	nodes, more, err := s.readOffloaded(ctx, request, m, maxNodeID, token)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case more:
+		last := nodes[len(nodes)-1]
+		return s.offloadedPage(nodes, &pageToken{
+			Offloaded:         true,
+			LastNodeID:        last.NodeID,
+			LastTransactionID: last.TransactionID,
+		})
+	case maxNodeID >= request.MaxNodeID:
+		return &persistence.InternalReadHistoryBranchResponse{Nodes: nodes}, nil
+	case len(nodes) == 0:
+		return s.readStoreForward(ctx, request, max(request.MinNodeID, maxNodeID), nil)
+	default:
+		return s.offloadedPage(nodes, &pageToken{StoreMinNodeID: max(request.MinNodeID, maxNodeID)})
+	}
+}
+
+// readReverse reads the nodes in the database first, followed by the offloaded nodes below the lowest node in
+// the database.
+func (s *executionStore) readReverse(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+	token *pageToken,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	if token != nil && token.Offloaded {
+		return s.readOffloadedReverse(ctx, request, token)
+	}
+
+	storeRequest := *request
+	lowestNodeID := request.MaxNodeID
+	if token != nil {
+		storeRequest.NextPageToken = token.StoreToken
+		lowestNodeID = token.LowestNodeID
+	}
+	resp, err := s.ExecutionStore.ReadHistoryBranch(ctx, &storeRequest)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Nodes) > 0 {
+		lowestNodeID = resp.Nodes[len(resp.Nodes)-1].NodeID
+	}
+	if len(resp.NextPageToken) > 0 {
+		resp.NextPageToken, err = encodePageToken(&pageToken{LowestNodeID: lowestNodeID, StoreToken: resp.NextPageToken})
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+	if lowestNodeID <= request.MinNodeID {
+		return resp, nil
+	}
+
+	m, err := s.loadManifest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if m.watermark() <= request.MinNodeID {
+		return resp, nil
+	}
+	offloadedToken := &pageToken{Offloaded: true, LowestNodeID: min(lowestNodeID, m.watermark())}
+	if len(resp.Nodes) == 0 {
+		return s.readOffloadedReverse(ctx, request, offloadedToken)
+	}
+	resp.NextPageToken, err = encodePageToken(offloadedToken)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *executionStore) readOffloadedReverse(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+	token *pageToken,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	m, err := s.loadManifest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	nodes, more, err := s.readOffloaded(ctx, request, m, token.LowestNodeID, token)
+	if err != nil {
+		return nil, err
+	}
+	if !more {
+		return &persistence.InternalReadHistoryBranchResponse{Nodes: nodes}, nil
+	}
+	last := nodes[len(nodes)-1]
+	return s.offloadedPage(nodes, &pageToken{
+		Offloaded:         true,
+		LastNodeID:        last.NodeID,
+		LastTransactionID: last.TransactionID,
+		LowestNodeID:      token.LowestNodeID,
+	})
+}
+
+// readOffloaded returns a page of the offloaded nodes in [request.MinNodeID, maxNodeID) which follow the last
+// node of token, in the order of the request. It also returns whether there are more nodes after the page.
+func (s *executionStore) readOffloaded(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+	m *manifest,
+	maxNodeID int64,
+	token *pageToken,
+) ([]persistence.InternalHistoryNode, bool, error) {
+	if m == nil {
+		return nil, false, nil
+	}
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = math.MaxInt
+	}
+	started := token.LastNodeID == 0
+	follows := func(node persistence.InternalHistoryNode) bool {
+		c := compareNodes(node, persistence.InternalHistoryNode{NodeID: token.LastNodeID, TransactionID: token.LastTransactionID})
+		if request.ReverseOrder {
+			return c < 0
+		}
+		return c > 0
+	}
+
+	segments := slices.Clone(m.Segments)
+	if request.ReverseOrder {
+		slices.Reverse(segments)
+	}
+	var nodes []persistence.InternalHistoryNode
+	for _, seg := range segments {
+		if seg.NextNodeID <= request.MinNodeID || seg.FirstNodeID >= maxNodeID {
+			continue
+		}
+		if !started && (!request.ReverseOrder && seg.NextNodeID <= token.LastNodeID ||
+			request.ReverseOrder && seg.FirstNodeID > token.LastNodeID) {
+			continue
+		}
+		segmentNodes, err := loadSegment(ctx, s.blobs, seg)
+		if err != nil {
+			return nil, false, err
+		}
+		slices.SortFunc(segmentNodes, compareNodes)
+		if request.ReverseOrder {
+			slices.Reverse(segmentNodes)
+		}
+		for _, node := range segmentNodes {
+			if node.NodeID < request.MinNodeID || node.NodeID >= maxNodeID {
+				continue
+			}
+			if !started {
+				if !follows(node) {
+					continue
+				}
+				started = true
+			}
+			if len(nodes) == pageSize {
+				return nodes, true, nil
+			}
+			if request.MetadataOnly {
+				node.Events = nil
+			}
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, false, nil
+}
+
+func (s *executionStore) offloadedPage(
+	nodes []persistence.InternalHistoryNode,
+	token *pageToken,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	nextPageToken, err := encodePageToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return &persistence.InternalReadHistoryBranchResponse{
+		Nodes:         nodes,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *executionStore) loadManifest(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*manifest, error) {
+	branch, err := s.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+	return loadManifest(ctx, s.blobs, branch.TreeId, request.BranchID)
+}
+
+func (s *executionStore) isOlderThan(node persistence.InternalHistoryNode, t time.Time) (bool, error) {
+	events, err := s.serializer.DeserializeEvents(node.Events)
+	if err != nil {
+		return false, err
+	}
+	if len(events) == 0 {
+		return false, nil
+	}
+	return events[len(events)-1].GetEventTime().AsTime().Before(t), nil
+}
+
+// compareNodes orders nodes the way the database returns them in forward reads: by node ID, and then by
+// transaction ID in descending order.
+func compareNodes(a persistence.InternalHistoryNode, b persistence.InternalHistoryNode) int {
+	if c := cmp.Compare(a.NodeID, b.NodeID); c != 0 {
+		return c
+	}
+	return cmp.Compare(b.TransactionID, a.TransactionID)
+}
+
+func encodePageToken(token *pageToken) ([]byte, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+	return append(slices.Clone(pageTokenPrefix), data...), nil
+}
+
+// decodePageToken returns the page token of data, or nil if data is not a page token of this store.
+func decodePageToken(data []byte) (*pageToken, error) {
+	if !bytes.HasPrefix(data, pageTokenPrefix) {
+		return nil, nil
+	}
+	token := &pageToken{}
+	if err := json.Unmarshal(data[len(pageTokenPrefix):], token); err != nil {
+		return nil, fmt.Errorf("invalid history page token: %w", err)
+	}
+	return token, nil
+}
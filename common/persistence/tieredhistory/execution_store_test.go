@@ -0,0 +1,306 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tieredhistory_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/sql"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/tieredhistory"
+	"go.temporal.io/server/common/resolver"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testShardID = int32(1)
+	// testBatches batches of two events are appended to each branch, the first testOldBatches of them are old
+	testBatches    = 10
+	testOldBatches = 6
+)
+
+type (
+	executionStoreSuite struct {
+		suite.Suite
+
+		ctx              context.Context
+		cancel           context.CancelFunc
+		blobDir          string
+		store            persistence.DataStoreFactory
+		factory          *tieredhistory.DataStoreFactory
+		executionManager persistence.ExecutionManager
+	}
+)
+
+func TestExecutionStoreSuite(t *testing.T) {
+	suite.Run(t, new(executionStoreSuite))
+}
+
+func (s *executionStoreSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+	logger := log.NewNoopLogger()
+	s.blobDir = s.T().TempDir()
+	blobs, err := tieredhistory.NewBlobStore("file://" + s.blobDir)
+	s.Require().NoError(err)
+	s.store = sql.NewFactory(
+		config.SQL{
+			PluginName:         "sqlite",
+			DatabaseName:       uuid.NewString(),
+			ConnectAttributes:  map[string]string{"mode": "memory", "cache": "private"},
+			TaskScanPartitions: 1,
+		},
+		resolver.NewNoopResolver(),
+		"tiered-history-test-cluster",
+		logger,
+		metrics.NoopMetricsHandler,
+	)
+	s.factory = tieredhistory.NewDataStoreFactory(s.store, blobs, logger)
+	executionStore, err := s.factory.NewExecutionStore()
+	s.Require().NoError(err)
+	s.executionManager = persistence.NewExecutionManager(
+		executionStore,
+		serialization.NewSerializer(),
+		nil,
+		logger,
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		dynamicconfig.GetBoolPropertyFn(false),
+	)
+}
+
+func (s *executionStoreSuite) TearDownTest() {
+	s.cancel()
+	s.factory.Close()
+}
+
+func (s *executionStoreSuite) TestReadOffloadedHistory() {
+	branchToken := s.createBranch(testOldBatches)
+	s.Equal(testOldBatches, s.offload(branchToken))
+
+	// only the recent nodes are left in the database
+	nodes := s.readStoreNodes(branchToken)
+	s.Len(nodes, testBatches-testOldBatches)
+	s.Equal(int64(2*testOldBatches+1), nodes[0].NodeID)
+
+	s.Equal(eventIDs(1, 2*testBatches+1), s.readEvents(branchToken, common.FirstEventID, 2*testBatches+1, 4))
+	s.Equal(eventIDs(5, 15), s.readEvents(branchToken, 5, 15, 2))
+	s.Equal(eventIDs(15, 2*testBatches+1), s.readEvents(branchToken, 15, 2*testBatches+1, 1))
+	s.Equal(eventIDs(3, 7), s.readEvents(branchToken, 3, 7, 1))
+
+	expected := eventIDs(1, 2*testBatches+1)
+	for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+		expected[i], expected[j] = expected[j], expected[i]
+	}
+	s.Equal(expected, s.readEventsReverse(branchToken, 2*testBatches+1, 3))
+
+	// nothing left to offload
+	s.Equal(0, s.offload(branchToken))
+	s.Equal(eventIDs(1, 2*testBatches+1), s.readEvents(branchToken, common.FirstEventID, 2*testBatches+1, 100))
+}
+
+func (s *executionStoreSuite) TestOffloadKeepsLastNode() {
+	branchToken := s.createBranch(testBatches)
+	s.Equal(testBatches-1, s.offload(branchToken))
+
+	nodes := s.readStoreNodes(branchToken)
+	s.Len(nodes, 1)
+	s.Equal(int64(2*testBatches-1), nodes[0].NodeID)
+	s.Equal(eventIDs(1, 2*testBatches+1), s.readEvents(branchToken, common.FirstEventID, 2*testBatches+1, 3))
+}
+
+func (s *executionStoreSuite) TestReadForkedBranch() {
+	branchToken := s.createBranch(testOldBatches)
+	s.Equal(testOldBatches, s.offload(branchToken))
+
+	resp, err := s.executionManager.ForkHistoryBranch(s.ctx, &persistence.ForkHistoryBranchRequest{
+		ShardID:         testShardID,
+		NamespaceID:     uuid.NewString(),
+		ForkBranchToken: branchToken,
+		ForkNodeID:      9,
+		Info:            persistence.BuildHistoryGarbageCleanupInfo(uuid.NewString(), uuid.NewString(), uuid.NewString()),
+		NewRunID:        uuid.NewString(),
+	})
+	s.Require().NoError(err)
+	s.Equal(eventIDs(1, 9), s.readEvents(resp.NewBranchToken, common.FirstEventID, 9, 2))
+}
+
+func (s *executionStoreSuite) TestDeleteOffloadedBranch() {
+	branchToken := s.createBranch(testOldBatches)
+	s.Equal(testOldBatches, s.offload(branchToken))
+	branch, err := s.executionManager.GetHistoryBranchUtil().ParseHistoryBranchInfo(branchToken)
+	s.Require().NoError(err)
+	branchDir := filepath.Join(s.blobDir, branch.TreeId, branch.BranchId)
+	entries, err := os.ReadDir(branchDir)
+	s.Require().NoError(err)
+	s.Len(entries, 2)
+
+	s.NoError(s.executionManager.DeleteHistoryBranch(s.ctx, &persistence.DeleteHistoryBranchRequest{
+		ShardID:     testShardID,
+		BranchToken: branchToken,
+	}))
+	entries, err = os.ReadDir(branchDir)
+	s.Require().NoError(err)
+	s.Empty(entries)
+	s.Empty(s.readStoreNodes(branchToken))
+}
+
+// createBranch creates a branch with testBatches batches, of which the first oldBatches are 10 days old.
+func (s *executionStoreSuite) createBranch(oldBatches int) []byte {
+	branchToken, err := s.executionManager.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.NewString(),
+		uuid.NewString(),
+		uuid.NewString(),
+		uuid.NewString(),
+		nil,
+		nil,
+		0,
+		0,
+		0,
+	)
+	s.Require().NoError(err)
+
+	now := time.Now().UTC()
+	for i := 0; i < testBatches; i++ {
+		eventTime := now
+		if i < oldBatches {
+			eventTime = now.Add(-10 * 24 * time.Hour)
+		}
+		var events []*historypb.HistoryEvent
+		for eventID := int64(2*i + 1); eventID <= int64(2*i+2); eventID++ {
+			events = append(events, &historypb.HistoryEvent{
+				EventId:   eventID,
+				EventTime: timestamppb.New(eventTime),
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+				Version:   common.EmptyVersion,
+			})
+		}
+		_, err := s.executionManager.AppendHistoryNodes(s.ctx, &persistence.AppendHistoryNodesRequest{
+			ShardID:           testShardID,
+			IsNewBranch:       i == 0,
+			Info:              persistence.BuildHistoryGarbageCleanupInfo(uuid.NewString(), uuid.NewString(), uuid.NewString()),
+			BranchToken:       branchToken,
+			Events:            events,
+			PrevTransactionID: int64(i),
+			TransactionID:     int64(i + 1),
+		})
+		s.Require().NoError(err)
+	}
+	return branchToken
+}
+
+func (s *executionStoreSuite) offload(branchToken []byte) int {
+	resp, err := s.factory.OffloadHistoryBranch(s.ctx, &tieredhistory.OffloadHistoryBranchRequest{
+		ShardID:     testShardID,
+		BranchToken: branchToken,
+		OlderThan:   time.Now().Add(-24 * time.Hour),
+	})
+	s.Require().NoError(err)
+	return resp.OffloadedNodes
+}
+
+func (s *executionStoreSuite) readEvents(branchToken []byte, minEventID int64, maxEventID int64, pageSize int) []int64 {
+	var ids []int64
+	var token []byte
+	for {
+		resp, err := s.executionManager.ReadHistoryBranch(s.ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       testShardID,
+			BranchToken:   branchToken,
+			MinEventID:    minEventID,
+			MaxEventID:    maxEventID,
+			PageSize:      pageSize,
+			NextPageToken: token,
+		})
+		s.Require().NoError(err)
+		for _, event := range resp.HistoryEvents {
+			ids = append(ids, event.GetEventId())
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			return ids
+		}
+	}
+}
+
+func (s *executionStoreSuite) readEventsReverse(branchToken []byte, maxEventID int64, pageSize int) []int64 {
+	var ids []int64
+	var token []byte
+	for {
+		resp, err := s.executionManager.ReadHistoryBranchReverse(s.ctx, &persistence.ReadHistoryBranchReverseRequest{
+			ShardID:                testShardID,
+			BranchToken:            branchToken,
+			MaxEventID:             maxEventID,
+			PageSize:               pageSize,
+			LastFirstTransactionID: testBatches,
+			NextPageToken:          token,
+		})
+		s.Require().NoError(err)
+		for _, event := range resp.HistoryEvents {
+			ids = append(ids, event.GetEventId())
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			return ids
+		}
+	}
+}
+
+// readStoreNodes returns the nodes of a branch in the database.
+func (s *executionStoreSuite) readStoreNodes(branchToken []byte) []persistence.InternalHistoryNode {
+	store, err := s.store.NewExecutionStore()
+	s.Require().NoError(err)
+	branch, err := store.GetHistoryBranchUtil().ParseHistoryBranchInfo(branchToken)
+	s.Require().NoError(err)
+	resp, err := store.ReadHistoryBranch(s.ctx, &persistence.InternalReadHistoryBranchRequest{
+		BranchToken:  branchToken,
+		BranchID:     branch.BranchId,
+		MinNodeID:    common.FirstEventID,
+		MaxNodeID:    common.EndEventID,
+		PageSize:     100,
+		ShardID:      testShardID,
+		MetadataOnly: true,
+	})
+	s.Require().NoError(err)
+	return resp.Nodes
+}
+
+func eventIDs(first int64, next int64) []int64 {
+	var ids []int64
+	for id := first; id < next; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,154 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tieredhistory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+// The offloaded history of a branch is kept under <treeID>/<branchID>/ in the blob store:
+//
+//	manifest         - the manifest of the branch, listing its segments.
+//	<firstNodeID>    - a segment, holding the nodes with an ID in [FirstNodeID, NextNodeID) of the segment.
+//
+// Segments are contiguous and ordered, so every node of the branch with an ID below the NextNodeID of the last
+// segment (the watermark) is offloaded, and the nodes from the watermark on are in the database.
+const (
+	manifestBlob = "manifest"
+)
+
+type (
+	manifest struct {
+		Segments []segment `json:"segments"`
+	}
+
+	segment struct {
+		FirstNodeID int64  `json:"firstNodeID"`
+		NextNodeID  int64  `json:"nextNodeID"`
+		Key         string `json:"key"`
+	}
+
+	segmentNode struct {
+		NodeID            int64                `json:"nodeID"`
+		TransactionID     int64                `json:"transactionID"`
+		PrevTransactionID int64                `json:"prevTransactionID"`
+		EncodingType      enumspb.EncodingType `json:"encodingType"`
+		Data              []byte               `json:"data"`
+	}
+)
+
+// watermark returns the ID of the first node of the branch which is not offloaded.
+func (m *manifest) watermark() int64 {
+	if m == nil || len(m.Segments) == 0 {
+		return 0
+	}
+	return m.Segments[len(m.Segments)-1].NextNodeID
+}
+
+func branchPrefix(treeID string, branchID string) string {
+	return treeID + "/" + branchID + "/"
+}
+
+func manifestKey(treeID string, branchID string) string {
+	return branchPrefix(treeID, branchID) + manifestBlob
+}
+
+func segmentKey(treeID string, branchID string, firstNodeID int64) string {
+	return fmt.Sprintf("%s%020d", branchPrefix(treeID, branchID), firstNodeID)
+}
+
+// loadManifest returns the manifest of a branch, or nil if none of its history is offloaded.
+func loadManifest(ctx context.Context, blobs BlobStore, treeID string, branchID string) (*manifest, error) {
+	data, err := blobs.Get(ctx, manifestKey(treeID, branchID))
+	if errors.Is(err, ErrBlobNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := &manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("unable to decode history manifest of branch %s: %w", branchID, err)
+	}
+	return m, nil
+}
+
+func storeManifest(ctx context.Context, blobs BlobStore, treeID string, branchID string, m *manifest) error {
+	if len(m.Segments) == 0 {
+		return blobs.Delete(ctx, manifestKey(treeID, branchID))
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return blobs.Put(ctx, manifestKey(treeID, branchID), data)
+}
+
+func loadSegment(ctx context.Context, blobs BlobStore, s segment) ([]persistence.InternalHistoryNode, error) {
+	data, err := blobs.Get(ctx, s.Key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read history segment %s: %w", s.Key, err)
+	}
+	var segmentNodes []segmentNode
+	if err := json.Unmarshal(data, &segmentNodes); err != nil {
+		return nil, fmt.Errorf("unable to decode history segment %s: %w", s.Key, err)
+	}
+	nodes := make([]persistence.InternalHistoryNode, 0, len(segmentNodes))
+	for _, node := range segmentNodes {
+		nodes = append(nodes, persistence.InternalHistoryNode{
+			NodeID:            node.NodeID,
+			TransactionID:     node.TransactionID,
+			PrevTransactionID: node.PrevTransactionID,
+			Events: &commonpb.DataBlob{
+				EncodingType: node.EncodingType,
+				Data:         node.Data,
+			},
+		})
+	}
+	return nodes, nil
+}
+
+func storeSegment(ctx context.Context, blobs BlobStore, s segment, nodes []persistence.InternalHistoryNode) error {
+	segmentNodes := make([]segmentNode, 0, len(nodes))
+	for _, node := range nodes {
+		segmentNodes = append(segmentNodes, segmentNode{
+			NodeID:            node.NodeID,
+			TransactionID:     node.TransactionID,
+			PrevTransactionID: node.PrevTransactionID,
+			EncodingType:      node.Events.GetEncodingType(),
+			Data:              node.Events.GetData(),
+		})
+	}
+	data, err := json.Marshal(segmentNodes)
+	if err != nil {
+		return err
+	}
+	return blobs.Put(ctx, s.Key, data)
+}
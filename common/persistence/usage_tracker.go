@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	OperationClassRead  = "read"
+	OperationClassWrite = "write"
+
+	// UsageWindow is the sliding window over which persistence usage is averaged.
+	UsageWindow = time.Minute
+
+	usageBucketCount      = 6
+	usageBucketSize       = UsageWindow / usageBucketCount
+	usageUnknownNamespace = "_unknown_"
+)
+
+type (
+	// UsageTracker accounts the persistence requests of each namespace on this host by operation class.
+	UsageTracker interface {
+		Record(namespace string, operation string, err error)
+		// Usage returns the usage of namespace over the last UsageWindow, or of every namespace with requests
+		// in the window if namespace is empty.
+		Usage(namespace string) []NamespaceUsage
+		Start()
+		Stop()
+	}
+
+	NamespaceUsage struct {
+		Namespace      string
+		OperationClass string
+		// RPS is the rate of requests which reached the data store.
+		RPS float64
+		// ThrottledRPS is the rate of requests rejected by persistence rate limits.
+		ThrottledRPS float64
+		// QuotaRPS is the persistence QPS limit of the namespace on this host, or zero if unlimited.
+		QuotaRPS float64
+	}
+
+	// NamespaceQuotaFn returns the persistence QPS limit of a namespace on this host, or zero if unlimited.
+	NamespaceQuotaFn func(namespace string) float64
+
+	UsageTrackerImpl struct {
+		status     int32
+		shutdownCh chan struct{}
+
+		timeSource       clock.TimeSource
+		quotaFn          NamespaceQuotaFn
+		metricsHandler   metrics.Handler
+		emitMetricsTimer *time.Ticker
+
+		countersLock sync.Mutex
+		counters     map[usageKey]*usageCounter
+	}
+
+	usageKey struct {
+		namespace      string
+		operationClass string
+	}
+
+	// usageCounter holds the request counts of the last usageBucketCount buckets, indexed by bucket number
+	// modulo usageBucketCount.
+	usageCounter struct {
+		buckets [usageBucketCount]usageBucket
+	}
+
+	usageBucket struct {
+		number    int64
+		requests  int64
+		throttled int64
+	}
+)
+
+var _ UsageTracker = (*UsageTrackerImpl)(nil)
+
+func NewUsageTrackerImpl(
+	quotaFn NamespaceQuotaFn,
+	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
+) *UsageTrackerImpl {
+	return &UsageTrackerImpl{
+		status:           common.DaemonStatusInitialized,
+		shutdownCh:       make(chan struct{}),
+		timeSource:       timeSource,
+		quotaFn:          quotaFn,
+		metricsHandler:   metricsHandler,
+		emitMetricsTimer: time.NewTicker(emitMetricsInterval),
+		counters:         make(map[usageKey]*usageCounter),
+	}
+}
+
+func (t *UsageTrackerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go t.emitMetricsLoop()
+}
+
+func (t *UsageTrackerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(t.shutdownCh)
+	t.emitMetricsTimer.Stop()
+}
+
+func (t *UsageTrackerImpl) Record(namespace string, operation string, err error) {
+	if namespace == "" {
+		namespace = usageUnknownNamespace
+	}
+	key := usageKey{namespace: namespace, operationClass: OperationClass(operation)}
+	number := t.bucketNumber()
+	throttled := isPersistenceLimitError(err)
+
+	t.countersLock.Lock()
+	defer t.countersLock.Unlock()
+	counter, ok := t.counters[key]
+	if !ok {
+		counter = &usageCounter{}
+		t.counters[key] = counter
+	}
+	bucket := &counter.buckets[number%usageBucketCount]
+	if bucket.number != number {
+		*bucket = usageBucket{number: number}
+	}
+	if throttled {
+		bucket.throttled++
+	} else {
+		bucket.requests++
+	}
+}
+
+func (t *UsageTrackerImpl) Usage(namespace string) []NamespaceUsage {
+	usage := t.collect(namespace, false)
+	for i := range usage {
+		usage[i].QuotaRPS = t.quota(usage[i].Namespace)
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Namespace != usage[j].Namespace {
+			return usage[i].Namespace < usage[j].Namespace
+		}
+		return usage[i].OperationClass < usage[j].OperationClass
+	})
+	return usage
+}
+
+// collect returns the usage of namespace, or of every namespace if empty, without quotas. Namespaces without
+// requests in the window are skipped, unless prune is true, in which case they are returned with zero usage and
+// dropped from the tracker.
+func (t *UsageTrackerImpl) collect(namespace string, prune bool) []NamespaceUsage {
+	number := t.bucketNumber()
+
+	t.countersLock.Lock()
+	defer t.countersLock.Unlock()
+	var usage []NamespaceUsage
+	for key, counter := range t.counters {
+		if namespace != "" && key.namespace != namespace {
+			continue
+		}
+		requests, throttled := counter.sum(number)
+		if requests == 0 && throttled == 0 {
+			if !prune {
+				continue
+			}
+			delete(t.counters, key)
+		}
+		usage = append(usage, NamespaceUsage{
+			Namespace:      key.namespace,
+			OperationClass: key.operationClass,
+			RPS:            float64(requests) / UsageWindow.Seconds(),
+			ThrottledRPS:   float64(throttled) / UsageWindow.Seconds(),
+		})
+	}
+	return usage
+}
+
+func (t *UsageTrackerImpl) bucketNumber() int64 {
+	return t.timeSource.Now().UnixNano() / int64(usageBucketSize)
+}
+
+func (t *UsageTrackerImpl) quota(namespace string) float64 {
+	if t.quotaFn == nil || namespace == usageUnknownNamespace {
+		return 0
+	}
+	return t.quotaFn(namespace)
+}
+
+func (t *UsageTrackerImpl) emitMetricsLoop() {
+	for {
+		select {
+		case <-t.shutdownCh:
+			return
+		case <-t.emitMetricsTimer.C:
+			t.emitMetrics()
+		}
+	}
+}
+
+func (t *UsageTrackerImpl) emitMetrics() {
+	// Idle namespaces are reported with zero usage once before they are dropped, so that their gauges don't keep
+	// the last non-zero value.
+	usage := t.collect("", true)
+	quotaEmitted := make(map[string]struct{})
+	for _, u := range usage {
+		handler := t.metricsHandler.WithTags(metrics.NamespaceTag(u.Namespace), metrics.OperationClassTag(u.OperationClass))
+		metrics.PersistenceNamespaceRPS.With(handler).Record(u.RPS)
+		metrics.PersistenceNamespaceThrottledRPS.With(handler).Record(u.ThrottledRPS)
+		if _, ok := quotaEmitted[u.Namespace]; !ok {
+			quotaEmitted[u.Namespace] = struct{}{}
+			metrics.PersistenceNamespaceQuotaRPS.With(t.metricsHandler).Record(t.quota(u.Namespace), metrics.NamespaceTag(u.Namespace))
+		}
+	}
+}
+
+// sum returns the request counts of the buckets within UsageWindow of the bucket with the given number.
+func (c *usageCounter) sum(number int64) (requests int64, throttled int64) {
+	for _, bucket := range c.buckets {
+		if bucket.number > number-usageBucketCount && bucket.number <= number {
+			requests += bucket.requests
+			throttled += bucket.throttled
+		}
+	}
+	return requests, throttled
+}
+
+func NamespaceUsageToProto(usage []NamespaceUsage) []*persistencespb.NamespacePersistenceUsage {
+	result := make([]*persistencespb.NamespacePersistenceUsage, 0, len(usage))
+	for _, u := range usage {
+		result = append(result, &persistencespb.NamespacePersistenceUsage{
+			Namespace:      u.Namespace,
+			OperationClass: u.OperationClass,
+			Rps:            u.RPS,
+			ThrottledRps:   u.ThrottledRPS,
+			QuotaRps:       u.QuotaRPS,
+		})
+	}
+	return result
+}
+
+// OperationClass returns the operation class of a persistence operation, as named by its metrics scope.
+func OperationClass(operation string) string {
+	for _, prefix := range []string{"Get", "Read", "List", "Count", "Scan", "Assert"} {
+		if strings.HasPrefix(operation, prefix) {
+			return OperationClassRead
+		}
+	}
+	return OperationClassWrite
+}
+
+func isPersistenceLimitError(err error) bool {
+	var resourceExhausted *serviceerror.ResourceExhausted
+	return errors.As(err, &resourceExhausted) && resourceExhausted.Cause == enumspb.RESOURCE_EXHAUSTED_CAUSE_PERSISTENCE_LIMIT
+}
@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+func TestUsageTracker_Usage(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	tracker := NewUsageTrackerImpl(
+		func(namespace string) float64 {
+			if namespace == "ns-1" {
+				return 100
+			}
+			return 50
+		},
+		metrics.NoopMetricsHandler,
+		timeSource,
+	)
+
+	for i := 0; i < 120; i++ {
+		tracker.Record("ns-1", "GetWorkflowExecution", nil)
+	}
+	for i := 0; i < 60; i++ {
+		tracker.Record("ns-1", "UpdateWorkflowExecution", nil)
+	}
+	tracker.Record("ns-1", "UpdateWorkflowExecution", ErrPersistenceNamespaceLimitExceeded)
+	tracker.Record("ns-1", "UpdateWorkflowExecution", errors.New("some error"))
+	tracker.Record("ns-2", "ReadHistoryBranch", nil)
+	tracker.Record("", "GetOrCreateShard", nil)
+
+	assert.Equal(t, []NamespaceUsage{
+		{Namespace: "_unknown_", OperationClass: OperationClassRead, RPS: 1.0 / 60},
+		{Namespace: "ns-1", OperationClass: OperationClassRead, RPS: 2, QuotaRPS: 100},
+		{Namespace: "ns-1", OperationClass: OperationClassWrite, RPS: 61.0 / 60, ThrottledRPS: 1.0 / 60, QuotaRPS: 100},
+		{Namespace: "ns-2", OperationClass: OperationClassRead, RPS: 1.0 / 60, QuotaRPS: 50},
+	}, tracker.Usage(""))
+	assert.Equal(t, []NamespaceUsage{
+		{Namespace: "ns-2", OperationClass: OperationClassRead, RPS: 1.0 / 60, QuotaRPS: 50},
+	}, tracker.Usage("ns-2"))
+
+	// requests older than the window are not counted
+	timeSource.Advance(UsageWindow / 2)
+	tracker.Record("ns-2", "ReadHistoryBranch", nil)
+	assert.Equal(t, []NamespaceUsage{
+		{Namespace: "ns-2", OperationClass: OperationClassRead, RPS: 2.0 / 60, QuotaRPS: 50},
+	}, tracker.Usage("ns-2"))
+	timeSource.Advance(UsageWindow / 2)
+	assert.Equal(t, []NamespaceUsage{
+		{Namespace: "ns-2", OperationClass: OperationClassRead, RPS: 1.0 / 60, QuotaRPS: 50},
+	}, tracker.Usage(""))
+	timeSource.Advance(UsageWindow)
+	assert.Empty(t, tracker.Usage(""))
+}
+
+func TestUsageTracker_EmitMetrics(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	metricsHandler := metricstest.NewCaptureHandler()
+	tracker := NewUsageTrackerImpl(func(string) float64 { return 10 }, metricsHandler, timeSource)
+
+	tracker.Record("ns-1", "CreateWorkflowExecution", nil)
+	tracker.Record("ns-1", "CreateWorkflowExecution", ErrPersistenceNamespaceLimitExceeded)
+
+	capture := metricsHandler.StartCapture()
+	tracker.emitMetrics()
+	snapshot := capture.Snapshot()
+	require.Len(t, snapshot[metrics.PersistenceNamespaceRPS.Name()], 1)
+	recording := snapshot[metrics.PersistenceNamespaceRPS.Name()][0]
+	assert.Equal(t, 1.0/60, recording.Value)
+	assert.Equal(t, map[string]string{"namespace": "ns-1", "operation_class": OperationClassWrite}, recording.Tags)
+	require.Len(t, snapshot[metrics.PersistenceNamespaceThrottledRPS.Name()], 1)
+	assert.Equal(t, 1.0/60, snapshot[metrics.PersistenceNamespaceThrottledRPS.Name()][0].Value)
+	require.Len(t, snapshot[metrics.PersistenceNamespaceQuotaRPS.Name()], 1)
+	assert.Equal(t, 10.0, snapshot[metrics.PersistenceNamespaceQuotaRPS.Name()][0].Value)
+	metricsHandler.StopCapture(capture)
+
+	// idle namespaces are reported with zero usage once, then dropped
+	timeSource.Advance(UsageWindow)
+	capture = metricsHandler.StartCapture()
+	tracker.emitMetrics()
+	tracker.emitMetrics()
+	snapshot = capture.Snapshot()
+	require.Len(t, snapshot[metrics.PersistenceNamespaceRPS.Name()], 1)
+	assert.Equal(t, 0.0, snapshot[metrics.PersistenceNamespaceRPS.Name()][0].Value)
+	metricsHandler.StopCapture(capture)
+}
+
+func TestOperationClass(t *testing.T) {
+	assert.Equal(t, OperationClassRead, OperationClass("GetWorkflowExecution"))
+	assert.Equal(t, OperationClassRead, OperationClass("ReadHistoryBranchReverse"))
+	assert.Equal(t, OperationClassRead, OperationClass("ListConcreteExecutions"))
+	assert.Equal(t, OperationClassRead, OperationClass("AssertShardOwnership"))
+	assert.Equal(t, OperationClassWrite, OperationClass("AppendHistoryNodes"))
+	assert.Equal(t, OperationClassWrite, OperationClass("RangeCompleteTimerTasks"))
+}
@@ -59,6 +59,7 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		CountWorkflowExecutionsHistogram(ctx context.Context, request *CountWorkflowExecutionsHistogramRequest) (*CountWorkflowExecutionsHistogramResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
 	}
 
@@ -145,6 +146,33 @@ type (
 		Groups []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup
 	}
 
+	// HistogramInterval is the width of the buckets returned by CountWorkflowExecutionsHistogram.
+	// Buckets are aligned in UTC, and weeks start on Monday.
+	HistogramInterval int
+
+	// CountWorkflowExecutionsHistogramRequest is request from CountWorkflowExecutionsHistogram
+	CountWorkflowExecutionsHistogramRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		// Optional visibility query to filter the executions to count. 'order by' and 'group by' are not allowed.
+		Query string
+		// Search attribute the executions are bucketed by, either StartTime or CloseTime.
+		// Executions without a value (e.g. running executions for CloseTime) are not counted.
+		TimeField string
+		Interval  HistogramInterval
+	}
+
+	// CountWorkflowExecutionsHistogramResponse is response to CountWorkflowExecutionsHistogram
+	CountWorkflowExecutionsHistogramResponse struct {
+		// Buckets sorted by StartTime. Buckets without executions are omitted.
+		Buckets []*HistogramBucket
+	}
+
+	HistogramBucket struct {
+		StartTime time.Time // UTC time the bucket starts at
+		Count     int64
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		NamespaceID namespace.ID
@@ -168,6 +196,29 @@ type (
 	}
 )
 
+const (
+	HistogramIntervalUnspecified HistogramInterval = iota
+	HistogramIntervalMinute
+	HistogramIntervalHour
+	HistogramIntervalDay
+	HistogramIntervalWeek
+)
+
+func (i HistogramInterval) String() string {
+	switch i {
+	case HistogramIntervalMinute:
+		return "Minute"
+	case HistogramIntervalHour:
+		return "Hour"
+	case HistogramIntervalDay:
+		return "Day"
+	case HistogramIntervalWeek:
+		return "Week"
+	default:
+		return "Unspecified"
+	}
+}
+
 func (r *ListWorkflowExecutionsRequest) OverrideToken(token []byte) {
 	r.NextPageToken = token
 }
@@ -91,6 +91,21 @@ func (mr *MockVisibilityManagerMockRecorder) CountWorkflowExecutions(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutions", reflect.TypeOf((*MockVisibilityManager)(nil).CountWorkflowExecutions), ctx, request)
 }
 
+// CountWorkflowExecutionsHistogram mocks base method.
+func (m *MockVisibilityManager) CountWorkflowExecutionsHistogram(ctx context.Context, request *CountWorkflowExecutionsHistogramRequest) (*CountWorkflowExecutionsHistogramResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWorkflowExecutionsHistogram", ctx, request)
+	ret0, _ := ret[0].(*CountWorkflowExecutionsHistogramResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkflowExecutionsHistogram indicates an expected call of CountWorkflowExecutionsHistogram.
+func (mr *MockVisibilityManagerMockRecorder) CountWorkflowExecutionsHistogram(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutionsHistogram", reflect.TypeOf((*MockVisibilityManager)(nil).CountWorkflowExecutionsHistogram), ctx, request)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockVisibilityManager) DeleteWorkflowExecution(ctx context.Context, request *VisibilityDeleteWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
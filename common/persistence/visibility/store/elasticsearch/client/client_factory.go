@@ -35,6 +35,8 @@ func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Clie
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, httpClient, logger)
+	case VersionOpenSearch2:
+		return newOpenSearchClient(config, httpClient, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
@@ -44,6 +46,8 @@ func NewCLIClient(config *Config, logger log.Logger) (CLIClient, error) {
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, nil, logger)
+	case VersionOpenSearch2:
+		return newOpenSearchClient(config, nil, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
@@ -53,6 +57,8 @@ func NewFunctionalTestsClient(config *Config, logger log.Logger) (IntegrationTes
 	switch config.Version {
 	case "v8", "v7", "":
 		return newClient(config, nil, logger)
+	case VersionOpenSearch2:
+		return newOpenSearchClient(config, nil, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
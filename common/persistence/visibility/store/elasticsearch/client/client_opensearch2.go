@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/blang/semver/v4"
+	"github.com/olivere/elastic/v7"
+	"github.com/olivere/elastic/v7/uritemplates"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// openSearchClientImpl implements Client for OpenSearch 2.x. OpenSearch is wire compatible with
+	// Elasticsearch 7.10 for most APIs, so only the diverging ones are overridden.
+	openSearchClientImpl struct {
+		*clientImpl
+	}
+
+	openSearchInfo struct {
+		Version struct {
+			Distribution string `json:"distribution"`
+			Number       string `json:"number"`
+		} `json:"version"`
+	}
+
+	openSearchOpenPointInTimeResponse struct {
+		PitID string `json:"pit_id"`
+	}
+
+	openSearchClosePointInTimeResponse struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+)
+
+const (
+	openSearchDistribution = "opensearch"
+)
+
+var (
+	openSearchPointInTimeSupportedIn = semver.MustParseRange(">=2.4.0")
+)
+
+var _ Client = (*openSearchClientImpl)(nil)
+
+// newOpenSearchClient creates an OpenSearch 2.x client
+func newOpenSearchClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*openSearchClientImpl, error) {
+	client, err := newClient(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &openSearchClientImpl{clientImpl: client}, nil
+}
+
+func (c *openSearchClientImpl) IsPointInTimeSupported(ctx context.Context) bool {
+	c.initIsPointInTimeSupported.Do(func() {
+		c.isPointInTimeSupported = c.queryPointInTimeSupported(ctx)
+	})
+	return c.isPointInTimeSupported
+}
+
+func (c *openSearchClientImpl) queryPointInTimeSupported(ctx context.Context) bool {
+	// Ping response of olivere/elastic/v7 doesn't have the distribution field.
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   "/",
+	})
+	if err != nil {
+		return false
+	}
+	var info openSearchInfo
+	if err := json.Unmarshal(res.Body, &info); err != nil {
+		return false
+	}
+	if info.Version.Distribution != openSearchDistribution {
+		return false
+	}
+	version, err := semver.ParseTolerant(info.Version.Number)
+	if err != nil {
+		return false
+	}
+	return openSearchPointInTimeSupportedIn(version)
+}
+
+// OpenPointInTime uses the OpenSearch point in time API, which differs from the Elasticsearch one.
+// Searching with the returned id is the same for both.
+func (c *openSearchClientImpl) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	path, err := uritemplates.Expand("/{index}/_search/point_in_time", map[string]string{
+		"index": index,
+	})
+	if err != nil {
+		return "", err
+	}
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   path,
+		Params: url.Values{"keep_alive": []string{keepAliveInterval}},
+	})
+	if err != nil {
+		return "", err
+	}
+	var resp openSearchOpenPointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return "", err
+	}
+	if resp.PitID == "" {
+		return "", fmt.Errorf("missing point in time id in OpenSearch response: %s", res.Body)
+	}
+	return resp.PitID, nil
+}
+
+func (c *openSearchClientImpl) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "DELETE",
+		Path:   "/_search/point_in_time",
+		Body:   map[string][]string{"pit_id": {id}},
+	})
+	if err != nil {
+		return false, err
+	}
+	var resp openSearchClosePointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return false, err
+	}
+	for _, pit := range resp.Pits {
+		if pit.PitID == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
+
+// IndexPutTemplate creates a composable index template, which is the template format of
+// schema/opensearch/visibility/index_template_v2.json.
+func (c *openSearchClientImpl) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	resp, err := c.esClient.IndexPutIndexTemplate(templateName).BodyString(bodyString).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
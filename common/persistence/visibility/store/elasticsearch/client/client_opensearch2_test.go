@@ -0,0 +1,159 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  url.Values
+	body   string
+}
+
+func newOpenSearchTestClient(t *testing.T, responses map[string]string) (*openSearchClientImpl, *[]recordedRequest) {
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gz, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			body = gz
+		}
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		requests = append(requests, recordedRequest{
+			method: r.Method,
+			path:   r.URL.Path,
+			query:  r.URL.Query(),
+			body:   string(data),
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[r.Method+" "+r.URL.Path]))
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client, err := newOpenSearchClient(&Config{URL: *serverURL}, server.Client(), log.NewNoopLogger())
+	require.NoError(t, err)
+	return client, &requests
+}
+
+func TestOpenSearchClient_IsPointInTimeSupported(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     string
+		expected bool
+	}{
+		{
+			name:     "opensearch 2.11",
+			info:     `{"version":{"distribution":"opensearch","number":"2.11.1"}}`,
+			expected: true,
+		},
+		{
+			name:     "opensearch 2.4",
+			info:     `{"version":{"distribution":"opensearch","number":"2.4.0"}}`,
+			expected: true,
+		},
+		{
+			name:     "opensearch 2.3",
+			info:     `{"version":{"distribution":"opensearch","number":"2.3.0"}}`,
+			expected: false,
+		},
+		{
+			name:     "elasticsearch",
+			info:     `{"version":{"number":"7.17.0"}}`,
+			expected: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, _ := newOpenSearchTestClient(t, map[string]string{"GET /": tc.info})
+			require.Equal(t, tc.expected, client.IsPointInTimeSupported(context.Background()))
+		})
+	}
+}
+
+func TestOpenSearchClient_OpenPointInTime(t *testing.T) {
+	client, requests := newOpenSearchTestClient(t, map[string]string{
+		"POST /temporal_visibility_v1/_search/point_in_time": `{"pit_id":"pit-id","creation_time":1700000000000}`,
+	})
+
+	id, err := client.OpenPointInTime(context.Background(), "temporal_visibility_v1", "1m")
+	require.NoError(t, err)
+	require.Equal(t, "pit-id", id)
+	require.Len(t, *requests, 1)
+	require.Equal(t, "1m", (*requests)[0].query.Get("keep_alive"))
+}
+
+func TestOpenSearchClient_OpenPointInTime_MissingID(t *testing.T) {
+	client, _ := newOpenSearchTestClient(t, map[string]string{
+		"POST /temporal_visibility_v1/_search/point_in_time": `{}`,
+	})
+
+	_, err := client.OpenPointInTime(context.Background(), "temporal_visibility_v1", "1m")
+	require.Error(t, err)
+}
+
+func TestOpenSearchClient_ClosePointInTime(t *testing.T) {
+	client, requests := newOpenSearchTestClient(t, map[string]string{
+		"DELETE /_search/point_in_time": `{"pits":[{"pit_id":"pit-id","successful":true}]}`,
+	})
+
+	succeeded, err := client.ClosePointInTime(context.Background(), "pit-id")
+	require.NoError(t, err)
+	require.True(t, succeeded)
+	require.Len(t, *requests, 1)
+	var body map[string][]string
+	require.NoError(t, json.Unmarshal([]byte((*requests)[0].body), &body))
+	require.Equal(t, []string{"pit-id"}, body["pit_id"])
+
+	succeeded, err = client.ClosePointInTime(context.Background(), "unknown-pit-id")
+	require.NoError(t, err)
+	require.False(t, succeeded)
+}
+
+func TestOpenSearchClient_IndexPutTemplate(t *testing.T) {
+	client, requests := newOpenSearchTestClient(t, map[string]string{
+		"PUT /_index_template/temporal_visibility_v1_template": `{"acknowledged":true}`,
+	})
+
+	acknowledged, err := client.IndexPutTemplate(context.Background(), "temporal_visibility_v1_template", `{"index_patterns":["temporal_visibility_v1*"]}`)
+	require.NoError(t, err)
+	require.True(t, acknowledged)
+	require.Len(t, *requests, 1)
+	require.JSONEq(t, `{"index_patterns":["temporal_visibility_v1*"]}`, (*requests)[0].body)
+}
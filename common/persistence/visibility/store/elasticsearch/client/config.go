@@ -38,6 +38,9 @@ const (
 	// VisibilityAppName is used to find ES indexName for visibility
 	VisibilityAppName          = "visibility"
 	SecondaryVisibilityAppName = "secondary_visibility"
+
+	// VersionOpenSearch2 is the Version to set in Config to connect to OpenSearch 2.x.
+	VersionOpenSearch2 = "opensearch2"
 )
 
 // Config for connecting to Elasticsearch
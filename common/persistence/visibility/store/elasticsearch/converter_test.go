@@ -48,7 +48,7 @@ var errorCases = map[string]string{
 	"select * from a where 1 = 1":            query.InvalidExpressionErrMessage,
 	"select * from a where 1=a":              query.InvalidExpressionErrMessage,
 	"select * from a where zz(k=2)":          query.NotSupportedErrMessage,
-	"select * from a group by k, m":          query.NotSupportedErrMessage,
+	"select * from a group by k, m, n":       query.NotSupportedErrMessage,
 	"select * from a group by k order by id": query.NotSupportedErrMessage,
 	"select * from a where a like '%a%'":     "operator 'like' not allowed in comparison expression",
 	"select * from a where a not like '%a%'": "operator 'not like' not allowed in comparison expression",
@@ -128,6 +128,10 @@ var supportedWhereGroupByCases = map[string]struct {
 		query:   `{"bool":{"filter":{"term":{"id":1}}}}`,
 		groupBy: []string{"status"},
 	},
+	"group by status, channel": {
+		query:   ``,
+		groupBy: []string{"status", "channel"},
+	},
 }
 
 var testNameTypeMap = searchattribute.NewNameTypeMapStub(
@@ -119,10 +119,10 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 			)
 		}
 	case query.FieldNameGroupBy:
-		if fieldName != searchattribute.ExecutionStatus {
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
 			return "", query.NewConverterError(
-				"'group by' clause is only supported for %s search attribute",
-				searchattribute.ExecutionStatus,
+				"'group by' clause is only supported for search attributes of type %s",
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
 			)
 		}
 	}
@@ -61,6 +61,10 @@ const (
 	delimiter                    = "~"
 	scrollKeepAliveInterval      = "1m"
 	pointInTimeKeepAliveInterval = "1m"
+
+	// countGroupByMaxBuckets bounds the number of groups returned by CountWorkflowExecutions with 'group by'.
+	// It must stay below the search.max_buckets cluster setting, which defaults to 65536.
+	countGroupByMaxBuckets = 10000
 )
 
 type (
@@ -523,10 +527,14 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	//     }
 	//   }
 	// }
-	termsAgg := elastic.NewTermsAggregation().Field(groupByFields[len(groupByFields)-1])
+	// The terms aggregation returns only 10 buckets by default, so the size of each level is set such that
+	// the total number of buckets doesn't exceed countGroupByMaxBuckets.
+	bucketsSize := countGroupByBucketsSize(len(groupByFields))
+	termsAgg := elastic.NewTermsAggregation().Field(groupByFields[len(groupByFields)-1]).Size(bucketsSize)
 	for i := len(groupByFields) - 2; i >= 0; i-- {
 		termsAgg = elastic.NewTermsAggregation().
 			Field(groupByFields[i]).
+			Size(bucketsSize).
 			SubAggregation(groupByFields[i+1], termsAgg)
 	}
 	esResponse, err := s.esClient.CountGroupBy(
@@ -542,6 +550,75 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return s.parseCountGroupByResponse(esResponse, groupByFields)
 }
 
+func (s *VisibilityStore) CountWorkflowExecutionsHistogram(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsHistogramRequest,
+) (*manager.CountWorkflowExecutionsHistogramResponse, error) {
+	queryParams, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryParams.GroupBy) > 0 {
+		return nil, serviceerror.NewInvalidArgument("GROUP BY clause is not supported")
+	}
+	interval, err := getCalendarInterval(request.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty buckets are not returned to match SQL stores.
+	histogramAgg := elastic.NewDateHistogramAggregation().
+		Field(request.TimeField).
+		CalendarInterval(interval).
+		MinDocCount(1)
+	esResponse, err := s.esClient.CountGroupBy(
+		ctx,
+		s.index,
+		queryParams.Query,
+		request.TimeField,
+		histogramAgg,
+	)
+	if err != nil {
+		return nil, ConvertElasticsearchClientError("CountWorkflowExecutionsHistogram failed", err)
+	}
+
+	histogram, ok := esResponse.Aggregations.DateHistogram(request.TimeField)
+	if !ok {
+		return nil, serviceerror.NewInternal(
+			fmt.Sprintf("Unable to find %s histogram in Elasticsearch response", request.TimeField),
+		)
+	}
+	response := &manager.CountWorkflowExecutionsHistogramResponse{
+		Buckets: make([]*manager.HistogramBucket, 0, len(histogram.Buckets)),
+	}
+	for _, bucket := range histogram.Buckets {
+		response.Buckets = append(response.Buckets, &manager.HistogramBucket{
+			StartTime: time.UnixMilli(int64(bucket.Key)).UTC(),
+			Count:     bucket.DocCount,
+		})
+	}
+	return response, nil
+}
+
+func getCalendarInterval(interval manager.HistogramInterval) (string, error) {
+	switch interval {
+	case manager.HistogramIntervalMinute:
+		return "1m", nil
+	case manager.HistogramIntervalHour:
+		return "1h", nil
+	case manager.HistogramIntervalDay:
+		return "1d", nil
+	case manager.HistogramIntervalWeek:
+		return "1w", nil
+	default:
+		return "", serviceerror.NewInvalidArgument(fmt.Sprintf("invalid histogram interval: %v", interval))
+	}
+}
+
+func countGroupByBucketsSize(numGroupByFields int) int {
+	return int(math.Pow(countGroupByMaxBuckets, 1/float64(numGroupByFields)))
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -1255,7 +1255,7 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
 				MustNot(namespaceDivisionExists),
 			searchattribute.ExecutionStatus,
-			elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+			elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(10000),
 		).
 		Return(
 			&elastic.SearchResult{
@@ -1294,18 +1294,60 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 		resp),
 	)
 
-	// test only allowed to group by a single field
-	request.Query = "GROUP BY ExecutionStatus, WorkflowType"
+	// test group by two keyword fields
+	request.Query = "GROUP BY WorkflowType, CustomKeywordField"
+	s.mockESClient.EXPECT().
+		CountGroupBy(
+			gomock.Any(),
+			testIndex,
+			elastic.NewBoolQuery().
+				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
+				MustNot(namespaceDivisionExists),
+			searchattribute.WorkflowType,
+			elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(100).SubAggregation(
+				"CustomKeywordField",
+				elastic.NewTermsAggregation().Field("CustomKeywordField").Size(100),
+			),
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					searchattribute.WorkflowType: json.RawMessage(
+						`{"buckets":[{"key":"wf-type","doc_count":10,"CustomKeywordField":{"buckets":[{"key":"kw","doc_count":10}]}}]}`,
+					),
+				},
+			},
+			nil,
+		)
+	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	wfTypePayload, _ := searchattribute.EncodeValue("wf-type", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	keywordPayload, _ := searchattribute.EncodeValue("kw", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.True(temporalproto.DeepEqual(
+		&manager.CountWorkflowExecutionsResponse{
+			Count: 10,
+			Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+				{
+					GroupValues: []*commonpb.Payload{wfTypePayload, keywordPayload},
+					Count:       10,
+				},
+			},
+		},
+		resp),
+	)
+
+	// test only allowed to group by at most two fields
+	request.Query = "GROUP BY ExecutionStatus, WorkflowType, TaskQueue"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause supports only a single field")
+	s.Contains(err.Error(), "'group by' clause supports at most 2 fields")
 	s.Nil(resp)
 
-	// test only allowed to group by ExecutionStatus
-	request.Query = "GROUP BY WorkflowType"
+	// test only allowed to group by keyword fields
+	request.Query = "GROUP BY CustomIntField"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause is only supported for ExecutionStatus search attribute")
+	s.Contains(err.Error(), "'group by' clause is only supported for search attributes of type Keyword")
 	s.Nil(resp)
 }
 
@@ -1338,7 +1380,7 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 			name:    "group by one field",
 			groupBy: []string{searchattribute.ExecutionStatus},
 			aggName: searchattribute.ExecutionStatus,
-			agg:     elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+			agg:     elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(10000),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
 					searchattribute.ExecutionStatus: json.RawMessage(
@@ -1376,9 +1418,9 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 			name:    "group by two fields",
 			groupBy: []string{searchattribute.ExecutionStatus, searchattribute.WorkflowType},
 			aggName: searchattribute.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(100).SubAggregation(
 				searchattribute.WorkflowType,
-				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType),
+				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(100),
 			),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
@@ -1453,11 +1495,11 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 				searchattribute.WorkflowID,
 			},
 			aggName: searchattribute.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(21).SubAggregation(
 				searchattribute.WorkflowType,
-				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).SubAggregation(
+				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(21).SubAggregation(
 					searchattribute.WorkflowID,
-					elastic.NewTermsAggregation().Field(searchattribute.WorkflowID),
+					elastic.NewTermsAggregation().Field(searchattribute.WorkflowID).Size(21),
 				),
 			),
 			mockResponse: &elastic.SearchResult{
@@ -1592,6 +1634,62 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	}
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutionsHistogram() {
+	request := &manager.CountWorkflowExecutionsHistogramRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "WorkflowType = 'wf-type'",
+		TimeField:   searchattribute.StartTime,
+		Interval:    manager.HistogramIntervalHour,
+	}
+	s.mockESClient.EXPECT().
+		CountGroupBy(
+			gomock.Any(),
+			testIndex,
+			elastic.NewBoolQuery().
+				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
+				MustNot(namespaceDivisionExists).
+				Filter(elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.WorkflowType, "wf-type"))),
+			searchattribute.StartTime,
+			elastic.NewDateHistogramAggregation().
+				Field(searchattribute.StartTime).
+				CalendarInterval("1h").
+				MinDocCount(1),
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					searchattribute.StartTime: json.RawMessage(
+						`{"buckets":[
+							{"key_as_string":"2024-01-01T10:00:00.000Z","key":1704103200000,"doc_count":7},
+							{"key_as_string":"2024-01-01T12:00:00.000Z","key":1704110400000,"doc_count":3}
+						]}`,
+					),
+				},
+			},
+			nil,
+		)
+	resp, err := s.visibilityStore.CountWorkflowExecutionsHistogram(context.Background(), request)
+	s.NoError(err)
+	s.Equal(
+		&manager.CountWorkflowExecutionsHistogramResponse{
+			Buckets: []*manager.HistogramBucket{
+				{StartTime: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), Count: 7},
+				{StartTime: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), Count: 3},
+			},
+		},
+		resp,
+	)
+
+	// test group by is not allowed
+	request.Query = "GROUP BY ExecutionStatus"
+	resp, err = s.visibilityStore.CountWorkflowExecutionsHistogram(context.Background(), request)
+	s.Error(err)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+	s.Nil(resp)
+}
+
 func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index string, docID string) (*elastic.GetResult, error) {
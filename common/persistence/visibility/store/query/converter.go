@@ -39,6 +39,9 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 )
 
+// MaxGroupByFields is the maximum number of fields allowed in the 'group by' clause.
+const MaxGroupByFields = 2
+
 type (
 	ExprConverter interface {
 		Convert(expr sqlparser.Expr) (elastic.Query, error)
@@ -244,8 +247,12 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
 		queryParams.Query = query
 	}
 
-	if len(sel.GroupBy) > 1 {
-		return nil, NewConverterError("%s: 'group by' clause supports only a single field", NotSupportedErrMessage)
+	if len(sel.GroupBy) > MaxGroupByFields {
+		return nil, NewConverterError(
+			"%s: 'group by' clause supports at most %d fields",
+			NotSupportedErrMessage,
+			MaxGroupByFields,
+		)
 	}
 	for _, groupByExpr := range sel.GroupBy {
 		_, colName, err := convertColName(c.fnInterceptor, groupByExpr, FieldNameGroupBy)
@@ -35,6 +35,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
@@ -58,6 +59,16 @@ type (
 		getDatetimeFormat() string
 
 		getCoalesceCloseTimeExpr() sqlparser.Expr
+
+		// getDatetimeTruncExpr returns an expression truncating the datetime column to the start of the
+		// histogram bucket it falls in.
+		getDatetimeTruncExpr(colName string, interval manager.HistogramInterval) string
+	}
+
+	// customSearchAttributeConverter is implemented by plugin query converters of schemas that don't have
+	// a dedicated column for every custom search attribute.
+	customSearchAttributeConverter interface {
+		convertCustomSearchAttributeColName(col *saColName)
 	}
 
 	QueryConverter struct {
@@ -75,6 +86,8 @@ type (
 		queryString string
 		// List of search attributes to group by (field name, not db name).
 		groupBy []string
+		// List of db expressions to group by, matching groupBy.
+		groupByDbColNames []string
 	}
 )
 
@@ -183,11 +196,7 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	if err != nil {
 		return nil, err
 	}
-	groupByDbNames := make([]string, len(qp.groupBy))
-	for i, fieldName := range qp.groupBy {
-		groupByDbNames[i] = searchattribute.GetSqlDbColName(fieldName)
-	}
-	queryString, queryArgs := c.buildCountStmt(c.namespaceID, qp.queryString, groupByDbNames)
+	queryString, queryArgs := c.buildCountStmt(c.namespaceID, qp.queryString, qp.groupByDbColNames)
 	return &sqlplugin.VisibilitySelectFilter{
 		Query:     queryString,
 		QueryArgs: queryArgs,
@@ -195,6 +204,42 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	}, nil
 }
 
+func (c *QueryConverter) BuildHistogramStmt(
+	timeField string,
+	interval manager.HistogramInterval,
+) (*sqlplugin.VisibilitySelectFilter, error) {
+	switch interval {
+	case manager.HistogramIntervalMinute,
+		manager.HistogramIntervalHour,
+		manager.HistogramIntervalDay,
+		manager.HistogramIntervalWeek:
+	default:
+		return nil, query.NewConverterError("%s: histogram interval %v", query.NotSupportedErrMessage, interval)
+	}
+	qp, err := c.convertWhereString(c.queryString)
+	if err != nil {
+		return nil, err
+	}
+	if len(qp.groupBy) > 0 {
+		return nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	}
+	colName := searchattribute.GetSqlDbColName(timeField)
+	queryString := fmt.Sprintf("%s is not null", colName)
+	if len(qp.queryString) > 0 {
+		queryString = fmt.Sprintf("%s and %s", qp.queryString, queryString)
+	}
+	queryString, queryArgs := c.buildCountStmt(
+		c.namespaceID,
+		queryString,
+		[]string{c.getDatetimeTruncExpr(colName, interval)},
+	)
+	return &sqlplugin.VisibilitySelectFilter{
+		Query:     queryString,
+		QueryArgs: queryArgs,
+		GroupBy:   []string{timeField},
+	}, nil
+}
+
 func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, error) {
 	where := strings.TrimSpace(queryString)
 	if where != "" &&
@@ -223,6 +268,7 @@ func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, e
 		// The parser already ensures the type is saColName.
 		colName := groupByExpr.(*saColName)
 		res.groupBy = append(res.groupBy, colName.fieldName)
+		res.groupByDbColNames = append(res.groupByDbColNames, sqlparser.String(colName))
 	}
 	return res, nil
 }
@@ -281,10 +327,11 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		}
 	}
 
-	if len(sel.GroupBy) > 1 {
+	if len(sel.GroupBy) > query.MaxGroupByFields {
 		return query.NewConverterError(
-			"%s: 'group by' clause supports only a single field",
+			"%s: 'group by' clause supports at most %d fields",
 			query.NotSupportedErrMessage,
+			query.MaxGroupByFields,
 		)
 	}
 	for k := range sel.GroupBy {
@@ -292,13 +339,24 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		if err != nil {
 			return err
 		}
-		if colName.fieldName != searchattribute.ExecutionStatus {
+		if colName.valueType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
 			return query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
+				"%s: 'group by' clause is only supported for search attributes of type %s",
 				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
 			)
 		}
+		// Custom search attributes might not be set. Elasticsearch doesn't return a group for executions
+		// missing the field, so they are filtered out here too.
+		if _, isCustom := c.saTypeMap.Custom()[colName.fieldName]; isCustom {
+			sel.Where.Expr = &sqlparser.AndExpr{
+				Left: sel.Where.Expr,
+				Right: &sqlparser.IsExpr{
+					Operator: sqlparser.IsNotNullStr,
+					Expr:     colName,
+				},
+			}
+		}
 	}
 
 	return nil
@@ -503,6 +561,11 @@ func (c *QueryConverter) convertColName(exprRef *sqlparser.Expr) (*saColName, er
 		saFieldName,
 		saType,
 	)
+	if csac, ok := c.pluginQueryConverter.(customSearchAttributeConverter); ok {
+		if _, isCustom := c.saTypeMap.Custom()[saFieldName]; isCustom {
+			csac.convertCustomSearchAttributeColName(newExpr)
+		}
+	}
 	*exprRef = newExpr
 	return newExpr, nil
 }
@@ -25,6 +25,7 @@
 package sql
 
 import (
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
@@ -34,6 +35,7 @@ import (
 
 func NewQueryConverter(
 	pluginName string,
+	searchAttributesStorage string,
 	namespaceName namespace.Name,
 	namespaceID namespace.ID,
 	saTypeMap searchattribute.NameTypeMap,
@@ -44,6 +46,9 @@ func NewQueryConverter(
 	case mysql.PluginName:
 		return newMySQLQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
 	case postgresql.PluginName, postgresql.PluginNamePGX:
+		if searchAttributesStorage == config.SQLSearchAttributesStorageJSONB {
+			return newPostgreSQLJSONBQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
+		}
 		return newPostgreSQLQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
 	case sqlite.PluginName:
 		return newSqliteQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
@@ -32,6 +32,7 @@ import (
 	"github.com/temporalio/sqlparser"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -113,6 +114,23 @@ func (c *mysqlQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *mysqlQueryConverter) getDatetimeTruncExpr(colName string, interval manager.HistogramInterval) string {
+	switch interval {
+	case manager.HistogramIntervalMinute:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d %%H:%%i:00')", colName)
+	case manager.HistogramIntervalHour:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d %%H:00:00')", colName)
+	case manager.HistogramIntervalDay:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d 00:00:00')", colName)
+	default:
+		return fmt.Sprintf(
+			"DATE_FORMAT(DATE_SUB(%s, INTERVAL WEEKDAY(%s) DAY), '%%Y-%%m-%%d 00:00:00')",
+			colName,
+			colName,
+		)
+	}
+}
+
 func (c *mysqlQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -30,6 +30,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/temporalio/sqlparser"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 )
 
@@ -56,6 +57,36 @@ func (s *mysqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *mysqlQueryConverterSuite) TestGetDatetimeTruncExpr() {
+	var tests = []struct {
+		interval manager.HistogramInterval
+		output   string
+	}{
+		{
+			interval: manager.HistogramIntervalMinute,
+			output:   "DATE_FORMAT(start_time, '%Y-%m-%d %H:%i:00')",
+		},
+		{
+			interval: manager.HistogramIntervalHour,
+			output:   "DATE_FORMAT(start_time, '%Y-%m-%d %H:00:00')",
+		},
+		{
+			interval: manager.HistogramIntervalDay,
+			output:   "DATE_FORMAT(start_time, '%Y-%m-%d 00:00:00')",
+		},
+		{
+			interval: manager.HistogramIntervalWeek,
+			output:   "DATE_FORMAT(DATE_SUB(start_time, INTERVAL WEEKDAY(start_time) DAY), '%Y-%m-%d 00:00:00')",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.interval.String(), func() {
+			s.Equal(tc.output, s.queryConverter.getDatetimeTruncExpr("start_time", tc.interval))
+		})
+	}
+}
+
 func (s *mysqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
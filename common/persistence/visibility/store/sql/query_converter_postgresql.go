@@ -29,8 +29,10 @@ import (
 	"strings"
 
 	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -42,13 +44,20 @@ type (
 		Type  *sqlparser.ConvertType
 	}
 
-	pgQueryConverter struct{}
+	pgQueryConverter struct {
+		// jsonbSearchAttributes is set when custom search attributes are only stored in the search_attributes
+		// JSONB column (see config.SQLSearchAttributesStorageJSONB).
+		jsonbSearchAttributes bool
+	}
 )
 
 const (
-	jsonBuildArrayFuncName = "jsonb_build_array"
-	jsonContainsOp         = "@>"
-	ftsMatchOp             = "@@"
+	searchAttributesColName = "search_attributes"
+
+	jsonBuildArrayFuncName  = "jsonb_build_array"
+	jsonBuildObjectFuncName = "jsonb_build_object"
+	jsonContainsOp          = "@>"
+	ftsMatchOp              = "@@"
 )
 
 var (
@@ -57,6 +66,7 @@ var (
 
 var _ sqlparser.Expr = (*pgCastExpr)(nil)
 var _ pluginQueryConverter = (*pgQueryConverter)(nil)
+var _ customSearchAttributeConverter = (*pgQueryConverter)(nil)
 
 func (node *pgCastExpr) Format(buf *sqlparser.TrackedBuffer) {
 	buf.Myprintf("%v::%v", node.Value, node.Type)
@@ -79,6 +89,23 @@ func newPostgreSQLQueryConverter(
 	)
 }
 
+func newPostgreSQLJSONBQueryConverter(
+	namespaceName namespace.Name,
+	namespaceID namespace.ID,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
+	queryString string,
+) *QueryConverter {
+	return newQueryConverterInternal(
+		&pgQueryConverter{jsonbSearchAttributes: true},
+		namespaceName,
+		namespaceID,
+		saTypeMap,
+		saMapper,
+		queryString,
+	)
+}
+
 func (c *pgQueryConverter) getDatetimeFormat() string {
 	return "2006-01-02 15:04:05.999999"
 }
@@ -91,6 +118,50 @@ func (c *pgQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *pgQueryConverter) getDatetimeTruncExpr(colName string, interval manager.HistogramInterval) string {
+	var field string
+	switch interval {
+	case manager.HistogramIntervalMinute:
+		field = "minute"
+	case manager.HistogramIntervalHour:
+		field = "hour"
+	case manager.HistogramIntervalDay:
+		field = "day"
+	default:
+		field = "week"
+	}
+	return fmt.Sprintf("date_trunc('%s', %s)", field, colName)
+}
+
+// convertCustomSearchAttributeColName reads the custom search attribute from the search_attributes JSONB
+// column if there are no pre-allocated columns. The expressions are the same as the ones of the generated
+// columns in the default schema, so expression indexes must be created with the exact same expression.
+func (c *pgQueryConverter) convertCustomSearchAttributeColName(col *saColName) {
+	if !c.jsonbSearchAttributes {
+		return
+	}
+	field := "'" + strings.ReplaceAll(col.fieldName, "'", "''") + "'"
+	var expr string
+	switch col.valueType {
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		expr = fmt.Sprintf("(%s->%s)::boolean", searchAttributesColName, field)
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		expr = fmt.Sprintf("convert_ts(%s->>%s)", searchAttributesColName, field)
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		expr = fmt.Sprintf("(%s->%s)::decimal", searchAttributesColName, field)
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		expr = fmt.Sprintf("(%s->%s)::bigint", searchAttributesColName, field)
+	case enumspb.INDEXED_VALUE_TYPE_TEXT:
+		expr = fmt.Sprintf("(%s->>%s)::tsvector", searchAttributesColName, field)
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		expr = fmt.Sprintf("(%s->%s)", searchAttributesColName, field)
+	default:
+		expr = fmt.Sprintf("(%s->>%s)", searchAttributesColName, field)
+	}
+	col.dbColName = newColName(expr)
+	col.jsonb = true
+}
+
 func (c *pgQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -209,6 +280,18 @@ func (c *pgQueryConverter) newJsonContainsExpr(
 	jsonExpr sqlparser.Expr,
 	valueExpr sqlparser.Expr,
 ) sqlparser.Expr {
+	if col, ok := jsonExpr.(*saColName); ok && col.jsonb {
+		// Containment on the whole column can use the GIN index on search_attributes.
+		return &sqlparser.ComparisonExpr{
+			Operator: jsonContainsOp,
+			Left:     newColName(searchAttributesColName),
+			Right: newFuncExpr(
+				jsonBuildObjectFuncName,
+				newUnsafeSQLString(strings.ReplaceAll(col.fieldName, "'", "''")),
+				newFuncExpr(jsonBuildArrayFuncName, valueExpr),
+			),
+		}
+	}
 	return &sqlparser.ComparisonExpr{
 		Operator: jsonContainsOp,
 		Left:     jsonExpr,
@@ -28,9 +28,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/temporalio/sqlparser"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -56,6 +59,36 @@ func (s *postgresqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *postgresqlQueryConverterSuite) TestGetDatetimeTruncExpr() {
+	var tests = []struct {
+		interval manager.HistogramInterval
+		output   string
+	}{
+		{
+			interval: manager.HistogramIntervalMinute,
+			output:   "date_trunc('minute', start_time)",
+		},
+		{
+			interval: manager.HistogramIntervalHour,
+			output:   "date_trunc('hour', start_time)",
+		},
+		{
+			interval: manager.HistogramIntervalDay,
+			output:   "date_trunc('day', start_time)",
+		},
+		{
+			interval: manager.HistogramIntervalWeek,
+			output:   "date_trunc('week', start_time)",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.interval.String(), func() {
+			s.Equal(tc.output, s.queryConverter.getDatetimeTruncExpr("start_time", tc.interval))
+		})
+	}
+}
+
 func (s *postgresqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -157,3 +190,117 @@ func (s *postgresqlQueryConverterSuite) TestConvertTextComparisonExpr() {
 		})
 	}
 }
+
+func TestPostgreSQLJSONBQueryConverter(t *testing.T) {
+	var tests = []testCase{
+		{
+			name:   "keyword",
+			input:  "AliasForKeyword01 = 'foo'",
+			output: "((search_attributes->>'Keyword01') = 'foo') and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "keyword starts with",
+			input:  "AliasForKeyword01 STARTS_WITH 'foo'",
+			output: "((search_attributes->>'Keyword01') like 'foo%' escape '!') and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "int range",
+			input:  "AliasForInt01 BETWEEN 1 AND 5",
+			output: "((search_attributes->'Int01')::bigint between 1 and 5) and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "double",
+			input:  "AliasForDouble01 > 1.5",
+			output: "((search_attributes->'Double01')::decimal > 1.5) and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "bool",
+			input:  "AliasForBool01 = true",
+			output: "((search_attributes->'Bool01')::boolean = true) and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "datetime",
+			input:  "AliasForDatetime01 < '2020-01-01T00:00:00Z'",
+			output: "(convert_ts(search_attributes->>'Datetime01') < '2020-01-01 00:00:00') and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "is null",
+			input:  "AliasForKeyword01 IS NULL",
+			output: "((search_attributes->>'Keyword01') is null) and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "text",
+			input:  "AliasForText01 = 'foo bar'",
+			output: "((search_attributes->>'Text01')::tsvector @@ 'foo | bar'::tsquery) and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "keyword list",
+			input:  "AliasForKeywordList01 in ('foo', 'bar')",
+			output: "(search_attributes @> jsonb_build_object('KeywordList01', jsonb_build_array('foo')) or search_attributes @> jsonb_build_object('KeywordList01', jsonb_build_array('bar'))) and TemporalNamespaceDivision is null",
+		},
+		{
+			name:   "predefined search attributes keep their columns",
+			input:  "BuildIds = 'foo' AND WorkflowId = 'bar'",
+			output: "(BuildIds @> jsonb_build_array('foo') and workflow_id = 'bar') and TemporalNamespaceDivision is null",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newPostgreSQLJSONBQueryConverter(
+				testNamespaceName,
+				testNamespaceID,
+				searchattribute.TestNameTypeMap,
+				&searchattribute.TestMapper{},
+				"",
+			)
+			qp, err := c.convertWhereString(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.output, qp.queryString)
+		})
+	}
+}
+
+func TestPostgreSQLJSONBQueryConverter_BuildCountStmtGroupBy(t *testing.T) {
+	c := newPostgreSQLJSONBQueryConverter(
+		testNamespaceName,
+		testNamespaceID,
+		searchattribute.TestNameTypeMap,
+		&searchattribute.TestMapper{},
+		"GROUP BY ExecutionStatus, AliasForKeyword01",
+	)
+	filter, err := c.BuildCountStmt()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"SELECT status, (search_attributes->>'Keyword01'), COUNT(*) FROM executions_visibility "+
+			"WHERE (namespace_id = ?) AND TemporalNamespaceDivision is null and "+
+			"(search_attributes->>'Keyword01') is not null "+
+			"GROUP BY status, (search_attributes->>'Keyword01')",
+		filter.Query,
+	)
+	require.Equal(t, []any{testNamespaceID.String()}, filter.QueryArgs)
+	require.Equal(t, []string{searchattribute.ExecutionStatus, "Keyword01"}, filter.GroupBy)
+}
+
+func TestPostgreSQLQueryConverter_BuildHistogramStmt(t *testing.T) {
+	c := newPostgreSQLQueryConverter(
+		testNamespaceName,
+		testNamespaceID,
+		searchattribute.TestNameTypeMap,
+		&searchattribute.TestMapper{},
+		"WorkflowType = 'foo'",
+	)
+	filter, err := c.BuildHistogramStmt(searchattribute.CloseTime, manager.HistogramIntervalHour)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"SELECT date_trunc('hour', close_time), COUNT(*) FROM executions_visibility "+
+			"WHERE (namespace_id = ?) AND (workflow_type_name = 'foo') and TemporalNamespaceDivision is null and "+
+			"close_time is not null "+
+			"GROUP BY date_trunc('hour', close_time)",
+		filter.Query,
+	)
+	require.Equal(t, []any{testNamespaceID.String()}, filter.QueryArgs)
+	require.Equal(t, []string{searchattribute.CloseTime}, filter.GroupBy)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/temporalio/sqlparser"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -75,6 +76,20 @@ func (c *sqliteQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *sqliteQueryConverter) getDatetimeTruncExpr(colName string, interval manager.HistogramInterval) string {
+	switch interval {
+	case manager.HistogramIntervalMinute:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d %%H:%%M:00', %s)", colName)
+	case manager.HistogramIntervalHour:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d %%H:00:00', %s)", colName)
+	case manager.HistogramIntervalDay:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d 00:00:00', %s)", colName)
+	default:
+		// Go back 6 days and then forward to the next Monday, i.e. the Monday of the same week.
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d 00:00:00', %s, 'start of day', '-6 days', 'weekday 1')", colName)
+	}
+}
+
 func (c *sqliteQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
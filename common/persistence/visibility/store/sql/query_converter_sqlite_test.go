@@ -30,6 +30,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/temporalio/sqlparser"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 )
 
@@ -56,6 +57,36 @@ func (s *sqliteQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *sqliteQueryConverterSuite) TestGetDatetimeTruncExpr() {
+	var tests = []struct {
+		interval manager.HistogramInterval
+		output   string
+	}{
+		{
+			interval: manager.HistogramIntervalMinute,
+			output:   "strftime('%Y-%m-%d %H:%M:00', start_time)",
+		},
+		{
+			interval: manager.HistogramIntervalHour,
+			output:   "strftime('%Y-%m-%d %H:00:00', start_time)",
+		},
+		{
+			interval: manager.HistogramIntervalDay,
+			output:   "strftime('%Y-%m-%d 00:00:00', start_time)",
+		},
+		{
+			interval: manager.HistogramIntervalWeek,
+			output:   "strftime('%Y-%m-%d 00:00:00', start_time, 'start of day', '-6 days', 'weekday 1')",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.interval.String(), func() {
+			s.Equal(tc.output, s.queryConverter.getDatetimeTruncExpr("start_time", tc.interval))
+		})
+	}
+}
+
 func (s *sqliteQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -36,6 +36,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
@@ -122,28 +123,49 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 			name:  "group by one field",
 			input: "GROUP BY ExecutionStatus",
 			output: &queryParams{
-				queryString: "TemporalNamespaceDivision is null",
-				groupBy:     []string{searchattribute.ExecutionStatus},
+				queryString:       "TemporalNamespaceDivision is null",
+				groupBy:           []string{searchattribute.ExecutionStatus},
+				groupByDbColNames: []string{"status"},
 			},
 			err: nil,
 		},
 		{
-			name:   "group by two fields not supported",
-			input:  "GROUP BY ExecutionStatus, WorkflowType",
+			name:  "group by two fields",
+			input: "GROUP BY WorkflowType, TaskQueue",
+			output: &queryParams{
+				queryString:       "TemporalNamespaceDivision is null",
+				groupBy:           []string{searchattribute.WorkflowType, searchattribute.TaskQueue},
+				groupByDbColNames: []string{"workflow_type_name", "task_queue"},
+			},
+			err: nil,
+		},
+		{
+			name:  "group by custom keyword field",
+			input: "AliasForInt01 = 1 GROUP BY ExecutionStatus, AliasForKeyword01",
+			output: &queryParams{
+				queryString:       "(Int01 = 1) and TemporalNamespaceDivision is null and Keyword01 is not null",
+				groupBy:           []string{searchattribute.ExecutionStatus, "Keyword01"},
+				groupByDbColNames: []string{"status", "Keyword01"},
+			},
+			err: nil,
+		},
+		{
+			name:   "group by three fields not supported",
+			input:  "GROUP BY ExecutionStatus, WorkflowType, TaskQueue",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause supports only a single field",
+				"%s: 'group by' clause supports at most 2 fields",
 				query.NotSupportedErrMessage,
 			),
 		},
 		{
-			name:   "group by non ExecutionStatus",
-			input:  "GROUP BY WorkflowType",
+			name:   "group by non keyword field not supported",
+			input:  "GROUP BY AliasForInt01",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
+				"%s: 'group by' clause is only supported for search attributes of type %s",
 				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
 			),
 		},
 		{
@@ -182,6 +204,60 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 	}
 }
 
+func (s *queryConverterSuite) TestBuildHistogramStmt() {
+	var tests = []struct {
+		name     string
+		query    string
+		interval manager.HistogramInterval
+		err      error
+	}{
+		{
+			name:     "valid",
+			query:    "AliasForKeyword01 = 'foo'",
+			interval: manager.HistogramIntervalDay,
+			err:      nil,
+		},
+		{
+			name:     "unspecified interval",
+			query:    "",
+			interval: manager.HistogramIntervalUnspecified,
+			err: query.NewConverterError(
+				"%s: histogram interval %v",
+				query.NotSupportedErrMessage,
+				manager.HistogramIntervalUnspecified,
+			),
+		},
+		{
+			name:     "group by not supported",
+			query:    "GROUP BY ExecutionStatus",
+			interval: manager.HistogramIntervalDay,
+			err:      query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			qc := newQueryConverterInternal(
+				s.pqc,
+				testNamespaceName,
+				testNamespaceID,
+				searchattribute.TestNameTypeMap,
+				&searchattribute.TestMapper{},
+				tc.query,
+			)
+			filter, err := qc.BuildHistogramStmt(searchattribute.StartTime, tc.interval)
+			if tc.err == nil {
+				s.NoError(err)
+				s.Contains(filter.Query, s.pqc.getDatetimeTruncExpr("start_time", tc.interval))
+				s.Equal([]string{searchattribute.StartTime}, filter.GroupBy)
+			} else {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			}
+		})
+	}
+}
+
 func (s *queryConverterSuite) TestConvertAndExpr() {
 	var tests = []testCase{
 		{
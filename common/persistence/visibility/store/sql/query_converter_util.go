@@ -53,6 +53,9 @@ type (
 		alias     string
 		fieldName string
 		valueType enumspb.IndexedValueType
+		// jsonb is set when the search attribute is read from the search_attributes JSONB column
+		// instead of a dedicated column.
+		jsonb bool
 	}
 )
 
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"go.temporal.io/api/common/v1"
@@ -41,6 +42,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	persistencesql "go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
@@ -51,6 +53,7 @@ import (
 type (
 	VisibilityStore struct {
 		sqlStore                       persistencesql.SqlStore
+		searchAttributesStorage        string
 		searchAttributesProvider       searchattribute.Provider
 		searchAttributesMapperProvider searchattribute.MapperProvider
 	}
@@ -69,6 +72,11 @@ func NewSQLVisibilityStore(
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) (*VisibilityStore, error) {
+	if cfg.SearchAttributesStorage == config.SQLSearchAttributesStorageJSONB &&
+		cfg.PluginName != postgresql.PluginName && cfg.PluginName != postgresql.PluginNamePGX {
+		return nil, fmt.Errorf("search attributes storage %s is not supported by sql plugin %s",
+			cfg.SearchAttributesStorage, cfg.PluginName)
+	}
 	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r, logger, metricsHandler)
 	db, err := refDbConn.Get()
 	if err != nil {
@@ -76,6 +84,7 @@ func NewSQLVisibilityStore(
 	}
 	return &VisibilityStore{
 		sqlStore:                       persistencesql.NewSqlStore(db, logger),
+		searchAttributesStorage:        cfg.SearchAttributesStorage,
 		searchAttributesProvider:       searchAttributesProvider,
 		searchAttributesMapperProvider: searchAttributesMapperProvider,
 	}, nil
@@ -197,6 +206,7 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 
 	converter := NewQueryConverter(
 		s.GetName(),
+		s.searchAttributesStorage,
 		request.Namespace,
 		request.NamespaceID,
 		saTypeMap,
@@ -275,6 +285,7 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 
 	converter := NewQueryConverter(
 		s.GetName(),
+		s.searchAttributesStorage,
 		request.Namespace,
 		request.NamespaceID,
 		saTypeMap,
@@ -347,6 +358,82 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return resp, nil
 }
 
+func (s *VisibilityStore) CountWorkflowExecutionsHistogram(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsHistogramRequest,
+) (*manager.CountWorkflowExecutionsHistogramResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := NewQueryConverter(
+		s.GetName(),
+		s.searchAttributesStorage,
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		saMapper,
+		request.Query,
+	)
+	selectFilter, err := converter.BuildHistogramStmt(request.TimeField, request.Interval)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("CountWorkflowExecutionsHistogram operation failed. Query failed: %v", err))
+	}
+	resp := &manager.CountWorkflowExecutionsHistogramResponse{
+		Buckets: make([]*manager.HistogramBucket, 0, len(rows)),
+	}
+	for _, row := range rows {
+		startTime, err := parseHistogramBucketStartTime(row.GroupValues[0])
+		if err != nil {
+			return nil, err
+		}
+		resp.Buckets = append(resp.Buckets, &manager.HistogramBucket{
+			StartTime: startTime,
+			Count:     row.Count,
+		})
+	}
+	slices.SortFunc(resp.Buckets, func(a, b *manager.HistogramBucket) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	return resp, nil
+}
+
+// parseHistogramBucketStartTime parses the truncated datetime returned by the database. PostgreSQL returns
+// a timestamp while MySQL and SQLite return a formatted string.
+func parseHistogramBucketStartTime(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC(), nil
+	case string:
+		t, err := time.ParseInLocation(time.DateTime, v, time.UTC)
+		if err != nil {
+			return time.Time{}, serviceerror.NewInternal(
+				fmt.Sprintf("Unable to parse histogram bucket start time %q: %v", v, err))
+		}
+		return t, nil
+	default:
+		return time.Time{}, serviceerror.NewInternal(
+			fmt.Sprintf("Unable to parse histogram bucket start time (got: %v of type: %T)", v, v))
+	}
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -91,6 +91,21 @@ func (mr *MockVisibilityStoreMockRecorder) CountWorkflowExecutions(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutions", reflect.TypeOf((*MockVisibilityStore)(nil).CountWorkflowExecutions), ctx, request)
 }
 
+// CountWorkflowExecutionsHistogram mocks base method.
+func (m *MockVisibilityStore) CountWorkflowExecutionsHistogram(ctx context.Context, request *manager.CountWorkflowExecutionsHistogramRequest) (*manager.CountWorkflowExecutionsHistogramResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWorkflowExecutionsHistogram", ctx, request)
+	ret0, _ := ret[0].(*manager.CountWorkflowExecutionsHistogramResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkflowExecutionsHistogram indicates an expected call of CountWorkflowExecutionsHistogram.
+func (mr *MockVisibilityStoreMockRecorder) CountWorkflowExecutionsHistogram(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkflowExecutionsHistogram", reflect.TypeOf((*MockVisibilityStore)(nil).CountWorkflowExecutionsHistogram), ctx, request)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockVisibilityStore) DeleteWorkflowExecution(ctx context.Context, request *manager.VisibilityDeleteWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
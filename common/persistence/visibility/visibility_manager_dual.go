@@ -237,6 +237,26 @@ func (v *VisibilityManagerDual) CountWorkflowExecutions(
 	return v.managerSelector.readManager(request.Namespace).CountWorkflowExecutions(ctx, request)
 }
 
+func (v *VisibilityManagerDual) CountWorkflowExecutionsHistogram(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsHistogramRequest,
+) (*manager.CountWorkflowExecutionsHistogramResponse, error) {
+	if v.enableShadowReadMode() {
+		ms, err := v.managerSelector.readManagers(request.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		//nolint:errcheck // ignore error since it's shadow request
+		go ms[1].CountWorkflowExecutionsHistogram(ctx, request)
+		res, err := ms[0].CountWorkflowExecutionsHistogram(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		return res, err
+	}
+	return v.managerSelector.readManager(request.Namespace).CountWorkflowExecutionsHistogram(ctx, request)
+}
+
 func (v *VisibilityManagerDual) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
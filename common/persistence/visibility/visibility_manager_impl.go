@@ -189,6 +189,29 @@ func (p *visibilityManagerImpl) CountWorkflowExecutions(
 	return response, err
 }
 
+func (p *visibilityManagerImpl) CountWorkflowExecutionsHistogram(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsHistogramRequest,
+) (*manager.CountWorkflowExecutionsHistogramResponse, error) {
+	if request.TimeField != searchattribute.StartTime && request.TimeField != searchattribute.CloseTime {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"histogram is only supported for %s and %s, got %q",
+			searchattribute.StartTime,
+			searchattribute.CloseTime,
+			request.TimeField,
+		))
+	}
+	switch request.Interval {
+	case manager.HistogramIntervalMinute,
+		manager.HistogramIntervalHour,
+		manager.HistogramIntervalDay,
+		manager.HistogramIntervalWeek:
+	default:
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid histogram interval: %v", request.Interval))
+	}
+	return p.store.CountWorkflowExecutionsHistogram(ctx, request)
+}
+
 func (p *visibilityManagerImpl) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
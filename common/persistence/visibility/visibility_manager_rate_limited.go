@@ -160,6 +160,16 @@ func (m *visibilityManagerRateLimited) CountWorkflowExecutions(
 	return m.delegate.CountWorkflowExecutions(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) CountWorkflowExecutionsHistogram(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsHistogramRequest,
+) (*manager.CountWorkflowExecutionsHistogramResponse, error) {
+	if ok := allow(ctx, "CountWorkflowExecutionsHistogram", m.readRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.CountWorkflowExecutionsHistogram(ctx, request)
+}
+
 func (m *visibilityManagerRateLimited) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -41,6 +42,7 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/searchattribute"
 	"go.uber.org/mock/gomock"
 )
 
@@ -217,3 +219,58 @@ func (s *VisibilityManagerSuite) TestGetWorkflowExecution() {
 	_, err = s.visibilityManager.GetWorkflowExecution(context.Background(), request)
 	s.Equal(persistence.ErrPersistenceSystemLimitExceeded, err)
 }
+
+func (s *VisibilityManagerSuite) TestCountWorkflowExecutionsHistogram() {
+	request := &manager.CountWorkflowExecutionsHistogramRequest{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+		TimeField:   searchattribute.StartTime,
+		Interval:    manager.HistogramIntervalHour,
+	}
+	response := &manager.CountWorkflowExecutionsHistogramResponse{
+		Buckets: []*manager.HistogramBucket{
+			{StartTime: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), Count: 5},
+		},
+	}
+	s.visibilityStore.EXPECT().CountWorkflowExecutionsHistogram(gomock.Any(), request).Return(response, nil)
+	s.metricsHandler.EXPECT().
+		WithTags(
+			metrics.OperationTag(metrics.VisibilityPersistenceCountWorkflowExecutionsHistogramScope),
+			metrics.VisibilityPluginNameTag(s.visibilityStore.GetName()),
+			metrics.VisibilityIndexNameTag(s.visibilityStore.GetIndexName()),
+		).
+		Return(metrics.NoopMetricsHandler).Times(2)
+	resp, err := s.visibilityManager.CountWorkflowExecutionsHistogram(context.Background(), request)
+	s.NoError(err)
+	s.Equal(response, resp)
+
+	// no remaining tokens
+	_, err = s.visibilityManager.CountWorkflowExecutionsHistogram(context.Background(), request)
+	s.Equal(persistence.ErrPersistenceSystemLimitExceeded, err)
+}
+
+func (s *VisibilityManagerSuite) TestCountWorkflowExecutionsHistogram_InvalidArgument() {
+	visibilityManager := newVisibilityManagerImpl(s.visibilityStore, log.NewNoopLogger())
+
+	_, err := visibilityManager.CountWorkflowExecutionsHistogram(
+		context.Background(),
+		&manager.CountWorkflowExecutionsHistogramRequest{
+			NamespaceID: testNamespaceUUID,
+			Namespace:   testNamespace,
+			TimeField:   searchattribute.ExecutionTime,
+			Interval:    manager.HistogramIntervalHour,
+		},
+	)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = visibilityManager.CountWorkflowExecutionsHistogram(
+		context.Background(),
+		&manager.CountWorkflowExecutionsHistogramRequest{
+			NamespaceID: testNamespaceUUID,
+			Namespace:   testNamespace,
+			TimeField:   searchattribute.CloseTime,
+		},
+	)
+	s.ErrorAs(err, &invalidArgument)
+}
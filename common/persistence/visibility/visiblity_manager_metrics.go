@@ -181,6 +181,16 @@ func (m *visibilityManagerMetrics) CountWorkflowExecutions(
 	return response, m.updateErrorMetric(handler, err)
 }
 
+func (m *visibilityManagerMetrics) CountWorkflowExecutionsHistogram(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsHistogramRequest,
+) (*manager.CountWorkflowExecutionsHistogramResponse, error) {
+	handler, startTime := m.tagScope(metrics.VisibilityPersistenceCountWorkflowExecutionsHistogramScope)
+	response, err := m.delegate.CountWorkflowExecutionsHistogram(ctx, request)
+	metrics.VisibilityPersistenceLatency.With(handler).Record(time.Since(startTime))
+	return response, m.updateErrorMetric(handler, err)
+}
+
 func (m *visibilityManagerMetrics) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
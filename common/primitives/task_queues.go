@@ -29,8 +29,11 @@ const (
 	DefaultWorkerTaskQueue = "default-worker-tq"
 	PerNSWorkerTaskQueue   = "temporal-sys-per-ns-tq"
 
-	MigrationActivityTQ           = "temporal-sys-migration-activity-tq"
-	AddSearchAttributesActivityTQ = "temporal-sys-add-search-attributes-activity-tq"
-	DeleteNamespaceActivityTQ     = "temporal-sys-delete-namespace-activity-tq"
-	DLQActivityTQ                 = "temporal-sys-dlq-activity-tq"
+	MigrationActivityTQ            = "temporal-sys-migration-activity-tq"
+	AddSearchAttributesActivityTQ  = "temporal-sys-add-search-attributes-activity-tq"
+	DeleteNamespaceActivityTQ      = "temporal-sys-delete-namespace-activity-tq"
+	DLQActivityTQ                  = "temporal-sys-dlq-activity-tq"
+	ArchivalVerifierActivityTQ     = "temporal-sys-archival-verifier-activity-tq"
+	VisibilityMigrationActivityTQ  = "temporal-sys-visibility-migration-activity-tq"
+	PersistenceMigrationActivityTQ = "temporal-sys-persistence-migration-activity-tq"
 )
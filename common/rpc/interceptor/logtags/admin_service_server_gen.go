@@ -45,6 +45,8 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 		return nil
 	case *adminservice.DeepHealthCheckRequest:
 		return nil
+	case *adminservice.DeleteDynamicConfigOverrideRequest:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -59,13 +61,21 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetWorkflowExecution().GetRunId()),
 		}
+	case *adminservice.DescribeHistoryPersistenceUsageRequest:
+		return nil
 	case *adminservice.DescribeMutableStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *adminservice.DescribePersistenceMigrationRequest:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
+	case *adminservice.EvaluateDynamicConfigRequest:
+		return nil
+	case *adminservice.ExportArchivedWorkflowExecutionsRequest:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.GenerateLastHistoryReplicationTasksRequest:
@@ -79,6 +89,8 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 		return nil
 	case *adminservice.GetDLQTasksRequest:
 		return nil
+	case *adminservice.GetDynamicConfigOverridesRequest:
+		return nil
 	case *adminservice.GetNamespaceRequest:
 		return nil
 	case *adminservice.GetNamespaceReplicationMessagesRequest:
@@ -110,6 +122,8 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 		return nil
 	case *adminservice.ListClustersRequest:
 		return nil
+	case *adminservice.ListDynamicConfigSettingsRequest:
+		return nil
 	case *adminservice.ListHistoryTasksRequest:
 		return nil
 	case *adminservice.ListQueuesRequest:
@@ -148,6 +162,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 			tag.WorkflowID(r.GetWorkflowId()),
 			tag.WorkflowRunID(r.GetRunId()),
 		}
+	case *adminservice.SetDynamicConfigOverrideRequest:
+		return nil
+	case *adminservice.StartArchivalVerificationRequest:
+		return nil
+	case *adminservice.StartPersistenceMigrationRequest:
+		return nil
+	case *adminservice.StartVisibilityMigrationRequest:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -71,6 +71,8 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerRequest(req any) []tag.Ta
 			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetRequest().GetExecution().GetRunId()),
 		}
+	case *historyservice.EvaluateDynamicConfigRequest:
+		return nil
 	case *historyservice.ExecuteMultiOperationRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowId()),
@@ -96,6 +98,8 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerRequest(req any) []tag.Ta
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *historyservice.GetPersistenceUsageRequest:
+		return nil
 	case *historyservice.GetReplicationMessagesRequest:
 		return nil
 	case *historyservice.GetReplicationStatusRequest:
@@ -81,6 +81,8 @@ var (
 		"ListTasks":                 {},
 		"CompleteNexusOperation":    {}, // NamespaceId is in the completion token for this request.
 		"DeepHealthCheck":           {},
+		"GetPersistenceUsage":       {},
+		"EvaluateDynamicConfig":     {},
 	}
 )
 
@@ -43,6 +43,8 @@ const (
 	CassandraPort = "CASSANDRA_PORT"
 	// CassandraDefaultPort Cassandra default port
 	CassandraDefaultPort = 9042
+	// CassandraScylla env, set to true if the Cassandra endpoints are ScyllaDB
+	CassandraScylla = "CASSANDRA_SCYLLA"
 
 	// MySQLSeeds env
 	MySQLSeeds = "MYSQL_SEEDS"
@@ -181,6 +183,19 @@ func GetCassandraPort() int {
 	return p
 }
 
+// GetCassandraScylla returns true if the Cassandra endpoints are ScyllaDB
+func GetCassandraScylla() bool {
+	scylla := os.Getenv(CassandraScylla)
+	if scylla == "" {
+		return false
+	}
+	s, err := strconv.ParseBool(scylla)
+	if err != nil {
+		panic(fmt.Sprintf("error getting env %v", CassandraScylla))
+	}
+	return s
+}
+
 // GetMySQLAddress return the cassandra address
 func GetMySQLAddress() string {
 	addr := os.Getenv(MySQLSeeds)
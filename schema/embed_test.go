@@ -46,6 +46,7 @@ func TestSchemaDirs(t *testing.T) {
 	requireContains(t, []string{
 		"postgresql/v12/temporal",
 		"postgresql/v12/visibility",
+		"postgresql/v12/visibility_jsonb",
 	}, dirs)
 }
 
@@ -0,0 +1,109 @@
+CREATE EXTENSION IF NOT EXISTS btree_gin;
+
+-- convert_ts converts a timestamp in RFC3339 to UTC timestamp without time zone.
+CREATE FUNCTION convert_ts(s VARCHAR) RETURNS TIMESTAMP AS $$
+BEGIN
+  RETURN s::timestamptz at time zone 'UTC';
+END
+$$ LANGUAGE plpgsql IMMUTABLE RETURNS NULL ON NULL INPUT;
+
+CREATE TABLE executions_visibility (
+  namespace_id            CHAR(64)      NOT NULL,
+  run_id                  CHAR(64)      NOT NULL,
+  start_time              TIMESTAMP     NOT NULL,
+  execution_time          TIMESTAMP     NOT NULL,
+  workflow_id             VARCHAR(255)  NOT NULL,
+  workflow_type_name      VARCHAR(255)  NOT NULL,
+  status                  INTEGER       NOT NULL,  -- enum WorkflowExecutionStatus {RUNNING, COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  close_time              TIMESTAMP     NULL,
+  history_length          BIGINT        NULL,
+  history_size_bytes      BIGINT        NULL,
+  execution_duration      BIGINT        NULL,
+  state_transition_count  BIGINT        NULL,
+  memo                    BYTEA         NULL,
+  encoding                VARCHAR(64)   NOT NULL,
+  task_queue              VARCHAR(255)  NOT NULL DEFAULT '',
+  search_attributes       JSONB         NULL,
+  parent_workflow_id      VARCHAR(255)  NULL,
+  parent_run_id           VARCHAR(255)  NULL,
+  root_workflow_id        VARCHAR(255)  NOT NULL DEFAULT '',
+  root_run_id             VARCHAR(255)  NOT NULL DEFAULT '',
+
+  -- Each predefined search attribute has its own generated column.
+  -- Since PostgreSQL doesn't support virtual columns, all columns are stored.
+  -- PostgreSQL doesn't auto cast to the corresponding column type, so we need to explicitly do it.
+  -- Custom search attributes don't have columns, they are only stored in search_attributes.
+
+  -- Pre-defined search attributes
+  TemporalChangeVersion         JSONB         GENERATED ALWAYS AS (search_attributes->'TemporalChangeVersion')                    STORED,
+  BinaryChecksums               JSONB         GENERATED ALWAYS AS (search_attributes->'BinaryChecksums')                          STORED,
+  BatcherUser                   VARCHAR(255)  GENERATED ALWAYS AS (search_attributes->>'BatcherUser')                             STORED,
+  TemporalScheduledStartTime    TIMESTAMP     GENERATED ALWAYS AS (convert_ts(search_attributes->>'TemporalScheduledStartTime'))  STORED,
+  TemporalScheduledById         VARCHAR(255)  GENERATED ALWAYS AS (search_attributes->>'TemporalScheduledById')                   STORED,
+  TemporalSchedulePaused        BOOLEAN       GENERATED ALWAYS AS ((search_attributes->'TemporalSchedulePaused')::boolean)        STORED,
+  TemporalNamespaceDivision     VARCHAR(255)  GENERATED ALWAYS AS (search_attributes->>'TemporalNamespaceDivision')               STORED,
+  BuildIds                      JSONB         GENERATED ALWAYS AS (search_attributes->'BuildIds')                                 STORED,
+  TemporalPauseInfo            JSONB         GENERATED ALWAYS AS (search_attributes->'TemporalPauseInfo')                       STORED,
+
+  PRIMARY KEY  (namespace_id, run_id)
+);
+
+CREATE INDEX default_idx                ON executions_visibility (namespace_id, (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_execution_time          ON executions_visibility (namespace_id, execution_time,         (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_workflow_id             ON executions_visibility (namespace_id, workflow_id,            (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_workflow_type           ON executions_visibility (namespace_id, workflow_type_name,     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_status                  ON executions_visibility (namespace_id, status,                 (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_history_length          ON executions_visibility (namespace_id, history_length,         (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_history_size_bytes      ON executions_visibility (namespace_id, history_size_bytes,     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_execution_duration      ON executions_visibility (namespace_id, execution_duration,     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_state_transition_count  ON executions_visibility (namespace_id, state_transition_count, (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_task_queue              ON executions_visibility (namespace_id, task_queue,             (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_parent_workflow_id      ON executions_visibility (namespace_id, parent_workflow_id,     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_parent_run_id           ON executions_visibility (namespace_id, parent_run_id,          (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_root_workflow_id        ON executions_visibility (namespace_id, root_workflow_id,       (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_root_run_id             ON executions_visibility (namespace_id, root_run_id,            (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+
+-- Indexes for the predefined search attributes
+CREATE INDEX by_temporal_change_version       ON executions_visibility USING GIN (namespace_id, TemporalChangeVersion jsonb_path_ops);
+CREATE INDEX by_binary_checksums              ON executions_visibility USING GIN (namespace_id, BinaryChecksums jsonb_path_ops);
+CREATE INDEX by_build_ids                     ON executions_visibility USING GIN (namespace_id, BuildIds jsonb_path_ops);
+CREATE INDEX by_temporal_pause_info           ON executions_visibility USING GIN (namespace_id, TemporalPauseInfo jsonb_path_ops);
+CREATE INDEX by_batcher_user                  ON executions_visibility (namespace_id, BatcherUser,                (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_scheduled_start_time ON executions_visibility (namespace_id, TemporalScheduledStartTime, (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_scheduled_by_id      ON executions_visibility (namespace_id, TemporalScheduledById,      (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_schedule_paused      ON executions_visibility (namespace_id, TemporalSchedulePaused,     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_namespace_division   ON executions_visibility (namespace_id, TemporalNamespaceDivision,  (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+
+-- GIN index for the custom search attributes. It serves the containment (@>) predicates used for KeywordList
+-- search attributes.
+CREATE INDEX by_search_attributes ON executions_visibility USING GIN (namespace_id, search_attributes jsonb_path_ops);
+
+-- Expression indexes for the custom search attributes created in cluster metadata by default. An expression index
+-- is only used if its expression is the same as the one generated by the query converter, i.e. the expression of
+-- the generated column of the same type in the default visibility schema. Indexes for additional custom search
+-- attributes must be created the same way.
+CREATE INDEX by_bool_01     ON executions_visibility (namespace_id, ((search_attributes->'Bool01')::boolean),       (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_bool_02     ON executions_visibility (namespace_id, ((search_attributes->'Bool02')::boolean),       (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_bool_03     ON executions_visibility (namespace_id, ((search_attributes->'Bool03')::boolean),       (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_datetime_01 ON executions_visibility (namespace_id, (convert_ts(search_attributes->>'Datetime01')), (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_datetime_02 ON executions_visibility (namespace_id, (convert_ts(search_attributes->>'Datetime02')), (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_datetime_03 ON executions_visibility (namespace_id, (convert_ts(search_attributes->>'Datetime03')), (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_double_01   ON executions_visibility (namespace_id, ((search_attributes->'Double01')::decimal),     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_double_02   ON executions_visibility (namespace_id, ((search_attributes->'Double02')::decimal),     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_double_03   ON executions_visibility (namespace_id, ((search_attributes->'Double03')::decimal),     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_int_01      ON executions_visibility (namespace_id, ((search_attributes->'Int01')::bigint),         (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_int_02      ON executions_visibility (namespace_id, ((search_attributes->'Int02')::bigint),         (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_int_03      ON executions_visibility (namespace_id, ((search_attributes->'Int03')::bigint),         (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_01  ON executions_visibility (namespace_id, (search_attributes->>'Keyword01'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_02  ON executions_visibility (namespace_id, (search_attributes->>'Keyword02'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_03  ON executions_visibility (namespace_id, (search_attributes->>'Keyword03'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_04  ON executions_visibility (namespace_id, (search_attributes->>'Keyword04'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_05  ON executions_visibility (namespace_id, (search_attributes->>'Keyword05'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_06  ON executions_visibility (namespace_id, (search_attributes->>'Keyword06'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_07  ON executions_visibility (namespace_id, (search_attributes->>'Keyword07'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_08  ON executions_visibility (namespace_id, (search_attributes->>'Keyword08'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_09  ON executions_visibility (namespace_id, (search_attributes->>'Keyword09'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_keyword_10  ON executions_visibility (namespace_id, (search_attributes->>'Keyword10'),              (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_text_01     ON executions_visibility USING GIN (namespace_id, ((search_attributes->>'Text01')::tsvector));
+CREATE INDEX by_text_02     ON executions_visibility USING GIN (namespace_id, ((search_attributes->>'Text02')::tsvector));
+CREATE INDEX by_text_03     ON executions_visibility USING GIN (namespace_id, ((search_attributes->>'Text03')::tsvector));
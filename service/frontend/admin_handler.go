@@ -31,6 +31,8 @@ import (
 	"io"
 	"maps"
 	"net"
+	"slices"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -59,11 +61,16 @@ import (
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/overrides"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -85,7 +92,10 @@ import (
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/archivalverifier"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/persistencemigration"
+	"go.temporal.io/server/service/worker/visibilitymigration"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -95,6 +105,11 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	exportArchivedHistoryPageSize           = 100
+	// Every exported execution carries its whole history, so export pages are kept much smaller than
+	// archival query pages to stay within gRPC message size limits.
+	exportArchivedWorkflowExecutionsDefaultPageSize = 10
+	exportArchivedWorkflowExecutionsMaxPageSize     = 100
 )
 
 type (
@@ -131,6 +146,11 @@ type (
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
+		archiverProvider           provider.ArchiverProvider
+		archivalMetadata           archiver.ArchivalMetadata
+		persistenceMigration       *config.DataStoreMigration
+		dynamicConfigOverrides     *overrides.Manager
+		dynamicConfig              *dynamicconfig.Collection
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -165,6 +185,10 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ArchiverProvider                    provider.ArchiverProvider
+		ArchivalMetadata                    archiver.ArchivalMetadata
+		DynamicConfigOverrides              *overrides.Manager
+		DynamicConfig                       *dynamicconfig.Collection
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -204,10 +228,13 @@ func NewAdminHandler(
 	)
 
 	return &AdminHandler{
-		logger:                args.Logger,
-		status:                common.DaemonStatusInitialized,
-		numberOfHistoryShards: args.PersistenceConfig.NumHistoryShards,
-		config:                args.Config,
+		logger:                 args.Logger,
+		status:                 common.DaemonStatusInitialized,
+		numberOfHistoryShards:  args.PersistenceConfig.NumHistoryShards,
+		persistenceMigration:   args.PersistenceConfig.Migration,
+		dynamicConfigOverrides: args.DynamicConfigOverrides,
+		dynamicConfig:          args.DynamicConfig,
+		config:                 args.Config,
 		namespaceDLQHandler: namespace.NewDLQMessageHandler(
 			namespaceReplicationTaskExecutor,
 			args.NamespaceReplicationQueue,
@@ -248,6 +275,8 @@ func NewAdminHandler(
 		clusterMetadata:      args.ClusterMetadata,
 		healthServer:         args.HealthServer,
 		historyHealthChecker: historyHealthChecker,
+		archiverProvider:     args.ArchiverProvider,
+		archivalMetadata:     args.ArchivalMetadata,
 		taskCategoryRegistry: args.CategoryRegistry,
 		matchingClient:       args.matchingClient,
 	}
@@ -752,6 +781,628 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 	}, nil
 }
 
+// ExportArchivedWorkflowExecutions returns a page of archived visibility records of a namespace, each with the
+// archived history of its execution.
+func (adh *AdminHandler) ExportArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportArchivedWorkflowExecutionsRequest,
+) (_ *adminservice.ExportArchivedWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	if request.GetPageSize() <= 0 {
+		request.PageSize = exportArchivedWorkflowExecutionsDefaultPageSize
+	} else if request.GetPageSize() > exportArchivedWorkflowExecutionsMaxPageSize {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errPageSizeTooBigMessage, exportArchivedWorkflowExecutionsMaxPageSize))
+	}
+
+	if !adh.archivalMetadata.GetVisibilityConfig().ClusterConfiguredForArchival() {
+		return nil, errClusterIsNotConfiguredForVisibilityArchival
+	}
+	if !adh.archivalMetadata.GetVisibilityConfig().ReadEnabled() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalVisibility
+	}
+	if !adh.archivalMetadata.GetHistoryConfig().ReadEnabled() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalHistory
+	}
+
+	entry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	if entry.VisibilityArchivalState().State != enumspb.ARCHIVAL_STATE_ENABLED {
+		return nil, errNamespaceIsNotConfiguredForVisibilityArchival
+	}
+	if entry.HistoryArchivalState().URI == "" {
+		// URI is only empty if the namespace has never enabled history archival.
+		return nil, errNamespaceIsNotConfiguredForHistoryArchival
+	}
+
+	visibilityURI, err := archiver.NewURI(entry.VisibilityArchivalState().URI)
+	if err != nil {
+		return nil, err
+	}
+	visibilityArchiver, err := adh.archiverProvider.GetVisibilityArchiver(visibilityURI.Scheme(), string(primitives.FrontendService))
+	if err != nil {
+		return nil, err
+	}
+	historyURI, err := archiver.NewURI(entry.HistoryArchivalState().URI)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := adh.archiverProvider.GetHistoryArchiver(historyURI.Scheme(), string(primitives.FrontendService))
+	if err != nil {
+		return nil, err
+	}
+
+	searchAttributes, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err))
+	}
+
+	queryResp, err := visibilityArchiver.Query(
+		ctx,
+		visibilityURI,
+		&archiver.QueryVisibilityRequest{
+			NamespaceID:   entry.ID().String(),
+			PageSize:      int(request.GetPageSize()),
+			NextPageToken: request.GetNextPageToken(),
+			Query:         request.GetQuery(),
+		},
+		searchAttributes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	executions := make([]*adminservice.ArchivedWorkflowExecution, 0, len(queryResp.Executions))
+	for _, record := range queryResp.Executions {
+		historyBatches, err := adh.getArchivedHistoryBatches(ctx, historyArchiver, historyURI, entry.ID(), record.GetExecution())
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			adh.logger.Warn("Archived history not found for archived visibility record.",
+				tag.WorkflowNamespaceID(entry.ID().String()),
+				tag.WorkflowID(record.GetExecution().GetWorkflowId()),
+				tag.WorkflowRunID(record.GetExecution().GetRunId()),
+			)
+			executions = append(executions, &adminservice.ArchivedWorkflowExecution{
+				VisibilityRecord: record,
+				HistoryNotFound:  true,
+			})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, &adminservice.ArchivedWorkflowExecution{
+			VisibilityRecord: record,
+			HistoryBatches:   historyBatches,
+		})
+	}
+
+	return &adminservice.ExportArchivedWorkflowExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: queryResp.NextPageToken,
+	}, nil
+}
+
+// StartArchivalVerification starts the archival verifier workflow for a namespace. Only one verification
+// may run per namespace at a time.
+func (adh *AdminHandler) StartArchivalVerification(
+	ctx context.Context,
+	request *adminservice.StartArchivalVerificationRequest,
+) (_ *adminservice.StartArchivalVerificationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetSampleRate() < 0 || request.GetSampleRate() > 1 {
+		return nil, errInvalidSampleRate
+	}
+	if !adh.archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() {
+		return nil, errClusterIsNotConfiguredForHistoryArchival
+	}
+	if !adh.archivalMetadata.GetHistoryConfig().ReadEnabled() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalHistory
+	}
+
+	workflowID := fmt.Sprintf("%s-%s", archivalverifier.WorkflowName, request.GetNamespace())
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: primitives.DefaultWorkerTaskQueue,
+			ID:        workflowID,
+		},
+		archivalverifier.WorkflowName,
+		archivalverifier.VerifierParams{
+			Namespace:  namespace.Name(request.GetNamespace()),
+			Query:      request.GetQuery(),
+			SampleRate: request.GetSampleRate(),
+			Rearchive:  request.GetRearchive(),
+		},
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf(errUnableToStartWorkflowMessage, archivalverifier.WorkflowName, err),
+		)
+	}
+	return &adminservice.StartArchivalVerificationResponse{
+		WorkflowId: run.GetID(),
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// StartVisibilityMigration starts the visibility migration workflow for a namespace. Only one migration
+// may run per namespace at a time.
+func (adh *AdminHandler) StartVisibilityMigration(
+	ctx context.Context,
+	request *adminservice.StartVisibilityMigrationRequest,
+) (_ *adminservice.StartVisibilityMigrationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if _, isDual := adh.visibilityMgr.(*visibility.VisibilityManagerDual); !isDual {
+		return nil, errClusterIsNotConfiguredForSecondaryVisibility
+	}
+	if _, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	workflowID := fmt.Sprintf("%s-%s", visibilitymigration.WorkflowName, request.GetNamespace())
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: primitives.DefaultWorkerTaskQueue,
+			ID:        workflowID,
+		},
+		visibilitymigration.WorkflowName,
+		visibilitymigration.MigrationParams{
+			Namespace:    namespace.Name(request.GetNamespace()),
+			Query:        request.GetQuery(),
+			SkipBackfill: request.GetSkipBackfill(),
+		},
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf(errUnableToStartWorkflowMessage, visibilitymigration.WorkflowName, err),
+		)
+	}
+	return &adminservice.StartVisibilityMigrationResponse{
+		WorkflowId: run.GetID(),
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// StartPersistenceMigration starts the persistence migration workflow, which backfills the target store of the
+// data store migration of the cluster and cuts its shards over to it. Only one migration may run at a time.
+func (adh *AdminHandler) StartPersistenceMigration(
+	ctx context.Context,
+	request *adminservice.StartPersistenceMigrationRequest,
+) (_ *adminservice.StartPersistenceMigrationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if adh.persistenceMigration == nil {
+		return nil, errClusterIsNotConfiguredForPersistenceMigration
+	}
+	shardIDs := request.GetShardIds()
+	for _, shardID := range shardIDs {
+		if shardID < 1 || shardID > adh.numberOfHistoryShards {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidShardIDMessage, shardID, adh.numberOfHistoryShards))
+		}
+	}
+	if len(shardIDs) == 0 {
+		for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: primitives.DefaultWorkerTaskQueue,
+			ID:        persistencemigration.WorkflowID,
+		},
+		persistencemigration.WorkflowName,
+		persistencemigration.MigrationParams{
+			ShardIDs:    shardIDs,
+			SkipCutover: request.GetSkipCutover(),
+		},
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf(errUnableToStartWorkflowMessage, persistencemigration.WorkflowName, err),
+		)
+	}
+	return &adminservice.StartPersistenceMigrationResponse{
+		WorkflowId: run.GetID(),
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// DescribePersistenceMigration returns the progress of the latest persistence migration workflow.
+func (adh *AdminHandler) DescribePersistenceMigration(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceMigrationRequest,
+) (_ *adminservice.DescribePersistenceMigrationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if adh.persistenceMigration == nil {
+		return nil, errClusterIsNotConfiguredForPersistenceMigration
+	}
+
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	execution, err := sdkClient.DescribeWorkflowExecution(ctx, persistencemigration.WorkflowID, "")
+	if err != nil {
+		if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+			// no migration was started yet
+			return &adminservice.DescribePersistenceMigrationResponse{
+				TargetStore: adh.persistenceMigration.TargetStore,
+			}, nil
+		}
+		return nil, err
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	value, err := sdkClient.QueryWorkflow(ctx, persistencemigration.WorkflowID, runID, persistencemigration.QueryTypeProgress)
+	if err != nil {
+		return nil, err
+	}
+	var progress persistencemigration.MigrationProgress
+	if err := value.Get(&progress); err != nil {
+		return nil, err
+	}
+
+	shards := make([]*adminservice.PersistenceMigrationShardProgress, 0, len(progress.Shards))
+	for _, shard := range progress.Shards {
+		shards = append(shards, &adminservice.PersistenceMigrationShardProgress{
+			ShardId:          shard.ShardID,
+			State:            shard.State,
+			ExecutionsCopied: shard.ExecutionsCopied,
+			Mismatches:       shard.Mismatches,
+			LastError:        shard.LastError,
+		})
+	}
+	return &adminservice.DescribePersistenceMigrationResponse{
+		TargetStore:      adh.persistenceMigration.TargetStore,
+		Status:           execution.GetWorkflowExecutionInfo().GetStatus(),
+		MetadataMigrated: progress.MetadataMigrated,
+		Shards:           shards,
+	}, nil
+}
+
+// DescribeHistoryPersistenceUsage returns the persistence usage of each namespace, as reported by the available
+// history hosts.
+func (adh *AdminHandler) DescribeHistoryPersistenceUsage(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryPersistenceUsageRequest,
+) (_ *adminservice.DescribeHistoryPersistenceUsageResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	historyHosts := resolver.AvailableMembers()
+
+	type hostResult struct {
+		address string
+		usage   []*persistencespb.NamespacePersistenceUsage
+		err     error
+	}
+	resultCh := make(chan hostResult, len(historyHosts))
+	for _, host := range historyHosts {
+		go func(hostAddress string) {
+			resp, err := adh.historyClient.GetPersistenceUsage(ctx, &historyservice.GetPersistenceUsageRequest{
+				HostAddress: hostAddress,
+				Namespace:   request.GetNamespace(),
+			})
+			resultCh <- hostResult{address: hostAddress, usage: resp.GetUsage(), err: err}
+		}(host.GetAddress())
+	}
+
+	response := &adminservice.DescribeHistoryPersistenceUsageResponse{}
+	for range historyHosts {
+		result := <-resultCh
+		if result.err != nil {
+			adh.logger.Warn("Unable to get persistence usage of history host.", tag.Address(result.address), tag.Error(result.err))
+			response.UnreachableHosts = append(response.UnreachableHosts, result.address)
+			continue
+		}
+		response.Hosts = append(response.Hosts, &adminservice.HistoryHostPersistenceUsage{
+			Address: result.address,
+			Usage:   result.usage,
+		})
+	}
+	sort.Slice(response.Hosts, func(i, j int) bool {
+		return response.Hosts[i].GetAddress() < response.Hosts[j].GetAddress()
+	})
+	sort.Strings(response.UnreachableHosts)
+	response.Usage = sumPersistenceUsage(response.Hosts)
+	return response, nil
+}
+
+// sumPersistenceUsage sums the usage of every host by namespace and operation class.
+func sumPersistenceUsage(hosts []*adminservice.HistoryHostPersistenceUsage) []*persistencespb.NamespacePersistenceUsage {
+	type usageKey struct {
+		namespace      string
+		operationClass string
+	}
+	totals := make(map[usageKey]*persistencespb.NamespacePersistenceUsage)
+	for _, host := range hosts {
+		for _, usage := range host.GetUsage() {
+			key := usageKey{namespace: usage.GetNamespace(), operationClass: usage.GetOperationClass()}
+			total, ok := totals[key]
+			if !ok {
+				total = &persistencespb.NamespacePersistenceUsage{
+					Namespace:      usage.GetNamespace(),
+					OperationClass: usage.GetOperationClass(),
+				}
+				totals[key] = total
+			}
+			total.Rps += usage.GetRps()
+			total.ThrottledRps += usage.GetThrottledRps()
+			total.QuotaRps += usage.GetQuotaRps()
+		}
+	}
+	result := make([]*persistencespb.NamespacePersistenceUsage, 0, len(totals))
+	for _, total := range totals {
+		result = append(result, total)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].GetNamespace() != result[j].GetNamespace() {
+			return result[i].GetNamespace() < result[j].GetNamespace()
+		}
+		return result[i].GetOperationClass() < result[j].GetOperationClass()
+	})
+	return result
+}
+
+// GetDynamicConfigOverrides returns the dynamic config overrides and the audit log of their recent changes.
+func (adh *AdminHandler) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+) (_ *adminservice.GetDynamicConfigOverridesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	stored, err := adh.dynamicConfigOverrides.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := &adminservice.GetDynamicConfigOverridesResponse{}
+	for _, override := range stored.GetOverrides() {
+		if request.GetKey() == "" || strings.EqualFold(override.GetKey(), request.GetKey()) {
+			response.Overrides = append(response.Overrides, override)
+		}
+	}
+	for _, entry := range stored.GetAuditLog() {
+		override := entry.GetCurrent()
+		if override == nil {
+			override = entry.GetPrevious()
+		}
+		if request.GetKey() == "" || strings.EqualFold(override.GetKey(), request.GetKey()) {
+			response.AuditLog = append(response.AuditLog, entry)
+		}
+	}
+	return response, nil
+}
+
+// SetDynamicConfigOverride creates or replaces the dynamic config override of a key and constraints, and applies
+// it on this host. Other hosts load it within the refresh interval of overrides.
+func (adh *AdminHandler) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+) (_ *adminservice.SetDynamicConfigOverrideResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetIdentity() == "" {
+		return nil, serviceerror.NewInvalidArgument("Identity is required to set a dynamic config override.")
+	}
+	if request.GetExpireTime() != nil && !request.GetExpireTime().AsTime().After(time.Now()) {
+		return nil, serviceerror.NewInvalidArgument("Expire time of the dynamic config override is in the past.")
+	}
+	override := &persistencespb.DynamicConfigOverride{
+		Key:            request.GetKey(),
+		Constraints:    request.GetConstraints(),
+		Value:          request.GetValue(),
+		ExpireTime:     request.GetExpireTime(),
+		Identity:       authenticatedIdentity(ctx),
+		ClientIdentity: request.GetIdentity(),
+		Reason:         request.GetReason(),
+	}
+	key, _, err := overrides.Parse(override)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid dynamic config override: %v.", err))
+	}
+	override.Key = key.String()
+
+	if err := adh.dynamicConfigOverrides.Set(ctx, override); err != nil {
+		return nil, err
+	}
+	adh.refreshDynamicConfigOverrides(ctx)
+	return &adminservice.SetDynamicConfigOverrideResponse{}, nil
+}
+
+// DeleteDynamicConfigOverride deletes the dynamic config override of a key and constraints.
+func (adh *AdminHandler) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+) (_ *adminservice.DeleteDynamicConfigOverrideResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetIdentity() == "" {
+		return nil, serviceerror.NewInvalidArgument("Identity is required to delete a dynamic config override.")
+	}
+
+	err := adh.dynamicConfigOverrides.Delete(
+		ctx,
+		request.GetKey(),
+		request.GetConstraints(),
+		authenticatedIdentity(ctx),
+		request.GetIdentity(),
+		request.GetReason(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	adh.refreshDynamicConfigOverrides(ctx)
+	return &adminservice.DeleteDynamicConfigOverrideResponse{}, nil
+}
+
+// authenticatedIdentity returns the subject of the claims the caller was authorized with, or else the subject of
+// its verified TLS client certificate. It returns an empty string if the caller is not authenticated.
+func authenticatedIdentity(ctx context.Context) string {
+	if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims.Subject != "" {
+		return claims.Subject
+	}
+	if cert := authorization.PeerCert(authorization.TLSInfoFromContext(ctx)); cert != nil {
+		return cert.Subject.String()
+	}
+	return ""
+}
+
+// EvaluateDynamicConfig returns the effective value of a dynamic config setting on this host or a history host.
+func (adh *AdminHandler) EvaluateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.EvaluateDynamicConfigRequest,
+) (_ *adminservice.EvaluateDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetKey() == "" {
+		return nil, serviceerror.NewInvalidArgument("Key is not set on request.")
+	}
+
+	hostAddress := adh.hostInfoProvider.HostInfo().GetAddress()
+	if request.GetHostAddress() == "" || request.GetHostAddress() == hostAddress {
+		explanation, err := adh.dynamicConfig.Explain(
+			dynamicconfig.Key(request.GetKey()),
+			dynamicconfig.ConstraintsFromProto(request.GetConstraints()),
+		)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		return &adminservice.EvaluateDynamicConfigResponse{
+			HostAddress: hostAddress,
+			Evaluation:  dynamicconfig.ExplanationToProto(explanation),
+		}, nil
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(resolver.AvailableMembers(), func(host membership.HostInfo) bool {
+		return host.GetAddress() == request.GetHostAddress()
+	}) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Host %s is neither this frontend host nor an available history host.", request.GetHostAddress()))
+	}
+	resp, err := adh.historyClient.EvaluateDynamicConfig(ctx, &historyservice.EvaluateDynamicConfigRequest{
+		HostAddress: request.GetHostAddress(),
+		Key:         request.GetKey(),
+		Constraints: request.GetConstraints(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.EvaluateDynamicConfigResponse{
+		HostAddress: request.GetHostAddress(),
+		Evaluation:  resp.GetEvaluation(),
+	}, nil
+}
+
+// ListDynamicConfigSettings returns the registered dynamic config settings with their defaults.
+func (adh *AdminHandler) ListDynamicConfigSettings(
+	_ context.Context,
+	request *adminservice.ListDynamicConfigSettingsRequest,
+) (_ *adminservice.ListDynamicConfigSettingsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	prefix := strings.ToLower(request.GetKeyPrefix())
+	response := &adminservice.ListDynamicConfigSettingsResponse{}
+	for _, setting := range dynamicconfig.RegisteredSettings() {
+		if strings.HasPrefix(strings.ToLower(setting.Key.String()), prefix) {
+			response.Settings = append(response.Settings, dynamicconfig.SettingDescriptionToProto(setting))
+		}
+	}
+	return response, nil
+}
+
+func (adh *AdminHandler) refreshDynamicConfigOverrides(ctx context.Context) {
+	if err := adh.dynamicConfigOverrides.Refresh(ctx); err != nil {
+		adh.logger.Warn("Unable to refresh dynamic config overrides.", tag.Error(err))
+	}
+}
+
+func (adh *AdminHandler) getArchivedHistoryBatches(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	uri archiver.URI,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) ([]*historypb.History, error) {
+	var historyBatches []*historypb.History
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		resp, err := historyArchiver.Get(ctx, uri, &archiver.GetHistoryRequest{
+			NamespaceID:   namespaceID.String(),
+			WorkflowID:    execution.GetWorkflowId(),
+			RunID:         execution.GetRunId(),
+			NextPageToken: token,
+			PageSize:      exportArchivedHistoryPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		historyBatches = append(historyBatches, resp.HistoryBatches...)
+		token = resp.NextPageToken
+	}
+	return historyBatches, nil
+}
+
 func (adh *AdminHandler) unaliasAndValidateSearchAttributes(historyBatches []*commonpb.DataBlob, nsName namespace.Name) ([]*commonpb.DataBlob, error) {
 	var unaliasedBatches []*commonpb.DataBlob
 	for _, historyBatch := range historyBatches {
@@ -26,12 +26,16 @@ package frontend
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -45,6 +49,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
@@ -57,14 +62,19 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/overrides"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
@@ -75,15 +85,22 @@ import (
 	test "go.temporal.io/server/common/testing"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/archivalverifier"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/persistencemigration"
+	"go.temporal.io/server/service/worker/visibilitymigration"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -107,6 +124,10 @@ type (
 		mockProducer               *persistence.MockNamespaceReplicationQueue
 		mockMatchingClient         *matchingservicemock.MockMatchingServiceClient
 		mockSaMapper               *searchattribute.MockMapper
+		mockArchivalMetadata       archiver.MetadataMock
+		mockArchiverProvider       *provider.MockArchiverProvider
+		mockHistoryArchiver        *archiver.MockHistoryArchiver
+		mockVisibilityArchiver     *archiver.MockVisibilityArchiver
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -151,6 +172,10 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
 	s.mockMatchingClient = s.mockResource.MatchingClient
+	s.mockArchivalMetadata = s.mockResource.ArchivalMetadata
+	s.mockArchiverProvider = s.mockResource.ArchiverProvider
+	s.mockHistoryArchiver = archiver.NewMockHistoryArchiver(s.controller)
+	s.mockVisibilityArchiver = archiver.NewMockVisibilityArchiver(s.controller)
 
 	mockSaMapperProvider := searchattribute.NewMockMapperProvider(s.controller)
 	s.mockSaMapper = searchattribute.NewMockMapper(s.controller)
@@ -196,6 +221,16 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		s.mockArchiverProvider,
+		s.mockArchivalMetadata,
+		overrides.NewManager(
+			s.mockResource.GetClusterMetadataManager(),
+			nil,
+			dynamicconfig.NewNoopCollection(),
+			clock.NewRealTimeSource(),
+			s.mockResource.GetLogger(),
+		),
+		dynamicconfig.NewNoopCollection(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -2107,6 +2142,550 @@ func (s *adminHandlerSuite) TestImportWorkflowExecution_WithNonAliasedSearchAttr
 	}
 }
 
+func (s *adminHandlerSuite) TestExportArchivedWorkflowExecutions_ClusterNotConfiguredForArchival() {
+	resp, err := s.handler.ExportArchivedWorkflowExecutions(context.Background(), &adminservice.ExportArchivedWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Nil(resp)
+	s.Equal(errClusterIsNotConfiguredForVisibilityArchival, err)
+}
+
+func (s *adminHandlerSuite) TestExportArchivedWorkflowExecutions_PageSizeTooBig() {
+	resp, err := s.handler.ExportArchivedWorkflowExecutions(context.Background(), &adminservice.ExportArchivedWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		PageSize:  1000,
+	})
+	s.Nil(resp)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestExportArchivedWorkflowExecutions_NamespaceNotConfiguredForHistoryArchival() {
+	s.mockArchivalMetadata.SetHistoryEnabledByDefault()
+	s.mockArchivalMetadata.SetVisibilityEnabledByDefault()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		&persistencespb.NamespaceConfig{
+			VisibilityArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			VisibilityArchivalUri:   "testScheme://visibility/URI",
+		},
+		"",
+	), nil)
+
+	resp, err := s.handler.ExportArchivedWorkflowExecutions(context.Background(), &adminservice.ExportArchivedWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Nil(resp)
+	s.Equal(errNamespaceIsNotConfiguredForHistoryArchival, err)
+}
+
+func (s *adminHandlerSuite) TestExportArchivedWorkflowExecutions() {
+	s.mockArchivalMetadata.SetHistoryEnabledByDefault()
+	s.mockArchivalMetadata.SetVisibilityEnabledByDefault()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState:    enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:      "testScheme://history/URI",
+			VisibilityArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			VisibilityArchivalUri:   "testScheme://visibility/URI",
+		},
+		"",
+	), nil)
+	s.mockArchiverProvider.EXPECT().GetVisibilityArchiver("testscheme", string(primitives.FrontendService)).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.EXPECT().GetHistoryArchiver("testscheme", string(primitives.FrontendService)).Return(s.mockHistoryArchiver, nil)
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).Return(searchattribute.TestNameTypeMap, nil)
+
+	archivedExecution := &commonpb.WorkflowExecution{WorkflowId: "archived-workflow", RunId: uuid.New()}
+	missingExecution := &commonpb.WorkflowExecution{WorkflowId: "missing-workflow", RunId: uuid.New()}
+	s.mockVisibilityArchiver.EXPECT().Query(gomock.Any(), gomock.Any(), &archiver.QueryVisibilityRequest{
+		NamespaceID:   s.namespaceID.String(),
+		PageSize:      exportArchivedWorkflowExecutionsDefaultPageSize,
+		NextPageToken: []byte("visibility-token"),
+		Query:         "WorkflowType = 'foo'",
+	}, searchattribute.TestNameTypeMap).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: archivedExecution},
+			{Execution: missingExecution},
+		},
+		NextPageToken: []byte("next-visibility-token"),
+	}, nil)
+
+	firstBatch := &historypb.History{Events: []*historypb.HistoryEvent{{EventId: 1}, {EventId: 2}}}
+	secondBatch := &historypb.History{Events: []*historypb.HistoryEvent{{EventId: 3}}}
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), &archiver.GetHistoryRequest{
+		NamespaceID: s.namespaceID.String(),
+		WorkflowID:  archivedExecution.GetWorkflowId(),
+		RunID:       archivedExecution.GetRunId(),
+		PageSize:    exportArchivedHistoryPageSize,
+	}).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{firstBatch},
+		NextPageToken:  []byte("history-token"),
+	}, nil)
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), &archiver.GetHistoryRequest{
+		NamespaceID:   s.namespaceID.String(),
+		WorkflowID:    archivedExecution.GetWorkflowId(),
+		RunID:         archivedExecution.GetRunId(),
+		NextPageToken: []byte("history-token"),
+		PageSize:      exportArchivedHistoryPageSize,
+	}).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{secondBatch},
+	}, nil)
+	s.mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), &archiver.GetHistoryRequest{
+		NamespaceID: s.namespaceID.String(),
+		WorkflowID:  missingExecution.GetWorkflowId(),
+		RunID:       missingExecution.GetRunId(),
+		PageSize:    exportArchivedHistoryPageSize,
+	}).Return(nil, serviceerror.NewNotFound("history not found"))
+
+	resp, err := s.handler.ExportArchivedWorkflowExecutions(context.Background(), &adminservice.ExportArchivedWorkflowExecutionsRequest{
+		Namespace:     s.namespace.String(),
+		Query:         "WorkflowType = 'foo'",
+		NextPageToken: []byte("visibility-token"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("next-visibility-token"), resp.GetNextPageToken())
+	s.Len(resp.GetExecutions(), 2)
+	s.ProtoEqual(archivedExecution, resp.GetExecutions()[0].GetVisibilityRecord().GetExecution())
+	s.Len(resp.GetExecutions()[0].GetHistoryBatches(), 2)
+	s.ProtoEqual(firstBatch, resp.GetExecutions()[0].GetHistoryBatches()[0])
+	s.ProtoEqual(secondBatch, resp.GetExecutions()[0].GetHistoryBatches()[1])
+	s.ProtoEqual(missingExecution, resp.GetExecutions()[1].GetVisibilityRecord().GetExecution())
+	s.Empty(resp.GetExecutions()[1].GetHistoryBatches())
+	s.False(resp.GetExecutions()[0].GetHistoryNotFound())
+	s.True(resp.GetExecutions()[1].GetHistoryNotFound())
+}
+
+func (s *adminHandlerSuite) TestStartArchivalVerification_InvalidSampleRate() {
+	resp, err := s.handler.StartArchivalVerification(context.Background(), &adminservice.StartArchivalVerificationRequest{
+		Namespace:  s.namespace.String(),
+		SampleRate: 1.5,
+	})
+	s.Nil(resp)
+	s.Equal(errInvalidSampleRate, err)
+}
+
+func (s *adminHandlerSuite) TestStartArchivalVerification_ClusterNotConfiguredForArchival() {
+	resp, err := s.handler.StartArchivalVerification(context.Background(), &adminservice.StartArchivalVerificationRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Nil(resp)
+	s.Equal(errClusterIsNotConfiguredForHistoryArchival, err)
+}
+
+func (s *adminHandlerSuite) TestStartArchivalVerification() {
+	s.mockArchivalMetadata.SetHistoryEnabledByDefault()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetID().Return("temporal-sys-archival-verifier-workflow-" + s.namespace.String())
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: primitives.DefaultWorkerTaskQueue,
+			ID:        "temporal-sys-archival-verifier-workflow-" + s.namespace.String(),
+		},
+		archivalverifier.WorkflowName,
+		archivalverifier.VerifierParams{
+			Namespace:  s.namespace,
+			Query:      "WorkflowType = 'foo'",
+			SampleRate: 0.5,
+			Rearchive:  true,
+		},
+	).Return(mockRun, nil)
+
+	resp, err := s.handler.StartArchivalVerification(context.Background(), &adminservice.StartArchivalVerificationRequest{
+		Namespace:  s.namespace.String(),
+		Query:      "WorkflowType = 'foo'",
+		SampleRate: 0.5,
+		Rearchive:  true,
+	})
+	s.NoError(err)
+	s.Equal("temporal-sys-archival-verifier-workflow-"+s.namespace.String(), resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestStartVisibilityMigration_NoSecondaryVisibility() {
+	resp, err := s.handler.StartVisibilityMigration(context.Background(), &adminservice.StartVisibilityMigrationRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Nil(resp)
+	s.Equal(errClusterIsNotConfiguredForSecondaryVisibility, err)
+}
+
+func (s *adminHandlerSuite) TestStartVisibilityMigration() {
+	s.handler.visibilityMgr = visibility.NewVisibilityManagerDual(
+		s.mockVisibilityMgr,
+		manager.NewMockVisibilityManager(s.controller),
+		nil,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetID().Return("temporal-sys-visibility-migration-workflow-" + s.namespace.String())
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: primitives.DefaultWorkerTaskQueue,
+			ID:        "temporal-sys-visibility-migration-workflow-" + s.namespace.String(),
+		},
+		visibilitymigration.WorkflowName,
+		visibilitymigration.MigrationParams{
+			Namespace:    s.namespace,
+			Query:        "WorkflowType = 'foo'",
+			SkipBackfill: true,
+		},
+	).Return(mockRun, nil)
+
+	resp, err := s.handler.StartVisibilityMigration(context.Background(), &adminservice.StartVisibilityMigrationRequest{
+		Namespace:    s.namespace.String(),
+		Query:        "WorkflowType = 'foo'",
+		SkipBackfill: true,
+	})
+	s.NoError(err)
+	s.Equal("temporal-sys-visibility-migration-workflow-"+s.namespace.String(), resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestStartPersistenceMigration_NotConfigured() {
+	resp, err := s.handler.StartPersistenceMigration(context.Background(), &adminservice.StartPersistenceMigrationRequest{})
+	s.Nil(resp)
+	s.Equal(errClusterIsNotConfiguredForPersistenceMigration, err)
+}
+
+func (s *adminHandlerSuite) TestStartPersistenceMigration_InvalidShardID() {
+	s.handler.persistenceMigration = &config.DataStoreMigration{TargetStore: "target"}
+	resp, err := s.handler.StartPersistenceMigration(context.Background(), &adminservice.StartPersistenceMigrationRequest{
+		ShardIds: []int32{2},
+	})
+	s.Nil(resp)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) TestStartPersistenceMigration() {
+	s.handler.persistenceMigration = &config.DataStoreMigration{TargetStore: "target"}
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetID().Return(persistencemigration.WorkflowID)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: primitives.DefaultWorkerTaskQueue,
+			ID:        persistencemigration.WorkflowID,
+		},
+		persistencemigration.WorkflowName,
+		persistencemigration.MigrationParams{
+			ShardIDs:    []int32{1},
+			SkipCutover: true,
+		},
+	).Return(mockRun, nil)
+
+	resp, err := s.handler.StartPersistenceMigration(context.Background(), &adminservice.StartPersistenceMigrationRequest{
+		SkipCutover: true,
+	})
+	s.NoError(err)
+	s.Equal(persistencemigration.WorkflowID, resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestDescribePersistenceMigration_NotStarted() {
+	s.handler.persistenceMigration = &config.DataStoreMigration{TargetStore: "target"}
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), persistencemigration.WorkflowID, "").
+		Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	resp, err := s.handler.DescribePersistenceMigration(context.Background(), &adminservice.DescribePersistenceMigrationRequest{})
+	s.NoError(err)
+	s.Equal("target", resp.GetTargetStore())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED, resp.GetStatus())
+	s.Empty(resp.GetShards())
+}
+
+func (s *adminHandlerSuite) TestDescribePersistenceMigration() {
+	s.handler.persistenceMigration = &config.DataStoreMigration{TargetStore: "target"}
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), persistencemigration.WorkflowID, "").
+		Return(&workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: persistencemigration.WorkflowID,
+					RunId:      "run-id",
+				},
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			},
+		}, nil)
+	mockValue := mocksdk.NewMockEncodedValue(s.controller)
+	mockValue.EXPECT().Get(gomock.Any()).Do(func(result interface{}) {
+		*(result.(*persistencemigration.MigrationProgress)) = persistencemigration.MigrationProgress{
+			TargetStore:      "target",
+			MetadataMigrated: true,
+			Shards: []persistencemigration.ShardProgress{
+				{ShardID: 1, State: enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER, ExecutionsCopied: 10, Mismatches: 2, LastError: "mismatch"},
+			},
+		}
+	})
+	mockSdkClient.EXPECT().QueryWorkflow(gomock.Any(), persistencemigration.WorkflowID, "run-id", persistencemigration.QueryTypeProgress).
+		Return(mockValue, nil)
+
+	resp, err := s.handler.DescribePersistenceMigration(context.Background(), &adminservice.DescribePersistenceMigrationRequest{})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribePersistenceMigrationResponse{
+		TargetStore:      "target",
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		MetadataMigrated: true,
+		Shards: []*adminservice.PersistenceMigrationShardProgress{
+			{ShardId: 1, State: enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER, ExecutionsCopied: 10, Mismatches: 2, LastError: "mismatch"},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestDescribeHistoryPersistenceUsage() {
+	s.mockResource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("history-2"),
+		membership.NewHostInfoFromAddress("history-1"),
+		membership.NewHostInfoFromAddress("history-3"),
+	})
+	s.mockHistoryClient.EXPECT().GetPersistenceUsage(gomock.Any(), protomock.Eq(&historyservice.GetPersistenceUsageRequest{HostAddress: "history-1", Namespace: "ns-1"})).
+		Return(&historyservice.GetPersistenceUsageResponse{
+			Usage: []*persistencespb.NamespacePersistenceUsage{
+				{Namespace: "ns-1", OperationClass: persistence.OperationClassRead, Rps: 2, QuotaRps: 100},
+				{Namespace: "ns-1", OperationClass: persistence.OperationClassWrite, Rps: 3, ThrottledRps: 1, QuotaRps: 100},
+			},
+		}, nil)
+	s.mockHistoryClient.EXPECT().GetPersistenceUsage(gomock.Any(), protomock.Eq(&historyservice.GetPersistenceUsageRequest{HostAddress: "history-2", Namespace: "ns-1"})).
+		Return(&historyservice.GetPersistenceUsageResponse{
+			Usage: []*persistencespb.NamespacePersistenceUsage{
+				{Namespace: "ns-1", OperationClass: persistence.OperationClassWrite, Rps: 4, QuotaRps: 100},
+			},
+		}, nil)
+	s.mockHistoryClient.EXPECT().GetPersistenceUsage(gomock.Any(), protomock.Eq(&historyservice.GetPersistenceUsageRequest{HostAddress: "history-3", Namespace: "ns-1"})).
+		Return(nil, serviceerror.NewUnavailable("unavailable"))
+
+	resp, err := s.handler.DescribeHistoryPersistenceUsage(context.Background(), &adminservice.DescribeHistoryPersistenceUsageRequest{Namespace: "ns-1"})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribeHistoryPersistenceUsageResponse{
+		Usage: []*persistencespb.NamespacePersistenceUsage{
+			{Namespace: "ns-1", OperationClass: persistence.OperationClassRead, Rps: 2, QuotaRps: 100},
+			{Namespace: "ns-1", OperationClass: persistence.OperationClassWrite, Rps: 7, ThrottledRps: 1, QuotaRps: 200},
+		},
+		Hosts: []*adminservice.HistoryHostPersistenceUsage{
+			{
+				Address: "history-1",
+				Usage: []*persistencespb.NamespacePersistenceUsage{
+					{Namespace: "ns-1", OperationClass: persistence.OperationClassRead, Rps: 2, QuotaRps: 100},
+					{Namespace: "ns-1", OperationClass: persistence.OperationClassWrite, Rps: 3, ThrottledRps: 1, QuotaRps: 100},
+				},
+			},
+			{
+				Address: "history-2",
+				Usage: []*persistencespb.NamespacePersistenceUsage{
+					{Namespace: "ns-1", OperationClass: persistence.OperationClassWrite, Rps: 4, QuotaRps: 100},
+				},
+			},
+		},
+		UnreachableHosts: []string{"history-3"},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestSetDynamicConfigOverride() {
+	expireTime := timestamppb.New(time.Now().Add(time.Hour))
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{ClusterName: "active"},
+		Version:         3,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.Equal(int64(3), request.Version)
+			s.Equal("active", request.ClusterMetadata.GetClusterName())
+			overrides := request.ClusterMetadata.GetDynamicConfigOverrides().GetOverrides()
+			s.Len(overrides, 1)
+			s.Equal(dynamicconfig.MatchingNumTaskqueueReadPartitions.Key().String(), overrides[0].GetKey())
+			s.Equal("8", overrides[0].GetValue())
+			s.Equal("alice", overrides[0].GetIdentity())
+			s.Equal("admin", overrides[0].GetClientIdentity())
+			s.NotNil(overrides[0].GetUpdateTime())
+			auditLog := request.ClusterMetadata.GetDynamicConfigOverrides().GetAuditLog()
+			s.Len(auditLog, 1)
+			s.Nil(auditLog[0].GetPrevious())
+			s.ProtoEqual(overrides[0], auditLog[0].GetCurrent())
+			s.Equal("alice", auditLog[0].GetIdentity())
+			s.Equal("admin", auditLog[0].GetClientIdentity())
+			return true, nil
+		})
+
+	ctx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{Subject: "alice"})
+	_, err := s.handler.SetDynamicConfigOverride(ctx, &adminservice.SetDynamicConfigOverrideRequest{
+		Key:         "MATCHING.numTaskqueueReadPartitions",
+		Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns-1", TaskQueueName: "tq"},
+		Value:       "8",
+		ExpireTime:  expireTime,
+		Identity:    "admin",
+		Reason:      "incident",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestAuthenticatedIdentity() {
+	s.Equal("", authenticatedIdentity(context.Background()))
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "worker", Organization: []string{"temporal"}}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	})
+	s.Equal("CN=worker,O=temporal", authenticatedIdentity(ctx))
+
+	ctx = context.WithValue(ctx, authorization.MappedClaims, &authorization.Claims{Subject: "alice"})
+	s.Equal("alice", authenticatedIdentity(ctx))
+}
+
+func (s *adminHandlerSuite) TestSetDynamicConfigOverride_Invalid() {
+	testCases := []struct {
+		name    string
+		request *adminservice.SetDynamicConfigOverrideRequest
+	}{
+		{
+			name:    "no identity",
+			request: &adminservice.SetDynamicConfigOverrideRequest{Key: "frontend.rps", Value: "100"},
+		},
+		{
+			name: "expired",
+			request: &adminservice.SetDynamicConfigOverrideRequest{
+				Key: "frontend.rps", Value: "100", Identity: "admin", ExpireTime: timestamppb.New(time.Now().Add(-time.Minute)),
+			},
+		},
+		{
+			name:    "unknown key",
+			request: &adminservice.SetDynamicConfigOverrideRequest{Key: "frontend.unknown", Value: "100", Identity: "admin"},
+		},
+		{
+			name:    "invalid value",
+			request: &adminservice.SetDynamicConfigOverrideRequest{Key: "frontend.rps", Value: "fast", Identity: "admin"},
+		},
+		{
+			name: "invalid constraints",
+			request: &adminservice.SetDynamicConfigOverrideRequest{
+				Key: "frontend.rps", Value: "100", Identity: "admin", Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns-1"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.handler.SetDynamicConfigOverride(context.Background(), tc.request)
+			var invalidArgument *serviceerror.InvalidArgument
+			s.ErrorAs(err, &invalidArgument)
+		})
+	}
+}
+
+func (s *adminHandlerSuite) TestGetDynamicConfigOverrides() {
+	rpsOverride := &persistencespb.DynamicConfigOverride{Key: "frontend.rps", Value: "100", Identity: "admin"}
+	partitionsOverride := &persistencespb.DynamicConfigOverride{Key: "matching.numTaskqueueReadPartitions", Value: "8", Identity: "admin"}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{
+			DynamicConfigOverrides: &persistencespb.DynamicConfigOverrides{
+				Overrides: []*persistencespb.DynamicConfigOverride{rpsOverride, partitionsOverride},
+				AuditLog: []*persistencespb.DynamicConfigAuditEntry{
+					{Identity: "admin", Current: rpsOverride},
+					{Identity: "admin", Current: partitionsOverride},
+					{Identity: "admin", Previous: rpsOverride},
+				},
+			},
+		},
+	}, nil)
+
+	resp, err := s.handler.GetDynamicConfigOverrides(context.Background(), &adminservice.GetDynamicConfigOverridesRequest{Key: "Frontend.RPS"})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.GetDynamicConfigOverridesResponse{
+		Overrides: []*persistencespb.DynamicConfigOverride{rpsOverride},
+		AuditLog: []*persistencespb.DynamicConfigAuditEntry{
+			{Identity: "admin", Current: rpsOverride},
+			{Identity: "admin", Previous: rpsOverride},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestEvaluateDynamicConfig_Local() {
+	s.handler.dynamicConfig = dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.FrontendRPS.Key(): []dynamicconfig.ConstrainedValue{{Value: 100}},
+	}, s.mockResource.GetLogger())
+	s.mockResource.HostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("frontend")).AnyTimes()
+
+	for _, hostAddress := range []string{"", "frontend"} {
+		resp, err := s.handler.EvaluateDynamicConfig(context.Background(), &adminservice.EvaluateDynamicConfigRequest{
+			Key:         "Frontend.RPS",
+			Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns-1"},
+			HostAddress: hostAddress,
+		})
+		s.NoError(err)
+		s.ProtoEqual(&adminservice.EvaluateDynamicConfigResponse{
+			HostAddress: "frontend",
+			Evaluation: &persistencespb.DynamicConfigEvaluation{
+				Key:               "frontend.rps",
+				Precedence:        "Global",
+				LookupConstraints: []*persistencespb.DynamicConfigConstraints{{}},
+				Values:            []*persistencespb.DynamicConfigValue{{Constraints: &persistencespb.DynamicConfigConstraints{}, Value: "100"}},
+				Value:             "100",
+				MatchedValue:      &persistencespb.DynamicConfigValue{Constraints: &persistencespb.DynamicConfigConstraints{}, Value: "100"},
+			},
+		}, resp)
+	}
+
+	_, err := s.handler.EvaluateDynamicConfig(context.Background(), &adminservice.EvaluateDynamicConfigRequest{Key: "unknownKey"})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+}
+
+func (s *adminHandlerSuite) TestEvaluateDynamicConfig_HistoryHost() {
+	s.mockResource.HostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("frontend")).AnyTimes()
+	s.mockResource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("history-1"),
+	}).AnyTimes()
+	evaluation := &persistencespb.DynamicConfigEvaluation{Key: "history.rps", Value: "3000"}
+	s.mockHistoryClient.EXPECT().EvaluateDynamicConfig(gomock.Any(), protomock.Eq(&historyservice.EvaluateDynamicConfigRequest{
+		HostAddress: "history-1",
+		Key:         "history.rps",
+		Constraints: &persistencespb.DynamicConfigConstraints{ShardId: 1},
+	})).Return(&historyservice.EvaluateDynamicConfigResponse{Evaluation: evaluation}, nil)
+
+	resp, err := s.handler.EvaluateDynamicConfig(context.Background(), &adminservice.EvaluateDynamicConfigRequest{
+		Key:         "history.rps",
+		Constraints: &persistencespb.DynamicConfigConstraints{ShardId: 1},
+		HostAddress: "history-1",
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.EvaluateDynamicConfigResponse{HostAddress: "history-1", Evaluation: evaluation}, resp)
+
+	_, err = s.handler.EvaluateDynamicConfig(context.Background(), &adminservice.EvaluateDynamicConfigRequest{
+		Key:         "history.rps",
+		HostAddress: "matching-1",
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+}
+
+func (s *adminHandlerSuite) TestListDynamicConfigSettings() {
+	resp, err := s.handler.ListDynamicConfigSettings(context.Background(), &adminservice.ListDynamicConfigSettingsRequest{KeyPrefix: "Frontend.RPS"})
+	s.NoError(err)
+	s.NotEmpty(resp.GetSettings())
+	s.ProtoEqual(&persistencespb.DynamicConfigSetting{
+		Key:          "frontend.rps",
+		Precedence:   "Global",
+		Type:         "int",
+		DefaultValue: "2400",
+		Description:  "FrontendRPS is workflow rate limit per second per-instance",
+	}, resp.GetSettings()[0])
+	for _, setting := range resp.GetSettings() {
+		s.True(strings.HasPrefix(setting.GetKey(), "frontend.rps"))
+	}
+}
+
 func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo,
 	responsePhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo) {
 
@@ -64,6 +64,12 @@ var (
 	errClusterIsNotConfiguredForVisibilityArchival        = serviceerror.NewInvalidArgument("Cluster is not configured for visibility archival.")
 	errClusterIsNotConfiguredForReadingArchivalVisibility = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived visibility records.")
 	errNamespaceIsNotConfiguredForVisibilityArchival      = serviceerror.NewInvalidArgument("Namespace is not configured for visibility archival.")
+	errClusterIsNotConfiguredForHistoryArchival           = serviceerror.NewInvalidArgument("Cluster is not configured for history archival.")
+	errClusterIsNotConfiguredForReadingArchivalHistory    = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived history.")
+	errNamespaceIsNotConfiguredForHistoryArchival         = serviceerror.NewInvalidArgument("Namespace is not configured for history archival.")
+	errInvalidSampleRate                                  = serviceerror.NewInvalidArgument("SampleRate must be between 0 and 1.")
+	errClusterIsNotConfiguredForSecondaryVisibility       = serviceerror.NewInvalidArgument("Cluster is not configured with a secondary visibility store.")
+	errClusterIsNotConfiguredForPersistenceMigration      = serviceerror.NewInvalidArgument("Cluster is not configured with a data store migration.")
 	errSearchAttributesNotSet                             = serviceerror.NewInvalidArgument("SearchAttributes are not set on request.")
 	errInvalidPageSize                                    = serviceerror.NewInvalidArgument("Invalid PageSize.")                                 // DEPRECATED
 	errInvalidPaginationToken                             = serviceerror.NewInvalidArgument("Invalid pagination token.")                         // DEPRECATED
@@ -110,6 +116,7 @@ var (
 	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
+	errInvalidShardIDMessage = "Invalid ShardID %d, must be between 1 and %d."
 
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
 	errSearchAttributeAlreadyExistsMessage            = "Search attribute %s already exists."
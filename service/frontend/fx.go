@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/overrides"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -612,8 +613,12 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	archiverProvider provider.ArchiverProvider,
+	archivalMetadata archiver.ArchivalMetadata,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	matchingClient resource.MatchingClient,
+	dynamicConfigOverrides *overrides.Manager,
+	dynamicConfig *dynamicconfig.Collection,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -642,6 +647,10 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		archiverProvider,
+		archivalMetadata,
+		dynamicConfigOverrides,
+		dynamicConfig,
 		taskCategoryRegistry,
 		matchingClient,
 	}
@@ -663,6 +672,7 @@ func OperatorHandlerProvider(
 	clientFactory client.Factory,
 	namespaceRegistry namespace.Registry,
 	nexusEndpointClient *NexusEndpointClient,
+	persistenceConfig *config.Persistence,
 ) *OperatorHandlerImpl {
 	args := NewOperatorHandlerImplArgs{
 		configuration,
@@ -679,6 +689,7 @@ func OperatorHandlerProvider(
 		clientFactory,
 		namespaceRegistry,
 		nexusEndpointClient,
+		persistenceConfig,
 	}
 	return NewOperatorHandlerImpl(args)
 }
@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common"
 	clustermetadata "go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -90,6 +91,7 @@ type (
 		clientFactory          svc.Factory
 		namespaceRegistry      namespace.Registry
 		nexusEndpointClient    *NexusEndpointClient
+		persistenceConfig      *config.Persistence
 	}
 
 	NewOperatorHandlerImplArgs struct {
@@ -107,6 +109,7 @@ type (
 		clientFactory          svc.Factory
 		namespaceRegistry      namespace.Registry
 		nexusEndpointClient    *NexusEndpointClient
+		persistenceConfig      *config.Persistence
 	}
 )
 
@@ -137,6 +140,7 @@ func NewOperatorHandlerImpl(
 		clientFactory:          args.clientFactory,
 		namespaceRegistry:      args.namespaceRegistry,
 		nexusEndpointClient:    args.nexusEndpointClient,
+		persistenceConfig:      args.persistenceConfig,
 	}
 
 	return handler
@@ -239,7 +243,7 @@ func (h *OperatorHandlerImpl) addSearchAttributesInternal(
 			metrics.AddSearchAttributesWorkflowSuccessCount.With(scope).Record(1)
 		}
 	} else {
-		err = h.addSearchAttributesSQL(ctx, request, currentSearchAttributes)
+		err = h.addSearchAttributesSQL(ctx, request, indexName, currentSearchAttributes)
 	}
 	return err
 }
@@ -309,6 +313,7 @@ func (h *OperatorHandlerImpl) addSearchAttributesElasticsearch(
 func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 	ctx context.Context,
 	request *operatorservice.AddSearchAttributesRequest,
+	indexName string,
 	currentSearchAttributes searchattribute.NameTypeMap,
 ) error {
 	_, client, err := h.clientFactory.NewLocalFrontendClientWithTimeout(
@@ -333,6 +338,13 @@ func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 
 	dbCustomSearchAttributes := searchattribute.GetSqlDbIndexSearchAttributes().CustomSearchAttributes
 	cmCustomSearchAttributes := currentSearchAttributes.Custom()
+	// Without pre-allocated columns, any custom search attribute field in cluster metadata can be used,
+	// and new fields are created in cluster metadata when all of them are in use.
+	jsonbSearchAttributes := h.persistenceConfig.IsJSONBSearchAttributesVisibilityStore(indexName)
+	if jsonbSearchAttributes {
+		dbCustomSearchAttributes = cmCustomSearchAttributes
+	}
+	newCustomSearchAttributes := make(map[string]enumspb.IndexedValueType)
 	upsertFieldToAliasMap := make(map[string]string)
 	fieldToAliasMap := resp.Config.CustomSearchAttributeAliases
 	aliasToFieldMap := util.InverseMap(fieldToAliasMap)
@@ -366,6 +378,10 @@ func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 				break
 			}
 		}
+		if targetFieldName == "" && jsonbSearchAttributes {
+			targetFieldName = newSQLCustomSearchAttributeFieldName(saType, cmCustomSearchAttributes, newCustomSearchAttributes)
+			newCustomSearchAttributes[targetFieldName] = saType
+		}
 		if targetFieldName == "" {
 			return serviceerror.NewInvalidArgument(
 				fmt.Sprintf(errTooManySearchAttributesMessage, cntUsed, saType),
@@ -379,6 +395,13 @@ func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 		return nil
 	}
 
+	if len(newCustomSearchAttributes) > 0 {
+		maps.Copy(newCustomSearchAttributes, cmCustomSearchAttributes)
+		if err := h.saManager.SaveSearchAttributes(ctx, indexName, newCustomSearchAttributes); err != nil {
+			return serviceerror.NewUnavailable(fmt.Sprintf(errUnableToSaveSearchAttributesMessage, err))
+		}
+	}
+
 	_, err = client.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
 		Namespace: nsName,
 		Config: &namespacepb.NamespaceConfig{
@@ -394,6 +417,27 @@ func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 	return nil
 }
 
+// newSQLCustomSearchAttributeFieldName returns the first field name of the given type, following the naming of
+// the pre-allocated custom search attributes (e.g. Keyword11), that is not in any of the given maps.
+func newSQLCustomSearchAttributeFieldName(
+	saType enumspb.IndexedValueType,
+	fieldMaps ...map[string]enumspb.IndexedValueType,
+) string {
+	for i := 1; ; i++ {
+		fieldName := fmt.Sprintf("%s%02d", saType.String(), i)
+		inUse := false
+		for _, fieldMap := range fieldMaps {
+			if _, ok := fieldMap[fieldName]; ok {
+				inUse = true
+				break
+			}
+		}
+		if !inUse {
+			return fieldName
+		}
+	}
+}
+
 func (h *OperatorHandlerImpl) RemoveSearchAttributes(
 	ctx context.Context,
 	request *operatorservice.RemoveSearchAttributesRequest,
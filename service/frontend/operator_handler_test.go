@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"testing"
 	"time"
 
@@ -43,6 +44,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -115,6 +117,7 @@ func (s *operatorHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.NamespaceCache,
 		endpointClient,
+		&config.Persistence{},
 	}
 	s.handler = NewOperatorHandlerImpl(args)
 	s.handler.Start()
@@ -830,6 +833,7 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL() {
 			err := s.handler.addSearchAttributesSQL(
 				ctx,
 				tc.request,
+				testIndexName,
 				searchattribute.TestNameTypeMap,
 			)
 			if tc.expectedErrMsg == "" {
@@ -841,6 +845,65 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL() {
 	}
 }
 
+func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL_JSONB() {
+	ctx := context.Background()
+	s.handler.persistenceConfig = &config.Persistence{
+		VisibilityStore: "visibility",
+		DataStores: map[string]config.DataStore{
+			"visibility": {
+				SQL: &config.SQL{
+					DatabaseName:            testIndexName,
+					SearchAttributesStorage: config.SQLSearchAttributesStorageJSONB,
+				},
+			},
+		},
+	}
+	request := &operatorservice.AddSearchAttributesRequest{
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			// CustomKeywordField, Keyword02 and Keyword03 are the unused keyword fields in TestNameTypeMap.
+			"CustomAttr1": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomAttr2": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomAttr3": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomAttr4": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+		Namespace: testNamespace,
+	}
+
+	s.mockResource.ClientFactory.EXPECT().
+		NewLocalFrontendClientWithTimeout(gomock.Any(), gomock.Any()).
+		Return(nil, s.mockResource.GetFrontendClient(), nil)
+	s.mockResource.FrontendClient.EXPECT().
+		DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{Namespace: testNamespace}).
+		Return(&workflowservice.DescribeNamespaceResponse{
+			Config: &namespacepb.NamespaceConfig{CustomSearchAttributeAliases: searchattribute.TestAliases},
+		}, nil)
+	expectedCustomSearchAttributes := maps.Clone(searchattribute.TestNameTypeMap.Custom())
+	expectedCustomSearchAttributes["Keyword04"] = enumspb.INDEXED_VALUE_TYPE_KEYWORD
+	s.mockResource.SearchAttributesManager.EXPECT().
+		SaveSearchAttributes(gomock.Any(), testIndexName, expectedCustomSearchAttributes).
+		Return(nil)
+	s.mockResource.FrontendClient.EXPECT().
+		UpdateNamespace(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			r *workflowservice.UpdateNamespaceRequest,
+			opts ...any,
+		) (*workflowservice.UpdateNamespaceResponse, error) {
+			s.ElementsMatch(
+				[]string{"CustomAttr1", "CustomAttr2", "CustomAttr3", "CustomAttr4"},
+				expmaps.Values(r.Config.CustomSearchAttributeAliases),
+			)
+			s.ElementsMatch(
+				[]string{"CustomKeywordField", "Keyword02", "Keyword03", "Keyword04"},
+				expmaps.Keys(r.Config.CustomSearchAttributeAliases),
+			)
+			return &workflowservice.UpdateNamespaceResponse{}, nil
+		})
+
+	err := s.handler.addSearchAttributesSQL(ctx, request, testIndexName, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+}
+
 func (s *operatorHandlerSuite) Test_ListSearchAttributes_EmptyIndexName() {
 	handler := s.handler
 	ctx := context.Background()
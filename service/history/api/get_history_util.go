@@ -116,6 +116,7 @@ func GetHistory(
 ) (*historypb.History, []byte, error) {
 
 	var size int
+	requestNextPageToken := nextPageToken
 	isFirstPage := len(nextPageToken) == 0
 	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), shard.GetConfig().NumberOfShards)
 	var err error
@@ -149,13 +150,30 @@ func GetHistory(
 	metrics.HistorySize.With(metricsHandler).Record(int64(size))
 
 	isLastPage := len(nextPageToken) == 0
-	if err := events.VerifyHistoryIsComplete(
+	err = events.VerifyHistoryIsComplete(
 		historyEvents,
 		firstEventID,
 		nextEventID-1,
 		isFirstPage,
 		isLastPage,
-		int(pageSize)); err != nil {
+		int(pageSize))
+	if err != nil && persistence.ReplicaReadsAllowed(ctx) {
+		// The page was read from a replica which lags behind, read it again from the primary.
+		return GetHistory(
+			persistence.WithoutReplicaReads(ctx),
+			shard,
+			namespaceID,
+			execution,
+			firstEventID,
+			nextEventID,
+			pageSize,
+			requestNextPageToken,
+			transientWorkflowTaskInfo,
+			branchToken,
+			persistenceVisibilityMgr,
+		)
+	}
+	if err != nil {
 		metrics.ServiceErrIncompleteHistoryCounter.With(metricsHandler).Record(1)
 		logger.Error("getHistory: incomplete history",
 			tag.WorkflowNamespaceID(namespaceID.String()),
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/service/history/api"
@@ -186,6 +187,13 @@ func Invoke(
 		}
 	}()
 
+	// History of closed workflows does not change anymore, so it can be read from a read replica.
+	// api.GetHistory falls back to the primary if the replica returns incomplete history.
+	historyCtx := ctx
+	if !continuationToken.IsWorkflowRunning {
+		historyCtx = persistence.WithReplicaReads(ctx)
+	}
+
 	history := &historypb.History{}
 	history.Events = []*historypb.HistoryEvent{}
 	var historyBlob []*commonpb.DataBlob
@@ -212,7 +220,7 @@ func Invoke(
 				historyBlob = historyBlob[len(historyBlob)-1:]
 			} else {
 				history, _, err = api.GetHistory(
-					ctx,
+					historyCtx,
 					shardContext,
 					namespaceID,
 					execution,
@@ -261,7 +269,7 @@ func Invoke(
 				)
 			} else {
 				history, continuationToken.PersistenceToken, err = api.GetHistory(
-					ctx,
+					historyCtx,
 					shardContext,
 					namespaceID,
 					execution,
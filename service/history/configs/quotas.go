@@ -103,6 +103,8 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation":                 1,
 		"/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod":               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DeepHealthCheck":                        1,
+		"/temporal.server.api.historyservice.v1.HistoryService/GetPersistenceUsage":                    1,
+		"/temporal.server.api.historyservice.v1.HistoryService/EvaluateDynamicConfig":                  1,
 		"/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityOptions":                  1,
 		"/temporal.server.api.historyservice.v1.HistoryService/PauseActivity":                          1,
 		"/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity":                        1,
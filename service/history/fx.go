@@ -120,6 +120,8 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		persistenceShardManager:      args.PersistenceShardManager,
 		persistenceVisibilityManager: args.PersistenceVisibilityManager,
 		persistenceHealthSignal:      args.PersistenceHealthSignal,
+		persistenceUsageTracker:      args.PersistenceUsageTracker,
+		dynamicConfig:                args.DynamicConfig,
 		historyServiceResolver:       args.HistoryServiceResolver,
 		metricsHandler:               args.MetricsHandler,
 		payloadSerializer:            args.PayloadSerializer,
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -96,6 +97,8 @@ type (
 		persistenceShardManager      persistence.ShardManager
 		persistenceVisibilityManager manager.VisibilityManager
 		persistenceHealthSignal      persistence.HealthSignalAggregator
+		persistenceUsageTracker      persistence.UsageTracker
+		dynamicConfig                *dynamicconfig.Collection
 		historyServiceResolver       membership.ServiceResolver
 		metricsHandler               metrics.Handler
 		payloadSerializer            serialization.Serializer
@@ -125,7 +128,9 @@ type (
 		PersistenceExecutionManager  persistence.ExecutionManager
 		PersistenceShardManager      persistence.ShardManager
 		PersistenceHealthSignal      persistence.HealthSignalAggregator
+		PersistenceUsageTracker      persistence.UsageTracker
 		PersistenceVisibilityManager manager.VisibilityManager
+		DynamicConfig                *dynamicconfig.Collection
 		HistoryServiceResolver       membership.ServiceResolver
 		MetricsHandler               metrics.Handler
 		PayloadSerializer            serialization.Serializer
@@ -224,6 +229,36 @@ func (h *Handler) DeepHealthCheck(
 	return &historyservice.DeepHealthCheckResponse{State: enumsspb.HEALTH_STATE_SERVING}, nil
 }
 
+func (h *Handler) GetPersistenceUsage(
+	_ context.Context,
+	request *historyservice.GetPersistenceUsageRequest,
+) (_ *historyservice.GetPersistenceUsageResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	return &historyservice.GetPersistenceUsageResponse{
+		Usage: persistence.NamespaceUsageToProto(h.persistenceUsageTracker.Usage(request.GetNamespace())),
+	}, nil
+}
+
+// EvaluateDynamicConfig returns the effective value of a dynamic config setting on this host.
+func (h *Handler) EvaluateDynamicConfig(
+	_ context.Context,
+	request *historyservice.EvaluateDynamicConfigRequest,
+) (_ *historyservice.EvaluateDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	explanation, err := h.dynamicConfig.Explain(
+		dynamicconfig.Key(request.GetKey()),
+		dynamicconfig.ConstraintsFromProto(request.GetConstraints()),
+	)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	return &historyservice.EvaluateDynamicConfigResponse{
+		Evaluation: dynamicconfig.ExplanationToProto(explanation),
+	}, nil
+}
+
 // IsWorkflowTaskValid - whether workflow task is still valid
 func (h *Handler) IsWorkflowTaskValid(ctx context.Context, request *historyservice.IsWorkflowTaskValidRequest) (_ *historyservice.IsWorkflowTaskValidResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
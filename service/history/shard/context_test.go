@@ -35,14 +35,18 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/recorder"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
@@ -903,3 +907,48 @@ func (s *contextSuite) TestUpdateShardInfo_FirstUpdate() {
 	s.True(called)
 	s.Equal(s.mockShard.tasksCompletedSinceLastUpdate, 0)
 }
+
+func TestReplayTestContext(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+	r, err := recorder.NewRecorder(config.PersistenceRecorder{Dir: dir}, log.NewTestLogger())
+	require.NoError(t, err)
+
+	const shardID = 3
+	getRequest := &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: tests.NamespaceID.String(),
+		WorkflowID:  tests.WorkflowID,
+		RunID:       tests.RunID,
+	}
+	shardManager := persistence.NewMockShardManager(ctrl)
+	shardManager.EXPECT().GetOrCreateShard(gomock.Any(), gomock.Any()).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{ShardId: shardID, RangeId: 5},
+	}, nil)
+	shardManager.EXPECT().AssertShardOwnership(gomock.Any(), gomock.Any()).Return(nil)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+
+	recordingShardManager := recorder.NewShardPersistenceRecordingClient(shardManager, r)
+	recordingExecutionManager := recorder.NewExecutionPersistenceRecordingClient(executionManager, r)
+	_, err = recordingShardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{ShardID: shardID})
+	require.NoError(t, err)
+	require.NoError(t, recordingShardManager.AssertShardOwnership(ctx, &persistence.AssertShardOwnershipRequest{ShardID: shardID, RangeID: 5}))
+	_, err = recordingExecutionManager.GetWorkflowExecution(ctx, getRequest)
+	require.Error(t, err)
+	require.NoError(t, r.Close())
+
+	records, err := recorder.ReadRecording(dir, shardID)
+	require.NoError(t, err)
+	replayer := recorder.NewReplayer(records, true)
+	shardContext, err := NewReplayTestContext(ctrl, shardID, tests.NewDynamicConfig(), replayer)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), shardContext.GetRangeID())
+
+	require.NoError(t, shardContext.AssertOwnership(ctx))
+	_, err = shardContext.GetWorkflowExecution(ctx, getRequest)
+	var notFound *serviceerror.NotFound
+	require.ErrorAs(t, err, &notFound)
+	require.Zero(t, replayer.Remaining())
+}
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/recorder"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/service/history/configs"
@@ -93,12 +94,46 @@ func NewTestContext(
 	}
 }
 
+// NewReplayTestContext creates a test context for a shard whose persistence calls were recorded by the
+// persistence recorder. The shard is acquired with the recorded shard info, and its ExecutionManager and
+// ShardManager replay the recorded calls which follow. Use replayer.Remaining to check that all recorded calls
+// were replayed.
+func NewReplayTestContext(
+	ctrl *gomock.Controller,
+	shardID int32,
+	config *configs.Config,
+	replayer *recorder.Replayer,
+) (*ContextTest, error) {
+	shardInfo, err := replayer.ShardInfo(shardID)
+	if err != nil {
+		return nil, err
+	}
+	resourceTest := resourcetest.NewTest(ctrl, primitives.HistoryService)
+	eventsCache := events.NewMockCache(ctrl)
+	shard := newTestContext(
+		resourceTest,
+		eventsCache,
+		ContextConfigOverrides{
+			ShardInfo:        shardInfo,
+			Config:           config,
+			ExecutionManager: replayer,
+			ShardManager:     replayer,
+		},
+	)
+	return &ContextTest{
+		Resource:        resourceTest,
+		ContextImpl:     shard,
+		MockEventsCache: eventsCache,
+	}, nil
+}
+
 type ContextConfigOverrides struct {
 	ShardInfo        *persistencespb.ShardInfo
 	Config           *configs.Config
 	Registry         namespace.Registry
 	ClusterMetadata  cluster.Metadata
 	ExecutionManager persistence.ExecutionManager
+	ShardManager     persistence.ShardManager
 }
 
 type StubContext struct {
@@ -144,6 +179,10 @@ func newTestContext(t *resourcetest.Test, eventsCache events.Cache, config Conte
 	if executionManager == nil {
 		executionManager = t.ExecutionMgr
 	}
+	shardManager := config.ShardManager
+	if shardManager == nil {
+		shardManager = t.GetShardManager()
+	}
 	taskCategoryRegistry := tasks.NewDefaultTaskCategoryRegistry()
 	taskCategoryRegistry.AddCategory(tasks.CategoryArchival)
 
@@ -171,7 +210,7 @@ func newTestContext(t *resourcetest.Test, eventsCache events.Cache, config Conte
 		timeSource:              t.TimeSource,
 		namespaceRegistry:       registry,
 		stateMachineRegistry:    hsm.NewRegistry(),
-		persistenceShardManager: t.GetShardManager(),
+		persistenceShardManager: shardManager,
 		clientBean:              t.GetClientBean(),
 		saProvider:              t.GetSearchAttributesProvider(),
 		saMapperProvider:        t.GetSearchAttributesMapperProvider(),
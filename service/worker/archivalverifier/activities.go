@@ -0,0 +1,276 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgryski/go-farm"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// sampleBuckets is the granularity of SampleRate
+	sampleBuckets = 10000
+)
+
+type (
+	activities struct {
+		archiverProvider  provider.ArchiverProvider
+		namespaceRegistry namespace.Registry
+		executionManager  persistence.ExecutionManager
+		visibilityManager manager.VisibilityManager
+		saProvider        searchattribute.Provider
+		numHistoryShards  int32
+		logger            log.Logger
+	}
+
+	namespaceArchivalInfo struct {
+		NamespaceID   namespace.ID
+		HistoryURI    string
+		VisibilityURI string
+	}
+
+	verifyPageRequest struct {
+		Namespace     namespace.Name
+		ArchivalInfo  namespaceArchivalInfo
+		Query         string
+		SampleRate    float64
+		Rearchive     bool
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	verifyPageResult struct {
+		Listed        int
+		Verified      int
+		Failures      []VerificationFailure
+		NextPageToken []byte
+	}
+)
+
+func newActivities(
+	archiverProvider provider.ArchiverProvider,
+	namespaceRegistry namespace.Registry,
+	executionManager persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
+	saProvider searchattribute.Provider,
+	numHistoryShards int32,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		archiverProvider:  archiverProvider,
+		namespaceRegistry: namespaceRegistry,
+		executionManager:  executionManager,
+		visibilityManager: visibilityManager,
+		saProvider:        saProvider,
+		numHistoryShards:  numHistoryShards,
+		logger:            logger,
+	}
+}
+
+func (a *activities) GetNamespaceArchivalInfoActivity(_ context.Context, nsName namespace.Name) (namespaceArchivalInfo, error) {
+	ns, err := a.namespaceRegistry.GetNamespace(nsName)
+	if err != nil {
+		if _, isNotFound := err.(*serviceerror.NamespaceNotFound); isNotFound {
+			return namespaceArchivalInfo{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is not found", nsName), "", err)
+		}
+		return namespaceArchivalInfo{}, err
+	}
+	if ns.HistoryArchivalState().URI == "" {
+		return namespaceArchivalInfo{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s has no history archival URI", nsName), "", nil)
+	}
+	if ns.VisibilityArchivalState().URI == "" {
+		return namespaceArchivalInfo{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s has no visibility archival URI", nsName), "", nil)
+	}
+	return namespaceArchivalInfo{
+		NamespaceID:   ns.ID(),
+		HistoryURI:    ns.HistoryArchivalState().URI,
+		VisibilityURI: ns.VisibilityArchivalState().URI,
+	}, nil
+}
+
+func (a *activities) VerifyPageActivity(ctx context.Context, request verifyPageRequest) (verifyPageResult, error) {
+	ctx = headers.SetCallerName(ctx, request.Namespace.String())
+	logger := log.With(a.logger, tag.WorkflowNamespace(request.Namespace.String()))
+
+	historyURI, err := archiver.NewURI(request.ArchivalInfo.HistoryURI)
+	if err != nil {
+		return verifyPageResult{}, temporal.NewNonRetryableApplicationError("invalid history archival URI", "", err)
+	}
+	visibilityURI, err := archiver.NewURI(request.ArchivalInfo.VisibilityURI)
+	if err != nil {
+		return verifyPageResult{}, temporal.NewNonRetryableApplicationError("invalid visibility archival URI", "", err)
+	}
+	historyArchiver, err := a.archiverProvider.GetHistoryArchiver(historyURI.Scheme(), string(primitives.WorkerService))
+	if err != nil {
+		return verifyPageResult{}, temporal.NewNonRetryableApplicationError("unable to get history archiver", "", err)
+	}
+	visibilityArchiver, err := a.archiverProvider.GetVisibilityArchiver(visibilityURI.Scheme(), string(primitives.WorkerService))
+	if err != nil {
+		return verifyPageResult{}, temporal.NewNonRetryableApplicationError("unable to get visibility archiver", "", err)
+	}
+	saTypeMap, err := a.saProvider.GetSearchAttributes(a.visibilityManager.GetIndexName(), false)
+	if err != nil {
+		return verifyPageResult{}, err
+	}
+
+	queryResponse, err := visibilityArchiver.Query(ctx, visibilityURI, &archiver.QueryVisibilityRequest{
+		NamespaceID:   request.ArchivalInfo.NamespaceID.String(),
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+		Query:         request.Query,
+	}, saTypeMap)
+	if err != nil {
+		if _, isInvalidArgument := err.(*serviceerror.InvalidArgument); isInvalidArgument {
+			return verifyPageResult{}, temporal.NewNonRetryableApplicationError("unable to query archived visibility", "", err)
+		}
+		return verifyPageResult{}, err
+	}
+
+	result := verifyPageResult{
+		Listed:        len(queryResponse.Executions),
+		NextPageToken: queryResponse.NextPageToken,
+	}
+	for i, execution := range queryResponse.Executions {
+		workflowID := execution.GetExecution().GetWorkflowId()
+		runID := execution.GetExecution().GetRunId()
+		if !sampled(runID, request.SampleRate) {
+			continue
+		}
+
+		status, reason, err := verifyHistory(ctx, historyArchiver, historyURI, request.ArchivalInfo.NamespaceID.String(), workflowID, runID)
+		if err != nil {
+			return verifyPageResult{}, err
+		}
+		result.Verified++
+		activity.RecordHeartbeat(ctx, i)
+		if status == VerificationStatusValid {
+			continue
+		}
+
+		failure := VerificationFailure{
+			WorkflowID: workflowID,
+			RunID:      runID,
+			Status:     status,
+			Reason:     reason,
+		}
+		logger.Warn("Archived history failed verification.",
+			tag.WorkflowID(workflowID),
+			tag.WorkflowRunID(runID),
+			tag.ArchivalURI(historyURI.String()),
+			tag.NewStringTag("status", string(status)),
+			tag.NewStringTag("reason", reason),
+		)
+		if request.Rearchive {
+			rearchived, err := a.rearchive(ctx, historyArchiver, historyURI, request, workflowID, runID)
+			if err != nil {
+				logger.Error("Unable to re-archive history.", tag.WorkflowID(workflowID), tag.WorkflowRunID(runID), tag.Error(err))
+			}
+			failure.Rearchived = rearchived
+		}
+		result.Failures = append(result.Failures, failure)
+	}
+	return result, nil
+}
+
+// rearchive archives the history again from persistence and returns true if the new archived
+// copy passes verification. It returns false without error if the execution no longer exists.
+func (a *activities) rearchive(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	URI archiver.URI,
+	request verifyPageRequest,
+	workflowID string,
+	runID string,
+) (bool, error) {
+	namespaceID := request.ArchivalInfo.NamespaceID.String()
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, a.numHistoryShards)
+	resp, err := a.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	if err != nil {
+		if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	if resp.State.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return false, nil
+	}
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(resp.State.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return false, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return false, err
+	}
+
+	err = historyArchiver.Archive(ctx, URI, &archiver.ArchiveHistoryRequest{
+		ShardID:              shardID,
+		NamespaceID:          namespaceID,
+		Namespace:            request.Namespace.String(),
+		WorkflowID:           workflowID,
+		RunID:                runID,
+		BranchToken:          currentVersionHistory.GetBranchToken(),
+		NextEventID:          lastItem.GetEventId() + 1,
+		CloseFailoverVersion: lastItem.GetVersion(),
+	}, archiver.GetOverwriteArchiveOption())
+	if err != nil {
+		return false, err
+	}
+
+	status, _, err := verifyHistory(ctx, historyArchiver, URI, namespaceID, workflowID, runID)
+	if err != nil {
+		return false, err
+	}
+	return status == VerificationStatusValid, nil
+}
+
+func sampled(runID string, sampleRate float64) bool {
+	if sampleRate >= 1 {
+		return true
+	}
+	return farm.Fingerprint32([]byte(runID))%sampleBuckets < uint32(sampleRate*sampleBuckets)
+}
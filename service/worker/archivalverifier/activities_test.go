@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/testsuite"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testNamespace     = "test-namespace"
+	testVisibilityURI = "file:///tmp/visibility"
+	testShardCount    = 4
+)
+
+type activitiesSuite struct {
+	*require.Assertions
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller         *gomock.Controller
+	archiverProvider   *provider.MockArchiverProvider
+	historyArchiver    *archiver.MockHistoryArchiver
+	visibilityArchiver *archiver.MockVisibilityArchiver
+	executionManager   *persistence.MockExecutionManager
+	env                *testsuite.TestActivityEnvironment
+	a                  *activities
+}
+
+func TestActivitiesSuite(t *testing.T) {
+	suite.Run(t, new(activitiesSuite))
+}
+
+func (s *activitiesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.archiverProvider = provider.NewMockArchiverProvider(s.controller)
+	s.historyArchiver = archiver.NewMockHistoryArchiver(s.controller)
+	s.visibilityArchiver = archiver.NewMockVisibilityArchiver(s.controller)
+	s.executionManager = persistence.NewMockExecutionManager(s.controller)
+
+	visibilityManager := manager.NewMockVisibilityManager(s.controller)
+	visibilityManager.EXPECT().GetIndexName().Return("").AnyTimes()
+	saProvider := searchattribute.NewMockProvider(s.controller)
+	saProvider.EXPECT().GetSearchAttributes("", false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.archiverProvider.EXPECT().GetHistoryArchiver("file", string(primitives.WorkerService)).Return(s.historyArchiver, nil).AnyTimes()
+	s.archiverProvider.EXPECT().GetVisibilityArchiver("file", string(primitives.WorkerService)).Return(s.visibilityArchiver, nil).AnyTimes()
+
+	s.a = newActivities(s.archiverProvider, nil, s.executionManager, visibilityManager, saProvider, testShardCount, log.NewNoopLogger())
+	s.env = s.NewTestActivityEnvironment()
+	s.env.RegisterActivity(s.a)
+}
+
+func (s *activitiesSuite) TestVerifyPageActivity_Rearchive() {
+	s.expectQuery()
+	historyURI := s.historyURI()
+	gomock.InOrder(
+		s.historyArchiver.EXPECT().Get(gomock.Any(), historyURI, gomock.Any()).Return(nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())),
+		s.historyArchiver.EXPECT().Get(gomock.Any(), historyURI, gomock.Any()).Return(&archiver.GetHistoryResponse{
+			HistoryBatches: []*historypb.History{
+				testHistory(1, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED),
+				testHistory(2, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED),
+			},
+		}, nil),
+	)
+	s.expectGetWorkflowExecution(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, nil)
+	s.historyArchiver.EXPECT().Archive(gomock.Any(), historyURI, &archiver.ArchiveHistoryRequest{
+		ShardID:              common.WorkflowIDToHistoryShard(testNamespaceID, testWorkflowID, testShardCount),
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          []byte("branch-token"),
+		NextEventID:          3,
+		CloseFailoverVersion: 5,
+	}, gomock.Any()).DoAndReturn(func(_ context.Context, _ archiver.URI, _ *archiver.ArchiveHistoryRequest, opts ...archiver.ArchiveOption) error {
+		s.True(archiver.GetFeatureCatalog(opts...).Overwrite)
+		return nil
+	})
+
+	result := s.verifyPage()
+	s.Equal(1, result.Listed)
+	s.Equal(1, result.Verified)
+	s.Equal([]VerificationFailure{{
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		Status:     VerificationStatusMissing,
+		Reason:     archiver.ErrHistoryNotExist.Error(),
+		Rearchived: true,
+	}}, result.Failures)
+}
+
+func (s *activitiesSuite) TestVerifyPageActivity_Rearchive_ExecutionNoLongerExists() {
+	s.expectQuery()
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.historyURI(), gomock.Any()).Return(nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error()))
+	s.expectGetWorkflowExecution(enumsspb.WORKFLOW_EXECUTION_STATE_UNSPECIFIED, serviceerror.NewNotFound("workflow execution not found"))
+
+	result := s.verifyPage()
+	s.Len(result.Failures, 1)
+	s.Equal(VerificationStatusMissing, result.Failures[0].Status)
+	s.False(result.Failures[0].Rearchived)
+}
+
+func (s *activitiesSuite) TestVerifyPageActivity_Rearchive_StillRunning() {
+	s.expectQuery()
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.historyURI(), gomock.Any()).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{
+			testHistory(1, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED),
+		},
+	}, nil)
+	s.expectGetWorkflowExecution(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, nil)
+
+	result := s.verifyPage()
+	s.Len(result.Failures, 1)
+	s.Equal(VerificationStatusCorrupted, result.Failures[0].Status)
+	s.False(result.Failures[0].Rearchived)
+}
+
+func (s *activitiesSuite) TestVerifyPageActivity_NotSampled() {
+	s.expectQuery()
+
+	request := s.verifyPageRequest()
+	request.SampleRate = 0
+	result := s.executeVerifyPage(request)
+	s.Equal(1, result.Listed)
+	s.Zero(result.Verified)
+	s.Empty(result.Failures)
+}
+
+func (s *activitiesSuite) historyURI() archiver.URI {
+	uri, err := archiver.NewURI(testHistoryURI)
+	s.NoError(err)
+	return uri
+}
+
+func (s *activitiesSuite) expectQuery() {
+	s.visibilityArchiver.EXPECT().Query(gomock.Any(), gomock.Any(), &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    defaultPageSize,
+		Query:       "WorkflowType = 'type'",
+	}, searchattribute.TestNameTypeMap).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID}},
+		},
+	}, nil)
+}
+
+func (s *activitiesSuite) expectGetWorkflowExecution(state enumsspb.WorkflowExecutionState, err error) {
+	request := &persistence.GetWorkflowExecutionRequest{
+		ShardID:     common.WorkflowIDToHistoryShard(testNamespaceID, testWorkflowID, testShardCount),
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	}
+	if err != nil {
+		s.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), request).Return(nil, err)
+		return
+	}
+	s.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), request).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+					[]byte("branch-token"),
+					[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(2, 5)},
+				)),
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{State: state},
+		},
+	}, nil)
+}
+
+func (s *activitiesSuite) verifyPageRequest() verifyPageRequest {
+	return verifyPageRequest{
+		Namespace: testNamespace,
+		ArchivalInfo: namespaceArchivalInfo{
+			NamespaceID:   testNamespaceID,
+			HistoryURI:    testHistoryURI,
+			VisibilityURI: testVisibilityURI,
+		},
+		Query:      "WorkflowType = 'type'",
+		SampleRate: 1,
+		Rearchive:  true,
+		PageSize:   defaultPageSize,
+	}
+}
+
+func (s *activitiesSuite) verifyPage() verifyPageResult {
+	return s.executeVerifyPage(s.verifyPageRequest())
+}
+
+func (s *activitiesSuite) executeVerifyPage(request verifyPageRequest) verifyPageResult {
+	value, err := s.env.ExecuteActivity(s.a.VerifyPageActivity, request)
+	s.NoError(err)
+	var result verifyPageResult
+	s.NoError(value.Get(&result))
+	return result
+}
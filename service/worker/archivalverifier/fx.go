@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"context"
+
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	// archivalVerifierComponent represents the background work needed to verify archived histories.
+	archivalVerifierComponent struct {
+		componentParams
+	}
+
+	componentParams struct {
+		fx.In
+		ArchiverProvider  provider.ArchiverProvider
+		NamespaceRegistry namespace.Registry
+		ExecutionManager  persistence.ExecutionManager
+		VisibilityManager manager.VisibilityManager
+		SaProvider        searchattribute.Provider
+		PersistenceConfig *config.Persistence
+		Logger            log.Logger
+	}
+)
+
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params componentParams) workercommon.WorkerComponent {
+	return &archivalVerifierComponent{componentParams: params}
+}
+
+func (wc *archivalVerifierComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(ArchivalVerifierWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (wc *archivalVerifierComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *archivalVerifierComponent) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivity(wc.activities())
+}
+
+func (wc *archivalVerifierComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	return &workercommon.DedicatedWorkerOptions{
+		TaskQueue: primitives.ArchivalVerifierActivityTQ,
+		Options: sdkworker.Options{
+			BackgroundActivityContext: headers.SetCallerType(context.Background(), headers.CallerTypePreemptable),
+		},
+	}
+}
+
+func (wc *archivalVerifierComponent) activities() *activities {
+	return newActivities(
+		wc.ArchiverProvider,
+		wc.NamespaceRegistry,
+		wc.ExecutionManager,
+		wc.VisibilityManager,
+		wc.SaProvider,
+		wc.PersistenceConfig.NumHistoryShards,
+		wc.Logger,
+	)
+}
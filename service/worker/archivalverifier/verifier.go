@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"context"
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+)
+
+const (
+	// historyPageSize is the number of events requested per archived history page
+	historyPageSize = 1000
+)
+
+// verifyHistory reads an archived history back page by page and checks that event IDs are
+// contiguous starting from the first event and that the history ends with a close event.
+// Errors which are likely transient are returned to the caller. InvalidArgument errors point at
+// a misconfigured archival (bad URI, missing bucket) rather than at this history, so they are
+// returned as non-retryable errors instead of marking every history of the namespace corrupted.
+// All other errors are reported as the verification status.
+func verifyHistory(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	URI archiver.URI,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) (VerificationStatus, string, error) {
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+		PageSize:    historyPageSize,
+	}
+
+	expectedEventID := common.FirstEventID
+	lastEventType := enumspb.EVENT_TYPE_UNSPECIFIED
+	for {
+		response, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			switch err.(type) {
+			case *serviceerror.NotFound:
+				return VerificationStatusMissing, err.Error(), nil
+			case *serviceerror.InvalidArgument:
+				return "", "", temporal.NewNonRetryableApplicationError("unable to read archived history", "", err)
+			case *serviceerror.Unavailable, *serviceerror.ResourceExhausted, *serviceerror.DeadlineExceeded, *serviceerror.Canceled:
+				return "", "", err
+			}
+			if ctx.Err() != nil {
+				return "", "", ctx.Err()
+			}
+			return VerificationStatusCorrupted, err.Error(), nil
+		}
+
+		for _, batch := range response.HistoryBatches {
+			for _, event := range batch.GetEvents() {
+				if event.GetEventId() != expectedEventID {
+					return VerificationStatusCorrupted, fmt.Sprintf("expected event ID %d but found %d", expectedEventID, event.GetEventId()), nil
+				}
+				expectedEventID++
+				lastEventType = event.GetEventType()
+			}
+		}
+
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	if expectedEventID == common.FirstEventID {
+		return VerificationStatusCorrupted, "archived history has no events", nil
+	}
+	if !isWorkflowCloseEvent(lastEventType) {
+		return VerificationStatusCorrupted, fmt.Sprintf("archived history ends with %v instead of a workflow close event", lastEventType), nil
+	}
+	return VerificationStatusValid, "", nil
+}
+
+func isWorkflowCloseEvent(eventType enumspb.EventType) bool {
+	switch eventType {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return true
+	default:
+		return false
+	}
+}
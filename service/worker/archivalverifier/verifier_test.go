@@ -0,0 +1,185 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/common/archiver"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testNamespaceID = "test-namespace-id"
+	testWorkflowID  = "test-workflow-id"
+	testRunID       = "test-run-id"
+	testHistoryURI  = "file:///tmp/history"
+)
+
+type verifierSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller      *gomock.Controller
+	historyArchiver *archiver.MockHistoryArchiver
+	uri             archiver.URI
+}
+
+func TestVerifierSuite(t *testing.T) {
+	suite.Run(t, new(verifierSuite))
+}
+
+func (s *verifierSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.historyArchiver = archiver.NewMockHistoryArchiver(s.controller)
+
+	var err error
+	s.uri, err = archiver.NewURI(testHistoryURI)
+	s.NoError(err)
+}
+
+func (s *verifierSuite) TestVerifyHistory_Valid() {
+	gomock.InOrder(
+		s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(&archiver.GetHistoryResponse{
+			HistoryBatches: []*historypb.History{
+				testHistory(1, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED),
+				testHistory(2, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED),
+			},
+			NextPageToken: []byte("token"),
+		}, nil),
+		s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, &archiver.GetHistoryRequest{
+			NamespaceID:   testNamespaceID,
+			WorkflowID:    testWorkflowID,
+			RunID:         testRunID,
+			PageSize:      historyPageSize,
+			NextPageToken: []byte("token"),
+		}).Return(&archiver.GetHistoryResponse{
+			HistoryBatches: []*historypb.History{
+				testHistory(3, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED),
+			},
+		}, nil),
+	)
+
+	status, reason, err := verifyHistory(context.Background(), s.historyArchiver, s.uri, testNamespaceID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(VerificationStatusValid, status)
+	s.Empty(reason)
+}
+
+func (s *verifierSuite) TestVerifyHistory_EventGap() {
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{
+			testHistory(1, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED),
+			testHistory(3, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED),
+		},
+	}, nil)
+
+	status, reason, err := verifyHistory(context.Background(), s.historyArchiver, s.uri, testNamespaceID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(VerificationStatusCorrupted, status)
+	s.Contains(reason, "expected event ID 2")
+}
+
+func (s *verifierSuite) TestVerifyHistory_MissingCloseEvent() {
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{
+			testHistory(1, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED),
+			testHistory(2, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED),
+		},
+	}, nil)
+
+	status, _, err := verifyHistory(context.Background(), s.historyArchiver, s.uri, testNamespaceID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(VerificationStatusCorrupted, status)
+}
+
+func (s *verifierSuite) TestVerifyHistory_Empty() {
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(&archiver.GetHistoryResponse{}, nil)
+
+	status, _, err := verifyHistory(context.Background(), s.historyArchiver, s.uri, testNamespaceID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(VerificationStatusCorrupted, status)
+}
+
+func (s *verifierSuite) TestVerifyHistory_NotFound() {
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error()))
+
+	status, _, err := verifyHistory(context.Background(), s.historyArchiver, s.uri, testNamespaceID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(VerificationStatusMissing, status)
+}
+
+func (s *verifierSuite) TestVerifyHistory_UnreadableBlob() {
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(nil, serviceerror.NewInternal("archived history batch checksum mismatch"))
+
+	status, reason, err := verifyHistory(context.Background(), s.historyArchiver, s.uri, testNamespaceID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(VerificationStatusCorrupted, status)
+	s.Contains(reason, "checksum mismatch")
+}
+
+func (s *verifierSuite) TestVerifyHistory_TransientError() {
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(nil, serviceerror.NewUnavailable("unavailable"))
+
+	_, _, err := verifyHistory(context.Background(), s.historyArchiver, s.uri, testNamespaceID, testWorkflowID, testRunID)
+	s.IsType(&serviceerror.Unavailable{}, err)
+}
+
+func (s *verifierSuite) TestVerifyHistory_InvalidArgument() {
+	s.historyArchiver.EXPECT().Get(gomock.Any(), s.uri, gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("bucket does not exist"))
+
+	_, _, err := verifyHistory(context.Background(), s.historyArchiver, s.uri, testNamespaceID, testWorkflowID, testRunID)
+	var appErr *temporal.ApplicationError
+	s.ErrorAs(err, &appErr)
+	s.True(appErr.NonRetryable())
+	s.ErrorContains(err, "bucket does not exist")
+}
+
+func (s *verifierSuite) TestSampled() {
+	s.True(sampled(testRunID, 1))
+	s.False(sampled(testRunID, 0))
+
+	count := 0
+	for i := 0; i < 10000; i++ {
+		if sampled(string(rune(i))+testRunID, 0.1) {
+			count++
+		}
+	}
+	s.InDelta(1000, count, 200)
+}
+
+func testHistory(eventID int64, eventType enumspb.EventType) *historypb.History {
+	return &historypb.History{
+		Events: []*historypb.HistoryEvent{
+			{EventId: eventID, EventType: eventType},
+		},
+	}
+}
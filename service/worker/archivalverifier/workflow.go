@@ -0,0 +1,213 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	WorkflowName = "temporal-sys-archival-verifier-workflow"
+
+	defaultPageSize          = 100
+	defaultPagesPerExecution = 100
+	// maxReportedFailures caps the number of failures carried in the report so that it stays
+	// well below the payload size limit. Counters are always exact.
+	maxReportedFailures = 1000
+)
+
+type (
+	// VerifierParams are the input of the archival verifier workflow.
+	VerifierParams struct {
+		Namespace namespace.Name
+		// Query selects the archived executions to verify. It uses the archived visibility
+		// query syntax of the visibility archiver configured for the namespace.
+		Query string
+		// SampleRate is the fraction of the selected executions that are verified.
+		// Default is 1, which verifies every execution.
+		SampleRate float64
+		// Rearchive re-archives corrupted or missing histories if the workflow execution
+		// still exists in persistence.
+		Rearchive bool
+		// PageSize is the number of archived executions listed per activity. Default is 100.
+		PageSize int
+		// PagesPerExecution is the number of pages processed before the workflow continues as new. Default is 100.
+		PagesPerExecution int
+
+		// NextPageToken and PreviousReport are carried over on continue-as-new.
+		NextPageToken  []byte
+		PreviousReport VerifierReport
+	}
+
+	// VerifierReport is the result of the archival verifier workflow.
+	VerifierReport struct {
+		// Listed is the number of archived executions selected by the query.
+		Listed int
+		// Verified is the number of sampled executions whose archived history was verified.
+		Verified   int
+		Corrupted  int
+		Missing    int
+		Rearchived int
+		// Failures lists corrupted or missing histories. Only the first maxReportedFailures are kept.
+		Failures []VerificationFailure
+	}
+
+	// VerificationFailure describes one archived history which failed verification.
+	VerificationFailure struct {
+		WorkflowID string
+		RunID      string
+		Status     VerificationStatus
+		Reason     string
+		// Rearchived is true if the history was re-archived and verified again successfully.
+		Rearchived bool
+	}
+
+	// VerificationStatus is the outcome of verifying one archived history.
+	VerificationStatus string
+)
+
+const (
+	VerificationStatusValid     VerificationStatus = "Valid"
+	VerificationStatusCorrupted VerificationStatus = "Corrupted"
+	VerificationStatusMissing   VerificationStatus = "Missing"
+)
+
+var (
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval: 10 * time.Second,
+		MaximumInterval: 5 * time.Minute,
+	}
+
+	getArchivalInfoActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:            activityRetryPolicy,
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+	}
+
+	verifyActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:            activityRetryPolicy,
+		StartToCloseTimeout:    30 * time.Minute,
+		ScheduleToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:       2 * time.Minute,
+	}
+)
+
+func validateParams(params *VerifierParams) error {
+	if params.Namespace.IsEmpty() {
+		return temporal.NewNonRetryableApplicationError("namespace is required", "", nil)
+	}
+	if params.SampleRate < 0 || params.SampleRate > 1 {
+		return temporal.NewNonRetryableApplicationError("sample rate must be between 0 and 1", "", nil)
+	}
+	if params.SampleRate == 0 {
+		params.SampleRate = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.PagesPerExecution <= 0 {
+		params.PagesPerExecution = defaultPagesPerExecution
+	}
+	return nil
+}
+
+// ArchivalVerifierWorkflow reads back archived histories of a namespace, checks that they are
+// complete and optionally re-archives the ones that are corrupted or missing.
+func ArchivalVerifierWorkflow(ctx workflow.Context, params VerifierParams) (VerifierReport, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName))
+
+	if err := validateParams(&params); err != nil {
+		return params.PreviousReport, err
+	}
+
+	ctx = workflow.WithTaskQueue(ctx, primitives.ArchivalVerifierActivityTQ)
+	var a *activities
+
+	var archivalInfo namespaceArchivalInfo
+	ctx1 := workflow.WithActivityOptions(ctx, getArchivalInfoActivityOptions)
+	if err := workflow.ExecuteActivity(ctx1, a.GetNamespaceArchivalInfoActivity, params.Namespace).Get(ctx, &archivalInfo); err != nil {
+		return params.PreviousReport, err
+	}
+
+	report := params.PreviousReport
+	nextPageToken := params.NextPageToken
+	ctx2 := workflow.WithActivityOptions(ctx, verifyActivityOptions)
+	for page := 0; page < params.PagesPerExecution; page++ {
+		var result verifyPageResult
+		err := workflow.ExecuteActivity(ctx2, a.VerifyPageActivity, verifyPageRequest{
+			Namespace:     params.Namespace,
+			ArchivalInfo:  archivalInfo,
+			Query:         params.Query,
+			SampleRate:    params.SampleRate,
+			Rearchive:     params.Rearchive,
+			PageSize:      params.PageSize,
+			NextPageToken: nextPageToken,
+		}).Get(ctx, &result)
+		if err != nil {
+			return report, err
+		}
+		report.merge(result)
+
+		nextPageToken = result.NextPageToken
+		if len(nextPageToken) == 0 {
+			logger.Info("Workflow finished.",
+				tag.WorkflowType(WorkflowName),
+				tag.NewInt("verified", report.Verified),
+				tag.NewInt("corrupted", report.Corrupted),
+				tag.NewInt("missing", report.Missing),
+				tag.NewInt("rearchived", report.Rearchived),
+			)
+			return report, nil
+		}
+	}
+
+	params.NextPageToken = nextPageToken
+	params.PreviousReport = report
+	return report, workflow.NewContinueAsNewError(ctx, ArchivalVerifierWorkflow, params)
+}
+
+func (r *VerifierReport) merge(result verifyPageResult) {
+	r.Listed += result.Listed
+	r.Verified += result.Verified
+	for _, failure := range result.Failures {
+		switch failure.Status {
+		case VerificationStatusCorrupted:
+			r.Corrupted++
+		case VerificationStatusMissing:
+			r.Missing++
+		}
+		if failure.Rearchived {
+			r.Rearchived++
+		}
+		if len(r.Failures) < maxReportedFailures {
+			r.Failures = append(r.Failures, failure)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/namespace"
+)
+
+func Test_ArchivalVerifierWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	archivalInfo := namespaceArchivalInfo{
+		NamespaceID:   "namespace-id",
+		HistoryURI:    "file:///tmp/history",
+		VisibilityURI: "file:///tmp/visibility",
+	}
+	env.OnActivity(a.GetNamespaceArchivalInfoActivity, mock.Anything, namespace.Name("namespace")).Return(archivalInfo, nil).Once()
+	env.OnActivity(a.VerifyPageActivity, mock.Anything, verifyPageRequest{
+		Namespace:    "namespace",
+		ArchivalInfo: archivalInfo,
+		Query:        "WorkflowType = 'type'",
+		SampleRate:   1,
+		Rearchive:    true,
+		PageSize:     defaultPageSize,
+	}).Return(verifyPageResult{
+		Listed:   2,
+		Verified: 2,
+		Failures: []VerificationFailure{
+			{WorkflowID: "wf-1", RunID: "run-1", Status: VerificationStatusMissing, Rearchived: true},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	env.OnActivity(a.VerifyPageActivity, mock.Anything, verifyPageRequest{
+		Namespace:     "namespace",
+		ArchivalInfo:  archivalInfo,
+		Query:         "WorkflowType = 'type'",
+		SampleRate:    1,
+		Rearchive:     true,
+		PageSize:      defaultPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(verifyPageResult{
+		Listed:   1,
+		Verified: 1,
+		Failures: []VerificationFailure{
+			{WorkflowID: "wf-2", RunID: "run-2", Status: VerificationStatusCorrupted},
+		},
+	}, nil).Once()
+
+	env.ExecuteWorkflow(ArchivalVerifierWorkflow, VerifierParams{
+		Namespace: "namespace",
+		Query:     "WorkflowType = 'type'",
+		Rearchive: true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var report VerifierReport
+	require.NoError(t, env.GetWorkflowResult(&report))
+	require.Equal(t, 3, report.Listed)
+	require.Equal(t, 3, report.Verified)
+	require.Equal(t, 1, report.Missing)
+	require.Equal(t, 1, report.Corrupted)
+	require.Equal(t, 1, report.Rearchived)
+	require.Len(t, report.Failures, 2)
+}
+
+func Test_ArchivalVerifierWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.GetNamespaceArchivalInfoActivity, mock.Anything, namespace.Name("namespace")).Return(namespaceArchivalInfo{}, nil).Once()
+	env.OnActivity(a.VerifyPageActivity, mock.Anything, mock.Anything).Return(verifyPageResult{
+		Listed:        1,
+		Verified:      1,
+		NextPageToken: []byte("token"),
+	}, nil).Times(2)
+
+	env.ExecuteWorkflow(ArchivalVerifierWorkflow, VerifierParams{
+		Namespace:         "namespace",
+		PagesPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	var continueAsNew *workflow.ContinueAsNewError
+	require.ErrorAs(t, err, &continueAsNew)
+}
+
+func Test_ArchivalVerifierWorkflow_InvalidParams(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(ArchivalVerifierWorkflow, VerifierParams{
+		Namespace:  "namespace",
+		SampleRate: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+}
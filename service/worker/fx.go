@@ -40,19 +40,23 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/archivalverifier"
 	"go.temporal.io/server/service/worker/batcher"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/deployment"
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/migration"
+	"go.temporal.io/server/service/worker/persistencemigration"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/visibilitymigration"
 	"go.uber.org/fx"
 )
 
@@ -61,6 +65,9 @@ var Module = fx.Options(
 	addsearchattributes.Module,
 	resource.Module,
 	deletenamespace.Module,
+	archivalverifier.Module,
+	visibilitymigration.Module,
+	persistencemigration.Module,
 	scheduler.Module,
 	batcher.Module,
 	deployment.Module,
@@ -167,11 +174,23 @@ func VisibilityManagerProvider(
 	saProvider searchattribute.Provider,
 	namespaceRegistry namespace.Registry,
 ) (manager.VisibilityManager, error) {
+	// Worker only writes to the secondary visibility store, when backfilling it from the primary one.
+	var esProcessorConfig *elasticsearch.ProcessorConfig
+	if persistenceConfig.SecondaryVisibilityStore != "" {
+		esProcessorConfig = &elasticsearch.ProcessorConfig{
+			IndexerConcurrency:       serviceConfig.IndexerConcurrency,
+			ESProcessorNumOfWorkers:  serviceConfig.ESProcessorNumOfWorkers,
+			ESProcessorBulkActions:   serviceConfig.ESProcessorBulkActions,
+			ESProcessorBulkSize:      serviceConfig.ESProcessorBulkSize,
+			ESProcessorFlushInterval: serviceConfig.ESProcessorFlushInterval,
+			ESProcessorAckTimeout:    serviceConfig.ESProcessorAckTimeout,
+		}
+	}
 	return visibility.NewManager(
 		*persistenceConfig,
 		persistenceServiceResolver,
 		customVisibilityStoreFactory,
-		nil, // worker visibility never write
+		esProcessorConfig,
 		saProvider,
 		searchAttributesMapperProvider,
 		namespaceRegistry,
@@ -181,7 +200,7 @@ func VisibilityManagerProvider(
 		serviceConfig.VisibilityPersistenceSlowQueryThreshold,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		serviceConfig.VisibilityEnableShadowReadMode,
-		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // worker visibility never write
+		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // worker never dual writes
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
 		metricsHandler,
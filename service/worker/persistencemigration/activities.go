@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/storemigration"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	activities struct {
+		dataStoreFactory     persistence.DataStoreFactory
+		taskCategoryRegistry tasks.TaskCategoryRegistry
+		logger               log.Logger
+
+		migratorLock sync.Mutex
+		migrator     *storemigration.Migrator
+	}
+
+	migrateMetadataResult struct {
+		TargetStore string
+		// Copied is the number of metadata records which were missing or different in the target store.
+		Copied int
+	}
+
+	verifyShardRequest struct {
+		ShardID  int32
+		PageSize int
+		Repair   bool
+	}
+
+	verifyShardResult struct {
+		Executions int
+		// ExecutionMismatches and TaskMismatches are the number of executions and history tasks which were
+		// missing or different in the target store. They were copied again if the request asked for repair.
+		ExecutionMismatches int
+		TaskMismatches      int
+	}
+
+	// verifyShardHeartbeat lets a retried verification resume from the last page it processed.
+	verifyShardHeartbeat struct {
+		NextPageToken []byte
+		Result        verifyShardResult
+	}
+)
+
+func newActivities(
+	dataStoreFactory persistence.DataStoreFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		dataStoreFactory:     dataStoreFactory,
+		taskCategoryRegistry: taskCategoryRegistry,
+		logger:               logger,
+	}
+}
+
+// MigrateMetadataActivity copies the metadata records which are missing or different in the target store, then
+// checks that both stores agree. Writes to metadata are shadowed to the target store, so they only disagree if a
+// write happened between both passes, in which case the activity is retried.
+func (a *activities) MigrateMetadataActivity(ctx context.Context) (migrateMetadataResult, error) {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return migrateMetadataResult{}, err
+	}
+	copied, err := migrator.VerifyMetadata(ctx, true)
+	if err != nil {
+		return migrateMetadataResult{}, err
+	}
+	mismatches, err := migrator.VerifyMetadata(ctx, false)
+	if err != nil {
+		return migrateMetadataResult{}, err
+	}
+	if mismatches > 0 {
+		return migrateMetadataResult{}, fmt.Errorf("%d metadata records differ in data store %v after they were copied", mismatches, migrator.TargetStore())
+	}
+	return migrateMetadataResult{
+		TargetStore: migrator.TargetStore(),
+		Copied:      copied,
+	}, nil
+}
+
+func (a *activities) GetShardStateActivity(ctx context.Context, shardID int32) (enumsspb.DataStoreMigrationState, error) {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, err
+	}
+	return migrator.ShardState(ctx, shardID)
+}
+
+func (a *activities) CopyShardActivity(ctx context.Context, shardID int32) error {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return err
+	}
+	return migrator.CopyShard(ctx, shardID)
+}
+
+// VerifyShardActivity compares the executions and history tasks of a shard with the target store, and copies
+// the ones which are missing or different if the request asks for repair.
+func (a *activities) VerifyShardActivity(ctx context.Context, request verifyShardRequest) (verifyShardResult, error) {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return verifyShardResult{}, err
+	}
+
+	var progress verifyShardHeartbeat
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			return verifyShardResult{}, err
+		}
+	}
+	for first := true; first || len(progress.NextPageToken) > 0; first = false {
+		page, err := migrator.VerifyExecutions(ctx, request.ShardID, request.PageSize, progress.NextPageToken, request.Repair)
+		if err != nil {
+			return verifyShardResult{}, err
+		}
+		progress.Result.Executions += page.Executions
+		progress.Result.ExecutionMismatches += page.Mismatches
+		progress.NextPageToken = page.NextPageToken
+		activity.RecordHeartbeat(ctx, progress)
+	}
+
+	progress.Result.TaskMismatches, err = migrator.VerifyHistoryTasks(ctx, request.ShardID, request.Repair)
+	if err != nil {
+		return verifyShardResult{}, err
+	}
+	if progress.Result.ExecutionMismatches > 0 || progress.Result.TaskMismatches > 0 {
+		a.logger.Info("Shard differs in target data store.",
+			tag.ShardID(request.ShardID),
+			tag.NewStringTag("target-store", migrator.TargetStore()),
+			tag.NewInt("execution-mismatches", progress.Result.ExecutionMismatches),
+			tag.NewInt("task-mismatches", progress.Result.TaskMismatches),
+			tag.NewBoolTag("repair", request.Repair),
+		)
+	}
+	return progress.Result, nil
+}
+
+func (a *activities) BeginCutoverActivity(ctx context.Context, shardID int32) error {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return err
+	}
+	return migrator.BeginCutover(ctx, shardID)
+}
+
+func (a *activities) CompleteCutoverActivity(ctx context.Context, shardID int32) error {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return err
+	}
+	return migrator.CompleteCutover(ctx, shardID)
+}
+
+func (a *activities) AbortCutoverActivity(ctx context.Context, shardID int32) error {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return err
+	}
+	return migrator.AbortCutover(ctx, shardID)
+}
+
+func (a *activities) getMigrator() (*storemigration.Migrator, error) {
+	a.migratorLock.Lock()
+	defer a.migratorLock.Unlock()
+
+	if a.migrator != nil {
+		return a.migrator, nil
+	}
+	factory, ok := storemigration.FromDataStoreFactory(a.dataStoreFactory)
+	if !ok {
+		return nil, temporal.NewNonRetryableApplicationError("persistence is not configured with a data store migration", "", nil)
+	}
+	migrator, err := factory.NewMigrator(a.taskCategoryRegistry)
+	if err != nil {
+		return nil, err
+	}
+	a.migrator = migrator
+	return migrator, nil
+}
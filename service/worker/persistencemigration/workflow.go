@@ -0,0 +1,279 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	WorkflowName = "temporal-sys-persistence-migration-workflow"
+	// WorkflowID is the ID of the persistence migration workflow. Only one migration may run at a time.
+	WorkflowID = WorkflowName
+
+	// QueryTypeProgress returns the MigrationProgress of the workflow.
+	QueryTypeProgress = "progress"
+
+	defaultPageSize           = 100
+	defaultShardsPerExecution = 100
+	// shardRetryInterval is the delay before the migration of a shard which failed is attempted again.
+	shardRetryInterval = time.Minute
+)
+
+type (
+	// MigrationParams are the input of the persistence migration workflow.
+	MigrationParams struct {
+		// ShardIDs are the shards to migrate, in order.
+		ShardIDs []int32
+		// SkipCutover backfills and verifies the shards without making the target store their primary store.
+		SkipCutover bool
+		// PageSize is the number of executions verified per page. Default is 100.
+		PageSize int
+		// ShardsPerExecution is the number of shards migrated before the workflow continues as new. Default is 100.
+		ShardsPerExecution int
+
+		// Progress is carried over on continue-as-new.
+		Progress MigrationProgress
+	}
+
+	// MigrationProgress is the result of the persistence migration workflow.
+	MigrationProgress struct {
+		TargetStore string
+		// MetadataMigrated is true once namespaces, cluster metadata, Nexus endpoints and task queues
+		// were copied and verified.
+		MetadataMigrated bool
+		// Shards has the progress of the shards whose migration started, in the order of MigrationParams.ShardIDs.
+		Shards []ShardProgress
+	}
+
+	// ShardProgress is the migration progress of one shard.
+	ShardProgress struct {
+		ShardID          int32
+		State            enumsspb.DataStoreMigrationState
+		ExecutionsCopied int64
+		// Mismatches is the number of records which differed between both stores in the last verification.
+		Mismatches int64
+		// LastError is the last error of the shard if its migration is being retried.
+		LastError string
+	}
+)
+
+var (
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval: 10 * time.Second,
+		MaximumInterval: 5 * time.Minute,
+		// failures are surfaced in the progress of the shard, which is retried by the workflow
+		MaximumAttempts: 5,
+	}
+
+	shortActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:         activityRetryPolicy,
+		StartToCloseTimeout: time.Minute,
+	}
+
+	verifyActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:         activityRetryPolicy,
+		StartToCloseTimeout: 12 * time.Hour,
+		HeartbeatTimeout:    2 * time.Minute,
+	}
+)
+
+func validateParams(params *MigrationParams) error {
+	if len(params.ShardIDs) == 0 {
+		return temporal.NewNonRetryableApplicationError("shard IDs are required", "", nil)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.ShardsPerExecution <= 0 {
+		params.ShardsPerExecution = defaultShardsPerExecution
+	}
+	return nil
+}
+
+// PersistenceMigrationWorkflow migrates the cluster to the target store of a data store migration. Writes are
+// already shadowed to the target store, so the workflow copies the data written before, verifies that both
+// stores agree, and cuts the shards over to the target store one at a time.
+func PersistenceMigrationWorkflow(ctx workflow.Context, params MigrationParams) (MigrationProgress, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName))
+
+	progress := &params.Progress
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (MigrationProgress, error) {
+		return *progress, nil
+	}); err != nil {
+		return *progress, err
+	}
+	if err := validateParams(&params); err != nil {
+		return *progress, err
+	}
+
+	ctx = workflow.WithTaskQueue(ctx, primitives.PersistenceMigrationActivityTQ)
+	var a *activities
+
+	if !progress.MetadataMigrated {
+		var result migrateMetadataResult
+		ctx1 := workflow.WithActivityOptions(ctx, verifyActivityOptions)
+		if err := workflow.ExecuteActivity(ctx1, a.MigrateMetadataActivity).Get(ctx, &result); err != nil {
+			return *progress, err
+		}
+		progress.TargetStore = result.TargetStore
+		progress.MetadataMigrated = true
+		logger.Info("Metadata migrated.",
+			tag.WorkflowType(WorkflowName),
+			tag.NewStringTag("target-store", result.TargetStore),
+			tag.NewInt("copied", result.Copied),
+		)
+	}
+
+	for migrated := 0; len(progress.Shards) < len(params.ShardIDs); migrated++ {
+		if migrated == params.ShardsPerExecution {
+			return *progress, workflow.NewContinueAsNewError(ctx, PersistenceMigrationWorkflow, params)
+		}
+		progress.Shards = append(progress.Shards, ShardProgress{ShardID: params.ShardIDs[len(progress.Shards)]})
+		shard := &progress.Shards[len(progress.Shards)-1]
+		if err := migrateShardWithRetries(ctx, params, shard); err != nil {
+			return *progress, err
+		}
+		logger.Info("Shard migrated.",
+			tag.WorkflowType(WorkflowName),
+			tag.ShardID(shard.ShardID),
+			tag.NewStringTag("state", shard.State.String()),
+			tag.NewInt64("executions-copied", shard.ExecutionsCopied),
+		)
+	}
+
+	logger.Info("Workflow finished.", tag.WorkflowType(WorkflowName), tag.NewInt("shards", len(progress.Shards)))
+	return *progress, nil
+}
+
+// migrateShardWithRetries migrates a shard until it succeeds, keeping the last error in its progress.
+func migrateShardWithRetries(ctx workflow.Context, params MigrationParams, shard *ShardProgress) error {
+	for {
+		err := migrateShard(ctx, params, shard)
+		if err == nil {
+			shard.LastError = ""
+			return nil
+		}
+		var appErr *temporal.ApplicationError
+		if temporal.IsCanceledError(err) || (errors.As(err, &appErr) && appErr.NonRetryable()) {
+			return err
+		}
+		shard.LastError = err.Error()
+		workflow.GetLogger(ctx).Warn("Unable to migrate shard, retrying.",
+			tag.WorkflowType(WorkflowName),
+			tag.ShardID(shard.ShardID),
+			tag.Error(err),
+		)
+		if err := workflow.Sleep(ctx, shardRetryInterval); err != nil {
+			return err
+		}
+	}
+}
+
+func migrateShard(ctx workflow.Context, params MigrationParams, shard *ShardProgress) error {
+	var a *activities
+	ctx1 := workflow.WithActivityOptions(ctx, shortActivityOptions)
+
+	if err := workflow.ExecuteActivity(ctx1, a.GetShardStateActivity, shard.ShardID).Get(ctx, &shard.State); err != nil {
+		return err
+	}
+	switch shard.State {
+	case enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER:
+		return nil
+	case enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER:
+		// a previous attempt began the cutover, finish it
+	default:
+		if err := workflow.ExecuteActivity(ctx1, a.CopyShardActivity, shard.ShardID).Get(ctx, nil); err != nil {
+			return err
+		}
+		if err := verifyShard(ctx, params, shard, true); err != nil {
+			return err
+		}
+		shard.State = enumsspb.DATA_STORE_MIGRATION_STATE_BACKFILLED
+		if err := verifyShard(ctx, params, shard, false); err != nil {
+			return err
+		}
+		if shard.Mismatches > 0 {
+			return fmt.Errorf("%d records of shard %d differ after backfill", shard.Mismatches, shard.ShardID)
+		}
+		shard.State = enumsspb.DATA_STORE_MIGRATION_STATE_VERIFIED
+		if params.SkipCutover {
+			return nil
+		}
+		if err := workflow.ExecuteActivity(ctx1, a.BeginCutoverActivity, shard.ShardID).Get(ctx, nil); err != nil {
+			return err
+		}
+		shard.State = enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER
+	}
+
+	// the shard is unavailable until the cutover is completed or aborted, so the writes which were in flight
+	// when it began are the only ones left to copy
+	err := verifyShard(ctx, params, shard, true)
+	if err == nil {
+		err = verifyShard(ctx, params, shard, false)
+	}
+	if err == nil && shard.Mismatches > 0 {
+		err = fmt.Errorf("%d records of shard %d differ after cutover began", shard.Mismatches, shard.ShardID)
+	}
+	if err != nil {
+		if abortErr := workflow.ExecuteActivity(ctx1, a.AbortCutoverActivity, shard.ShardID).Get(ctx, nil); abortErr != nil {
+			return errors.Join(err, abortErr)
+		}
+		shard.State = enumsspb.DATA_STORE_MIGRATION_STATE_VERIFIED
+		return err
+	}
+	if err := workflow.ExecuteActivity(ctx1, a.CompleteCutoverActivity, shard.ShardID).Get(ctx, nil); err != nil {
+		return err
+	}
+	shard.State = enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER
+	return nil
+}
+
+// verifyShard verifies a shard, counting the copied records if repair is true.
+func verifyShard(ctx workflow.Context, params MigrationParams, shard *ShardProgress, repair bool) error {
+	var a *activities
+	ctx1 := workflow.WithActivityOptions(ctx, verifyActivityOptions)
+
+	var result verifyShardResult
+	if err := workflow.ExecuteActivity(ctx1, a.VerifyShardActivity, verifyShardRequest{
+		ShardID:  shard.ShardID,
+		PageSize: params.PageSize,
+		Repair:   repair,
+	}).Get(ctx, &result); err != nil {
+		return err
+	}
+	shard.Mismatches = int64(result.ExecutionMismatches + result.TaskMismatches)
+	if repair {
+		shard.ExecutionsCopied += int64(result.ExecutionMismatches)
+	}
+	return nil
+}
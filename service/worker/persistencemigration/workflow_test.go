@@ -0,0 +1,159 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+func Test_PersistenceMigrationWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.MigrateMetadataActivity, mock.Anything).Return(migrateMetadataResult{TargetStore: "target", Copied: 3}, nil).Once()
+	env.OnActivity(a.GetShardStateActivity, mock.Anything, int32(1)).Return(enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, nil).Once()
+	env.OnActivity(a.CopyShardActivity, mock.Anything, int32(1)).Return(nil).Once()
+	env.OnActivity(a.VerifyShardActivity, mock.Anything, verifyShardRequest{ShardID: 1, PageSize: defaultPageSize, Repair: true}).
+		Return(verifyShardResult{Executions: 10, ExecutionMismatches: 10, TaskMismatches: 4}, nil).Once()
+	env.OnActivity(a.VerifyShardActivity, mock.Anything, verifyShardRequest{ShardID: 1, PageSize: defaultPageSize}).
+		Return(verifyShardResult{Executions: 10}, nil).Once()
+	env.OnActivity(a.BeginCutoverActivity, mock.Anything, int32(1)).Return(nil).Once()
+	env.OnActivity(a.VerifyShardActivity, mock.Anything, verifyShardRequest{ShardID: 1, PageSize: defaultPageSize, Repair: true}).
+		Return(verifyShardResult{Executions: 11, ExecutionMismatches: 1}, nil).Once()
+	env.OnActivity(a.VerifyShardActivity, mock.Anything, verifyShardRequest{ShardID: 1, PageSize: defaultPageSize}).
+		Return(verifyShardResult{Executions: 11}, nil).Once()
+	env.OnActivity(a.CompleteCutoverActivity, mock.Anything, int32(1)).Return(nil).Once()
+	env.OnActivity(a.GetShardStateActivity, mock.Anything, int32(2)).Return(enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER, nil).Once()
+
+	env.ExecuteWorkflow(PersistenceMigrationWorkflow, MigrationParams{ShardIDs: []int32{1, 2}})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress MigrationProgress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Equal(t, MigrationProgress{
+		TargetStore:      "target",
+		MetadataMigrated: true,
+		Shards: []ShardProgress{
+			{ShardID: 1, State: enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER, ExecutionsCopied: 11},
+			{ShardID: 2, State: enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER},
+		},
+	}, progress)
+	env.AssertExpectations(t)
+}
+
+func Test_PersistenceMigrationWorkflow_SkipCutover(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.MigrateMetadataActivity, mock.Anything).Return(migrateMetadataResult{TargetStore: "target"}, nil).Once()
+	env.OnActivity(a.GetShardStateActivity, mock.Anything, int32(1)).Return(enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, nil).Once()
+	env.OnActivity(a.CopyShardActivity, mock.Anything, int32(1)).Return(nil).Once()
+	env.OnActivity(a.VerifyShardActivity, mock.Anything, mock.Anything).Return(verifyShardResult{Executions: 1}, nil).Twice()
+
+	env.ExecuteWorkflow(PersistenceMigrationWorkflow, MigrationParams{ShardIDs: []int32{1}, SkipCutover: true})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress MigrationProgress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Equal(t, []ShardProgress{
+		{ShardID: 1, State: enumsspb.DATA_STORE_MIGRATION_STATE_VERIFIED},
+	}, progress.Shards)
+	env.AssertExpectations(t)
+}
+
+func Test_PersistenceMigrationWorkflow_AbortCutover(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.MigrateMetadataActivity, mock.Anything).Return(migrateMetadataResult{TargetStore: "target"}, nil).Once()
+	// the first attempt finds a shard which is already being cut over, but still differs in the target store
+	env.OnActivity(a.GetShardStateActivity, mock.Anything, int32(1)).Return(enumsspb.DATA_STORE_MIGRATION_STATE_CUTTING_OVER, nil).Once()
+	env.OnActivity(a.VerifyShardActivity, mock.Anything, verifyShardRequest{ShardID: 1, PageSize: defaultPageSize, Repair: true}).
+		Return(verifyShardResult{}, nil).Once()
+	env.OnActivity(a.VerifyShardActivity, mock.Anything, verifyShardRequest{ShardID: 1, PageSize: defaultPageSize}).
+		Return(verifyShardResult{TaskMismatches: 2}, nil).Once()
+	env.OnActivity(a.AbortCutoverActivity, mock.Anything, int32(1)).Return(nil).Once()
+	// later attempts fail before the cutover begins
+	env.OnActivity(a.GetShardStateActivity, mock.Anything, int32(1)).Return(enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, nil)
+	env.OnActivity(a.CopyShardActivity, mock.Anything, int32(1)).Return(errors.New("copy failed"))
+
+	var progress MigrationProgress
+	env.RegisterDelayedCallback(func() {
+		value, err := env.QueryWorkflow(QueryTypeProgress)
+		require.NoError(t, err)
+		require.NoError(t, value.Get(&progress))
+		env.CancelWorkflow()
+	}, shardRetryInterval+time.Hour)
+	env.ExecuteWorkflow(PersistenceMigrationWorkflow, MigrationParams{ShardIDs: []int32{1}})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	require.True(t, progress.MetadataMigrated)
+	require.Len(t, progress.Shards, 1)
+	require.Equal(t, enumsspb.DATA_STORE_MIGRATION_STATE_UNSPECIFIED, progress.Shards[0].State)
+	require.Equal(t, int64(2), progress.Shards[0].Mismatches)
+	require.Contains(t, progress.Shards[0].LastError, "copy failed")
+	env.AssertExpectations(t)
+}
+
+func Test_PersistenceMigrationWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.MigrateMetadataActivity, mock.Anything).Return(migrateMetadataResult{TargetStore: "target"}, nil).Once()
+	env.OnActivity(a.GetShardStateActivity, mock.Anything, mock.Anything).Return(enumsspb.DATA_STORE_MIGRATION_STATE_CUT_OVER, nil).Twice()
+
+	env.ExecuteWorkflow(PersistenceMigrationWorkflow, MigrationParams{
+		ShardIDs:           []int32{1, 2, 3},
+		ShardsPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	var continueAsNew *workflow.ContinueAsNewError
+	require.ErrorAs(t, err, &continueAsNew)
+}
+
+func Test_PersistenceMigrationWorkflow_InvalidParams(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(PersistenceMigrationWorkflow, MigrationParams{})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+}
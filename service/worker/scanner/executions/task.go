@@ -215,7 +215,8 @@ func (t *task) getPaginationFn() collection.PaginationFn[*persistencespb.Workflo
 			PageSize:  executionsPageSize,
 			PageToken: paginationToken,
 		}
-		resp, err := t.executionManager.ListConcreteExecutions(t.ctx, req)
+		// The scanner tolerates stale executions, so the scan may be served by a read replica.
+		resp, err := t.executionManager.ListConcreteExecutions(persistence.WithReplicaReads(t.ctx), req)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/tieredhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 )
@@ -76,6 +77,10 @@ type (
 		historyDataMinAge           dynamicconfig.DurationPropertyFn
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
 		enableRetentionVerification dynamicconfig.BoolPropertyFn
+		// offloader offloads the history of open workflows older than historyTieringMinAge to the blob store,
+		// it is nil if history tiering is not configured.
+		offloader            tieredhistory.Offloader
+		historyTieringMinAge dynamicconfig.DurationPropertyFn
 
 		sync.WaitGroup
 		sync.Mutex
@@ -88,6 +93,7 @@ type (
 		workflowID  string
 		runID       string
 		branchToken []byte
+		forkTime    time.Time
 	}
 )
 
@@ -103,6 +109,7 @@ const (
 // each branch, the scavenger will attempt
 //   - describe the corresponding workflow execution
 //   - deletion of history itself, if there are no workflow execution
+//   - offloading of old history to the blob store, if the workflow execution is open and offloader is set
 func NewScavenger(
 	numShards int32,
 	db persistence.ExecutionManager,
@@ -114,6 +121,8 @@ func NewScavenger(
 	historyDataMinAge dynamicconfig.DurationPropertyFn,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableRetentionVerification dynamicconfig.BoolPropertyFn,
+	offloader tieredhistory.Offloader,
+	historyTieringMinAge dynamicconfig.DurationPropertyFn,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Scavenger {
@@ -130,6 +139,8 @@ func NewScavenger(
 		historyDataMinAge:           historyDataMinAge,
 		executionDataDurationBuffer: executionDataDurationBuffer,
 		enableRetentionVerification: enableRetentionVerification,
+		offloader:                   offloader,
+		historyTieringMinAge:        historyTieringMinAge,
 		metricsHandler:              metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryScavengerScope)),
 		logger:                      logger,
 
@@ -229,7 +240,11 @@ func (s *Scavenger) filterTask(
 	branch persistence.HistoryBranchDetail,
 ) *taskDetail {
 
-	if time.Now().UTC().Add(-s.historyDataMinAge()).Before(timestamp.TimeValue(branch.ForkTime)) {
+	minAge := s.historyDataMinAge()
+	if s.offloader != nil {
+		minAge = min(minAge, s.historyTieringMinAge())
+	}
+	if time.Now().UTC().Add(-minAge).Before(timestamp.TimeValue(branch.ForkTime)) {
 		metrics.HistoryScavengerSkipCount.With(s.metricsHandler).Record(1)
 
 		s.Lock()
@@ -267,6 +282,7 @@ func (s *Scavenger) filterTask(
 		workflowID:  workflowID,
 		runID:       runID,
 		branchToken: branchToken.Data,
+		forkTime:    timestamp.TimeValue(branch.ForkTime),
 	}
 }
 
@@ -285,6 +301,9 @@ func (s *Scavenger) handleTask(
 	})
 	switch err.(type) {
 	case nil:
+		if err := s.offloadHistory(ctx, task, ms.GetDatabaseMutableState()); err != nil {
+			return err
+		}
 		if s.enableRetentionVerification() {
 			return s.cleanUpWorkflowPastRetention(ctx, ms.GetDatabaseMutableState())
 		}
@@ -296,6 +315,12 @@ func (s *Scavenger) handleTask(
 		return err
 	}
 
+	// branches younger than historyDataMinAge are only scanned for offloading, they may belong to a workflow
+	// which is being created
+	if time.Now().UTC().Add(-s.historyDataMinAge()).Before(task.forkTime) {
+		return nil
+	}
+
 	//deleting history branch
 	err = s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
 		ShardID:     task.shardID,
@@ -347,6 +372,32 @@ func (s *Scavenger) getPaginationFn(
 	}
 }
 
+func (s *Scavenger) offloadHistory(
+	ctx context.Context,
+	task taskDetail,
+	mutableState *persistencepb.WorkflowMutableState,
+) error {
+	if s.offloader == nil || mutableState.GetExecutionState().GetState() == enums.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		// history of closed workflows is deleted after retention
+		return nil
+	}
+
+	resp, err := s.offloader.OffloadHistoryBranch(ctx, &tieredhistory.OffloadHistoryBranchRequest{
+		ShardID:     task.shardID,
+		BranchToken: task.branchToken,
+		OlderThan:   time.Now().UTC().Add(-s.historyTieringMinAge()),
+	})
+	if err != nil {
+		s.logger.Error("encountered error when offloading history branch", getTaskLoggingTags(err, task)...)
+		return err
+	}
+	if resp.OffloadedNodes > 0 {
+		metrics.HistoryScavengerOffloadedNodeCount.With(s.metricsHandler).Record(int64(resp.OffloadedNodes))
+		s.logger.Info("offloaded history", append(getTaskLoggingTags(nil, task), tag.Counter(resp.OffloadedNodes))...)
+	}
+	return nil
+}
+
 func (s *Scavenger) cleanUpWorkflowPastRetention(
 	ctx context.Context,
 	mutableState *persistencepb.WorkflowMutableState,
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/tieredhistory"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/testing/protomock"
@@ -124,6 +125,8 @@ func (s *ScavengerTestSuite) createTestScavenger(
 		dataAge,
 		executionDataAge,
 		enableRetentionVerification,
+		nil,
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
 		s.metricHandler,
 		s.logger,
 	)
@@ -746,3 +749,104 @@ func (s *ScavengerTestSuite) TestDeleteWorkflowAfterRetention() {
 	s.Equal(2, hbd.CurrentPage)
 	s.Equal(0, len(hbd.NextPageToken))
 }
+
+func (s *ScavengerTestSuite) TestOffloadOpenWorkflows() {
+	offloader := tieredhistory.NewMockOffloader(s.controller)
+	s.scavenger.offloader = offloader
+	s.scavenger.historyTieringMinAge = dynamicconfig.GetDurationPropertyFn(10 * time.Minute)
+	// younger than historyDataMinAge, but old enough to be offloaded
+	forkTime := timestamp.TimeNowPtrUtcAddDuration(-30 * time.Minute)
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), protomock.Eq(&persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	})).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{
+				BranchInfo: &persistencepb.HistoryBranch{
+					TreeId:   treeID1,
+					BranchId: branchID1,
+				},
+				ForkTime: forkTime,
+				Info:     persistence.BuildHistoryGarbageCleanupInfo("namespaceID1", "workflowID1", "runID1"),
+			},
+			{
+				BranchInfo: &persistencepb.HistoryBranch{
+					TreeId:   treeID2,
+					BranchId: branchID2,
+				},
+				ForkTime: forkTime,
+				Info:     persistence.BuildHistoryGarbageCleanupInfo("namespaceID2", "workflowID2", "runID2"),
+			},
+			{
+				BranchInfo: &persistencepb.HistoryBranch{
+					TreeId:   treeID3,
+					BranchId: branchID3,
+				},
+				ForkTime: forkTime,
+				Info:     persistence.BuildHistoryGarbageCleanupInfo("namespaceID3", "workflowID3", "runID3"),
+			},
+		},
+	}, nil)
+
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID1",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID1",
+			RunId:      "runID1",
+		},
+	})).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencepb.WorkflowMutableState{
+			ExecutionState: &persistencepb.WorkflowExecutionState{
+				RunId: "runID1",
+				State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID2",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID2",
+			RunId:      "runID2",
+		},
+	})).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencepb.WorkflowMutableState{
+			ExecutionInfo: &persistencepb.WorkflowExecutionInfo{
+				LastUpdateTime: timestamppb.New(time.Now()),
+			},
+			ExecutionState: &persistencepb.WorkflowExecutionState{
+				RunId: "runID2",
+				State: enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			},
+		},
+	}, nil)
+	// the branch is too young to be deleted as garbage
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID3",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID3",
+			RunId:      "runID3",
+		},
+	})).Return(nil, serviceerror.NewNotFound(""))
+	s.mockRegistry.EXPECT().GetNamespaceByID(gomock.Any()).Return(namespace.NewNamespaceForTest(
+		nil,
+		&persistencepb.NamespaceConfig{Retention: durationpb.New(time.Hour)},
+		false,
+		nil,
+		0,
+	), nil)
+
+	branchToken1, err := s.historyBranchUtil.NewHistoryBranch(uuid.New(), uuid.New(), uuid.New(), treeID1, &branchID1, []*persistencepb.HistoryBranchRange{}, 0, 0, 0)
+	s.Nil(err)
+	offloader.EXPECT().OffloadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *tieredhistory.OffloadHistoryBranchRequest) (*tieredhistory.OffloadHistoryBranchResponse, error) {
+			s.Equal(common.WorkflowIDToHistoryShard("namespaceID1", "workflowID1", s.numShards), request.ShardID)
+			s.Equal(branchToken1, request.BranchToken)
+			s.WithinDuration(time.Now().Add(-10*time.Minute), request.OlderThan, time.Minute)
+			return &tieredhistory.OffloadHistoryBranchResponse{OffloadedNodes: 3}, nil
+		})
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.Nil(err)
+	s.Equal(0, hbd.SkipCount)
+	s.Equal(3, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+}
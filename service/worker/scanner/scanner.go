@@ -48,6 +48,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/tieredhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
@@ -78,6 +79,8 @@ type (
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
 		// HistoryScannerVerifyRetention indicates if the history scavenger to do retention verification
 		HistoryScannerVerifyRetention dynamicconfig.BoolPropertyFn
+		// HistoryTieringMinAge is the age after which the history of open workflows is offloaded to the blob store
+		HistoryTieringMinAge dynamicconfig.DurationPropertyFn
 		// ExecutionScannerPerHostQPS the max rate of calls to scan execution data per host
 		ExecutionScannerPerHostQPS dynamicconfig.IntPropertyFn
 		// ExecutionScannerPerShardQPS the max rate of calls to scan execution data per shard
@@ -104,6 +107,7 @@ type (
 		sdkClientFactory   sdk.ClientFactory
 		metricsHandler     metrics.Handler
 		executionManager   persistence.ExecutionManager
+		historyOffloader   tieredhistory.Offloader
 		taskManager        persistence.TaskManager
 		visibilityManager  manager.VisibilityManager
 		metadataManager    persistence.MetadataManager
@@ -136,6 +140,7 @@ func New(
 	sdkClientFactory sdk.ClientFactory,
 	metricsHandler metrics.Handler,
 	executionManager persistence.ExecutionManager,
+	historyOffloader tieredhistory.Offloader,
 	metadataManager persistence.MetadataManager,
 	visibilityManager manager.VisibilityManager,
 	taskManager persistence.TaskManager,
@@ -153,6 +158,7 @@ func New(
 			logger:             logger,
 			metricsHandler:     metricsHandler,
 			executionManager:   executionManager,
+			historyOffloader:   historyOffloader,
 			taskManager:        taskManager,
 			visibilityManager:  visibilityManager,
 			metadataManager:    metadataManager,
@@ -189,7 +195,9 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, executionsScannerTaskQueueName)
 	}
 
-	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL && s.context.cfg.TaskQueueScannerEnabled() {
+	// Tasks of the SQL and pebble stores don't expire, the task queue scanner deletes them.
+	storeType := s.context.cfg.Persistence.DefaultStoreType()
+	if (storeType == config.StoreTypeSQL || storeType == config.StoreTypePebble) && s.context.cfg.TaskQueueScannerEnabled() {
 		s.wg.Add(1)
 		go s.startWorkflowWithRetry(ctx, tlScannerWFStartOptions, tqScannerWFTypeName)
 		workerTaskQueueNames = append(workerTaskQueueNames, tqScannerTaskQueueName)
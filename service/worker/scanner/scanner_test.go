@@ -218,6 +218,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				mockSdkClientFactory,
 				metrics.NoopMetricsHandler,
 				p.NewMockExecutionManager(ctrl),
+				nil,
 				// These nils are irrelevant since they're only used by the build ID scavenger which is not tested here.
 				nil,
 				nil,
@@ -294,6 +295,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		mockSdkClientFactory,
 		metrics.NoopMetricsHandler,
 		p.NewMockExecutionManager(ctrl),
+		nil,
 		// These nils are irrelevant since they're only used by the build ID scavenger which is not tested here.
 		nil,
 		nil,
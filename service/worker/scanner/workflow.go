@@ -155,6 +155,8 @@ func HistoryScavengerActivity(
 		ctx.cfg.HistoryScannerDataMinAge,
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.HistoryScannerVerifyRetention,
+		ctx.historyOffloader,
+		ctx.cfg.HistoryTieringMinAge,
 		ctx.metricsHandler,
 		ctx.logger,
 	)
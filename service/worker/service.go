@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/tieredhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
@@ -63,6 +64,7 @@ type (
 		membershipMonitor      membership.Monitor
 		hostInfo               membership.HostInfo
 		executionManager       persistence.ExecutionManager
+		historyOffloader       tieredhistory.Offloader
 		taskManager            persistence.TaskManager
 		historyClient          resource.HistoryClient
 		namespaceRegistry      namespace.Registry
@@ -112,6 +114,14 @@ type (
 		VisibilityEnableShadowReadMode          dynamicconfig.BoolPropertyFn
 		VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+		// Used to write to the secondary visibility store when backfilling it from the primary one.
+		IndexerConcurrency       dynamicconfig.IntPropertyFn
+		ESProcessorNumOfWorkers  dynamicconfig.IntPropertyFn
+		ESProcessorBulkActions   dynamicconfig.IntPropertyFn
+		ESProcessorBulkSize      dynamicconfig.IntPropertyFn
+		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
+		ESProcessorAckTimeout    dynamicconfig.DurationPropertyFn
 	}
 )
 
@@ -137,6 +147,7 @@ func NewService(
 	visibilityManager manager.VisibilityManager,
 	matchingClient resource.MatchingClient,
 	namespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor,
+	dataStoreFactory persistence.DataStoreFactory,
 ) (*Service, error) {
 	workerServiceResolver, err := membershipMonitor.GetResolver(primitives.WorkerService)
 	if err != nil {
@@ -153,6 +164,7 @@ func NewService(
 		clusterMetadataManager:    clusterMetadataManager,
 		namespaceRegistry:         namespaceRegistry,
 		executionManager:          executionManager,
+		historyOffloader:          tieredhistory.OffloaderFromDataStoreFactory(dataStoreFactory),
 		workerServiceResolver:     workerServiceResolver,
 		membershipMonitor:         membershipMonitor,
 		hostInfo:                  hostInfoProvider.HostInfo(),
@@ -201,6 +213,7 @@ func NewConfig(
 			ExecutionsScannerEnabled:                dynamicconfig.ExecutionsScannerEnabled.Get(dc),
 			HistoryScannerDataMinAge:                dynamicconfig.HistoryScannerDataMinAge.Get(dc),
 			HistoryScannerVerifyRetention:           dynamicconfig.HistoryScannerVerifyRetention.Get(dc),
+			HistoryTieringMinAge:                    dynamicconfig.HistoryTieringMinAge.Get(dc),
 			ExecutionScannerPerHostQPS:              dynamicconfig.ExecutionScannerPerHostQPS.Get(dc),
 			ExecutionScannerPerShardQPS:             dynamicconfig.ExecutionScannerPerShardQPS.Get(dc),
 			ExecutionDataDurationBuffer:             dynamicconfig.ExecutionDataDurationBuffer.Get(dc),
@@ -232,6 +245,13 @@ func NewConfig(
 		VisibilityEnableShadowReadMode:          dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
+
+		IndexerConcurrency:       dynamicconfig.WorkerIndexerConcurrency.Get(dc),
+		ESProcessorNumOfWorkers:  dynamicconfig.WorkerESProcessorNumOfWorkers.Get(dc),
+		ESProcessorBulkActions:   dynamicconfig.WorkerESProcessorBulkActions.Get(dc),
+		ESProcessorBulkSize:      dynamicconfig.WorkerESProcessorBulkSize.Get(dc),
+		ESProcessorFlushInterval: dynamicconfig.WorkerESProcessorFlushInterval.Get(dc),
+		ESProcessorAckTimeout:    dynamicconfig.WorkerESProcessorAckTimeout.Get(dc),
 	}
 	return config
 }
@@ -321,6 +341,7 @@ func (s *Service) initScanner() error {
 		s.sdkClientFactory,
 		s.metricsHandler,
 		s.executionManager,
+		s.historyOffloader,
 		s.metadataManager,
 		s.visibilityManager,
 		s.taskManager,
@@ -0,0 +1,379 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// backfillTaskID is the task ID of the records written to the secondary store. Elasticsearch uses
+	// the task ID as the document version, and real task IDs are always greater, so a record written
+	// by history in the meantime is never overwritten by the older copy read from the primary store.
+	// This also means that stale Elasticsearch documents are not overwritten: they are reported by
+	// the verification pass and are fixed by the next visibility update of their execution.
+	backfillTaskID = 0
+)
+
+type (
+	activities struct {
+		visibilityManager manager.VisibilityManager
+		namespaceRegistry namespace.Registry
+		saMapperProvider  searchattribute.MapperProvider
+		numHistoryShards  int32
+		logger            log.Logger
+	}
+
+	pageRequest struct {
+		Namespace     namespace.Name
+		Query         string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	backfillPageResult struct {
+		Scanned       int
+		Missing       int
+		Stale         int
+		Written       int
+		NextPageToken []byte
+	}
+
+	verifyPageResult struct {
+		Verified      int
+		Diffs         []RecordDiff
+		NextPageToken []byte
+	}
+
+	countRequest struct {
+		Namespace namespace.Name
+		Query     string
+	}
+
+	countResult struct {
+		PrimaryCount   int64
+		SecondaryCount int64
+	}
+)
+
+func newActivities(
+	visibilityManager manager.VisibilityManager,
+	namespaceRegistry namespace.Registry,
+	saMapperProvider searchattribute.MapperProvider,
+	numHistoryShards int32,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		visibilityManager: visibilityManager,
+		namespaceRegistry: namespaceRegistry,
+		saMapperProvider:  saMapperProvider,
+		numHistoryShards:  numHistoryShards,
+		logger:            logger,
+	}
+}
+
+// BackfillVisibilityPageActivity writes the records of one page of the primary store to the secondary store
+// if they are missing or different there.
+func (a *activities) BackfillVisibilityPageActivity(ctx context.Context, request pageRequest) (backfillPageResult, error) {
+	ctx = headers.SetCallerName(ctx, request.Namespace.String())
+	primary, secondary, err := a.getVisibilityManagers()
+	if err != nil {
+		return backfillPageResult{}, err
+	}
+	nsID, err := a.getNamespaceID(request.Namespace)
+	if err != nil {
+		return backfillPageResult{}, err
+	}
+	resp, err := a.scan(ctx, primary, nsID, request)
+	if err != nil {
+		return backfillPageResult{}, err
+	}
+
+	result := backfillPageResult{
+		Scanned:       len(resp.Executions),
+		NextPageToken: resp.NextPageToken,
+	}
+	for i, record := range resp.Executions {
+		current, err := a.getRecord(ctx, secondary, nsID, request.Namespace, record)
+		if err != nil {
+			return backfillPageResult{}, err
+		}
+		if current == nil {
+			result.Missing++
+		} else if diffRecords(record, current) != "" {
+			result.Stale++
+		} else {
+			continue
+		}
+		if err := a.writeRecord(ctx, secondary, nsID, request.Namespace, record); err != nil {
+			return backfillPageResult{}, err
+		}
+		result.Written++
+		activity.RecordHeartbeat(ctx, i)
+	}
+	return result, nil
+}
+
+// VerifyVisibilityPageActivity compares the records of one page of the primary store to the secondary store.
+func (a *activities) VerifyVisibilityPageActivity(ctx context.Context, request pageRequest) (verifyPageResult, error) {
+	ctx = headers.SetCallerName(ctx, request.Namespace.String())
+	logger := log.With(a.logger, tag.WorkflowNamespace(request.Namespace.String()))
+	primary, secondary, err := a.getVisibilityManagers()
+	if err != nil {
+		return verifyPageResult{}, err
+	}
+	nsID, err := a.getNamespaceID(request.Namespace)
+	if err != nil {
+		return verifyPageResult{}, err
+	}
+	resp, err := a.scan(ctx, primary, nsID, request)
+	if err != nil {
+		return verifyPageResult{}, err
+	}
+
+	result := verifyPageResult{
+		Verified:      len(resp.Executions),
+		NextPageToken: resp.NextPageToken,
+	}
+	for i, record := range resp.Executions {
+		reason, err := a.verifyRecord(ctx, primary, secondary, nsID, request.Namespace, record)
+		if err != nil {
+			return verifyPageResult{}, err
+		}
+		activity.RecordHeartbeat(ctx, i)
+		if reason == "" {
+			continue
+		}
+		logger.Warn("Visibility record differs between primary and secondary visibility stores.",
+			tag.WorkflowID(record.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(record.GetExecution().GetRunId()),
+			tag.NewStringTag("reason", reason),
+		)
+		result.Diffs = append(result.Diffs, RecordDiff{
+			WorkflowID: record.GetExecution().GetWorkflowId(),
+			RunID:      record.GetExecution().GetRunId(),
+			Reason:     reason,
+		})
+	}
+	return result, nil
+}
+
+// CountActivity counts the records selected by the query in both stores.
+func (a *activities) CountActivity(ctx context.Context, request countRequest) (countResult, error) {
+	ctx = headers.SetCallerName(ctx, request.Namespace.String())
+	primary, secondary, err := a.getVisibilityManagers()
+	if err != nil {
+		return countResult{}, err
+	}
+	nsID, err := a.getNamespaceID(request.Namespace)
+	if err != nil {
+		return countResult{}, err
+	}
+	var counts [2]int64
+	for i, m := range []manager.VisibilityManager{primary, secondary} {
+		resp, err := m.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+			NamespaceID: nsID,
+			Namespace:   request.Namespace,
+			Query:       request.Query,
+		})
+		if err != nil {
+			if _, isInvalidArgument := err.(*serviceerror.InvalidArgument); isInvalidArgument {
+				return countResult{}, temporal.NewNonRetryableApplicationError("unable to count visibility records", "", err)
+			}
+			return countResult{}, err
+		}
+		counts[i] = resp.Count
+	}
+	return countResult{
+		PrimaryCount:   counts[0],
+		SecondaryCount: counts[1],
+	}, nil
+}
+
+// verifyRecord returns the reason why the secondary copy of record differs from it, or an empty string
+// if it doesn't. The primary record is read again before reporting a diff, to rule out an update
+// made to both stores after the page was scanned.
+func (a *activities) verifyRecord(
+	ctx context.Context,
+	primary manager.VisibilityManager,
+	secondary manager.VisibilityManager,
+	nsID namespace.ID,
+	nsName namespace.Name,
+	record *workflowpb.WorkflowExecutionInfo,
+) (string, error) {
+	reason, err := a.diffSecondaryRecord(ctx, secondary, nsID, nsName, record)
+	if err != nil || reason == "" {
+		return reason, err
+	}
+	latest, err := a.getRecord(ctx, primary, nsID, nsName, record)
+	if err != nil {
+		return "", err
+	}
+	if latest == nil {
+		// Deleted after the page was scanned.
+		return "", nil
+	}
+	if diffRecords(record, latest) == "" {
+		return reason, nil
+	}
+	return a.diffSecondaryRecord(ctx, secondary, nsID, nsName, latest)
+}
+
+func (a *activities) diffSecondaryRecord(
+	ctx context.Context,
+	secondary manager.VisibilityManager,
+	nsID namespace.ID,
+	nsName namespace.Name,
+	record *workflowpb.WorkflowExecutionInfo,
+) (string, error) {
+	current, err := a.getRecord(ctx, secondary, nsID, nsName, record)
+	if err != nil {
+		return "", err
+	}
+	if current == nil {
+		return "missing from secondary visibility store", nil
+	}
+	return diffRecords(record, current), nil
+}
+
+func (a *activities) getVisibilityManagers() (manager.VisibilityManager, manager.VisibilityManager, error) {
+	dual, ok := a.visibilityManager.(*visibility.VisibilityManagerDual)
+	if !ok {
+		return nil, nil, temporal.NewNonRetryableApplicationError("secondary visibility store is not configured", "", nil)
+	}
+	return dual.GetPrimaryVisibility(), dual.GetSecondaryVisibility(), nil
+}
+
+func (a *activities) getNamespaceID(nsName namespace.Name) (namespace.ID, error) {
+	nsID, err := a.namespaceRegistry.GetNamespaceID(nsName)
+	if err != nil {
+		if _, isNotFound := err.(*serviceerror.NamespaceNotFound); isNotFound {
+			return "", temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is not found", nsName), "", err)
+		}
+		return "", err
+	}
+	return nsID, nil
+}
+
+func (a *activities) scan(
+	ctx context.Context,
+	primary manager.VisibilityManager,
+	nsID namespace.ID,
+	request pageRequest,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	resp, err := primary.ScanWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   nsID,
+		Namespace:     request.Namespace,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+		Query:         request.Query,
+	})
+	if err != nil {
+		if _, isInvalidArgument := err.(*serviceerror.InvalidArgument); isInvalidArgument {
+			return nil, temporal.NewNonRetryableApplicationError("unable to scan primary visibility store", "", err)
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// getRecord returns the copy of record stored by m, or nil if m has no such record.
+func (a *activities) getRecord(
+	ctx context.Context,
+	m manager.VisibilityManager,
+	nsID namespace.ID,
+	nsName namespace.Name,
+	record *workflowpb.WorkflowExecutionInfo,
+) (*workflowpb.WorkflowExecutionInfo, error) {
+	resp, err := m.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: nsID,
+		Namespace:   nsName,
+		WorkflowID:  record.GetExecution().GetWorkflowId(),
+		RunID:       record.GetExecution().GetRunId(),
+	})
+	if err != nil {
+		if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.Execution, nil
+}
+
+func (a *activities) writeRecord(
+	ctx context.Context,
+	secondary manager.VisibilityManager,
+	nsID namespace.ID,
+	nsName namespace.Name,
+	record *workflowpb.WorkflowExecutionInfo,
+) error {
+	// Visibility stores return search attributes by alias and store them by field name.
+	searchAttributes, err := searchattribute.UnaliasFields(a.saMapperProvider, record.GetSearchAttributes(), nsName.String())
+	if err != nil {
+		return err
+	}
+	requestBase := &manager.VisibilityRequestBase{
+		NamespaceID:      nsID,
+		Namespace:        nsName,
+		Execution:        record.GetExecution(),
+		WorkflowTypeName: record.GetType().GetName(),
+		StartTime:        record.GetStartTime().AsTime(),
+		Status:           record.GetStatus(),
+		ExecutionTime:    record.GetExecutionTime().AsTime(),
+		TaskID:           backfillTaskID,
+		ShardID:          common.WorkflowIDToHistoryShard(nsID.String(), record.GetExecution().GetWorkflowId(), a.numHistoryShards),
+		Memo:             record.GetMemo(),
+		TaskQueue:        record.GetTaskQueue(),
+		SearchAttributes: searchAttributes,
+		ParentExecution:  record.GetParentExecution(),
+		RootExecution:    record.GetRootExecution(),
+	}
+	if record.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return secondary.UpsertWorkflowExecution(ctx, &manager.UpsertWorkflowExecutionRequest{
+			VisibilityRequestBase: requestBase,
+		})
+	}
+	return secondary.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: requestBase,
+		CloseTime:             record.GetCloseTime().AsTime(),
+		ExecutionDuration:     record.GetExecutionDuration().AsDuration(),
+		HistoryLength:         record.GetHistoryLength(),
+		HistorySizeBytes:      record.GetHistorySizeBytes(),
+		StateTransitionCount:  record.GetStateTransitionCount(),
+	})
+}
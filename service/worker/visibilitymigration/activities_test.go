@@ -0,0 +1,250 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testNamespace   = "test-namespace"
+	testNamespaceID = "test-namespace-id"
+	testWorkflowID  = "workflow-id"
+	testRunID       = "run-id"
+	testQuery       = "WorkflowType = 'workflow-type'"
+	testShardCount  = 4
+)
+
+type activitiesSuite struct {
+	*require.Assertions
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller *gomock.Controller
+	primary    *manager.MockVisibilityManager
+	secondary  *manager.MockVisibilityManager
+	env        *testsuite.TestActivityEnvironment
+	a          *activities
+}
+
+func TestActivitiesSuite(t *testing.T) {
+	suite.Run(t, new(activitiesSuite))
+}
+
+func (s *activitiesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.primary = manager.NewMockVisibilityManager(s.controller)
+	s.secondary = manager.NewMockVisibilityManager(s.controller)
+
+	namespaceRegistry := namespace.NewMockRegistry(s.controller)
+	namespaceRegistry.EXPECT().GetNamespaceID(namespace.Name(testNamespace)).Return(namespace.ID(testNamespaceID), nil).AnyTimes()
+
+	s.a = newActivities(
+		visibility.NewVisibilityManagerDual(s.primary, s.secondary, nil, nil),
+		namespaceRegistry,
+		searchattribute.NewTestMapperProvider(&searchattribute.TestMapper{}),
+		testShardCount,
+		log.NewNoopLogger(),
+	)
+	s.env = s.NewTestActivityEnvironment()
+	s.env.RegisterActivity(s.a)
+}
+
+func (s *activitiesSuite) TestBackfillPageActivity() {
+	missing := testRecord(s.T(), enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	missing.Execution = &commonpb.WorkflowExecution{WorkflowId: "missing", RunId: "missing-run"}
+	stale := testRecord(s.T(), enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	stale.Execution = &commonpb.WorkflowExecution{WorkflowId: "stale", RunId: "stale-run"}
+	same := testRecord(s.T(), enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	s.expectScan(missing, stale, same)
+
+	s.expectGet(s.secondary, missing, nil)
+	staleCopy := proto.Clone(stale).(*workflowpb.WorkflowExecutionInfo)
+	staleCopy.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	staleCopy.CloseTime = nil
+	s.expectGet(s.secondary, stale, staleCopy)
+	s.expectGet(s.secondary, same, same)
+
+	s.secondary.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, request *manager.UpsertWorkflowExecutionRequest) error {
+			s.Equal(namespace.ID(testNamespaceID), request.NamespaceID)
+			s.Equal("missing", request.Execution.GetWorkflowId())
+			s.Equal(int64(backfillTaskID), request.TaskID)
+			s.Equal(common.WorkflowIDToHistoryShard(testNamespaceID, "missing", testShardCount), request.ShardID)
+			s.Equal(missing.GetStartTime().AsTime(), request.StartTime)
+			s.True(proto.Equal(missing.GetMemo(), request.Memo))
+			// Search attributes are written by field name.
+			s.Len(request.SearchAttributes.GetIndexedFields(), 2)
+			s.Contains(request.SearchAttributes.GetIndexedFields(), "Keyword01")
+			s.Contains(request.SearchAttributes.GetIndexedFields(), "Datetime01")
+			return nil
+		})
+	s.secondary.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			s.Equal("stale", request.Execution.GetWorkflowId())
+			s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, request.Status)
+			s.Equal(stale.GetCloseTime().AsTime(), request.CloseTime)
+			s.Equal(stale.GetHistoryLength(), request.HistoryLength)
+			s.Equal(stale.GetHistorySizeBytes(), request.HistorySizeBytes)
+			s.Equal(stale.GetStateTransitionCount(), request.StateTransitionCount)
+			return nil
+		})
+
+	value, err := s.env.ExecuteActivity(s.a.BackfillVisibilityPageActivity, s.pageRequest())
+	s.NoError(err)
+	var result backfillPageResult
+	s.NoError(value.Get(&result))
+	s.Equal(backfillPageResult{
+		Scanned:       3,
+		Missing:       1,
+		Stale:         1,
+		Written:       2,
+		NextPageToken: []byte("next-page"),
+	}, result)
+}
+
+func (s *activitiesSuite) TestVerifyPageActivity() {
+	missing := testRecord(s.T(), enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	missing.Execution = &commonpb.WorkflowExecution{WorkflowId: "missing", RunId: "missing-run"}
+	different := testRecord(s.T(), enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	different.Execution = &commonpb.WorkflowExecution{WorkflowId: "different", RunId: "different-run"}
+	updated := testRecord(s.T(), enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	s.expectScan(missing, different, updated)
+
+	s.expectGet(s.secondary, missing, nil)
+	s.expectGet(s.primary, missing, missing)
+
+	differentCopy := proto.Clone(different).(*workflowpb.WorkflowExecutionInfo)
+	differentCopy.TaskQueue = "other-task-queue"
+	s.expectGet(s.secondary, different, differentCopy)
+	s.expectGet(s.primary, different, different)
+
+	// Closed in both stores after the page was scanned.
+	closed := testRecord(s.T(), enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	s.secondary.EXPECT().GetWorkflowExecution(gomock.Any(), s.getRequest(updated)).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: closed}, nil,
+	).Times(2)
+	s.expectGet(s.primary, updated, closed)
+
+	value, err := s.env.ExecuteActivity(s.a.VerifyVisibilityPageActivity, s.pageRequest())
+	s.NoError(err)
+	var result verifyPageResult
+	s.NoError(value.Get(&result))
+	s.Equal(3, result.Verified)
+	s.Equal([]RecordDiff{
+		{WorkflowID: "missing", RunID: "missing-run", Reason: "missing from secondary visibility store"},
+		{WorkflowID: "different", RunID: "different-run", Reason: "TaskQueue differs: primary task-queue, secondary other-task-queue"},
+	}, result.Diffs)
+	s.Equal([]byte("next-page"), result.NextPageToken)
+}
+
+func (s *activitiesSuite) TestCountActivity() {
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       testQuery,
+	}
+	s.primary.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&manager.CountWorkflowExecutionsResponse{Count: 10}, nil)
+	s.secondary.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&manager.CountWorkflowExecutionsResponse{Count: 9}, nil)
+
+	value, err := s.env.ExecuteActivity(s.a.CountActivity, countRequest{Namespace: testNamespace, Query: testQuery})
+	s.NoError(err)
+	var result countResult
+	s.NoError(value.Get(&result))
+	s.Equal(countResult{PrimaryCount: 10, SecondaryCount: 9}, result)
+}
+
+func (s *activitiesSuite) TestBackfillPageActivity_InvalidQuery() {
+	s.primary.EXPECT().ScanWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("invalid query"))
+
+	_, err := s.env.ExecuteActivity(s.a.BackfillVisibilityPageActivity, s.pageRequest())
+	var appErr *temporal.ApplicationError
+	s.ErrorAs(err, &appErr)
+	s.True(appErr.NonRetryable())
+}
+
+func (s *activitiesSuite) TestBackfillPageActivity_NoSecondaryStore() {
+	s.a.visibilityManager = s.primary
+
+	_, err := s.env.ExecuteActivity(s.a.BackfillVisibilityPageActivity, s.pageRequest())
+	var appErr *temporal.ApplicationError
+	s.ErrorAs(err, &appErr)
+	s.True(appErr.NonRetryable())
+}
+
+func (s *activitiesSuite) pageRequest() pageRequest {
+	return pageRequest{
+		Namespace: testNamespace,
+		Query:     testQuery,
+		PageSize:  defaultPageSize,
+	}
+}
+
+func (s *activitiesSuite) expectScan(records ...*workflowpb.WorkflowExecutionInfo) {
+	s.primary.EXPECT().ScanWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    defaultPageSize,
+		Query:       testQuery,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions:    records,
+		NextPageToken: []byte("next-page"),
+	}, nil)
+}
+
+func (s *activitiesSuite) expectGet(m *manager.MockVisibilityManager, record *workflowpb.WorkflowExecutionInfo, result *workflowpb.WorkflowExecutionInfo) {
+	if result == nil {
+		m.EXPECT().GetWorkflowExecution(gomock.Any(), s.getRequest(record)).Return(nil, serviceerror.NewNotFound("not found"))
+		return
+	}
+	m.EXPECT().GetWorkflowExecution(gomock.Any(), s.getRequest(record)).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: result}, nil,
+	)
+}
+
+func (s *activitiesSuite) getRequest(record *workflowpb.WorkflowExecutionInfo) *manager.GetWorkflowExecutionRequest {
+	return &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		WorkflowID:  record.GetExecution().GetWorkflowId(),
+		RunID:       record.GetExecution().GetRunId(),
+	}
+}
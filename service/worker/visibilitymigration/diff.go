@@ -0,0 +1,121 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// diffRecords returns the reason why the secondary record differs from the primary one, or an empty
+// string if they are the same. Timestamps are compared at microsecond precision, which is the
+// precision of the SQL visibility stores.
+func diffRecords(primary *workflowpb.WorkflowExecutionInfo, secondary *workflowpb.WorkflowExecutionInfo) string {
+	fields := []struct {
+		name      string
+		primary   any
+		secondary any
+	}{
+		{"WorkflowType", primary.GetType().GetName(), secondary.GetType().GetName()},
+		{"Status", primary.GetStatus(), secondary.GetStatus()},
+		{"StartTime", truncateTime(primary.GetStartTime()), truncateTime(secondary.GetStartTime())},
+		{"ExecutionTime", truncateTime(primary.GetExecutionTime()), truncateTime(secondary.GetExecutionTime())},
+		{"CloseTime", truncateTime(primary.GetCloseTime()), truncateTime(secondary.GetCloseTime())},
+		{"TaskQueue", primary.GetTaskQueue(), secondary.GetTaskQueue()},
+		{"HistoryLength", primary.GetHistoryLength(), secondary.GetHistoryLength()},
+		{"HistorySizeBytes", primary.GetHistorySizeBytes(), secondary.GetHistorySizeBytes()},
+		{"StateTransitionCount", primary.GetStateTransitionCount(), secondary.GetStateTransitionCount()},
+		{"ParentExecution", executionKey(primary.GetParentExecution()), executionKey(secondary.GetParentExecution())},
+		{"RootExecution", executionKey(primary.GetRootExecution()), executionKey(secondary.GetRootExecution())},
+	}
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.primary, field.secondary) {
+			return fmt.Sprintf("%s differs: primary %v, secondary %v", field.name, field.primary, field.secondary)
+		}
+	}
+
+	if len(primary.GetMemo().GetFields()) != 0 || len(secondary.GetMemo().GetFields()) != 0 {
+		if !proto.Equal(primary.GetMemo(), secondary.GetMemo()) {
+			return "Memo differs"
+		}
+	}
+
+	if names := diffSearchAttributes(primary.GetSearchAttributes(), secondary.GetSearchAttributes()); len(names) > 0 {
+		return "SearchAttributes differ: " + strings.Join(names, ", ")
+	}
+	return ""
+}
+
+// diffSearchAttributes returns the sorted names of the search attributes whose values differ.
+// Values are compared decoded, since stores may encode the same value differently.
+func diffSearchAttributes(primary *commonpb.SearchAttributes, secondary *commonpb.SearchAttributes) []string {
+	primaryValues := decodeSearchAttributes(primary)
+	secondaryValues := decodeSearchAttributes(secondary)
+	var names []string
+	for name, primaryValue := range primaryValues {
+		if value, ok := secondaryValues[name]; !ok || !reflect.DeepEqual(primaryValue, value) {
+			names = append(names, name)
+		}
+	}
+	for name := range secondaryValues {
+		if _, ok := primaryValues[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func decodeSearchAttributes(searchAttributes *commonpb.SearchAttributes) map[string]any {
+	// Undecodable values are kept as nil, which makes them differ from any decodable value.
+	values, _ := searchattribute.Decode(searchAttributes, nil, true)
+	for name, value := range values {
+		if t, ok := value.(time.Time); ok {
+			values[name] = t.UTC().Truncate(time.Microsecond)
+		}
+	}
+	return values
+}
+
+func truncateTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime().Truncate(time.Microsecond)
+}
+
+func executionKey(execution *commonpb.WorkflowExecution) string {
+	if execution.GetWorkflowId() == "" && execution.GetRunId() == "" {
+		return ""
+	}
+	return execution.GetWorkflowId() + "/" + execution.GetRunId()
+}
@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testRecord(t *testing.T, status enumspb.WorkflowExecutionStatus) *workflowpb.WorkflowExecutionInfo {
+	startTime := time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC)
+	keyword, err := searchattribute.EncodeValue("value", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	require.NoError(t, err)
+	datetime, err := searchattribute.EncodeValue(startTime, enumspb.INDEXED_VALUE_TYPE_DATETIME)
+	require.NoError(t, err)
+	record := &workflowpb.WorkflowExecutionInfo{
+		Execution:     &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID},
+		Type:          &commonpb.WorkflowType{Name: "workflow-type"},
+		StartTime:     timestamppb.New(startTime),
+		ExecutionTime: timestamppb.New(startTime),
+		Status:        status,
+		TaskQueue:     "task-queue",
+		Memo:          &commonpb.Memo{Fields: map[string]*commonpb.Payload{"memo": payload.EncodeString("memo")}},
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+			"AliasForKeyword01":  keyword,
+			"AliasForDatetime01": datetime,
+		}},
+		RootExecution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID},
+	}
+	if status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		record.CloseTime = timestamppb.New(startTime.Add(time.Minute))
+		record.HistoryLength = 10
+		record.HistorySizeBytes = 1024
+		record.StateTransitionCount = 5
+	}
+	return record
+}
+
+func Test_DiffRecords(t *testing.T) {
+	primary := testRecord(t, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+
+	tests := []struct {
+		name     string
+		modify   func(record *workflowpb.WorkflowExecutionInfo)
+		expected string
+	}{
+		{
+			name:     "same",
+			modify:   func(record *workflowpb.WorkflowExecutionInfo) {},
+			expected: "",
+		},
+		{
+			name: "nanosecond precision",
+			modify: func(record *workflowpb.WorkflowExecutionInfo) {
+				record.StartTime = timestamppb.New(record.StartTime.AsTime().Add(999 * time.Nanosecond))
+			},
+			expected: "",
+		},
+		{
+			name: "empty memo",
+			modify: func(record *workflowpb.WorkflowExecutionInfo) {
+				record.Memo = &commonpb.Memo{}
+			},
+			expected: "Memo differs",
+		},
+		{
+			name: "status",
+			modify: func(record *workflowpb.WorkflowExecutionInfo) {
+				record.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+			},
+			expected: "Status differs: primary Completed, secondary Running",
+		},
+		{
+			name: "history length",
+			modify: func(record *workflowpb.WorkflowExecutionInfo) {
+				record.HistoryLength = 11
+			},
+			expected: "HistoryLength differs: primary 10, secondary 11",
+		},
+		{
+			name: "parent execution",
+			modify: func(record *workflowpb.WorkflowExecutionInfo) {
+				record.ParentExecution = &commonpb.WorkflowExecution{WorkflowId: "parent", RunId: "parent-run"}
+			},
+			expected: "ParentExecution differs: primary , secondary parent/parent-run",
+		},
+		{
+			name: "search attribute value",
+			modify: func(record *workflowpb.WorkflowExecutionInfo) {
+				record.SearchAttributes.IndexedFields["AliasForKeyword01"] = payload.EncodeString("other")
+			},
+			expected: "SearchAttributes differ: AliasForKeyword01",
+		},
+		{
+			name: "search attribute missing and extra",
+			modify: func(record *workflowpb.WorkflowExecutionInfo) {
+				delete(record.SearchAttributes.IndexedFields, "AliasForDatetime01")
+				record.SearchAttributes.IndexedFields["AliasForInt01"] = payload.EncodeString("1")
+			},
+			expected: "SearchAttributes differ: AliasForDatetime01, AliasForInt01",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			secondary := proto.Clone(primary).(*workflowpb.WorkflowExecutionInfo)
+			tc.modify(secondary)
+			require.Equal(t, tc.expected, diffRecords(primary, secondary))
+		})
+	}
+}
+
+func Test_DiffRecords_SearchAttributeEncoding(t *testing.T) {
+	primary := testRecord(t, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	secondary := proto.Clone(primary).(*workflowpb.WorkflowExecutionInfo)
+	datetime, err := searchattribute.EncodeValue(
+		primary.StartTime.AsTime().Add(999*time.Nanosecond),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	require.NoError(t, err)
+	secondary.SearchAttributes.IndexedFields["AliasForDatetime01"] = datetime
+
+	require.Empty(t, diffRecords(primary, secondary))
+}
@@ -0,0 +1,243 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	WorkflowName = "temporal-sys-visibility-migration-workflow"
+
+	defaultPageSize          = 100
+	defaultPagesPerExecution = 100
+	// maxReportedDiffs caps the number of diffs carried in the report so that it stays
+	// well below the payload size limit. Counters are always exact.
+	maxReportedDiffs = 1000
+)
+
+type (
+	// MigrationParams are the input of the visibility migration workflow.
+	MigrationParams struct {
+		Namespace namespace.Name
+		// Query selects the visibility records to migrate and verify. It uses the query syntax of
+		// the primary visibility store. Empty selects every record of the namespace.
+		Query string
+		// SkipBackfill only runs the verification pass.
+		SkipBackfill bool
+		// PageSize is the number of records scanned per activity. Default is 100.
+		PageSize int
+		// PagesPerExecution is the number of pages processed before the workflow continues as new. Default is 100.
+		PagesPerExecution int
+
+		// Phase, NextPageToken and PreviousReport are carried over on continue-as-new.
+		Phase          MigrationPhase
+		NextPageToken  []byte
+		PreviousReport MigrationReport
+	}
+
+	// MigrationReport is the result of the visibility migration workflow.
+	MigrationReport struct {
+		// Scanned is the number of primary records scanned by the backfill pass.
+		Scanned int
+		// Missing and Stale are the number of scanned records which were missing from or different in
+		// the secondary store. Written is the number of records written to the secondary store.
+		Missing int
+		Stale   int
+		Written int
+
+		// Verified is the number of primary records compared to the secondary store by the verification pass.
+		Verified int
+		// DiffCount is the number of verified records which are missing from or different in the secondary store.
+		DiffCount int
+		// Diffs lists the records counted in DiffCount. Only the first maxReportedDiffs are kept.
+		Diffs []RecordDiff
+		// PrimaryCount and SecondaryCount are the number of records selected by the query in each store
+		// at the end of the verification pass.
+		PrimaryCount   int64
+		SecondaryCount int64
+	}
+
+	// RecordDiff describes one record which differs between the primary and the secondary store.
+	RecordDiff struct {
+		WorkflowID string
+		RunID      string
+		Reason     string
+	}
+
+	// MigrationPhase is the pass the visibility migration workflow is running.
+	MigrationPhase string
+)
+
+const (
+	MigrationPhaseBackfill MigrationPhase = "Backfill"
+	MigrationPhaseVerify   MigrationPhase = "Verify"
+)
+
+var (
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval: 10 * time.Second,
+		MaximumInterval: 5 * time.Minute,
+	}
+
+	pageActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:            activityRetryPolicy,
+		StartToCloseTimeout:    30 * time.Minute,
+		ScheduleToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:       2 * time.Minute,
+	}
+
+	countActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:            activityRetryPolicy,
+		StartToCloseTimeout:    5 * time.Minute,
+		ScheduleToCloseTimeout: time.Hour,
+	}
+)
+
+func validateParams(params *MigrationParams) error {
+	if params.Namespace.IsEmpty() {
+		return temporal.NewNonRetryableApplicationError("namespace is required", "", nil)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.PagesPerExecution <= 0 {
+		params.PagesPerExecution = defaultPagesPerExecution
+	}
+	switch params.Phase {
+	case "":
+		params.Phase = MigrationPhaseBackfill
+		if params.SkipBackfill {
+			params.Phase = MigrationPhaseVerify
+		}
+	case MigrationPhaseBackfill, MigrationPhaseVerify:
+	default:
+		return temporal.NewNonRetryableApplicationError("unknown migration phase "+string(params.Phase), "", nil)
+	}
+	return nil
+}
+
+// VisibilityMigrationWorkflow backfills the secondary visibility store of a dual visibility setup
+// with the records of the primary store which are missing or stale in the secondary one, then
+// verifies that both stores agree.
+func VisibilityMigrationWorkflow(ctx workflow.Context, params MigrationParams) (MigrationReport, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName), tag.NewStringTag("phase", string(params.Phase)))
+
+	if err := validateParams(&params); err != nil {
+		return params.PreviousReport, err
+	}
+
+	ctx = workflow.WithTaskQueue(ctx, primitives.VisibilityMigrationActivityTQ)
+	var a *activities
+
+	report := params.PreviousReport
+	nextPageToken := params.NextPageToken
+	ctx1 := workflow.WithActivityOptions(ctx, pageActivityOptions)
+	for page := 0; page < params.PagesPerExecution; page++ {
+		request := pageRequest{
+			Namespace:     params.Namespace,
+			Query:         params.Query,
+			PageSize:      params.PageSize,
+			NextPageToken: nextPageToken,
+		}
+		switch params.Phase {
+		case MigrationPhaseBackfill:
+			var result backfillPageResult
+			if err := workflow.ExecuteActivity(ctx1, a.BackfillVisibilityPageActivity, request).Get(ctx, &result); err != nil {
+				return report, err
+			}
+			report.mergeBackfill(result)
+			nextPageToken = result.NextPageToken
+		case MigrationPhaseVerify:
+			var result verifyPageResult
+			if err := workflow.ExecuteActivity(ctx1, a.VerifyVisibilityPageActivity, request).Get(ctx, &result); err != nil {
+				return report, err
+			}
+			report.mergeVerify(result)
+			nextPageToken = result.NextPageToken
+		}
+		if len(nextPageToken) != 0 {
+			continue
+		}
+
+		if params.Phase == MigrationPhaseBackfill {
+			logger.Info("Backfill finished.",
+				tag.WorkflowType(WorkflowName),
+				tag.NewInt("scanned", report.Scanned),
+				tag.NewInt("missing", report.Missing),
+				tag.NewInt("stale", report.Stale),
+				tag.NewInt("written", report.Written),
+			)
+			params.Phase = MigrationPhaseVerify
+			continue
+		}
+
+		var counts countResult
+		ctx2 := workflow.WithActivityOptions(ctx, countActivityOptions)
+		if err := workflow.ExecuteActivity(ctx2, a.CountActivity, countRequest{
+			Namespace: params.Namespace,
+			Query:     params.Query,
+		}).Get(ctx, &counts); err != nil {
+			return report, err
+		}
+		report.PrimaryCount = counts.PrimaryCount
+		report.SecondaryCount = counts.SecondaryCount
+		logger.Info("Workflow finished.",
+			tag.WorkflowType(WorkflowName),
+			tag.NewInt("verified", report.Verified),
+			tag.NewInt("diffs", report.DiffCount),
+			tag.NewInt64("primary-count", report.PrimaryCount),
+			tag.NewInt64("secondary-count", report.SecondaryCount),
+		)
+		return report, nil
+	}
+
+	params.NextPageToken = nextPageToken
+	params.PreviousReport = report
+	return report, workflow.NewContinueAsNewError(ctx, VisibilityMigrationWorkflow, params)
+}
+
+func (r *MigrationReport) mergeBackfill(result backfillPageResult) {
+	r.Scanned += result.Scanned
+	r.Missing += result.Missing
+	r.Stale += result.Stale
+	r.Written += result.Written
+}
+
+func (r *MigrationReport) mergeVerify(result verifyPageResult) {
+	r.Verified += result.Verified
+	r.DiffCount += len(result.Diffs)
+	for _, diff := range result.Diffs {
+		if len(r.Diffs) >= maxReportedDiffs {
+			break
+		}
+		r.Diffs = append(r.Diffs, diff)
+	}
+}
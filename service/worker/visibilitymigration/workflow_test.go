@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func Test_VisibilityMigrationWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.BackfillVisibilityPageActivity, mock.Anything, pageRequest{
+		Namespace: "namespace",
+		Query:     testQuery,
+		PageSize:  defaultPageSize,
+	}).Return(backfillPageResult{
+		Scanned:       2,
+		Missing:       1,
+		Written:       1,
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	env.OnActivity(a.BackfillVisibilityPageActivity, mock.Anything, pageRequest{
+		Namespace:     "namespace",
+		Query:         testQuery,
+		PageSize:      defaultPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(backfillPageResult{
+		Scanned: 1,
+		Stale:   1,
+		Written: 1,
+	}, nil).Once()
+	env.OnActivity(a.VerifyVisibilityPageActivity, mock.Anything, pageRequest{
+		Namespace: "namespace",
+		Query:     testQuery,
+		PageSize:  defaultPageSize,
+	}).Return(verifyPageResult{
+		Verified: 3,
+		Diffs: []RecordDiff{
+			{WorkflowID: "wf-1", RunID: "run-1", Reason: "missing from secondary visibility store"},
+		},
+	}, nil).Once()
+	env.OnActivity(a.CountActivity, mock.Anything, countRequest{
+		Namespace: "namespace",
+		Query:     testQuery,
+	}).Return(countResult{PrimaryCount: 3, SecondaryCount: 2}, nil).Once()
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, MigrationParams{
+		Namespace: "namespace",
+		Query:     testQuery,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var report MigrationReport
+	require.NoError(t, env.GetWorkflowResult(&report))
+	require.Equal(t, MigrationReport{
+		Scanned:   3,
+		Missing:   1,
+		Stale:     1,
+		Written:   2,
+		Verified:  3,
+		DiffCount: 1,
+		Diffs: []RecordDiff{
+			{WorkflowID: "wf-1", RunID: "run-1", Reason: "missing from secondary visibility store"},
+		},
+		PrimaryCount:   3,
+		SecondaryCount: 2,
+	}, report)
+}
+
+func Test_VisibilityMigrationWorkflow_SkipBackfill(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.VerifyVisibilityPageActivity, mock.Anything, mock.Anything).Return(verifyPageResult{Verified: 1}, nil).Once()
+	env.OnActivity(a.CountActivity, mock.Anything, mock.Anything).Return(countResult{PrimaryCount: 1, SecondaryCount: 1}, nil).Once()
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, MigrationParams{
+		Namespace:    "namespace",
+		SkipBackfill: true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var report MigrationReport
+	require.NoError(t, env.GetWorkflowResult(&report))
+	require.Zero(t, report.Scanned)
+	require.Equal(t, 1, report.Verified)
+}
+
+func Test_VisibilityMigrationWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.BackfillVisibilityPageActivity, mock.Anything, mock.Anything).Return(backfillPageResult{
+		Scanned:       1,
+		NextPageToken: []byte("token"),
+	}, nil).Times(2)
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, MigrationParams{
+		Namespace:         "namespace",
+		PagesPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	var continueAsNew *workflow.ContinueAsNewError
+	require.ErrorAs(t, err, &continueAsNew)
+}
+
+func Test_VisibilityMigrationWorkflow_InvalidParams(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, MigrationParams{})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+}
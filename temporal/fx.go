@@ -133,13 +133,14 @@ type (
 		ServiceHosts               map[primitives.ServiceName]static.Hosts
 
 		// below are things that could be over write by server options or may have default if not supplied by serverOptions.
-		Logger                log.Logger
-		ClientFactoryProvider client.FactoryProvider
-		DynamicConfigClient   dynamicconfig.Client
-		TLSConfigProvider     encryption.TLSConfigProvider
-		EsConfig              *esclient.Config
-		EsClient              esclient.Client
-		MetricsHandler        metrics.Handler
+		Logger                      log.Logger
+		ClientFactoryProvider       client.FactoryProvider
+		DynamicConfigClient         dynamicconfig.Client
+		DynamicConfigOverrideClient *dynamicconfig.OverrideClient
+		TLSConfigProvider           encryption.TLSConfigProvider
+		EsConfig                    *esclient.Config
+		EsClient                    esclient.Client
+		MetricsHandler              metrics.Handler
 	}
 )
 
@@ -232,6 +233,8 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 			dcClient = dynamicconfig.NewNoopClient()
 		}
 	}
+	// Overrides set through the admin API take precedence over the values of the client.
+	dcOverrideClient := dynamicconfig.NewOverrideClient(dcClient, logger)
 
 	// TLSConfigProvider
 	tlsConfigProvider := so.tlsConfigProvider
@@ -305,13 +308,14 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		ClaimMapper:                so.claimMapper,
 		AudienceGetter:             so.audienceGetter,
 
-		Logger:                logger,
-		ClientFactoryProvider: clientFactoryProvider,
-		DynamicConfigClient:   dcClient,
-		TLSConfigProvider:     tlsConfigProvider,
-		EsConfig:              esConfig,
-		EsClient:              esClient,
-		MetricsHandler:        metricHandler,
+		Logger:                      logger,
+		ClientFactoryProvider:       clientFactoryProvider,
+		DynamicConfigClient:         dcOverrideClient,
+		DynamicConfigOverrideClient: dcOverrideClient,
+		TLSConfigProvider:           tlsConfigProvider,
+		EsConfig:                    esConfig,
+		EsClient:                    esClient,
+		MetricsHandler:              metricHandler,
 	}, nil
 }
 
@@ -351,31 +355,32 @@ type (
 	ServiceProviderParamsCommon struct {
 		fx.In
 
-		Cfg                        *config.Config
-		ServiceNames               resource.ServiceNames
-		Logger                     log.Logger
-		NamespaceLogger            resource.NamespaceLogger
-		DynamicConfigClient        dynamicconfig.Client
-		MetricsHandler             metrics.Handler
-		EsConfig                   *esclient.Config
-		EsClient                   esclient.Client
-		TlsConfigProvider          encryption.TLSConfigProvider
-		PersistenceConfig          config.Persistence
-		ClusterMetadata            *cluster.Config
-		ClientFactoryProvider      client.FactoryProvider
-		AudienceGetter             authorization.JWTAudienceMapper
-		PersistenceServiceResolver resolver.ServiceResolver
-		PersistenceFactoryProvider persistenceClient.FactoryProviderFn
-		SearchAttributesMapper     searchattribute.Mapper
-		CustomFrontendInterceptors []grpc.UnaryServerInterceptor
-		Authorizer                 authorization.Authorizer
-		ClaimMapper                authorization.ClaimMapper
-		DataStoreFactory           persistenceClient.AbstractDataStoreFactory
-		VisibilityStoreFactory     visibility.VisibilityStoreFactory
-		SpanExporters              []otelsdktrace.SpanExporter
-		InstanceID                 resource.InstanceID                     `optional:"true"`
-		StaticServiceHosts         map[primitives.ServiceName]static.Hosts `optional:"true"`
-		TaskCategoryRegistry       tasks.TaskCategoryRegistry
+		Cfg                         *config.Config
+		ServiceNames                resource.ServiceNames
+		Logger                      log.Logger
+		NamespaceLogger             resource.NamespaceLogger
+		DynamicConfigClient         dynamicconfig.Client
+		DynamicConfigOverrideClient *dynamicconfig.OverrideClient
+		MetricsHandler              metrics.Handler
+		EsConfig                    *esclient.Config
+		EsClient                    esclient.Client
+		TlsConfigProvider           encryption.TLSConfigProvider
+		PersistenceConfig           config.Persistence
+		ClusterMetadata             *cluster.Config
+		ClientFactoryProvider       client.FactoryProvider
+		AudienceGetter              authorization.JWTAudienceMapper
+		PersistenceServiceResolver  resolver.ServiceResolver
+		PersistenceFactoryProvider  persistenceClient.FactoryProviderFn
+		SearchAttributesMapper      searchattribute.Mapper
+		CustomFrontendInterceptors  []grpc.UnaryServerInterceptor
+		Authorizer                  authorization.Authorizer
+		ClaimMapper                 authorization.ClaimMapper
+		DataStoreFactory            persistenceClient.AbstractDataStoreFactory
+		VisibilityStoreFactory      visibility.VisibilityStoreFactory
+		SpanExporters               []otelsdktrace.SpanExporter
+		InstanceID                  resource.InstanceID                     `optional:"true"`
+		StaticServiceHosts          map[primitives.ServiceName]static.Hosts `optional:"true"`
+		TaskCategoryRegistry        tasks.TaskCategoryRegistry
 	}
 )
 
@@ -434,6 +439,9 @@ func (params ServiceProviderParamsCommon) GetCommonServiceOptions(serviceName pr
 			func() dynamicconfig.Client {
 				return params.DynamicConfigClient
 			},
+			func() *dynamicconfig.OverrideClient {
+				return params.DynamicConfigOverrideClient
+			},
 			func() log.Logger {
 				return params.Logger
 			},
@@ -843,8 +851,14 @@ func PersistenceFactoryProvider() persistenceClient.FactoryProviderFn {
 func ServerLifetimeHooks(
 	lc fx.Lifecycle,
 	svr *ServerImpl,
+	authorizer authorization.Authorizer,
 ) {
 	lc.Append(fx.StartStopHook(svr.Start, svr.Stop))
+	// Stops background work of the authorizer, e.g. reloading the policy file of the policy authorizer.
+	// Hooks are stopped in reverse order, so this runs after the server stopped.
+	if closer, ok := authorizer.(interface{ Close() }); ok {
+		lc.Append(fx.StopHook(closer.Close))
+	}
 }
 
 func verifyPersistenceCompatibleVersion(config config.Persistence, persistenceServiceResolver resolver.ServiceResolver) error {
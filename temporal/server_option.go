@@ -108,7 +108,8 @@ func WithNamespaceLogger(namespaceLogger log.Logger) ServerOption {
 	})
 }
 
-// WithAuthorizer sets a low level authorizer to allow/deny all API calls
+// WithAuthorizer sets a low level authorizer to allow/deny all API calls. If the authorizer has a
+// Close method, it is called when the server stops.
 func WithAuthorizer(authorizer authorization.Authorizer) ServerOption {
 	return applyFunc(func(s *serverOptions) {
 		s.authorizer = authorizer
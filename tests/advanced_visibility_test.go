@@ -1152,17 +1152,35 @@ func (s *AdvancedVisibilitySuite) TestCountGroupByWorkflow() {
 		resp.Groups[1],
 	)
 
-	query = `GROUP BY WorkflowType`
+	query = fmt.Sprintf(`WorkflowType = %q GROUP BY WorkflowType, ExecutionStatus`, wt)
+	countRequest.Query = query
+	resp, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
+	s.NoError(err)
+	s.Equal(int64(numWorkflows), resp.GetCount())
+	s.Equal(2, len(resp.Groups))
+	workflowTypePayload, _ := searchattribute.EncodeValue(wt, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	for _, group := range resp.Groups {
+		s.Equal(2, len(group.GroupValues))
+		s.ProtoEqual(workflowTypePayload, group.GroupValues[0])
+		if proto.Equal(runningStatusPayload, group.GroupValues[1]) {
+			s.Equal(int64(numWorkflows-numClosedWorkflows), group.Count)
+		} else {
+			s.ProtoEqual(terminatedStatusPayload, group.GroupValues[1])
+			s.Equal(int64(numClosedWorkflows), group.Count)
+		}
+	}
+
+	query = `GROUP BY StartTime`
 	countRequest.Query = query
 	_, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause is only supported for ExecutionStatus search attribute")
+	s.Contains(err.Error(), "'group by' clause is only supported for search attributes of type Keyword")
 
-	query = `GROUP BY ExecutionStatus, WorkflowType`
+	query = `GROUP BY ExecutionStatus, WorkflowType, TaskQueue`
 	countRequest.Query = query
 	_, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause supports only a single field")
+	s.Contains(err.Error(), "'group by' clause supports at most 2 fields")
 }
 
 func (s *AdvancedVisibilitySuite) createStartWorkflowExecutionRequest(id, wt, tl string) *workflowservice.StartWorkflowExecutionRequest {
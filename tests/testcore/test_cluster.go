@@ -190,6 +190,8 @@ func (f *defaultPersistenceTestBaseFactory) NewTestBase(options *persistencetest
 		options.ConnectAttributes = ops.ConnectAttributes
 	case config.StoreTypeNoSQL:
 		// noop for now
+	case config.StoreTypePebble:
+		// the database is created in a temporary directory
 	default:
 		panic(fmt.Sprintf("unknown store type: %v", options.StoreType))
 	}
@@ -409,6 +411,9 @@ func setupIndex(esConfig *esclient.Config, logger log.Logger) error {
 	}
 
 	indexTemplateFile := path.Join(testutils.GetRepoRootDirectory(), "schema/elasticsearch/visibility/index_template_v7.json")
+	if esConfig.Version == esclient.VersionOpenSearch2 {
+		indexTemplateFile = path.Join(testutils.GetRepoRootDirectory(), "schema/opensearch/visibility/index_template_v2.json")
+	}
 	logger.Info("Creating index template.", tag.NewStringTag("templatePath", indexTemplateFile))
 	template, err := os.ReadFile(indexTemplateFile)
 	if err != nil {
@@ -27,6 +27,8 @@ package cassandra
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -77,6 +79,8 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	listColumnsCQL              = `SELECT table_name, column_name, type, kind, position, clustering_order from system_schema.columns where keyspace_name=?`
+	listIndexesCQL              = `SELECT table_name, index_name, kind, options from system_schema.indexes where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -115,6 +119,7 @@ func newCQLClient(cfg *CQLClientConfig, logger log.Logger) (*cqlClient, error) {
 		func() (*gocql.ClusterConfig, error) {
 			return commongocql.NewCassandraCluster(*cassandraConfig, resolver.NewNoopResolver())
 		},
+		cassandraConfig.Scylla,
 		logger,
 		metrics.NoopMetricsHandler,
 	)
@@ -253,6 +258,69 @@ func (client *cqlClient) ListTables() ([]string, error) {
 	return names, nil
 }
 
+// ReadCatalog returns the tables, columns and indexes of the Keyspace
+func (client *cqlClient) ReadCatalog() (*schema.Catalog, error) {
+	tables, err := client.ListTables()
+	if err != nil {
+		return nil, err
+	}
+	catalog := schema.NewCatalog()
+	for _, table := range tables {
+		catalog.AddTable(table)
+	}
+
+	iter := client.session.Query(listColumnsCQL, client.keyspace).Iter()
+	var table, column, columnType, kind, clusteringOrder string
+	var position int
+	for iter.Scan(&table, &column, &columnType, &kind, &position, &clusteringOrder) {
+		catalog.AddColumn(table, column, columnDefinition(columnType, kind, position, clusteringOrder))
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = client.session.Query(listIndexesCQL, client.keyspace).Iter()
+	var index string
+	var options map[string]string
+	for iter.Scan(&table, &index, &kind, &options) {
+		catalog.AddIndex(table, index, indexDefinition(kind, options))
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+// columnDefinition describes a column by its type and, for primary key
+// and static columns, its kind, position and clustering order
+func columnDefinition(columnType string, kind string, position int, clusteringOrder string) string {
+	definition := columnType
+	if kind != "regular" {
+		definition += " " + kind
+	}
+	if position >= 0 && kind != "regular" && kind != "static" {
+		definition += fmt.Sprintf("(%d)", position)
+	}
+	if clusteringOrder != "" && clusteringOrder != "none" {
+		definition += " " + clusteringOrder
+	}
+	return definition
+}
+
+// indexDefinition describes an index by its kind and options, e.g. the target column
+func indexDefinition(kind string, options map[string]string) string {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+options[key])
+	}
+	return kind + "(" + strings.Join(pairs, ", ") + ")"
+}
+
 // listTypes lists the User defined types in a Keyspace
 func (client *cqlClient) listTypes() ([]string, error) {
 	qry := client.session.Query(listTypesCQL, client.keyspace)
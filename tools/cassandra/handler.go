@@ -88,6 +88,84 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// planSchema prints the statements that
+// updateSchema would execute using the
+// given command line args as input
+func planSchema(cli *cli.Context, logger log.Logger) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	client, err := newCQLClient(config, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	defer client.Close()
+	if err := schema.Plan(cli, client, logger); err != nil {
+		logger.Error("Unable to plan CQL schema update.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+// diffSchema compares the schema of the keyspace
+// with the versioned schema, which is applied to a
+// scratch keyspace that is dropped afterwards. A
+// scratch keyspace with tables is only used if
+// dropping them is explicitly allowed
+func diffSchema(cli *cli.Context, logger log.Logger) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	scratchConfig := *config
+	scratchConfig.Keyspace = cli.String(schema.CLIOptScratchKeyspace)
+	if scratchConfig.Keyspace == "" {
+		scratchConfig.Keyspace = config.Keyspace + "_schema_diff"
+	}
+	if scratchConfig.Keyspace == config.Keyspace {
+		err := schema.NewConfigError(flag(schema.CLIOptScratchKeyspace) + " must differ from " + flag(schema.CLIOptKeyspace))
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+
+	client, err := newCQLClient(config, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	defer client.Close()
+	if err := client.createKeyspace(scratchConfig.Keyspace); err != nil {
+		logger.Error("Unable to create scratch keyspace.", tag.Error(err))
+		return err
+	}
+
+	scratchClient, err := newCQLClient(&scratchConfig, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	if err := schema.CheckScratch(scratchClient, scratchConfig.Keyspace, cli.Bool(schema.CLIOptDropScratch)); err != nil {
+		scratchClient.Close()
+		logger.Error("Unable to use scratch keyspace.", tag.Error(err))
+		return err
+	}
+	defer func() {
+		scratchClient.Close()
+		if err := client.dropKeyspace(scratchConfig.Keyspace); err != nil {
+			logger.Warn("Unable to drop scratch keyspace.", tag.Error(err))
+		}
+	}()
+	if err := schema.Diff(cli, client, scratchClient, logger); err != nil {
+		logger.Error("Unable to verify CQL schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 func createKeyspace(cli *cli.Context, logger log.Logger) error {
 	config, err := newCQLClientConfig(cli)
 	if err != nil {
@@ -218,6 +218,70 @@ func buildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "plan-schema",
+			Aliases: []string{"plan"},
+			Usage:   "print the statements update-schema would execute, without applying them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "target version for the schema update, defaults to latest",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("cassandra")),
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, planSchema, logger)
+			},
+		},
+		{
+			Name:    "diff-schema",
+			Aliases: []string{"diff"},
+			Usage:   "compare tables, columns and indexes of the keyspace with the versioned schema, fail on drift",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "schema version to compare with, defaults to the current version of the keyspace",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("cassandra")),
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagScratchKeyspace,
+					Usage: "keyspace to build the expected schema in, it is created if missing and dropped afterwards; defaults to <keyspace>_schema_diff",
+				},
+				cli.BoolFlag{
+					Name:  schema.CLIOptDropScratch,
+					Usage: "drop the tables of the scratch keyspace if it already has any",
+				},
+				cli.IntFlag{
+					Name:  schema.CLIFlagReplicationFactor,
+					Value: 1,
+					Usage: "replication factor for the scratch keyspace",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagDatacenter,
+					Value: "",
+					Usage: "enable NetworkTopologyStrategy for the scratch keyspace by providing datacenter name",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, diffSchema, logger)
+			},
+		},
 		{
 			Name:    "create-keyspace",
 			Aliases: []string{"create", "create-Keyspace"},
@@ -53,6 +53,20 @@ func (s *UpdateSchemaTestSuite) TestUpdateSchema() {
 	s.RunUpdateSchemaTest(buildCLIOptions(), client, "-k", createTestCQLFileContent(), []string{"events", "tasks"})
 }
 
+func (s *UpdateSchemaTestSuite) TestPlanSchema() {
+	client, err := newTestCQLClient(s.DBName)
+	s.Nil(err)
+	defer client.Close()
+	s.RunPlanSchemaTest(buildCLIOptions(), client, "-k", createTestCQLFileContent())
+}
+
+func (s *UpdateSchemaTestSuite) TestDiffSchema() {
+	client, err := newTestCQLClient(s.DBName)
+	s.Nil(err)
+	defer client.Close()
+	s.RunDiffSchemaTest(buildCLIOptions(), client, "-k", createTestCQLFileContent(), "ALTER TABLE namespaces ADD extra int")
+}
+
 func (s *UpdateSchemaTestSuite) TestDryrun() {
 	client, err := newTestCQLClient(s.DBName)
 	s.Nil(err)
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+)
+
+type (
+	// Catalog is a snapshot of the tables, columns and
+	// indexes of a database as reported by the database
+	Catalog struct {
+		Tables map[string]*TableCatalog
+	}
+
+	// TableCatalog holds the columns and indexes of a table, keyed by
+	// name. Values are database specific definitions, e.g. the column type.
+	TableCatalog struct {
+		Columns map[string]string
+		Indexes map[string]string
+	}
+
+	// DriftKind is the kind of difference between two catalogs
+	DriftKind string
+
+	// Drift is a single difference between the expected
+	// catalog and the catalog of the live database
+	Drift struct {
+		Kind DriftKind
+		// Object is one of "table", "column" or "index"
+		Object string
+		// Name is the table name, or the table qualified column or index name
+		Name     string
+		Expected string
+		Actual   string
+	}
+)
+
+const (
+	// DriftMissing means the object is expected but does not exist
+	DriftMissing DriftKind = "missing"
+	// DriftUnexpected means the object exists but is not expected
+	DriftUnexpected DriftKind = "unexpected"
+	// DriftChanged means the object exists but its definition differs
+	DriftChanged DriftKind = "changed"
+)
+
+// NewCatalog returns an empty catalog
+func NewCatalog() *Catalog {
+	return &Catalog{Tables: make(map[string]*TableCatalog)}
+}
+
+// AddTable adds a table without columns or indexes if it does not exist yet
+func (c *Catalog) AddTable(table string) *TableCatalog {
+	t, ok := c.Tables[table]
+	if !ok {
+		t = &TableCatalog{
+			Columns: make(map[string]string),
+			Indexes: make(map[string]string),
+		}
+		c.Tables[table] = t
+	}
+	return t
+}
+
+// AddColumn adds a column, and its table if needed
+func (c *Catalog) AddColumn(table string, column string, definition string) {
+	c.AddTable(table).Columns[column] = definition
+}
+
+// AddIndex adds an index, and its table if needed
+func (c *Catalog) AddIndex(table string, index string, definition string) {
+	c.AddTable(table).Indexes[index] = definition
+}
+
+// DiffCatalogs returns the differences between the expected and the actual catalog, sorted by name.
+// Columns and indexes of a missing or unexpected table are not reported separately.
+func DiffCatalogs(expected *Catalog, actual *Catalog) []Drift {
+	var drifts []Drift
+	for name, expectedTable := range expected.Tables {
+		actualTable, ok := actual.Tables[name]
+		if !ok {
+			drifts = append(drifts, Drift{Kind: DriftMissing, Object: "table", Name: name})
+			continue
+		}
+		drifts = append(drifts, diffDefinitions("column", name, expectedTable.Columns, actualTable.Columns)...)
+		drifts = append(drifts, diffDefinitions("index", name, expectedTable.Indexes, actualTable.Indexes)...)
+	}
+	for name := range actual.Tables {
+		if _, ok := expected.Tables[name]; !ok {
+			drifts = append(drifts, Drift{Kind: DriftUnexpected, Object: "table", Name: name})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		if drifts[i].Name != drifts[j].Name {
+			return drifts[i].Name < drifts[j].Name
+		}
+		return drifts[i].Object < drifts[j].Object
+	})
+	return drifts
+}
+
+func diffDefinitions(object string, table string, expected map[string]string, actual map[string]string) []Drift {
+	var drifts []Drift
+	for name, expectedDef := range expected {
+		actualDef, ok := actual[name]
+		switch {
+		case !ok:
+			drifts = append(drifts, Drift{Kind: DriftMissing, Object: object, Name: table + "." + name, Expected: expectedDef})
+		case actualDef != expectedDef:
+			drifts = append(drifts, Drift{Kind: DriftChanged, Object: object, Name: table + "." + name, Expected: expectedDef, Actual: actualDef})
+		}
+	}
+	for name, actualDef := range actual {
+		if _, ok := expected[name]; !ok {
+			drifts = append(drifts, Drift{Kind: DriftUnexpected, Object: object, Name: table + "." + name, Actual: actualDef})
+		}
+	}
+	return drifts
+}
+
+// String returns a human readable description of the drift
+func (d Drift) String() string {
+	switch {
+	case d.Kind == DriftChanged:
+		return fmt.Sprintf("%s %s %s: expected %q, found %q", d.Kind, d.Object, d.Name, d.Expected, d.Actual)
+	case d.Expected != "":
+		return fmt.Sprintf("%s %s %s: expected %q", d.Kind, d.Object, d.Name, d.Expected)
+	case d.Actual != "":
+		return fmt.Sprintf("%s %s %s: found %q", d.Kind, d.Object, d.Name, d.Actual)
+	default:
+		return fmt.Sprintf("%s %s %s", d.Kind, d.Object, d.Name)
+	}
+}
@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffCatalogs_NoDifferences(t *testing.T) {
+	expected := NewCatalog()
+	expected.AddColumn("executions", "id", "bigint NOT NULL")
+	expected.AddIndex("executions", "PRIMARY", "UNIQUE (id)")
+	expected.AddTable("schema_version")
+
+	actual := NewCatalog()
+	actual.AddTable("schema_version")
+	actual.AddIndex("executions", "PRIMARY", "UNIQUE (id)")
+	actual.AddColumn("executions", "id", "bigint NOT NULL")
+
+	require.Empty(t, DiffCatalogs(expected, actual))
+}
+
+func TestDiffCatalogs(t *testing.T) {
+	expected := NewCatalog()
+	expected.AddColumn("executions", "id", "bigint NOT NULL")
+	expected.AddColumn("executions", "data", "blob")
+	expected.AddColumn("executions", "state", "int")
+	expected.AddIndex("executions", "by_state", "(state)")
+	expected.AddColumn("namespaces", "id", "int")
+
+	actual := NewCatalog()
+	actual.AddColumn("executions", "id", "bigint NOT NULL")
+	actual.AddColumn("executions", "data", "mediumblob")
+	actual.AddColumn("executions", "extra", "int")
+	actual.AddIndex("executions", "by_state", "(state)")
+	actual.AddIndex("executions", "by_extra", "(extra)")
+	actual.AddColumn("manual_backup", "id", "int")
+
+	drifts := DiffCatalogs(expected, actual)
+	require.Equal(t, []Drift{
+		{Kind: DriftUnexpected, Object: "index", Name: "executions.by_extra", Actual: "(extra)"},
+		{Kind: DriftChanged, Object: "column", Name: "executions.data", Expected: "blob", Actual: "mediumblob"},
+		{Kind: DriftUnexpected, Object: "column", Name: "executions.extra", Actual: "int"},
+		{Kind: DriftMissing, Object: "column", Name: "executions.state", Expected: "int"},
+		{Kind: DriftUnexpected, Object: "table", Name: "manual_backup"},
+		{Kind: DriftMissing, Object: "table", Name: "namespaces"},
+	}, drifts)
+}
+
+func TestDrift_String(t *testing.T) {
+	require.Equal(t, `changed column executions.data: expected "blob", found "mediumblob"`,
+		Drift{Kind: DriftChanged, Object: "column", Name: "executions.data", Expected: "blob", Actual: "mediumblob"}.String())
+	require.Equal(t, `missing index executions.by_state: expected "(state)"`,
+		Drift{Kind: DriftMissing, Object: "index", Name: "executions.by_state", Expected: "(state)"}.String())
+	require.Equal(t, `unexpected column executions.extra: found "int"`,
+		Drift{Kind: DriftUnexpected, Object: "column", Name: "executions.extra", Actual: "int"}.String())
+	require.Equal(t, "missing table namespaces",
+		Drift{Kind: DriftMissing, Object: "table", Name: "namespaces"}.String())
+}
@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// DiffTask represents a task that compares the catalog of a
+	// database with the catalog the versioned schema produces
+	DiffTask struct {
+		db        DB
+		scratchDB DB
+		config    *UpdateConfig
+		out       io.Writer
+		logger    log.Logger
+	}
+)
+
+// ErrSchemaDrift is returned by DiffTask when the
+// database does not match the expected schema
+var ErrSchemaDrift = errors.New("schema drift detected")
+
+// NewDiffSchemaTask returns a new instance of DiffTask. The versioned schema
+// is applied to scratchDB, which loses all its tables in the process.
+func NewDiffSchemaTask(db DB, scratchDB DB, config *UpdateConfig, out io.Writer, logger log.Logger) *DiffTask {
+	return &DiffTask{
+		db:        db,
+		scratchDB: scratchDB,
+		config:    config,
+		out:       out,
+		logger:    logger,
+	}
+}
+
+// Run executes the task. When no target version is
+// configured, the current version of the database is used.
+func (task *DiffTask) Run() error {
+	config := *task.config
+	if len(config.TargetVersion) == 0 {
+		currVer, err := task.db.ReadSchemaVersion()
+		if err != nil {
+			return fmt.Errorf("error reading current schema version:%v", err.Error())
+		}
+		config.TargetVersion = currVer
+	}
+
+	task.logger.Info("DiffSchemaTask started", tag.NewAnyTag("config", config))
+
+	setupConfig := &SetupConfig{
+		Overwrite:      true,
+		InitialVersion: "0.0",
+	}
+	if err := NewSetupSchemaTask(task.scratchDB, setupConfig, task.logger).Run(); err != nil {
+		return fmt.Errorf("error setting up scratch database:%v", err.Error())
+	}
+	if err := NewUpdateSchemaTask(task.scratchDB, &config, task.logger).Run(); err != nil {
+		return fmt.Errorf("error updating scratch database:%v", err.Error())
+	}
+
+	expected, err := task.scratchDB.ReadCatalog()
+	if err != nil {
+		return fmt.Errorf("error reading scratch database catalog:%v", err.Error())
+	}
+	actual, err := task.db.ReadCatalog()
+	if err != nil {
+		return fmt.Errorf("error reading database catalog:%v", err.Error())
+	}
+
+	drifts := DiffCatalogs(expected, actual)
+	for _, drift := range drifts {
+		if _, err := fmt.Fprintln(task.out, drift.String()); err != nil {
+			return err
+		}
+	}
+
+	task.logger.Info("DiffSchemaTask done")
+
+	if len(drifts) > 0 {
+		return fmt.Errorf("%w: %v differences from schema version %v", ErrSchemaDrift, len(drifts), config.TargetVersion)
+	}
+	_, err = fmt.Fprintf(task.out, "no differences from schema version %v\n", config.TargetVersion)
+	return err
+}
@@ -52,6 +52,42 @@ func Update(cli *cli.Context, db DB, logger log.Logger) error {
 	return NewUpdateSchemaTask(db, cfg, logger).Run()
 }
 
+// Plan prints the statements that Update would execute for the specified database
+func Plan(cli *cli.Context, db DB, logger log.Logger) error {
+	cfg, err := newUpdateConfig(cli, db)
+	if err != nil {
+		return err
+	}
+	return NewUpdateSchemaTask(db, cfg, logger).Plan(cli.App.Writer)
+}
+
+// Diff compares the schema of the specified database with the versioned
+// schema, which is applied to scratchDB, and prints the differences
+func Diff(cli *cli.Context, db DB, scratchDB DB, logger log.Logger) error {
+	cfg, err := newUpdateConfig(cli, db)
+	if err != nil {
+		return err
+	}
+	return NewDiffSchemaTask(db, scratchDB, cfg, cli.App.Writer, logger).Run()
+}
+
+// CheckScratch returns an error if scratchDB, named name, has tables
+// and dropScratch is false. Diff drops all tables of scratchDB, so a
+// database that was not created for it must not be used unless asked to
+func CheckScratch(scratchDB DB, name string, dropScratch bool) error {
+	if dropScratch {
+		return nil
+	}
+	catalog, err := scratchDB.ReadCatalog()
+	if err != nil {
+		return fmt.Errorf("error reading scratch database catalog:%v", err.Error())
+	}
+	if len(catalog.Tables) > 0 {
+		return NewConfigError(fmt.Sprintf("scratch database %v already has tables, pass --%v to drop them", name, CLIOptDropScratch))
+	}
+	return nil
+}
+
 func newUpdateConfig(cli *cli.Context, db DB) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
@@ -124,6 +124,18 @@ func (s *HandlerTestSuite) TestValidateUpdateConfig() {
 	s.assertValidateUpdateFails(config, s.db)
 }
 
+func (s *HandlerTestSuite) TestCheckScratch() {
+	s.db.catalog = &Catalog{Tables: map[string]*TableCatalog{}}
+	s.NoError(CheckScratch(s.db, "scratch", false))
+
+	s.db.catalog.Tables["executions"] = &TableCatalog{}
+	err := CheckScratch(s.db, "scratch", false)
+	s.Error(err)
+	_, ok := err.(*ConfigError)
+	s.True(ok)
+	s.NoError(CheckScratch(s.db, "scratch", true))
+}
+
 func (s *HandlerTestSuite) assertValidateSetupSucceeds(input *SetupConfig, db DB) {
 	err := validateSetupConfig(input, db)
 	s.Nil(err)
@@ -30,6 +30,7 @@ import (
 
 type (
 	mockSQLDB struct {
+		catalog *Catalog
 	}
 )
 
@@ -63,6 +64,14 @@ func (db *mockSQLDB) WriteSchemaUpdateLog(oldVersion string, newVersion string,
 	return fmt.Errorf("unimplemented")
 }
 
+// ReadCatalog returns the tables, columns and indexes of the keyspace
+func (db *mockSQLDB) ReadCatalog() (*Catalog, error) {
+	if db.catalog == nil {
+		return nil, fmt.Errorf("unimplemented")
+	}
+	return db.catalog, nil
+}
+
 // Close gracefully closes the client object
 func (db *mockSQLDB) Close() {}
 
@@ -25,6 +25,7 @@
 package test
 
 import (
+	"bytes"
 	"fmt"
 	"math/rand"
 	"os"
@@ -139,6 +140,81 @@ func (tb *UpdateSchemaTestBase) RunUpdateSchemaTest(app *cli.App, db DB, dbNameF
 	tb.NoError(db.DropAllTables())
 }
 
+// RunPlanSchemaTest tests that schema plan prints the pending updates without applying them
+func (tb *UpdateSchemaTestBase) RunPlanSchemaTest(app *cli.App, db DB, dbNameFlag string, sqlFileContent string) {
+	tmpDir := testutils.MkdirTemp(tb.T(), "", "plan_schema_test")
+
+	tb.makeSchemaVersionDirs(tmpDir, sqlFileContent)
+
+	command := append(tb.getCommandBase(), []string{
+		dbNameFlag, tb.DBName,
+		"-q",
+		"setup-schema",
+		"-v", "0.0",
+	}...)
+	tb.NoError(app.Run(command))
+
+	var out bytes.Buffer
+	app.Writer = &out
+	command = append(tb.getCommandBase(), []string{
+		dbNameFlag, tb.DBName,
+		"-q",
+		"plan-schema",
+		"-d", tmpDir,
+	}...)
+	tb.NoError(app.Run(command))
+	tb.Contains(out.String(), "-- updating schema from version 0.0 to 2.0")
+	tb.Contains(out.String(), "-- version 2.0: v2 of schema")
+	tb.Contains(out.String(), "CREATE TABLE namespaces")
+
+	ver, err := db.ReadSchemaVersion()
+	tb.NoError(err)
+	tb.Equal("0.0", ver)
+	tb.NoError(db.DropAllTables())
+}
+
+// RunDiffSchemaTest tests that schema diff reports no differences after a schema
+// update, and reports the column that driftStmt adds to the namespaces table
+func (tb *UpdateSchemaTestBase) RunDiffSchemaTest(app *cli.App, db DB, dbNameFlag string, sqlFileContent string, driftStmt string) {
+	tmpDir := testutils.MkdirTemp(tb.T(), "", "diff_schema_test")
+
+	tb.makeSchemaVersionDirs(tmpDir, sqlFileContent)
+
+	command := append(tb.getCommandBase(), []string{
+		dbNameFlag, tb.DBName,
+		"-q",
+		"setup-schema",
+		"-v", "0.0",
+	}...)
+	tb.NoError(app.Run(command))
+
+	command = append(tb.getCommandBase(), []string{
+		dbNameFlag, tb.DBName,
+		"-q",
+		"update-schema",
+		"-d", tmpDir,
+		"-v", "2.0",
+	}...)
+	tb.NoError(app.Run(command))
+
+	var out bytes.Buffer
+	app.Writer = &out
+	command = append(tb.getCommandBase(), []string{
+		dbNameFlag, tb.DBName,
+		"-q",
+		"diff-schema",
+		"-d", tmpDir,
+	}...)
+	tb.NoError(app.Run(command))
+	tb.Contains(out.String(), "no differences from schema version 2.0")
+
+	tb.NoError(db.Exec(driftStmt))
+	out.Reset()
+	tb.NoError(app.Run(command))
+	tb.Contains(out.String(), "unexpected column namespaces.extra")
+	tb.NoError(db.DropAllTables())
+}
+
 func (tb *UpdateSchemaTestBase) makeSchemaVersionDirs(rootDir string, sqlFileContent string) {
 	mData := `{
 		"CurrVersion": "1.0",
@@ -69,6 +69,8 @@ type (
 		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
 		// WriteSchemaUpdateLog adds an entry to the schema update history table
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// ReadCatalog returns the tables, columns and indexes of the keyspace
+		ReadCatalog() (*Catalog, error)
 		// Close gracefully closes the client object
 		Close()
 		// Type gives the type of db (e.g. "cassandra", "sql")
@@ -127,6 +129,12 @@ const (
 	CLIOptQuiet = "quiet"
 	// CLIOptForce is the cli option for force mode
 	CLIOptForce = "force"
+	// CLIOptScratchDatabase is the cli option for the scratch database used to build the expected schema
+	CLIOptScratchDatabase = "scratch-database"
+	// CLIOptScratchKeyspace is the cli option for the scratch keyspace used to build the expected schema
+	CLIOptScratchKeyspace = "scratch-keyspace"
+	// CLIOptDropScratch is the cli option allowing diff to drop the tables of a non-empty scratch database
+	CLIOptDropScratch = "drop-scratch"
 
 	// CLIFlagEndpoint is the cli flag for endpoint
 	CLIFlagEndpoint = CLIOptEndpoint + ", ep"
@@ -174,6 +182,10 @@ const (
 	CLIFlagQuiet = CLIOptQuiet + ", q"
 	// CLIFlagForce is the cli flag for force mode
 	CLIFlagForce = CLIOptForce + ", f"
+	// CLIFlagScratchDatabase is the cli flag for the scratch database used to build the expected schema
+	CLIFlagScratchDatabase = CLIOptScratchDatabase + ", sdb"
+	// CLIFlagScratchKeyspace is the cli flag for the scratch keyspace used to build the expected schema
+	CLIFlagScratchKeyspace = CLIOptScratchKeyspace + ", sk"
 	// CLIFlagDisableInitialHostLookup is the cli flag for only using supplied hosts to connect to the database
 	CLIFlagDisableInitialHostLookup = "disable-initial-host-lookup"
 
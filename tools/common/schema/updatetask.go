@@ -131,6 +131,41 @@ func (task *UpdateTask) Run() error {
 	return nil
 }
 
+// Plan writes the statements that Run would execute to w,
+// grouped by version, without applying them to the database
+func (task *UpdateTask) Plan(w io.Writer) error {
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	updates, err := task.buildChangeSet(currVer)
+	if err != nil {
+		return err
+	}
+
+	return writePlan(w, currVer, updates)
+}
+
+func writePlan(w io.Writer, currVer string, updates []changeSet) error {
+	if len(updates) == 0 {
+		_, err := fmt.Fprintf(w, "-- no updates pending for current version %v\n", currVer)
+		return err
+	}
+
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "-- updating schema from version %v to %v\n", currVer, updates[len(updates)-1].version)
+	for _, cs := range updates {
+		_, _ = fmt.Fprintf(&b, "\n-- version %v: %v\n", cs.version, cs.manifest.Description)
+		for _, stmt := range cs.cqlStmts {
+			b.WriteString(strings.TrimSuffix(strings.TrimSpace(stmt), ";"))
+			b.WriteString(";\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
 func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) error {
 	if len(updates) == 0 {
 		task.logger.Debug(fmt.Sprintf("found zero updates from current version %v", currVer))
@@ -26,6 +26,7 @@ package schema
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -97,6 +98,35 @@ func (s *UpdateTaskTestSuite) TestReadSchemaDir() {
 	s.Equal([]string{"v2.7.17", "v3.5", "v10.2"}, ans)
 }
 
+func (s *UpdateTaskTestSuite) TestWritePlan() {
+	var out strings.Builder
+	s.NoError(writePlan(&out, "1.0", nil))
+	s.Equal("-- no updates pending for current version 1.0\n", out.String())
+
+	out.Reset()
+	updates := []changeSet{
+		{
+			version:  "1.1",
+			manifest: &manifest{Description: "add namespaces"},
+			cqlStmts: []string{"CREATE TABLE namespaces (id int, PRIMARY KEY (id));"},
+		},
+		{
+			version:  "1.2",
+			manifest: &manifest{Description: "add namespace name"},
+			cqlStmts: []string{"ALTER TABLE namespaces ADD COLUMN name varchar(255)"},
+		},
+	}
+	s.NoError(writePlan(&out, "1.0", updates))
+	s.Equal(`-- updating schema from version 1.0 to 1.2
+
+-- version 1.1: add namespaces
+CREATE TABLE namespaces (id int, PRIMARY KEY (id));
+
+-- version 1.2: add namespace name
+ALTER TABLE namespaces ADD COLUMN name varchar(255);
+`, out.String())
+}
+
 func (s *UpdateTaskTestSuite) TestReadSchemaDirEFS() {
 	fsys := dbschemas.Assets()
 	versionsDir := "mysql/v8/temporal/versioned"
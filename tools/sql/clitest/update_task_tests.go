@@ -90,6 +90,22 @@ func (s *UpdateSchemaTestSuite) TestUpdateSchema() {
 	s.RunUpdateSchemaTest(sql.BuildCLIOptions(), conn, "--db", s.sqlQuery, []string{"executions", "current_executions"})
 }
 
+// TestPlanSchema test
+func (s *UpdateSchemaTestSuite) TestPlanSchema() {
+	conn, err := newTestConn(s.DBName, s.host, s.port, s.pluginName)
+	s.NoError(err)
+	defer conn.Close()
+	s.RunPlanSchemaTest(sql.BuildCLIOptions(), conn, "--db", s.sqlQuery)
+}
+
+// TestDiffSchema test
+func (s *UpdateSchemaTestSuite) TestDiffSchema() {
+	conn, err := newTestConn(s.DBName, s.host, s.port, s.pluginName)
+	s.NoError(err)
+	defer conn.Close()
+	s.RunDiffSchemaTest(sql.BuildCLIOptions(), conn, "--db", s.sqlQuery, "ALTER TABLE namespaces ADD COLUMN extra INT")
+}
+
 // TestDryrun test
 func (s *UpdateSchemaTestSuite) TestDryrun() {
 	conn, err := newTestConn(s.DBName, s.host, s.port, s.pluginName)
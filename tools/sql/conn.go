@@ -89,6 +89,34 @@ func (c *Connection) ListTables() ([]string, error) {
 	return c.adminDb.ListTables(c.dbName)
 }
 
+// ReadCatalog returns the tables, columns and indexes of this database
+func (c *Connection) ReadCatalog() (*schema.Catalog, error) {
+	tables, err := c.ListTables()
+	if err != nil {
+		return nil, err
+	}
+	columns, err := c.adminDb.ListColumns(c.dbName)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := c.adminDb.ListIndexes(c.dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := schema.NewCatalog()
+	for _, table := range tables {
+		catalog.AddTable(table)
+	}
+	for _, column := range columns {
+		catalog.AddColumn(column.TableName, column.ColumnName, column.ColumnType)
+	}
+	for _, index := range indexes {
+		catalog.AddIndex(index.TableName, index.IndexName, index.Definition)
+	}
+	return catalog, nil
+}
+
 // DropTable drops a given table from the database
 func (c *Connection) DropTable(name string) error {
 	return c.adminDb.DropTable(name)
@@ -80,6 +80,85 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// planSchema prints the statements that
+// updateSchema would execute using the
+// given command line args as input
+func planSchema(cli *cli.Context, logger log.Logger) error {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	conn, err := NewConnection(cfg, logger)
+	if err != nil {
+		logger.Error("Unable to connect to SQL database.", tag.Error(err))
+		return err
+	}
+	defer conn.Close()
+	if err := schema.Plan(cli, conn, logger); err != nil {
+		logger.Error("Unable to plan SQL schema update.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+// diffSchema compares the schema of the database
+// with the versioned schema, which is applied to a
+// scratch database that is dropped afterwards. A
+// scratch database with tables is only used if
+// dropping them is explicitly allowed
+func diffSchema(cli *cli.Context, logger log.Logger) error {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	scratchCfg := *cfg
+	scratchCfg.DatabaseName = cli.String(schema.CLIOptScratchDatabase)
+	if scratchCfg.DatabaseName == "" {
+		scratchCfg.DatabaseName = cfg.DatabaseName + "_schema_diff"
+	}
+	if scratchCfg.DatabaseName == cfg.DatabaseName {
+		err := schema.NewConfigError(flag(schema.CLIOptScratchDatabase) + " must differ from " + flag(schema.CLIOptDatabase))
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+
+	conn, err := NewConnection(cfg, logger)
+	if err != nil {
+		logger.Error("Unable to connect to SQL database.", tag.Error(err))
+		return err
+	}
+	defer conn.Close()
+	if err := conn.CreateDatabase(scratchCfg.DatabaseName); err != nil {
+		logger.Error("Unable to create scratch SQL database.", tag.Error(err))
+		return err
+	}
+
+	scratchConn, err := NewConnection(&scratchCfg, logger)
+	if err != nil {
+		logger.Error("Unable to connect to scratch SQL database.", tag.Error(err))
+		return err
+	}
+	if err := schema.CheckScratch(scratchConn, scratchCfg.DatabaseName, cli.Bool(schema.CLIOptDropScratch)); err != nil {
+		scratchConn.Close()
+		logger.Error("Unable to use scratch SQL database.", tag.Error(err))
+		return err
+	}
+	defer func() {
+		// the scratch database can only be dropped once its connection is closed
+		scratchConn.Close()
+		if err := conn.DropDatabase(scratchCfg.DatabaseName); err != nil {
+			logger.Warn("Unable to drop scratch SQL database.", tag.Error(err))
+		}
+	}()
+	if err := schema.Diff(cli, conn, scratchConn, logger); err != nil {
+		logger.Error("Unable to verify SQL schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context, logger log.Logger) error {
 	cfg, err := parseConnectConfig(cli)
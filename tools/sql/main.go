@@ -196,6 +196,60 @@ func BuildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "plan-schema",
+			Aliases: []string{"plan"},
+			Usage:   "print the statements update-schema would execute, without applying them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "target version for the schema update, defaults to latest",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("mysql")),
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, planSchema, logger)
+			},
+		},
+		{
+			Name:    "diff-schema",
+			Aliases: []string{"diff"},
+			Usage:   "compare tables, columns and indexes of the database with the versioned schema, fail on drift",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "schema version to compare with, defaults to the current version of the database",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("mysql")),
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagScratchDatabase,
+					Usage: "database to build the expected schema in, it is created if missing and dropped afterwards; defaults to <database>_schema_diff",
+				},
+				cli.BoolFlag{
+					Name:  schema.CLIOptDropScratch,
+					Usage: "drop the tables of the scratch database if it already has any",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, diffSchema, logger)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},
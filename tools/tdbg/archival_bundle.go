@@ -0,0 +1,325 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/codec"
+)
+
+// An archival bundle is a gzipped tarball holding the archived record of a namespace. Entries are written in
+// this order so that readers can stream the bundle:
+//
+//	manifest.json     - archivalBundleManifest describing the bundle.
+//	visibility.ndjson - one JSON encoded WorkflowExecutionInfo per line.
+//	histories.ndjson  - one archivalBundleHistory per line, including executions whose history was not
+//	                    found in the history archive.
+const (
+	archivalBundleFormatVersion  = 1
+	archivalBundleManifestFile   = "manifest.json"
+	archivalBundleVisibilityFile = "visibility.ndjson"
+	archivalBundleHistoriesFile  = "histories.ndjson"
+
+	// archivalBundleMaxLineSize bounds the size of a single history line when reading a bundle.
+	archivalBundleMaxLineSize = 512 * 1024 * 1024
+)
+
+type (
+	archivalBundleManifest struct {
+		FormatVersion          int       `json:"formatVersion"`
+		Namespace              string    `json:"namespace"`
+		Query                  string    `json:"query,omitempty"`
+		ExportTime             time.Time `json:"exportTime"`
+		WorkflowExecutionCount int       `json:"workflowExecutionCount"`
+		// MissingHistoryCount is the number of visibility records whose history was not found in the
+		// history archive.
+		MissingHistoryCount int    `json:"missingHistoryCount"`
+		VisibilityFile      string `json:"visibilityFile"`
+		HistoriesFile       string `json:"historiesFile"`
+	}
+
+	archivalBundleHistory struct {
+		Execution       json.RawMessage   `json:"execution"`
+		HistoryNotFound bool              `json:"historyNotFound,omitempty"`
+		HistoryBatches  []json.RawMessage `json:"historyBatches,omitempty"`
+	}
+
+	// archivalBundleWriter spools executions to temporary files, since tar entries need their size up front,
+	// and assembles the bundle on Finish.
+	archivalBundleWriter struct {
+		encoder        codec.JSONPBEncoder
+		manifest       archivalBundleManifest
+		visibilityFile *os.File
+		historiesFile  *os.File
+		visibility     *bufio.Writer
+		histories      *bufio.Writer
+	}
+)
+
+func newArchivalBundleWriter(namespace string, query string) (*archivalBundleWriter, error) {
+	visibilityFile, err := os.CreateTemp("", "tdbg-visibility-*.ndjson")
+	if err != nil {
+		return nil, err
+	}
+	historiesFile, err := os.CreateTemp("", "tdbg-histories-*.ndjson")
+	if err != nil {
+		_ = visibilityFile.Close()
+		_ = os.Remove(visibilityFile.Name())
+		return nil, err
+	}
+	return &archivalBundleWriter{
+		encoder: codec.NewJSONPBEncoder(),
+		manifest: archivalBundleManifest{
+			FormatVersion:  archivalBundleFormatVersion,
+			Namespace:      namespace,
+			Query:          query,
+			VisibilityFile: archivalBundleVisibilityFile,
+			HistoriesFile:  archivalBundleHistoriesFile,
+		},
+		visibilityFile: visibilityFile,
+		historiesFile:  historiesFile,
+		visibility:     bufio.NewWriter(visibilityFile),
+		histories:      bufio.NewWriter(historiesFile),
+	}, nil
+}
+
+// Add appends one archived execution to the bundle.
+func (w *archivalBundleWriter) Add(execution *adminservice.ArchivedWorkflowExecution) error {
+	record := execution.GetVisibilityRecord()
+	data, err := w.encoder.Encode(record)
+	if err != nil {
+		return fmt.Errorf("unable to encode visibility record: %w", err)
+	}
+	if err := writeLine(w.visibility, data); err != nil {
+		return err
+	}
+	w.manifest.WorkflowExecutionCount++
+
+	executionData, err := w.encoder.Encode(record.GetExecution())
+	if err != nil {
+		return fmt.Errorf("unable to encode workflow execution: %w", err)
+	}
+	history := archivalBundleHistory{
+		Execution:       executionData,
+		HistoryNotFound: execution.GetHistoryNotFound(),
+	}
+	if history.HistoryNotFound {
+		w.manifest.MissingHistoryCount++
+	}
+	for _, batch := range execution.GetHistoryBatches() {
+		batchData, err := w.encoder.Encode(batch)
+		if err != nil {
+			return fmt.Errorf("unable to encode history batch: %w", err)
+		}
+		history.HistoryBatches = append(history.HistoryBatches, batchData)
+	}
+	data, err = json.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("unable to encode history: %w", err)
+	}
+	return writeLine(w.histories, data)
+}
+
+// Finish writes the bundle to out. The writer must not be used afterwards.
+func (w *archivalBundleWriter) Finish(out io.Writer, exportTime time.Time) error {
+	if err := errors.Join(w.visibility.Flush(), w.histories.Flush()); err != nil {
+		return err
+	}
+	w.manifest.ExportTime = exportTime.UTC()
+	manifest, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	if err := writeTarEntry(tw, archivalBundleManifestFile, int64(len(manifest)), exportTime, bytes.NewReader(manifest)); err != nil {
+		return err
+	}
+	for _, entry := range []struct {
+		name string
+		file *os.File
+	}{
+		{name: archivalBundleVisibilityFile, file: w.visibilityFile},
+		{name: archivalBundleHistoriesFile, file: w.historiesFile},
+	} {
+		info, err := entry.file.Stat()
+		if err != nil {
+			return err
+		}
+		if _, err := entry.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := writeTarEntry(tw, entry.name, info.Size(), exportTime, entry.file); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Close releases the temporary files backing the writer.
+func (w *archivalBundleWriter) Close() error {
+	return errors.Join(
+		w.visibilityFile.Close(),
+		os.Remove(w.visibilityFile.Name()),
+		w.historiesFile.Close(),
+		os.Remove(w.historiesFile.Name()),
+	)
+}
+
+// readArchivalBundle reads a bundle written by archivalBundleWriter, invoking onVisibility and onHistory (either
+// may be nil) for every record in the bundle, and returns the bundle manifest. onHistory is not invoked for
+// executions whose history was not found in the history archive.
+func readArchivalBundle(
+	in io.Reader,
+	onVisibility func(record *workflowpb.WorkflowExecutionInfo) error,
+	onHistory func(execution *commonpb.WorkflowExecution, historyBatches []*historypb.History) error,
+) (*archivalBundleManifest, error) {
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read archival bundle: %w", err)
+	}
+	defer func() { _ = gz.Close() }()
+	tr := tar.NewReader(gz)
+	encoder := codec.NewJSONPBEncoder()
+
+	var manifest *archivalBundleManifest
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read archival bundle: %w", err)
+		}
+		if manifest == nil && header.Name != archivalBundleManifestFile {
+			return nil, fmt.Errorf("archival bundle must start with %s, found %s", archivalBundleManifestFile, header.Name)
+		}
+		switch header.Name {
+		case archivalBundleManifestFile:
+			manifest = &archivalBundleManifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("unable to decode archival bundle manifest: %w", err)
+			}
+			if manifest.FormatVersion != archivalBundleFormatVersion {
+				return nil, fmt.Errorf("unsupported archival bundle format version: %d", manifest.FormatVersion)
+			}
+		case manifest.VisibilityFile:
+			if onVisibility == nil {
+				continue
+			}
+			if err := readLines(tr, func(line []byte) error {
+				record := &workflowpb.WorkflowExecutionInfo{}
+				if err := encoder.Decode(line, record); err != nil {
+					return fmt.Errorf("unable to decode visibility record: %w", err)
+				}
+				return onVisibility(record)
+			}); err != nil {
+				return nil, err
+			}
+		case manifest.HistoriesFile:
+			if onHistory == nil {
+				continue
+			}
+			if err := readLines(tr, func(line []byte) error {
+				var history archivalBundleHistory
+				if err := json.Unmarshal(line, &history); err != nil {
+					return fmt.Errorf("unable to decode history: %w", err)
+				}
+				if history.HistoryNotFound {
+					return nil
+				}
+				execution := &commonpb.WorkflowExecution{}
+				if err := encoder.Decode(history.Execution, execution); err != nil {
+					return fmt.Errorf("unable to decode workflow execution: %w", err)
+				}
+				historyBatches := make([]*historypb.History, 0, len(history.HistoryBatches))
+				for _, batchData := range history.HistoryBatches {
+					batch := &historypb.History{}
+					if err := encoder.Decode(batchData, batch); err != nil {
+						return fmt.Errorf("unable to decode history batch: %w", err)
+					}
+					historyBatches = append(historyBatches, batch)
+				}
+				return onHistory(execution, historyBatches)
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("archival bundle is missing %s", archivalBundleManifestFile)
+	}
+	return manifest, nil
+}
+
+func writeTarEntry(tw *tar.Writer, name string, size int64, modTime time.Time, content io.Reader) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    size,
+		ModTime: modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, content)
+	return err
+}
+
+func writeLine(w *bufio.Writer, data []byte) error {
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	return w.WriteByte('\n')
+}
+
+func readLines(r io.Reader, fn func(line []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, archivalBundleMaxLineSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := fn(scanner.Bytes()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
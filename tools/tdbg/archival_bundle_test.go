@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestArchivalBundle_RoundTrip(t *testing.T) {
+	archived := &adminservice.ArchivedWorkflowExecution{
+		VisibilityRecord: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "wid-1", RunId: "rid-1"},
+			Type:      &commonpb.WorkflowType{Name: "foo"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+		HistoryBatches: []*historypb.History{
+			{Events: []*historypb.HistoryEvent{
+				{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+				{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+			}},
+			{Events: []*historypb.HistoryEvent{
+				{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+			}},
+		},
+	}
+	missing := &adminservice.ArchivedWorkflowExecution{
+		VisibilityRecord: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "wid-2", RunId: "rid-2"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+		},
+		HistoryNotFound: true,
+	}
+
+	writer, err := newArchivalBundleWriter("test-namespace", "WorkflowType = 'foo'")
+	require.NoError(t, err)
+	require.NoError(t, writer.Add(archived))
+	require.NoError(t, writer.Add(missing))
+	exportTime := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	var bundle bytes.Buffer
+	require.NoError(t, writer.Finish(&bundle, exportTime))
+	require.NoError(t, writer.Close())
+
+	var records []*workflowpb.WorkflowExecutionInfo
+	var executions []*commonpb.WorkflowExecution
+	var histories [][]*historypb.History
+	manifest, err := readArchivalBundle(
+		bytes.NewReader(bundle.Bytes()),
+		func(record *workflowpb.WorkflowExecutionInfo) error {
+			records = append(records, record)
+			return nil
+		},
+		func(execution *commonpb.WorkflowExecution, historyBatches []*historypb.History) error {
+			executions = append(executions, execution)
+			histories = append(histories, historyBatches)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &archivalBundleManifest{
+		FormatVersion:          archivalBundleFormatVersion,
+		Namespace:              "test-namespace",
+		Query:                  "WorkflowType = 'foo'",
+		ExportTime:             exportTime,
+		WorkflowExecutionCount: 2,
+		MissingHistoryCount:    1,
+		VisibilityFile:         archivalBundleVisibilityFile,
+		HistoriesFile:          archivalBundleHistoriesFile,
+	}, manifest)
+
+	require.Len(t, records, 2)
+	protorequire.ProtoEqual(t, archived.GetVisibilityRecord(), records[0])
+	protorequire.ProtoEqual(t, missing.GetVisibilityRecord(), records[1])
+
+	require.Len(t, executions, 1)
+	protorequire.ProtoEqual(t, archived.GetVisibilityRecord().GetExecution(), executions[0])
+	require.Len(t, histories[0], 2)
+	protorequire.ProtoEqual(t, archived.GetHistoryBatches()[0], histories[0][0])
+	protorequire.ProtoEqual(t, archived.GetHistoryBatches()[1], histories[0][1])
+}
+
+func TestArchivalBundle_UnsupportedFormatVersion(t *testing.T) {
+	var bundle bytes.Buffer
+	gz := gzip.NewWriter(&bundle)
+	tw := tar.NewWriter(gz)
+	manifest := []byte(`{"formatVersion": 99}`)
+	require.NoError(t, writeTarEntry(tw, archivalBundleManifestFile, int64(len(manifest)), time.Now(), bytes.NewReader(manifest)))
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	_, err := readArchivalBundle(&bundle, nil, nil)
+	require.ErrorContains(t, err, "unsupported archival bundle format version: 99")
+}
+
+func TestArchivalBundle_MissingManifest(t *testing.T) {
+	var bundle bytes.Buffer
+	gz := gzip.NewWriter(&bundle)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, writeTarEntry(tw, archivalBundleHistoriesFile, 0, time.Now(), bytes.NewReader(nil)))
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	_, err := readArchivalBundle(&bundle, nil, nil)
+	require.ErrorContains(t, err, "archival bundle must start with manifest.json")
+}
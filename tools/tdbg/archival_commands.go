@@ -0,0 +1,160 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives"
+)
+
+// AdminExportArchival writes the archived histories and visibility records of a namespace to an archival bundle
+func AdminExportArchival(c *cli.Context, clientFactory ClientFactory) (retErr error) {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	outputFileName, err := getRequiredOption(c, FlagOutputFilename)
+	if err != nil {
+		return err
+	}
+	query := c.String(FlagQuery)
+	pageSize := c.Int(FlagPageSize)
+
+	client := clientFactory.AdminClient(c)
+
+	writer, err := newArchivalBundleWriter(nsName, query)
+	if err != nil {
+		return fmt.Errorf("unable to create archival bundle: %s", err)
+	}
+	defer func() {
+		retErr = errors.Join(retErr, writer.Close())
+	}()
+
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		// Each page gets its own context, exporting a whole namespace may take longer than a single timeout.
+		ctx, cancel := newContext(c)
+		resp, err := client.ExportArchivedWorkflowExecutions(ctx, &adminservice.ExportArchivedWorkflowExecutionsRequest{
+			Namespace:     nsName,
+			Query:         query,
+			PageSize:      int32(pageSize),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to export archived workflow executions: %s", err)
+		}
+		for _, execution := range resp.GetExecutions() {
+			if err := writer.Add(execution); err != nil {
+				return fmt.Errorf("unable to write archival bundle: %s", err)
+			}
+		}
+		token = resp.GetNextPageToken()
+	}
+
+	outputFile, err := os.Create(outputFileName)
+	if err != nil {
+		return fmt.Errorf("unable to create archival bundle file: %s", err)
+	}
+	if err := errors.Join(writer.Finish(outputFile, time.Now()), outputFile.Close()); err != nil {
+		return fmt.Errorf("unable to write archival bundle file: %s", err)
+	}
+
+	fmt.Fprintf(c.App.Writer, "Exported %d workflow executions (%d without archived history) to %s\n",
+		writer.manifest.WorkflowExecutionCount, writer.manifest.MissingHistoryCount, outputFileName)
+	return nil
+}
+
+// AdminImportArchival imports every workflow history of an archival bundle into a namespace
+func AdminImportArchival(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	inputFileName, err := getRequiredOption(c, FlagInputFilename)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	serializer := serialization.NewSerializer()
+
+	inputFile, err := os.Open(inputFileName)
+	if err != nil {
+		return fmt.Errorf("unable to open archival bundle file: %s", err)
+	}
+	defer func() { _ = inputFile.Close() }()
+
+	imported := 0
+	manifest, err := readArchivalBundle(inputFile, nil, func(execution *commonpb.WorkflowExecution, historyBatches []*historypb.History) error {
+		ctx, cancel := newContext(c)
+		defer cancel()
+		if err := importWorkflowExecution(ctx, client, serializer, nsName, execution, historyBatches); err != nil {
+			return fmt.Errorf("workflow %s, run %s: %w", execution.GetWorkflowId(), execution.GetRunId(), err)
+		}
+		imported++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to import archival bundle: %s", err)
+	}
+
+	fmt.Fprintf(c.App.Writer, "Imported %d workflow executions exported from namespace %s at %s\n",
+		imported, manifest.Namespace, manifest.ExportTime.Format(time.RFC3339))
+	return nil
+}
+
+// AdminStartArchivalVerification starts the archival verifier workflow for a namespace
+func AdminStartArchivalVerification(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.StartArchivalVerification(ctx, &adminservice.StartArchivalVerificationRequest{
+		Namespace:  nsName,
+		Query:      c.String(FlagQuery),
+		SampleRate: c.Float64(FlagSampleRate),
+		Rearchive:  c.Bool(FlagRearchive),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start archival verification: %s", err)
+	}
+
+	fmt.Fprintf(c.App.Writer, "Started archival verification of namespace %s.\n", nsName)
+	fmt.Fprintf(c.App.Writer, "The verification report is the result of workflow %s, run %s, in namespace %s.\n",
+		resp.GetWorkflowId(), resp.GetRunId(), primitives.SystemLocalNamespace)
+	return nil
+}
@@ -25,6 +25,7 @@
 package tdbg
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -192,6 +193,22 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 		return fmt.Errorf("unable to deserialize History data: %s", err)
 	}
 
+	return importWorkflowExecution(ctx, client, serializer, nsName, &commonpb.WorkflowExecution{
+		WorkflowId: wid,
+		RunId:      rid,
+	}, historyBatches)
+}
+
+// importWorkflowExecution sends the history batches of one workflow execution to ImportWorkflowExecution and
+// commits the import.
+func importWorkflowExecution(
+	ctx context.Context,
+	client adminservice.AdminServiceClient,
+	serializer serialization.Serializer,
+	nsName string,
+	execution *commonpb.WorkflowExecution,
+	historyBatches []*historypb.History,
+) error {
 	versionHistory := &history.VersionHistory{}
 	for _, historyBatch := range historyBatches {
 		for _, event := range historyBatch.Events {
@@ -219,11 +236,8 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 			len(blobs) >= historyImportPageSize ||
 			(i == len(historyBatches) && len(blobs) > 0) {
 			resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
-				Namespace: nsName,
-				Execution: &commonpb.WorkflowExecution{
-					WorkflowId: wid,
-					RunId:      rid,
-				},
+				Namespace:      nsName,
+				Execution:      execution,
 				HistoryBatches: blobs,
 				VersionHistory: versionHistory,
 				Token:          token,
@@ -242,11 +256,8 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	}
 	// call with empty history to commit
 	resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
-		Namespace: nsName,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
+		Namespace:      nsName,
+		Execution:      execution,
 		HistoryBatches: []*commonpb.DataBlob{},
 		VersionHistory: versionHistory,
 		Token:          token,
@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// AdminEvaluateDynamicConfig evaluates a dynamic config setting on a host
+func AdminEvaluateDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	key, err := getRequiredOption(c, FlagDynamicConfigKey)
+	if err != nil {
+		return err
+	}
+	constraints, err := dynamicConfigConstraints(c)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.EvaluateDynamicConfig(ctx, &adminservice.EvaluateDynamicConfigRequest{
+		Key:         key,
+		Constraints: constraints,
+		HostAddress: c.String(FlagHostAddress),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to evaluate dynamic config: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminListDynamicConfigSettings lists the registered dynamic config settings
+func AdminListDynamicConfigSettings(c *cli.Context, clientFactory ClientFactory) error {
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.ListDynamicConfigSettings(ctx, &adminservice.ListDynamicConfigSettingsRequest{
+		KeyPrefix: c.String(FlagKeyPrefix),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list dynamic config settings: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// dynamicConfigConstraints builds the constraints to evaluate a setting for. The namespace flag is global and
+// defaults to "default", so it is only used as a constraint when set explicitly.
+func dynamicConfigConstraints(c *cli.Context) (*persistencespb.DynamicConfigConstraints, error) {
+	constraints := &persistencespb.DynamicConfigConstraints{
+		NamespaceId:   c.String(FlagNamespaceID),
+		TaskQueueName: c.String(FlagTaskQueue),
+		ShardId:       int32(c.Int(FlagShardID)),
+		Destination:   c.String(FlagDestination),
+	}
+	if c.IsSet(FlagNamespace) {
+		constraints.Namespace = c.String(FlagNamespace)
+	}
+	if c.IsSet(FlagTaskQueueType) {
+		taskQueueType, err := enumspb.TaskQueueTypeFromString(c.String(FlagTaskQueueType))
+		if err != nil {
+			return nil, fmt.Errorf("invalid task queue type: %s", err)
+		}
+		constraints.TaskQueueType = taskQueueType
+	}
+	if c.IsSet(FlagTaskType) {
+		taskType, err := enumsspb.TaskTypeFromString(c.String(FlagTaskType))
+		if err != nil {
+			return nil, fmt.Errorf("invalid task type: %s", err)
+		}
+		constraints.TaskType = taskType
+	}
+	return constraints, nil
+}
@@ -91,4 +91,14 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagQuery                      = "query"
+	FlagQueryAlias                 = []string{"q"}
+	FlagSampleRate                 = "sample-rate"
+	FlagRearchive                  = "rearchive"
+	FlagSkipBackfill               = "skip-backfill"
+	FlagDynamicConfigKey           = "key"
+	FlagKeyPrefix                  = "key-prefix"
+	FlagHostAddress                = "host-address"
+	FlagTaskType                   = "task-type"
+	FlagDestination                = "destination"
 )
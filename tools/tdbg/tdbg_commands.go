@@ -75,6 +75,22 @@ func getCommands(
 			Usage:       "Run admin operation on membership",
 			Subcommands: newAdminMembershipCommands(clientFactory),
 		},
+		{
+			Name:        "archival",
+			Usage:       "Run admin operation on archived workflow executions",
+			Subcommands: newAdminArchivalCommands(clientFactory),
+		},
+		{
+			Name:        "visibility",
+			Usage:       "Run admin operation on visibility stores",
+			Subcommands: newAdminVisibilityCommands(clientFactory),
+		},
+		{
+			Name:        "dynamic-config",
+			Aliases:     []string{"dc"},
+			Usage:       "Run admin operation on dynamic config",
+			Subcommands: newAdminDynamicConfigCommands(clientFactory),
+		},
 		{
 			Name:        "dlq",
 			Usage:       "Run admin operation on DLQ",
@@ -428,6 +444,155 @@ func newAdminMembershipCommands(clientFactory ClientFactory) []*cli.Command {
 	}
 }
 
+func newAdminArchivalCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "export",
+			Usage: "Export archived histories and visibility records of a namespace to a bundle",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagQuery,
+					Aliases: FlagQueryAlias,
+					Usage:   "Visibility query selecting the archived workflow executions to export",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Number of workflow executions fetched per request, at most 100",
+				},
+				&cli.StringFlag{
+					Name:     FlagOutputFilename,
+					Usage:    "Bundle file to write",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExportArchival(c, clientFactory)
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "Import every workflow history of a bundle into the namespace",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagInputFilename,
+					Usage:    "Bundle file to read",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminImportArchival(c, clientFactory)
+			},
+		},
+		{
+			Name:  "verify",
+			Usage: "Start a workflow in the system namespace verifying the archived histories of the namespace",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagQuery,
+					Aliases: FlagQueryAlias,
+					Usage:   "Visibility query selecting the archived workflow executions to verify",
+				},
+				&cli.Float64Flag{
+					Name:  FlagSampleRate,
+					Value: 1,
+					Usage: "Fraction of the selected workflow executions to verify",
+				},
+				&cli.BoolFlag{
+					Name:  FlagRearchive,
+					Usage: "Re-archive corrupted or missing histories of workflow executions which still exist",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartArchivalVerification(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminVisibilityCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "migrate",
+			Usage: "Start a workflow in the system namespace backfilling the secondary visibility store of the namespace from the primary one and verifying that they agree",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagQuery,
+					Aliases: FlagQueryAlias,
+					Usage:   "Visibility query selecting the workflow executions to migrate, in the syntax of the primary visibility store",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipBackfill,
+					Usage: "Only verify that the secondary visibility store agrees with the primary one",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartVisibilityMigration(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminDynamicConfigCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "evaluate",
+			Usage: "Evaluate a dynamic config setting on a host and show the value in effect and the configured value it came from",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagDynamicConfigKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagHostAddress,
+					Usage: "Address (IP:PORT) of the frontend or history host evaluating the setting, defaults to the frontend host serving the request",
+				},
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "Namespace ID constraint",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task Queue name constraint",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue type constraint: Workflow, Activity, Nexus",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "Shard ID constraint",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskType,
+					Usage: "History task type constraint, e.g. TransferActivityTask",
+				},
+				&cli.StringFlag{
+					Name:  FlagDestination,
+					Usage: "Destination constraint",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminEvaluateDynamicConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List registered dynamic config settings with their defaults",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagKeyPrefix,
+					Usage: "Only list settings whose key starts with this prefix, case-insensitive",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListDynamicConfigSettings(c, clientFactory)
+			},
+		},
+	}
+}
+
 func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 	return []*cli.Command{
 		{
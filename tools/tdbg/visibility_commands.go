@@ -0,0 +1,56 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives"
+)
+
+// AdminStartVisibilityMigration starts the visibility migration workflow for a namespace
+func AdminStartVisibilityMigration(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.StartVisibilityMigration(ctx, &adminservice.StartVisibilityMigrationRequest{
+		Namespace:    nsName,
+		Query:        c.String(FlagQuery),
+		SkipBackfill: c.Bool(FlagSkipBackfill),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start visibility migration: %s", err)
+	}
+
+	fmt.Fprintf(c.App.Writer, "Started visibility migration of namespace %s.\n", nsName)
+	fmt.Fprintf(c.App.Writer, "The migration report is the result of workflow %s, run %s, in namespace %s.\n",
+		resp.GetWorkflowId(), resp.GetRunId(), primitives.SystemLocalNamespace)
+	return nil
+}